@@ -0,0 +1,89 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten
+
+import (
+	"github.com/hajimehoshi/ebiten/v2/internal/ui"
+)
+
+// Announce sends the given text to the platform's screen reader, e.g., to read out the currently selected menu item.
+//
+// The text interrupts the previous announcement if the previous one is still being read out.
+//
+// On Windows, Announce uses UI Automation notifications. This works on Windows 10 version 1709 or newer.
+// On macOS, Announce uses NSAccessibility announcements.
+// On browsers, Announce uses an ARIA live region.
+// On Android, Announce uses View.announceForAccessibility.
+// On iOS, Announce uses UIAccessibility announcements.
+// On the other environments, Announce does nothing.
+//
+// On desktops, Announce does nothing before the game starts.
+//
+// Announce is concurrent-safe.
+func Announce(text string) {
+	ui.Get().Announce(text)
+}
+
+// IsReducedMotionPreferred reports whether the user prefers reduced motion in the system settings.
+//
+// A game should avoid large animations like screen shakes, flashes, and parallax scrolling when IsReducedMotionPreferred returns true.
+//
+// On Windows, IsReducedMotionPreferred reflects the 'Animation effects' setting.
+// On macOS, IsReducedMotionPreferred reflects the 'Reduce motion' setting.
+// On browsers, IsReducedMotionPreferred reflects the CSS media feature 'prefers-reduced-motion'.
+// On Android, IsReducedMotionPreferred reflects the 'Remove animations' setting.
+// On iOS, IsReducedMotionPreferred reflects the 'Reduce Motion' setting.
+// On the other environments, IsReducedMotionPreferred always returns false.
+//
+// On desktops, IsReducedMotionPreferred always returns false before RunGame is called.
+//
+// IsReducedMotionPreferred is concurrent-safe.
+func IsReducedMotionPreferred() bool {
+	return ui.Get().AccessibilityPreferences().ReducedMotion
+}
+
+// IsHighContrastPreferred reports whether the user prefers high contrast in the system settings.
+//
+// On Windows, IsHighContrastPreferred reflects the high contrast mode (contrast themes).
+// On macOS, IsHighContrastPreferred reflects the 'Increase contrast' setting.
+// On browsers, IsHighContrastPreferred reflects the CSS media features 'prefers-contrast' and 'forced-colors'.
+// On Android, IsHighContrastPreferred reflects the 'High contrast text' setting on a best-effort basis, as the setting is not a public API.
+// On iOS, IsHighContrastPreferred reflects the 'Increase Contrast' setting.
+// On the other environments, IsHighContrastPreferred always returns false.
+//
+// On desktops, IsHighContrastPreferred always returns false before RunGame is called.
+//
+// IsHighContrastPreferred is concurrent-safe.
+func IsHighContrastPreferred() bool {
+	return ui.Get().AccessibilityPreferences().HighContrast
+}
+
+// TextScaleFactor returns the scale factor of text sizes the user prefers in the system settings.
+//
+// TextScaleFactor returns 1 for the default text size.
+// A game can multiply its font sizes by TextScaleFactor to respect the setting.
+//
+// On Windows, TextScaleFactor reflects the 'Text size' setting.
+// On browsers, TextScaleFactor reflects the font size of the root element.
+// On Android, TextScaleFactor reflects the font scale.
+// On iOS, TextScaleFactor reflects the Dynamic Type size.
+// On the other environments, TextScaleFactor always returns 1.
+//
+// On desktops, TextScaleFactor always returns 1 before RunGame is called.
+//
+// TextScaleFactor is concurrent-safe.
+func TextScaleFactor() float64 {
+	return ui.Get().AccessibilityPreferences().TextScaleFactor
+}
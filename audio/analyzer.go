@@ -0,0 +1,170 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audio
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2/audio/internal/convert"
+)
+
+// AnalyzerOptions represents options for NewAnalyzer.
+type AnalyzerOptions struct {
+	// WindowSize is the number of frames to analyze at a time. A frame consists of a left sample and a right sample.
+	// WindowSize must be a power of 2.
+	//
+	// The default (zero) value is 1024.
+	WindowSize int
+}
+
+// Analyzer is an analysis tap for a stream, which is useful for music visualizers and beat-reactive gameplay.
+//
+// Analyzer passes the source stream through as it is, and keeps the recent data for analysis.
+// Pass an Analyzer to NewPlayer (or NewPlayerF32), and call the analysis functions with the player's position every tick.
+// As a player reads its source ahead by its buffer size, the position specifies which data is being heard.
+//
+// Analyzer keeps the data of about 1 second, so the player's buffer size must be less than 1 second.
+//
+// Analyzer is concurrent-safe.
+type Analyzer struct {
+	analyzer   *convert.Analyzer
+	sampleRate int
+	windowSize int
+
+	frames []float32
+	re     []float64
+	im     []float64
+	m      sync.Mutex
+}
+
+// NewAnalyzer creates a new analyzer for the given signed 16bit integer, little-endian, 2 channels (stereo) stream.
+// sampleRate is the sample rate of the stream.
+//
+// options can be nil.
+//
+// The returned value implements io.Seeker.
+// Seek works only when the source implements io.Seeker.
+func NewAnalyzer(source io.Reader, sampleRate int, options *AnalyzerOptions) *Analyzer {
+	return newAnalyzer(source, sampleRate, options, bitDepthInBytesInt16)
+}
+
+// NewAnalyzerF32 creates a new analyzer for the given 32bit float, little-endian, 2 channels (stereo) stream.
+//
+// For the details, see NewAnalyzer.
+func NewAnalyzerF32(source io.Reader, sampleRate int, options *AnalyzerOptions) *Analyzer {
+	return newAnalyzer(source, sampleRate, options, bitDepthInBytesFloat32)
+}
+
+func newAnalyzer(source io.Reader, sampleRate int, options *AnalyzerOptions, bitDepthInBytes int) *Analyzer {
+	if options == nil {
+		options = &AnalyzerOptions{}
+	}
+	windowSize := options.WindowSize
+	if windowSize == 0 {
+		windowSize = 1024
+	}
+	if windowSize < 0 || windowSize&(windowSize-1) != 0 {
+		panic(fmt.Sprintf("audio: WindowSize must be a power of 2 but %d", windowSize))
+	}
+	return &Analyzer{
+		analyzer:   convert.NewAnalyzer(source, bitDepthInBytes, sampleRate+windowSize),
+		sampleRate: sampleRate,
+		windowSize: windowSize,
+	}
+}
+
+// Read is implementation of io.Reader's Read.
+func (a *Analyzer) Read(buf []byte) (int, error) {
+	return a.analyzer.Read(buf)
+}
+
+// Seek is implementation of io.Seeker's Seek.
+//
+// Seek discards the kept data.
+func (a *Analyzer) Seek(offset int64, whence int) (int64, error) {
+	return a.analyzer.Seek(offset, whence)
+}
+
+// WindowSize returns the number of frames to analyze at a time.
+func (a *Analyzer) WindowSize() int {
+	return a.windowSize
+}
+
+func (a *Analyzer) appendWindow(frames []float32, position time.Duration) []float32 {
+	end := int64(position) * int64(a.sampleRate) / int64(time.Second)
+	return a.analyzer.AppendFrames(frames, end, a.windowSize)
+}
+
+// AppendSamples appends the samples of the window ending at the position to samples, and returns the extended slice.
+// position is usually the result of Player.Position.
+//
+// The appended samples are WindowSize frames of interleaved left and right samples in [-1, 1].
+// The samples that are not available, e.g. before the start of the stream, are zeros.
+func (a *Analyzer) AppendSamples(samples []float32, position time.Duration) []float32 {
+	return a.appendWindow(samples, position)
+}
+
+// RMS returns the root mean square levels of the left and right channels of the window ending at the position.
+// position is usually the result of Player.Position.
+//
+// The levels are in [0, 1]. A full-scale sine wave's level is about 0.707.
+func (a *Analyzer) RMS(position time.Duration) (left, right float64) {
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	a.frames = a.appendWindow(a.frames[:0], position)
+	var l, r float64
+	for i := 0; i < len(a.frames); i += 2 {
+		l += float64(a.frames[i]) * float64(a.frames[i])
+		r += float64(a.frames[i+1]) * float64(a.frames[i+1])
+	}
+	return math.Sqrt(l / float64(a.windowSize)), math.Sqrt(r / float64(a.windowSize))
+}
+
+// AppendSpectrum appends the magnitude spectrum of the window ending at the position to magnitudes,
+// and returns the extended slice.
+// position is usually the result of Player.Position.
+//
+// The spectrum is calculated by FFT for the average of the left and right channels with a Hann window.
+// The appended magnitudes are WindowSize/2 values, and the frequency of the i-th value is i * sampleRate / WindowSize.
+// The magnitudes are normalized so that a full-scale sine wave's magnitude is about 1.
+func (a *Analyzer) AppendSpectrum(magnitudes []float32, position time.Duration) []float32 {
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	a.frames = a.appendWindow(a.frames[:0], position)
+	n := a.windowSize
+	if len(a.re) != n {
+		a.re = make([]float64, n)
+		a.im = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		w := 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(n))
+		a.re[i] = w * (float64(a.frames[2*i]) + float64(a.frames[2*i+1])) / 2
+		a.im[i] = 0
+	}
+	convert.FFT(a.re, a.im)
+
+	// The sum of a Hann window is n/2, and a real sine wave's power is split into two bins.
+	scale := 4 / float64(n)
+	for i := 0; i < n/2; i++ {
+		magnitudes = append(magnitudes, float32(math.Hypot(a.re[i], a.im[i])*scale))
+	}
+	return magnitudes
+}
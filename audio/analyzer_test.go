@@ -0,0 +1,79 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audio_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+)
+
+func TestAnalyzer(t *testing.T) {
+	const (
+		sampleRate = 48000
+		windowSize = 1024
+		bin        = 32
+	)
+
+	src := make([]byte, 8*sampleRate)
+	for i := 0; i < sampleRate; i++ {
+		v := math.Float32bits(float32(math.Sin(2 * math.Pi * bin * float64(i) / windowSize)))
+		binary.LittleEndian.PutUint32(src[8*i:], v)
+		binary.LittleEndian.PutUint32(src[8*i+4:], v)
+	}
+
+	a := audio.NewAnalyzerF32(bytes.NewReader(src), sampleRate, &audio.AnalyzerOptions{
+		WindowSize: windowSize,
+	})
+	if _, err := io.Copy(io.Discard, a); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(a.AppendSamples(nil, time.Second)), 2*windowSize; got != want {
+		t.Errorf("len(AppendSamples): got: %d, want: %d", got, want)
+	}
+
+	l, r := a.RMS(time.Second)
+	if math.Abs(l-math.Sqrt2/2) > 1e-3 || math.Abs(r-math.Sqrt2/2) > 1e-3 {
+		t.Errorf("RMS: got: (%f, %f), want: (%f, %f)", l, r, math.Sqrt2/2, math.Sqrt2/2)
+	}
+
+	ms := a.AppendSpectrum(nil, time.Second)
+	if got, want := len(ms), windowSize/2; got != want {
+		t.Fatalf("len(AppendSpectrum): got: %d, want: %d", got, want)
+	}
+	for i, m := range ms {
+		var want float32
+		switch i {
+		case bin:
+			want = 1
+		case bin - 1, bin + 1:
+			want = 0.5
+		}
+		if math.Abs(float64(m-want)) > 1e-3 {
+			t.Errorf("magnitude[%d]: got: %f, want: %f", i, m, want)
+		}
+	}
+
+	// The data before the stream's start is silent.
+	if l, r := a.RMS(0); l != 0 || r != 0 {
+		t.Errorf("RMS at 0: got: (%f, %f), want: (0, 0)", l, r)
+	}
+}
@@ -36,9 +36,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"reflect"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2/audio/internal/convert"
@@ -66,6 +68,15 @@ type Context struct {
 
 	playingPlayers map[*playerImpl]struct{}
 
+	// pausedPlayers is the players paused by PauseAll.
+	pausedPlayers []*playerImpl
+
+	// timeScale is the time scale in bits of float64.
+	timeScale atomic.Uint64
+
+	// underrunCount is the number of detected buffer underruns.
+	underrunCount atomic.Int64
+
 	m         sync.Mutex
 	semaphore chan struct{}
 }
@@ -80,9 +91,36 @@ var (
 // sampleRate specifies the number of samples that should be played during one second.
 // Usual numbers are 44100 or 48000. One context has only one sample rate. You cannot play multiple audio
 // sources with different sample rates at the same time.
+// To play a source with a different sample rate, convert it with e.g. ResampleReaderWithOptions.
 //
 // NewContext panics when an audio context is already created.
 func NewContext(sampleRate int) *Context {
+	return NewContextWithOptions(&NewContextOptions{
+		SampleRate: sampleRate,
+	})
+}
+
+// NewContextOptions represents options for NewContextWithOptions.
+type NewContextOptions struct {
+	// SampleRate specifies the number of samples that should be played during one second.
+	// See NewContext for details.
+	SampleRate int
+
+	// BufferSize specifies the buffer size of the underlying audio device.
+	//
+	// A smaller buffer size reduces the latency, which is useful e.g. for rhythm games,
+	// but too small buffer size can cause glitch noises due to buffer underruns.
+	// See also Context.UnderrunCount.
+	//
+	// If BufferSize is 0, the driver's default buffer size is used.
+	// The buffer size might be adjusted by the driver.
+	BufferSize time.Duration
+}
+
+// NewContextWithOptions creates a new audio context with the given options.
+//
+// NewContextWithOptions panics when an audio context is already created.
+func NewContextWithOptions(options *NewContextOptions) *Context {
 	theContextLock.Lock()
 	defer theContextLock.Unlock()
 
@@ -90,12 +128,14 @@ func NewContext(sampleRate int) *Context {
 		panic("audio: context is already created")
 	}
 
+	sampleRate := options.SampleRate
 	c := &Context{
 		sampleRate:     sampleRate,
-		playerFactory:  newPlayerFactory(sampleRate),
+		playerFactory:  newPlayerFactory(sampleRate, options.BufferSize),
 		playingPlayers: map[*playerImpl]struct{}{},
 		semaphore:      make(chan struct{}, 1),
 	}
+	c.timeScale.Store(math.Float64bits(1))
 	theContext = c
 
 	h := getHook()
@@ -299,6 +339,84 @@ func (c *Context) updatePlayers() error {
 	return nil
 }
 
+// PauseAll pauses all the currently playing players.
+// The paused players can be resumed by ResumeAll.
+//
+// The players paused by PauseAll are treated as paused, e.g., IsPlaying returns false for them.
+// If PauseAll is called again before ResumeAll, the players paused by the previous PauseAll are kept paused
+// and are also resumed by ResumeAll.
+//
+// PauseAll is useful to pause the game, e.g., when a pause menu is shown.
+// Players started after PauseAll, e.g. for menu sounds, are not affected by ResumeAll.
+//
+// PauseAll is concurrent-safe.
+func (c *Context) PauseAll() {
+	// A Context must not call playerImpl's functions with a lock, or this causes a deadlock (#2737).
+	// Copy the playerImpls and iterate them without a lock.
+	c.m.Lock()
+	players := make([]*playerImpl, 0, len(c.playingPlayers))
+	for p := range c.playingPlayers {
+		players = append(players, p)
+	}
+	c.m.Unlock()
+
+	var paused []*playerImpl
+	for _, p := range players {
+		if p.pauseByContext() {
+			paused = append(paused, p)
+		}
+	}
+
+	c.m.Lock()
+	c.pausedPlayers = append(c.pausedPlayers, paused...)
+	c.m.Unlock()
+}
+
+// ResumeAll resumes all the players paused by PauseAll.
+//
+// A player is not resumed if the player is closed, or Play or Pause was called for the player after PauseAll.
+//
+// ResumeAll is concurrent-safe.
+func (c *Context) ResumeAll() {
+	c.m.Lock()
+	players := c.pausedPlayers
+	c.pausedPlayers = nil
+	c.m.Unlock()
+
+	for _, p := range players {
+		p.resumeIfPausedByContext()
+	}
+}
+
+// SetTimeScale sets the time scale of all the players.
+//
+// The time scale changes the playback speed of all the players proportionally, e.g. for slow-motion effects.
+// The pitch is changed together with the speed.
+// For example, 0.5 means that all the players play their sources at half speed, one octave lower.
+// The time scale is applied to the players including ones created after SetTimeScale.
+//
+// The change is applied after the data already sent to the audio device is played,
+// so there is a latency depending on the buffer size.
+//
+// scale must be positive. Otherwise, SetTimeScale panics.
+//
+// The default time scale is 1.
+//
+// SetTimeScale is concurrent-safe.
+func (c *Context) SetTimeScale(scale float64) {
+	if scale <= 0 || math.IsInf(scale, 0) || math.IsNaN(scale) {
+		panic(fmt.Sprintf("audio: scale must be a positive finite number but %f", scale))
+	}
+	c.timeScale.Store(math.Float64bits(scale))
+}
+
+// TimeScale returns the current time scale.
+//
+// TimeScale is concurrent-safe.
+func (c *Context) TimeScale() float64 {
+	return math.Float64frombits(c.timeScale.Load())
+}
+
 // IsReady returns a boolean value indicating whether the audio is ready or not.
 //
 // On some browsers, user interaction like click or pressing keys is required to start audio.
@@ -308,6 +426,27 @@ func (c *Context) IsReady() bool {
 	return c.ready
 }
 
+// RequestedBufferSize returns the buffer size of the underlying audio device requested at NewContextWithOptions.
+//
+// The actual buffer size might differ as the driver might adjust it, and the actual size is not available.
+// RequestedBufferSize returns 0 if the driver's default buffer size is used.
+//
+// RequestedBufferSize is concurrent-safe.
+func (c *Context) RequestedBufferSize() time.Duration {
+	return c.playerFactory.bufferSize
+}
+
+// UnderrunCount returns the number of detected buffer underruns so far.
+//
+// A buffer underrun happens when a playing player runs out of its buffered data before reaching the end of its source,
+// e.g., because the source is too slow or the buffer size is too small.
+// The detection is done periodically and is approximate, so short underruns might not be counted.
+//
+// UnderrunCount is concurrent-safe.
+func (c *Context) UnderrunCount() int {
+	return int(c.underrunCount.Load())
+}
+
 // SampleRate returns the sample rate.
 func (c *Context) SampleRate() int {
 	return c.sampleRate
@@ -519,6 +658,16 @@ func (p *Player) SetVolume(volume float64) {
 	p.p.SetVolume(volume)
 }
 
+// Latency returns the duration of the audio data that is buffered in the player but not played yet.
+// This is the delay from when the source is read until it is heard.
+//
+// The buffer of the underlying audio device is not included, as its size is not available from the driver.
+//
+// Latency is concurrent-safe.
+func (p *Player) Latency() time.Duration {
+	return p.p.Latency()
+}
+
 // SetBufferSize adjusts the buffer size of the player.
 // If 0 is specified, the default buffer size is used.
 // A small buffer size is useful if you want to play a real-time PCM for example.
@@ -590,6 +739,89 @@ func ResampleReaderF32(source io.Reader, size int64, from, to int) io.Reader {
 	return convert.NewResampling(source, size, from, to, bitDepthInBytesFloat32)
 }
 
+// ResamplingQuality represents the quality of resampling.
+type ResamplingQuality int
+
+const (
+	// ResamplingQualityMedium is the default quality, which is used by ResampleReader.
+	ResamplingQualityMedium ResamplingQuality = iota
+
+	// ResamplingQualityLow is a lower quality, which costs less.
+	ResamplingQualityLow
+
+	// ResamplingQualityHigh is a higher quality, which reduces aliasing but costs more.
+	ResamplingQualityHigh
+)
+
+func (q ResamplingQuality) windowSize() int {
+	switch q {
+	case ResamplingQualityLow:
+		return 2
+	case ResamplingQualityHigh:
+		return 32
+	default:
+		return convert.DefaultResamplingWindowSize
+	}
+}
+
+// ResampleOptions represents options for ResampleReaderWithOptions and ResampleReaderF32WithOptions.
+type ResampleOptions struct {
+	// Quality is the quality of resampling.
+	// The default (zero) value is ResamplingQualityMedium.
+	Quality ResamplingQuality
+}
+
+// ResampleReaderWithOptions converts the sample rate of the given singed 16bit integer, little-endian, 2 channels (stereo) stream with the given options.
+//
+// options can be nil. For the other details, see ResampleReader.
+func ResampleReaderWithOptions(source io.Reader, size int64, from, to int, options *ResampleOptions) io.Reader {
+	if from == to {
+		return source
+	}
+	if options == nil {
+		options = &ResampleOptions{}
+	}
+	return convert.NewResamplingWithWindowSize(source, size, from, to, bitDepthInBytesInt16, options.Quality.windowSize())
+}
+
+// ResampleReaderF32WithOptions converts the sample rate of the given 32bit float, little-endian, 2 channels (stereo) stream with the given options.
+//
+// options can be nil. For the other details, see ResampleReaderF32.
+func ResampleReaderF32WithOptions(source io.Reader, size int64, from, to int, options *ResampleOptions) io.Reader {
+	if from == to {
+		return source
+	}
+	if options == nil {
+		options = &ResampleOptions{}
+	}
+	return convert.NewResamplingWithWindowSize(source, size, from, to, bitDepthInBytesFloat32, options.Quality.windowSize())
+}
+
+// TimeStretchReader changes the playback speed of the given singed 16bit integer, little-endian, 2 channels (stereo) stream
+// without changing its pitch.
+// This is useful e.g. to fast-forward dialogues or to slow down music.
+//
+// speed is called periodically to get the current speed, and the speed can be changed dynamically.
+// 1 is the original speed, 2 is twice as fast, and 0.5 is half as fast. speed must return a positive value.
+//
+// Unlike Context.SetTimeScale, which changes the pitch together with the speed like a tape,
+// TimeStretchReader keeps the pitch with WSOLA (Waveform Similarity based Overlap-Add).
+// This causes a small delay and some artifacts, so TimeStretchReader is not suitable for short sound effects.
+//
+// The returned value implements io.Seeker.
+// Seek works only when the source implements io.Seeker, and the offset is a position in the source.
+func TimeStretchReader(source io.Reader, speed func() float64) io.Reader {
+	return convert.NewTimeStretched(source, bitDepthInBytesInt16, speed)
+}
+
+// TimeStretchReaderF32 changes the playback speed of the given 32bit float, little-endian, 2 channels (stereo) stream
+// without changing its pitch.
+//
+// For the details, see TimeStretchReader.
+func TimeStretchReaderF32(source io.Reader, speed func() float64) io.Reader {
+	return convert.NewTimeStretched(source, bitDepthInBytesFloat32, speed)
+}
+
 // Resample converts the sample rate of the given singed 16bit integer, little-endian, 2 channels (stereo) stream.
 // size is the length of the source stream in bytes.
 // from is the original sample rate.
@@ -178,3 +178,102 @@ func TestUncomparableSource(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestPauseAllAndResumeAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	p0, err := context.NewPlayer(bytes.NewReader(make([]byte, 1<<22)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p0.Close()
+	p1, err := context.NewPlayer(bytes.NewReader(make([]byte, 1<<22)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p1.Close()
+	p2, err := context.NewPlayer(bytes.NewReader(make([]byte, 1<<22)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p2.Close()
+
+	p0.Play()
+	p1.Play()
+
+	context.PauseAll()
+	if p0.IsPlaying() || p1.IsPlaying() {
+		t.Errorf("all the players must be paused after PauseAll")
+	}
+
+	// p1 is explicitly paused after PauseAll, and must not be resumed by ResumeAll.
+	p1.Pause()
+	// p2 is played after PauseAll, and must not be affected by ResumeAll.
+	p2.Play()
+
+	context.ResumeAll()
+	if !p0.IsPlaying() {
+		t.Errorf("p0 must be resumed after ResumeAll")
+	}
+	if p1.IsPlaying() {
+		t.Errorf("p1 must not be resumed after ResumeAll")
+	}
+	if !p2.IsPlaying() {
+		t.Errorf("p2 must be playing after ResumeAll")
+	}
+}
+
+func TestSetTimeScale(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if got, want := context.TimeScale(), 1.0; got != want {
+		t.Errorf("TimeScale(): got: %f, want: %f", got, want)
+	}
+	context.SetTimeScale(0.5)
+	if got, want := context.TimeScale(), 0.5; got != want {
+		t.Errorf("TimeScale(): got: %f, want: %f", got, want)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("SetTimeScale(0) must panic")
+		}
+	}()
+	context.SetTimeScale(0)
+}
+
+func TestSound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// 1 second of 16bit stereo data.
+	src := make([]byte, 44100*4)
+	s, err := context.NewSoundFromStream(bytes.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.Duration(), time.Second; got != want {
+		t.Errorf("Duration(): got: %v, want: %v", got, want)
+	}
+
+	// Multiple voices of the same sound can be played at the same time.
+	v0 := s.Play()
+	defer v0.Close()
+	v1 := s.NewVoice()
+	defer v1.Close()
+	v1.SetVolume(0.5)
+	v1.SetPan(-2)
+	if got, want := v1.Pan(), -1.0; got != want {
+		t.Errorf("Pan(): got: %f, want: %f", got, want)
+	}
+	v1.Play()
+
+	if !v0.IsPlaying() || !v1.IsPlaying() {
+		t.Errorf("all the voices must be playing")
+	}
+	if err := audio.UpdateForTesting(); err != nil {
+		t.Error(err)
+	}
+}
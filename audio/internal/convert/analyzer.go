@@ -0,0 +1,164 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"errors"
+	"io"
+	"math"
+	"math/bits"
+	"sync"
+)
+
+// Analyzer is a reader to pass a stereo source through as it is, recording the recent samples for analysis.
+//
+// Read is called on the audio goroutine and the other functions can be called on other goroutines.
+type Analyzer struct {
+	source          io.Reader
+	bitDepthInBytes int
+
+	// extra is the remainder in the case when the read byte sizes are not multiple of the frame size.
+	extra []byte
+
+	// history is a ring buffer of the recent frames. A frame consists of a left sample and a right sample.
+	history []float32
+
+	// head is the index of the next frame to write in history.
+	head int
+
+	// pos is the position in frames of the end of the read data.
+	pos int64
+
+	m sync.Mutex
+}
+
+// NewAnalyzer creates a new Analyzer that keeps historySize frames at most.
+func NewAnalyzer(source io.Reader, bitDepthInBytes int, historySize int) *Analyzer {
+	return &Analyzer{
+		source:          source,
+		bitDepthInBytes: bitDepthInBytes,
+		history:         make([]float32, 2*historySize),
+	}
+}
+
+func (a *Analyzer) Read(buf []byte) (int, error) {
+	n, err := a.source.Read(buf)
+
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	bytesPerFrame := 2 * a.bitDepthInBytes
+	data := buf[:n]
+	if len(a.extra) > 0 {
+		data = append(a.extra, data...)
+		a.extra = nil
+	}
+	frames := len(data) / bytesPerFrame
+	historySize := len(a.history) / 2
+	for i := 0; i < frames; i++ {
+		b := data[i*bytesPerFrame:]
+		a.history[2*a.head] = float32(readSample(b, a.bitDepthInBytes))
+		a.history[2*a.head+1] = float32(readSample(b[a.bitDepthInBytes:], a.bitDepthInBytes))
+		a.head++
+		if a.head == historySize {
+			a.head = 0
+		}
+	}
+	a.pos += int64(frames)
+	if r := data[frames*bytesPerFrame:]; len(r) > 0 {
+		a.extra = append([]byte{}, r...)
+	}
+
+	return n, err
+}
+
+func (a *Analyzer) Seek(offset int64, whence int) (int64, error) {
+	s, ok := a.source.(io.Seeker)
+	if !ok {
+		return 0, errors.New("convert: the source must be io.Seeker when seeking")
+	}
+	pos, err := s.Seek(offset, whence)
+	if err != nil {
+		return 0, err
+	}
+
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	clear(a.history)
+	a.head = 0
+	a.pos = pos / int64(2*a.bitDepthInBytes)
+	a.extra = nil
+	return pos, nil
+}
+
+// AppendFrames appends n frames ending at the position end in frames to dst, and returns the extended slice.
+// The frames that are not in the history are zeros.
+func (a *Analyzer) AppendFrames(dst []float32, end int64, n int) []float32 {
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	historySize := int64(len(a.history) / 2)
+	for p := end - int64(n); p < end; p++ {
+		// d is the distance from the latest frame.
+		d := a.pos - 1 - p
+		if p < 0 || d < 0 || d >= historySize {
+			dst = append(dst, 0, 0)
+			continue
+		}
+		i := (int64(a.head) - 1 - d + historySize) % historySize
+		dst = append(dst, a.history[2*i], a.history[2*i+1])
+	}
+	return dst
+}
+
+// FFT calculates the discrete Fourier transform of (re, im) in place.
+// The length of re and im must be the same power of 2.
+func FFT(re, im []float64) {
+	n := len(re)
+	if n != len(im) || n&(n-1) != 0 {
+		panic("convert: the lengths of re and im must be the same power of 2")
+	}
+	if n <= 1 {
+		return
+	}
+
+	// Reorder the elements in the bit-reversed order.
+	shift := 64 - bits.TrailingZeros(uint(n))
+	for i := 0; i < n; i++ {
+		j := int(bits.Reverse64(uint64(i)) >> shift)
+		if i < j {
+			re[i], re[j] = re[j], re[i]
+			im[i], im[j] = im[j], im[i]
+		}
+	}
+
+	for size := 2; size <= n; size <<= 1 {
+		half := size / 2
+		theta := -2 * math.Pi / float64(size)
+		for k := 0; k < half; k++ {
+			wi, wr := math.Sincos(theta * float64(k))
+			for i := k; i < n; i += size {
+				j := i + half
+				tr := wr*re[j] - wi*im[j]
+				ti := wr*im[j] + wi*re[j]
+				re[j] = re[i] - tr
+				im[j] = im[i] - ti
+				re[i] += tr
+				im[i] += ti
+			}
+		}
+	}
+}
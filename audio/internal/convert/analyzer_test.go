@@ -0,0 +1,98 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2/audio/internal/convert"
+)
+
+// oneByteReader reads one byte at a time to test frames across Read calls.
+type oneByteReader struct {
+	r io.Reader
+}
+
+func (o *oneByteReader) Read(buf []byte) (int, error) {
+	if len(buf) == 0 {
+		return 0, nil
+	}
+	return o.r.Read(buf[:1])
+}
+
+func TestAnalyzer(t *testing.T) {
+	const n = 16
+	in := make([]byte, 4*n)
+	for i := 0; i < n; i++ {
+		binary.LittleEndian.PutUint16(in[4*i:], uint16(int16(i*0x100)))
+		binary.LittleEndian.PutUint16(in[4*i+2:], uint16(int16(-i*0x100)))
+	}
+
+	a := convert.NewAnalyzer(&oneByteReader{r: bytes.NewReader(in)}, 2, 8)
+	out, err := io.ReadAll(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, in) {
+		t.Errorf("the output must be the same as the input")
+	}
+
+	// Only the last 8 frames are in the history.
+	got := a.AppendFrames(nil, 12, 6)
+	for i := 0; i < 6; i++ {
+		p := 6 + i
+		var wantL, wantR float32
+		if p >= n-8 {
+			wantL = float32(p*0x100) / (1 << 15)
+			wantR = -wantL
+		}
+		if got[2*i] != wantL || got[2*i+1] != wantR {
+			t.Errorf("frame %d: got: (%f, %f), want: (%f, %f)", p, got[2*i], got[2*i+1], wantL, wantR)
+		}
+	}
+
+	// The frames after the read data are zeros.
+	got = a.AppendFrames(nil, n+2, 4)
+	if got[2] == 0 || got[4] != 0 || got[6] != 0 {
+		t.Errorf("got: %v", got)
+	}
+}
+
+func TestFFT(t *testing.T) {
+	const (
+		n   = 64
+		bin = 5
+	)
+	re := make([]float64, n)
+	im := make([]float64, n)
+	for i := range re {
+		re[i] = math.Cos(2 * math.Pi * bin * float64(i) / n)
+	}
+	convert.FFT(re, im)
+	for i := range re {
+		mag := math.Hypot(re[i], im[i])
+		want := 0.0
+		if i == bin || i == n-bin {
+			want = n / 2
+		}
+		if math.Abs(mag-want) > 1e-9 {
+			t.Errorf("magnitude[%d]: got: %f, want: %f", i, mag, want)
+		}
+	}
+}
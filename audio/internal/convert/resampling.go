@@ -92,9 +92,19 @@ type Resampling struct {
 	lruSrcBlocks    []int64
 	eof             bool
 	eofBufIndex     int64
+	windowSize      float64
 }
 
+// DefaultResamplingWindowSize is the default window size of the windowed sinc filter for resampling.
+const DefaultResamplingWindowSize = 8
+
 func NewResampling(source io.Reader, size int64, from, to int, bitDepthInBytes int) *Resampling {
+	return NewResamplingWithWindowSize(source, size, from, to, bitDepthInBytes, DefaultResamplingWindowSize)
+}
+
+// NewResamplingWithWindowSize creates a Resampling with the given window size of the windowed sinc filter.
+// A bigger window size improves the quality but costs more.
+func NewResamplingWithWindowSize(source io.Reader, size int64, from, to int, bitDepthInBytes int, windowSize int) *Resampling {
 	r := &Resampling{
 		source:          source,
 		size:            size,
@@ -105,6 +115,7 @@ func NewResampling(source io.Reader, size int64, from, to int, bitDepthInBytes i
 		srcBufL:         map[int64][]float64{},
 		srcBufR:         map[int64][]float64{},
 		eofBufIndex:     -1,
+		windowSize:      float64(windowSize),
 	}
 	return r
 }
@@ -208,7 +219,7 @@ func (r *Resampling) src(i int64) (float64, float64, error) {
 }
 
 func (r *Resampling) at(t int64) (float64, float64, error) {
-	windowSize := 8.0
+	windowSize := r.windowSize
 	tInSrc := float64(t) * float64(r.from) / float64(r.to)
 	startN := int64(tInSrc - windowSize)
 	if startN < 0 {
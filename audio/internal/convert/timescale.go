@@ -0,0 +1,190 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// TimeScaled is a reader to change the playback speed of a stereo source dynamically.
+// The pitch is changed together with the speed like a tape.
+type TimeScaled struct {
+	source          io.Reader
+	bitDepthInBytes int
+	scale           func() float64
+
+	// buf is the buffered source bytes that are not consumed yet.
+	buf []byte
+
+	// backing is the backing array of buf.
+	backing []byte
+
+	// pos is the fractional position in samples from the head of buf.
+	pos float64
+
+	err error
+}
+
+// NewTimeScaled returns a new TimeScaled.
+//
+// scale is called at every Read to get the current speed scale. The scale must be positive.
+func NewTimeScaled(source io.Reader, bitDepthInBytes int, scale func() float64) *TimeScaled {
+	return &TimeScaled{
+		source:          source,
+		bitDepthInBytes: bitDepthInBytes,
+		scale:           scale,
+	}
+}
+
+func (t *TimeScaled) bytesPerSample() int {
+	const channelNum = 2
+	return t.bitDepthInBytes * channelNum
+}
+
+func (t *TimeScaled) Read(b []byte) (int, error) {
+	bps := t.bytesPerSample()
+	b = b[:len(b)/bps*bps]
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	if scale := t.scale(); scale != 1 {
+		return t.readScaled(b, scale)
+	}
+
+	// Snap the position to the nearest sample and pass the data through.
+	t.consume(int(math.Round(t.pos)))
+	t.pos = 0
+	if len(t.buf) > 0 {
+		n := copy(b, t.buf)
+		t.buf = t.buf[n:]
+		return n, nil
+	}
+	if t.err != nil {
+		return 0, t.err
+	}
+	return t.source.Read(b)
+}
+
+func (t *TimeScaled) readScaled(b []byte, scale float64) (int, error) {
+	bps := t.bytesPerSample()
+
+	var n int
+	for n < len(b) {
+		i := int(t.pos)
+		if (i+2)*bps > len(t.buf) {
+			t.consume(i)
+			if !t.fill(2 * bps) {
+				break
+			}
+			continue
+		}
+
+		f := t.pos - float64(i)
+		for ch := 0; ch < 2; ch++ {
+			v0 := t.sample(i*bps + ch*t.bitDepthInBytes)
+			v1 := t.sample((i+1)*bps + ch*t.bitDepthInBytes)
+			t.putSample(b[n+ch*t.bitDepthInBytes:], v0*(1-f)+v1*f)
+		}
+		n += bps
+		t.pos += scale
+	}
+
+	if n == 0 && t.err != nil {
+		return 0, t.err
+	}
+	return n, nil
+}
+
+// consume drops the first n samples from the buffer.
+func (t *TimeScaled) consume(n int) {
+	l := min(n*t.bytesPerSample(), len(t.buf))
+	t.buf = t.buf[l:]
+	t.pos -= float64(l / t.bytesPerSample())
+}
+
+// fill reads the source until the buffer has at least size bytes.
+// fill returns false if the source doesn't have enough data for now.
+func (t *TimeScaled) fill(size int) bool {
+	const readSize = 4096
+
+	for len(t.buf) < size {
+		if t.err != nil {
+			return false
+		}
+		if cap(t.buf)-len(t.buf) < readSize {
+			// Move the buffered bytes to the head of the backing array to reuse it.
+			if len(t.backing) < len(t.buf)+readSize {
+				t.backing = make([]byte, len(t.buf)+readSize)
+			}
+			t.buf = t.backing[:copy(t.backing, t.buf)]
+		}
+		n, err := t.source.Read(t.buf[len(t.buf):cap(t.buf)])
+		t.buf = t.buf[:len(t.buf)+n]
+		if err != nil {
+			t.err = err
+		}
+		if n == 0 && err == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *TimeScaled) sample(offset int) float64 {
+	return readSample(t.buf[offset:], t.bitDepthInBytes)
+}
+
+func (t *TimeScaled) putSample(b []byte, v float64) {
+	writeSample(b, v, t.bitDepthInBytes)
+}
+
+// readSample reads a sample of one channel from b.
+func readSample(b []byte, bitDepthInBytes int) float64 {
+	switch bitDepthInBytes {
+	case 2:
+		return float64(int16(binary.LittleEndian.Uint16(b))) / (1 << 15)
+	case 4:
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(b)))
+	default:
+		panic("convert: bitDepthInBytes must be 2 or 4")
+	}
+}
+
+// writeSample writes a sample of one channel to b.
+func writeSample(b []byte, v float64, bitDepthInBytes int) {
+	switch bitDepthInBytes {
+	case 2:
+		binary.LittleEndian.PutUint16(b, uint16(int16(max(-1, min(v, 1-1.0/(1<<15)))*(1<<15))))
+	case 4:
+		binary.LittleEndian.PutUint32(b, math.Float32bits(float32(v)))
+	default:
+		panic("convert: bitDepthInBytes must be 2 or 4")
+	}
+}
+
+func (t *TimeScaled) Seek(offset int64, whence int) (int64, error) {
+	s, ok := t.source.(io.Seeker)
+	if !ok {
+		return 0, errors.New("convert: the source must be io.Seeker when seeking")
+	}
+	t.buf = nil
+	t.pos = 0
+	t.err = nil
+	return s.Seek(offset, whence)
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2/audio/internal/convert"
+)
+
+func TestTimeScaled(t *testing.T) {
+	const n = 1000
+
+	in := make([]byte, 4*n)
+	for i := 0; i < n; i++ {
+		binary.LittleEndian.PutUint16(in[4*i:], uint16(int16(i)))
+		binary.LittleEndian.PutUint16(in[4*i+2:], uint16(int16(-i)))
+	}
+
+	for _, scale := range []float64{1, 0.5, 2} {
+		r := convert.NewTimeScaled(bytes.NewReader(in), 2, func() float64 {
+			return scale
+		})
+		out, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The last sample is not output when the speed is scaled, as there is no next sample to interpolate.
+		want := int(float64(n-1)/scale) + 1
+		if scale == 1 {
+			want = n
+		}
+		if got := len(out) / 4; got < want-1 || got > want {
+			t.Errorf("scale: %f, len(out)/4: got: %d, want: %d", scale, got, want)
+		}
+		for i := 0; i < len(out)/4; i++ {
+			l := int16(binary.LittleEndian.Uint16(out[4*i:]))
+			r := int16(binary.LittleEndian.Uint16(out[4*i+2:]))
+			if want := int16(float64(i) * scale); l < want-1 || l > want+1 {
+				t.Errorf("scale: %f, left[%d]: got: %d, want: %d", scale, i, l, want)
+			}
+			if want := -int16(float64(i) * scale); r < want-1 || r > want+1 {
+				t.Errorf("scale: %f, right[%d]: got: %d, want: %d", scale, i, r, want)
+			}
+		}
+	}
+}
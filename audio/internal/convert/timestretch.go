@@ -0,0 +1,286 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"errors"
+	"io"
+	"math"
+	"sync"
+)
+
+const (
+	// stretchFrameSize is the size of a frame in samples for WSOLA.
+	stretchFrameSize = 1024
+
+	// stretchHopSize is the distance between two adjacent output frames in samples.
+	stretchHopSize = stretchFrameSize / 2
+
+	// stretchTolerance is the maximum shift in samples to search for the best-matching frame.
+	stretchTolerance = stretchFrameSize / 4
+)
+
+var (
+	// stretchWindow is a Hann window of stretchFrameSize.
+	// It must be initialised the first time it is referenced
+	// in a function via its lazy load wrapper ensureStretchWindow().
+	stretchWindow     []float64
+	stretchWindowOnce sync.Once
+)
+
+func ensureStretchWindow() []float64 {
+	stretchWindowOnce.Do(func() {
+		stretchWindow = make([]float64, stretchFrameSize)
+		for i := range stretchWindow {
+			stretchWindow[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/stretchFrameSize)
+		}
+	})
+	return stretchWindow
+}
+
+// TimeStretched is a reader to change the playback speed of a stereo source dynamically without changing its pitch.
+//
+// TimeStretched uses WSOLA (Waveform Similarity based Overlap-Add).
+// Frames are taken from the source at the intervals scaled by the speed, shifted slightly to match the previous frame's waveform,
+// and overlapped at the constant intervals.
+type TimeStretched struct {
+	source          io.Reader
+	bitDepthInBytes int
+	speed           func() float64
+
+	// in is the buffered source samples. The left and right channels are interleaved.
+	in []float64
+
+	// inHead is the position in samples of the head of in in the source.
+	inHead int64
+
+	// raw is the bytes read from the source that are not converted into in yet.
+	raw []byte
+
+	// srcErr is the error returned by the source.
+	srcErr error
+
+	// analysisPos is the ideal position in samples of the next frame in the source.
+	analysisPos float64
+
+	// prevPos is the position in samples of the previous frame in the source.
+	prevPos int64
+
+	started bool
+
+	// tail is the windowed latter half of the previous frame, which is overlapped with the next frame.
+	tail []float64
+
+	// out is the output samples that are not read yet. The left and right channels are interleaved.
+	out []float64
+
+	err error
+}
+
+// NewTimeStretched returns a new TimeStretched.
+//
+// speed is called for every frame to get the current speed. The speed must be positive.
+func NewTimeStretched(source io.Reader, bitDepthInBytes int, speed func() float64) *TimeStretched {
+	return &TimeStretched{
+		source:          source,
+		bitDepthInBytes: bitDepthInBytes,
+		speed:           speed,
+	}
+}
+
+func (t *TimeStretched) bytesPerSample() int {
+	const channelNum = 2
+	return t.bitDepthInBytes * channelNum
+}
+
+func (t *TimeStretched) Read(b []byte) (int, error) {
+	bps := t.bytesPerSample()
+	b = b[:len(b)/bps*bps]
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	for len(t.out)/2 < len(b)/bps {
+		if !t.step() {
+			break
+		}
+	}
+
+	n := min(len(t.out)/2, len(b)/bps)
+	if n == 0 {
+		return 0, t.err
+	}
+	for i := 0; i < n; i++ {
+		writeSample(b[i*bps:], t.out[2*i], t.bitDepthInBytes)
+		writeSample(b[i*bps+t.bitDepthInBytes:], t.out[2*i+1], t.bitDepthInBytes)
+	}
+	t.out = t.out[2*n:]
+	return n * bps, nil
+}
+
+// step appends the next output frame to out.
+// step returns false if no more output is available for now.
+func (t *TimeStretched) step() bool {
+	if t.err != nil {
+		return false
+	}
+
+	center := int64(math.Round(t.analysisPos))
+	if !t.fill(center + stretchTolerance + stretchFrameSize) {
+		return false
+	}
+
+	// At the end of the source, output the remaining tail and finish.
+	if t.srcErr != nil && center >= t.inHead+int64(len(t.in)/2) {
+		t.out = append(t.out, t.tail...)
+		t.tail = nil
+		t.err = t.srcErr
+		return len(t.out) > 0
+	}
+
+	pos := center
+	if t.started {
+		pos = t.findBestPosition(center)
+	}
+
+	w := ensureStretchWindow()
+	for i := 0; i < stretchHopSize; i++ {
+		for ch := 0; ch < 2; ch++ {
+			v := t.at(pos+int64(i), ch)
+			if t.started {
+				v = t.tail[2*i+ch] + w[i]*v
+			}
+			t.out = append(t.out, v)
+		}
+	}
+	if t.tail == nil {
+		t.tail = make([]float64, 2*stretchHopSize)
+	}
+	for i := 0; i < stretchHopSize; i++ {
+		for ch := 0; ch < 2; ch++ {
+			t.tail[2*i+ch] = w[stretchHopSize+i] * t.at(pos+stretchHopSize+int64(i), ch)
+		}
+	}
+
+	t.prevPos = pos
+	t.started = true
+	speed := t.speed()
+	if speed <= 0 {
+		panic("convert: speed must be positive")
+	}
+	t.analysisPos += stretchHopSize * speed
+
+	// Drop the source samples that are no longer needed.
+	keep := min(t.prevPos+stretchHopSize, int64(math.Floor(t.analysisPos))-stretchTolerance)
+	if drop := keep - t.inHead; drop > 0 {
+		drop = min(drop, int64(len(t.in)/2))
+		t.in = t.in[2*drop:]
+		t.inHead += drop
+	}
+	return true
+}
+
+// findBestPosition returns the position around center whose waveform is the most similar to
+// the natural continuation of the previous frame.
+func (t *TimeStretched) findBestPosition(center int64) int64 {
+	natural := t.prevPos + stretchHopSize
+
+	best := center
+	bestCorr := math.Inf(-1)
+	for d := int64(-stretchTolerance); d <= stretchTolerance; d++ {
+		pos := center + d
+		if pos < t.inHead {
+			continue
+		}
+		var corr float64
+		// Compare every other sample, which is precise enough and halves the cost.
+		for i := int64(0); i < stretchHopSize; i += 2 {
+			a := t.at(natural+i, 0) + t.at(natural+i, 1)
+			b := t.at(pos+i, 0) + t.at(pos+i, 1)
+			corr += a * b
+		}
+		if corr > bestCorr {
+			bestCorr = corr
+			best = pos
+		}
+	}
+	return best
+}
+
+// at returns the source sample of the channel ch at the position pos.
+// at returns 0 if the position is out of the buffered samples.
+func (t *TimeStretched) at(pos int64, ch int) float64 {
+	i := pos - t.inHead
+	if i < 0 || i >= int64(len(t.in)/2) {
+		return 0
+	}
+	return t.in[2*i+int64(ch)]
+}
+
+// fill reads the source until the buffered samples reach the position end.
+// fill returns false if the source doesn't have enough data for now.
+func (t *TimeStretched) fill(end int64) bool {
+	const readSize = 4096
+
+	bps := t.bytesPerSample()
+	for t.inHead+int64(len(t.in)/2) < end {
+		if t.srcErr != nil {
+			return true
+		}
+
+		l := len(t.raw)
+		t.raw = append(t.raw, make([]byte, readSize)...)
+		n, err := t.source.Read(t.raw[l:])
+		t.raw = t.raw[:l+n]
+		if err != nil {
+			t.srcErr = err
+		}
+
+		c := len(t.raw) / bps
+		for i := 0; i < c; i++ {
+			t.in = append(t.in, readSample(t.raw[i*bps:], t.bitDepthInBytes), readSample(t.raw[i*bps+t.bitDepthInBytes:], t.bitDepthInBytes))
+		}
+		t.raw = t.raw[:copy(t.raw, t.raw[c*bps:])]
+
+		if n == 0 && err == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *TimeStretched) Seek(offset int64, whence int) (int64, error) {
+	s, ok := t.source.(io.Seeker)
+	if !ok {
+		return 0, errors.New("convert: the source must be io.Seeker when seeking")
+	}
+	pos, err := s.Seek(offset, whence)
+	if err != nil {
+		return 0, err
+	}
+
+	// The source position is the stretched stream's origin from now.
+	t.in = nil
+	t.inHead = 0
+	t.raw = nil
+	t.srcErr = nil
+	t.analysisPos = 0
+	t.prevPos = 0
+	t.started = false
+	t.tail = nil
+	t.out = nil
+	t.err = nil
+	return pos, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2/audio/internal/convert"
+)
+
+func TestTimeStretched(t *testing.T) {
+	const (
+		sampleRate = 48000
+		freq       = 440
+		n          = sampleRate
+	)
+
+	in := make([]byte, 4*n)
+	for i := 0; i < n; i++ {
+		v := int16(math.Sin(2*math.Pi*freq*float64(i)/sampleRate) * 0x3fff)
+		binary.LittleEndian.PutUint16(in[4*i:], uint16(v))
+		binary.LittleEndian.PutUint16(in[4*i+2:], uint16(v))
+	}
+
+	for _, speed := range []float64{1, 0.5, 2} {
+		r := convert.NewTimeStretched(bytes.NewReader(in), 2, func() float64 {
+			return speed
+		})
+		out, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The length is scaled by the speed, with an error of a frame at most.
+		got := len(out) / 4
+		want := int(n / speed)
+		if math.Abs(float64(got-want)) > 1024 {
+			t.Errorf("speed: %f, len(out)/4: got: %d, want: %d", speed, got, want)
+		}
+
+		// The pitch is preserved, i.e., the number of zero crossings per sample doesn't change.
+		var crossings int
+		var prev int16
+		for i := 0; i < got; i++ {
+			v := int16(binary.LittleEndian.Uint16(out[4*i:]))
+			if i > 0 && (prev < 0) != (v < 0) {
+				crossings++
+			}
+			prev = v
+		}
+		gotFreq := float64(crossings) / 2 * sampleRate / float64(got)
+		if math.Abs(gotFreq-freq) > freq*0.05 {
+			t.Errorf("speed: %f, frequency: got: %f, want: %d", speed, gotFreq, freq)
+		}
+	}
+}
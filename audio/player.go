@@ -21,6 +21,8 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/hajimehoshi/ebiten/v2/audio/internal/convert"
 )
 
 // player is almost the same as the interface oto.Player.
@@ -48,15 +50,17 @@ type context interface {
 type playerFactory struct {
 	context    context
 	sampleRate int
+	bufferSize time.Duration
 
 	m sync.Mutex
 }
 
 var driverForTesting context
 
-func newPlayerFactory(sampleRate int) *playerFactory {
+func newPlayerFactory(sampleRate int, bufferSize time.Duration) *playerFactory {
 	f := &playerFactory{
 		sampleRate: sampleRate,
+		bufferSize: bufferSize,
 	}
 	if driverForTesting != nil {
 		f.context = driverForTesting
@@ -87,6 +91,12 @@ type playerImpl struct {
 	// stopwatch is a stopwatch to measure the time duration during the player position doesn't change while its playing.
 	stopwatch stopwatch
 
+	// pausedByContext indicates whether the player is paused by Context.PauseAll.
+	pausedByContext bool
+
+	// underrun indicates whether the player is in a buffer underrun.
+	underrun bool
+
 	m sync.Mutex
 }
 
@@ -145,7 +155,7 @@ func (f *playerFactory) initContextIfNeeded() (<-chan struct{}, error) {
 		return nil, nil
 	}
 
-	c, ready, err := newContext(f.sampleRate)
+	c, ready, err := newContext(f.sampleRate, f.bufferSize)
 	if err != nil {
 		return nil, err
 	}
@@ -178,7 +188,7 @@ func (p *playerImpl) ensurePlayer() error {
 		p.stream = s
 	}
 	if p.player == nil {
-		p.player = p.factory.context.NewPlayer(p.stream)
+		p.player = p.factory.context.NewPlayer(convert.NewTimeScaled(p.stream, p.bytesPerSample/channelCount, p.context.TimeScale))
 		if p.initBufferSize != 0 {
 			p.player.SetBufferSize(p.initBufferSize)
 			p.initBufferSize = 0
@@ -191,6 +201,11 @@ func (p *playerImpl) Play() {
 	p.m.Lock()
 	defer p.m.Unlock()
 
+	p.pausedByContext = false
+	p.play()
+}
+
+func (p *playerImpl) play() {
 	if err := p.ensurePlayer(); err != nil {
 		p.context.setError(err)
 		return
@@ -207,16 +222,50 @@ func (p *playerImpl) Pause() {
 	p.m.Lock()
 	defer p.m.Unlock()
 
+	p.pausedByContext = false
+	p.pause()
+}
+
+func (p *playerImpl) pause() bool {
 	if p.player == nil {
-		return
+		return false
 	}
 	if !p.player.IsPlaying() {
-		return
+		return false
 	}
 
 	p.player.Pause()
 	p.context.removePlayingPlayer(p)
 	p.stopwatch.stop()
+	return true
+}
+
+// pauseByContext pauses the player by Context.PauseAll.
+// pauseByContext reports whether the player is actually paused.
+func (p *playerImpl) pauseByContext() bool {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if !p.pause() {
+		return false
+	}
+	p.pausedByContext = true
+	return true
+}
+
+// resumeIfPausedByContext resumes the player if the player is still paused by Context.PauseAll.
+func (p *playerImpl) resumeIfPausedByContext() {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if !p.pausedByContext {
+		return
+	}
+	p.pausedByContext = false
+	if p.player == nil {
+		return
+	}
+	p.play()
 }
 
 func (p *playerImpl) IsPlaying() bool {
@@ -274,6 +323,17 @@ func (p *playerImpl) Position() time.Duration {
 	return time.Duration(p.adjustedPosition.Load())
 }
 
+func (p *playerImpl) Latency() time.Duration {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.player == nil {
+		return 0
+	}
+	samples := int64(p.player.BufferedSize() / p.bytesPerSample)
+	return time.Duration(samples) * time.Second / time.Duration(p.factory.sampleRate)
+}
+
 func (p *playerImpl) Rewind() error {
 	return p.SetPosition(0)
 }
@@ -367,13 +427,18 @@ func (p *playerImpl) updatePosition() {
 		return
 	}
 
-	samples := (p.stream.position() - int64(p.player.BufferedSize())) / int64(p.bytesPerSample)
+	p.detectUnderrun()
+
+	// The buffered data is time-scaled. Convert its size to the source's size.
+	scale := p.context.TimeScale()
+	buffered := int64(float64(p.player.BufferedSize()) * scale)
+	samples := (p.stream.position() - buffered) / int64(p.bytesPerSample)
 
 	var adjustingTime time.Duration
 	if p.lastSamples >= 0 && p.lastSamples == samples {
 		// If the number of samples is not changed from the last tick,
 		// the underlying buffer is not updated yet. Adjust the position by the time (#2901).
-		adjustingTime = p.stopwatch.current()
+		adjustingTime = time.Duration(float64(p.stopwatch.current()) * scale)
 	} else {
 		p.lastSamples = samples
 		p.stopwatch.reset()
@@ -386,6 +451,21 @@ func (p *playerImpl) updatePosition() {
 	p.adjustedPosition.Store(int64(time.Duration(samples)*time.Second/time.Duration(p.factory.sampleRate) + adjustingTime))
 }
 
+// detectUnderrun counts a buffer underrun when the player starts running out of its buffered data.
+func (p *playerImpl) detectUnderrun() {
+	// Skip the check just after starting or seeking, as the buffer is not filled yet.
+	if p.lastSamples < 0 {
+		p.underrun = false
+		return
+	}
+
+	underrun := p.isPlaying() && p.player.BufferedSize() == 0 && !p.stream.isEOF()
+	if underrun && !p.underrun {
+		p.context.underrunCount.Add(1)
+	}
+	p.underrun = underrun
+}
+
 type timeStream struct {
 	r              io.Reader
 	seekable       bool
@@ -393,6 +473,9 @@ type timeStream struct {
 	pos            atomic.Int64
 	bytesPerSample int
 
+	// eof indicates whether the source reached its end.
+	eof atomic.Bool
+
 	// m is a mutex for this stream.
 	// All the exported functions are protected by this mutex as Read can be read from a different goroutine than Seek.
 	m sync.Mutex
@@ -427,6 +510,9 @@ func (s *timeStream) Read(buf []byte) (int, error) {
 
 	n, err := s.r.Read(buf)
 	s.pos.Add(int64(n))
+	if err == io.EOF {
+		s.eof.Store(true)
+	}
 	return n, err
 }
 
@@ -444,6 +530,7 @@ func (s *timeStream) Seek(offset int64, whence int) (int64, error) {
 	}
 
 	s.pos.Store(pos)
+	s.eof.Store(false)
 	return pos, nil
 }
 
@@ -457,6 +544,10 @@ func (s *timeStream) timeDurationToPos(offset time.Duration) int64 {
 	return o
 }
 
+func (s *timeStream) isEOF() bool {
+	return s.eof.Load()
+}
+
 func (s *timeStream) position() int64 {
 	return s.pos.Load()
 }
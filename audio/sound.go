@@ -0,0 +1,176 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2/audio/internal/convert"
+)
+
+// Sound is decoded audio data that can be played by multiple voices at the same time.
+//
+// A Sound decodes its source only once and keeps the decoded data in memory.
+// All the voices of a Sound share the same data, so playing the same sound effect many times
+// doesn't require decoding or copying the data for each play.
+//
+// Sound is useful for short sound effects played frequently.
+// For long audio like background music, use a Player with a stream instead.
+type Sound struct {
+	context *Context
+
+	// data is the decoded data in 32bit float.
+	data []byte
+}
+
+// NewSoundFromStream creates a new Sound by reading all the data from src.
+//
+// src's format must be linear PCM (signed 16bits little endian, 2 channel stereo)
+// without a header (e.g. RIFF header).
+// The sample rate must be same as that of the audio context.
+//
+// src must be finite. NewSoundFromStream returns an error when reading src fails.
+//
+// A Sound for 16bit integer must be used with 16bit integer version of audio APIs, like vorbis.DecodeWithoutResampling.
+func (c *Context) NewSoundFromStream(src io.Reader) (*Sound, error) {
+	return c.NewSoundF32FromStream(convert.NewFloat32BytesReaderFromInt16BytesReader(src))
+}
+
+// NewSoundF32FromStream creates a new Sound by reading all the data from src.
+//
+// src's format must be linear PCM (32bit float, little endian, 2 channel stereo)
+// without a header (e.g. RIFF header).
+// The sample rate must be same as that of the audio context.
+//
+// src must be finite. NewSoundF32FromStream returns an error when reading src fails.
+//
+// A Sound for 32bit float must be used with 32bit float version of audio APIs, like vorbis.DecodeF32.
+func (c *Context) NewSoundF32FromStream(src io.Reader) (*Sound, error) {
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return nil, fmt.Errorf("audio: reading the source failed: %w", err)
+	}
+	const bytesPerSample = bitDepthInBytesFloat32 * channelCount
+	return &Sound{
+		context: c,
+		data:    data[:len(data)/bytesPerSample*bytesPerSample],
+	}, nil
+}
+
+// Duration returns the duration of the sound.
+func (s *Sound) Duration() time.Duration {
+	const bytesPerSample = bitDepthInBytesFloat32 * channelCount
+	return time.Duration(len(s.data)/bytesPerSample) * time.Second / time.Duration(s.context.SampleRate())
+}
+
+// NewVoice creates a new voice of the sound.
+// The voice is not played until Play is called.
+//
+// NewVoice is useful to set the volume and the pan before playing the voice.
+func (s *Sound) NewVoice() *Voice {
+	stream := &panStream{
+		r: bytes.NewReader(s.data),
+	}
+	p, err := s.context.NewPlayerF32(stream)
+	if err != nil {
+		// Errors should never happen.
+		panic(fmt.Sprintf("audio: %v at NewVoice", err))
+	}
+	return &Voice{
+		Player: p,
+		stream: stream,
+	}
+}
+
+// Play creates a new voice of the sound and plays it.
+//
+// Play is a shortcut of NewVoice and Voice's Play.
+func (s *Sound) Play() *Voice {
+	v := s.NewVoice()
+	v.Play()
+	return v
+}
+
+// Voice is a lightweight player of a Sound.
+//
+// A Voice is a Player with panning.
+// A Voice is GCed after the voice finishes playing, as well as a Player.
+type Voice struct {
+	*Player
+
+	stream *panStream
+}
+
+// Pan returns the current pan of the voice.
+func (v *Voice) Pan() float64 {
+	return v.stream.pan()
+}
+
+// SetPan sets the pan of the voice.
+//
+// pan is in the range of [-1, 1]. -1 means the left, 0 means the center, and 1 means the right.
+// If pan is out of the range, pan is clamped.
+//
+// When the pan is not 0, the opposite channel is attenuated linearly.
+// For example, when pan is 0.5, the left channel's volume is 0.5 and the right channel's volume is 1.
+//
+// The change is applied after the data already sent to the audio device is played.
+func (v *Voice) SetPan(pan float64) {
+	v.stream.setPan(pan)
+}
+
+// panStream is a stream of 32bit float stereo data with panning.
+type panStream struct {
+	r       *bytes.Reader
+	panBits atomic.Uint64
+}
+
+func (p *panStream) pan() float64 {
+	return math.Float64frombits(p.panBits.Load())
+}
+
+func (p *panStream) setPan(pan float64) {
+	p.panBits.Store(math.Float64bits(min(max(pan, -1), 1)))
+}
+
+func (p *panStream) Read(buf []byte) (int, error) {
+	const bytesPerSample = bitDepthInBytesFloat32 * channelCount
+
+	n, err := p.r.Read(buf[:len(buf)/bytesPerSample*bytesPerSample])
+	pan := p.pan()
+	if pan == 0 {
+		return n, err
+	}
+
+	ls := float32(min(1-pan, 1))
+	rs := float32(min(1+pan, 1))
+	for i := 0; i < n/bytesPerSample; i++ {
+		l := math.Float32frombits(binary.LittleEndian.Uint32(buf[bytesPerSample*i:]))
+		r := math.Float32frombits(binary.LittleEndian.Uint32(buf[bytesPerSample*i+4:]))
+		binary.LittleEndian.PutUint32(buf[bytesPerSample*i:], math.Float32bits(l*ls))
+		binary.LittleEndian.PutUint32(buf[bytesPerSample*i+4:], math.Float32bits(r*rs))
+	}
+	return n, err
+}
+
+func (p *panStream) Seek(offset int64, whence int) (int64, error) {
+	return p.r.Seek(offset, whence)
+}
@@ -372,3 +372,69 @@ var (
 	//     α_out = α_src + α_dst
 	BlendLighter = internalBlendToBlend(graphicsdriver.BlendLighter)
 )
+
+// Presets for common effects.
+//
+// Unlike the Porter Duff presets, these presets keep the output alpha the same as the regular alpha blending,
+// so that the results can be composited with other images as usual.
+// The results are clamped to [0, 1] on all the platforms.
+var (
+	// BlendAdditive is a preset Blend for additive blending, e.g. for lights, fires, and particles.
+	//
+	//     c_out = c_src + c_dst
+	//     α_out = α_src + α_dst × (1 - α_src)
+	//
+	// As the source color is alpha-premultiplied, the source alpha is already applied to the source color.
+	// Unlike BlendLighter, the output alpha never exceeds the regular alpha blending's one.
+	BlendAdditive = Blend{
+		BlendFactorSourceRGB:        BlendFactorOne,
+		BlendFactorSourceAlpha:      BlendFactorOne,
+		BlendFactorDestinationRGB:   BlendFactorOne,
+		BlendFactorDestinationAlpha: BlendFactorOneMinusSourceAlpha,
+		BlendOperationRGB:           BlendOperationAdd,
+		BlendOperationAlpha:         BlendOperationAdd,
+	}
+
+	// BlendMultiply is a preset Blend for multiply blending, e.g. for shadows and tints.
+	//
+	//     c_out = c_src × c_dst + c_dst × (1 - α_src)
+	//     α_out = α_src + α_dst × (1 - α_src)
+	//
+	// This is equivalent to CSS's 'multiply' when the destination is opaque.
+	// When the destination is translucent, the term c_src × (1 - α_dst) is missing,
+	// as it cannot be represented by a fixed-function blending.
+	// Use a shader for the exact result in this case.
+	BlendMultiply = Blend{
+		BlendFactorSourceRGB:        BlendFactorDestinationColor,
+		BlendFactorSourceAlpha:      BlendFactorOne,
+		BlendFactorDestinationRGB:   BlendFactorOneMinusSourceAlpha,
+		BlendFactorDestinationAlpha: BlendFactorOneMinusSourceAlpha,
+		BlendOperationRGB:           BlendOperationAdd,
+		BlendOperationAlpha:         BlendOperationAdd,
+	}
+
+	// BlendScreen is a preset Blend for screen blending, e.g. for highlights.
+	//
+	//     c_out = c_src + c_dst × (1 - c_src)
+	//     α_out = α_src + α_dst × (1 - α_src)
+	//
+	// This is equivalent to CSS's 'screen'.
+	BlendScreen = Blend{
+		BlendFactorSourceRGB:        BlendFactorOne,
+		BlendFactorSourceAlpha:      BlendFactorOne,
+		BlendFactorDestinationRGB:   BlendFactorOneMinusSourceColor,
+		BlendFactorDestinationAlpha: BlendFactorOneMinusSourceAlpha,
+		BlendOperationRGB:           BlendOperationAdd,
+		BlendOperationAlpha:         BlendOperationAdd,
+	}
+
+	// BlendPremultipliedOver is a preset Blend for the regular alpha blending of alpha-premultiplied colors.
+	// This is the same as BlendSourceOver, and is the default blending.
+	//
+	//     c_out = c_src + c_dst × (1 - α_src)
+	//     α_out = α_src + α_dst × (1 - α_src)
+	//
+	// Note that Ebitengine treats all the colors of images as alpha-premultiplied.
+	// Use this rather than BlendFactorSourceAlpha for the source factor, which applies the alpha twice.
+	BlendPremultipliedOver = BlendSourceOver
+)
@@ -0,0 +1,96 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// ebitenfontsubset creates a subset of a TrueType font that includes only the glyphs for the given texts.
+//
+// Usage:
+//
+//	ebitenfontsubset -o output [-text text] [-textfile file]... font
+//
+// -text specifies a text to include. -textfile specifies a file whose content is included. -textfile can be specified multiple times.
+//
+// ebitenfontsubset is useful with go:generate to reduce the size of a font to embed. For example:
+//
+//	//go:generate go run github.com/hajimehoshi/ebiten/v2/cmd/ebitenfontsubset -o subset.ttf -textfile texts.txt font.ttf
+//
+// For the details, see text.SubsetFont.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/fontsubset"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	var (
+		output    string
+		textArg   string
+		textFiles []string
+	)
+	flag.StringVar(&output, "o", "", "output file path")
+	flag.StringVar(&textArg, "text", "", "text to include")
+	flag.Func("textfile", "file whose content is included (can be specified multiple times)", func(value string) error {
+		textFiles = append(textFiles, value)
+		return nil
+	})
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "ebitenfontsubset -o output [-text text] [-textfile file]... font")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if output == "" || flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(textArg)
+	for _, file := range textFiles {
+		bs, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		sb.Write(bs)
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	subset, err := fontsubset.Subset(f, sb.String())
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(output, subset, 0644); err != nil {
+		return err
+	}
+	return nil
+}
@@ -68,6 +68,8 @@ class EbitenSurfaceView extends GLSurfaceView implements Renderer {
                 return;
             }
             contextLost_ = true;
+            // Notify the context lost to give the application a chance to save its state before termination.
+            Ebitenmobileview.onContextLost();
             new Handler(Looper.getMainLooper()).post(new Runnable() {
                 @Override
                 public void run() {
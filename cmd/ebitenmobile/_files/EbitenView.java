@@ -19,19 +19,40 @@ import java.util.Collections;
 import java.util.Comparator;
 import java.util.List;
 
+import android.content.ComponentCallbacks2;
+import android.content.ContentResolver;
 import android.content.Context;
+import android.content.res.Configuration;
+import android.hardware.Sensor;
+import android.hardware.SensorEvent;
+import android.hardware.SensorEventListener;
+import android.hardware.SensorManager;
+import android.hardware.display.DisplayManager;
 import android.hardware.input.InputManager;
+import android.graphics.Insets;
+import android.os.Build;
 import android.os.Handler;
 import android.os.Looper;
+import android.os.SystemClock;
+import android.provider.Settings;
 import android.util.AttributeSet;
 import android.util.DisplayMetrics;
+import android.text.InputType;
 import android.util.Log;
 import android.view.Display;
+import android.view.DisplayCutout;
+import android.view.KeyCharacterMap;
 import android.view.KeyEvent;
 import android.view.InputDevice;
 import android.view.MotionEvent;
+import android.view.RoundedCorner;
 import android.view.ViewGroup;
+import android.view.WindowInsets;
 import android.view.WindowManager;
+import android.view.inputmethod.BaseInputConnection;
+import android.view.inputmethod.EditorInfo;
+import android.view.inputmethod.InputConnection;
+import android.view.inputmethod.InputMethodManager;
 
 import {{.JavaPkg}}.ebitenmobileview.Ebitenmobileview;
 
@@ -96,6 +117,228 @@ public class EbitenView extends ViewGroup implements InputManager.InputDeviceLis
         }
     }
 
+    // SensorController enables or disables device motion sensors requested by the game.
+    private class SensorController implements {{.JavaPkg}}.ebitenmobileview.SensorManager, SensorEventListener {
+        // The indices must match with the sensor types in internal/sensor.
+        private final int[] sensorTypes = {
+            Sensor.TYPE_ACCELEROMETER,
+            Sensor.TYPE_GYROSCOPE,
+            Sensor.TYPE_MAGNETIC_FIELD,
+        };
+        private final int[] intervalsInMicroseconds = new int[sensorTypes.length];
+        private boolean paused = false;
+
+        @Override
+        public synchronized void setSensorEnabled(long sensorType, boolean enabled, long intervalInMicroseconds) {
+            if (sensorType < 0 || sensorType >= sensorTypes.length) {
+                return;
+            }
+            int index = (int)sensorType;
+            unregister(index);
+            intervalsInMicroseconds[index] = enabled ? (int)intervalInMicroseconds : 0;
+            if (!paused) {
+                register(index);
+            }
+        }
+
+        synchronized void pause() {
+            paused = true;
+            for (int i = 0; i < sensorTypes.length; i++) {
+                unregister(i);
+            }
+        }
+
+        synchronized void resume() {
+            paused = false;
+            for (int i = 0; i < sensorTypes.length; i++) {
+                register(i);
+            }
+        }
+
+        private void register(int index) {
+            if (intervalsInMicroseconds[index] <= 0) {
+                return;
+            }
+            Sensor sensor = sensorManager.getDefaultSensor(sensorTypes[index]);
+            if (sensor == null) {
+                return;
+            }
+            sensorManager.registerListener(this, sensor, intervalsInMicroseconds[index]);
+        }
+
+        private void unregister(int index) {
+            Sensor sensor = sensorManager.getDefaultSensor(sensorTypes[index]);
+            if (sensor == null) {
+                return;
+            }
+            sensorManager.unregisterListener(this, sensor);
+        }
+
+        @Override
+        public void onSensorChanged(SensorEvent event) {
+            int type = event.sensor.getType();
+            for (int i = 0; i < sensorTypes.length; i++) {
+                if (sensorTypes[i] != type) {
+                    continue;
+                }
+                // The units and the coordinate system of Android sensors are the same as Ebitengine's.
+                Ebitenmobileview.updateSensor(i, event.values[0], event.values[1], event.values[2]);
+                return;
+            }
+        }
+
+        @Override
+        public void onAccuracyChanged(Sensor sensor, int accuracy) {
+        }
+    }
+
+    // VirtualKeyboardController shows or hides the on-screen virtual keyboard requested by the game.
+    private class VirtualKeyboardController implements {{.JavaPkg}}.ebitenmobileview.VirtualKeyboard {
+        private final Handler handler = new Handler(Looper.getMainLooper());
+        private boolean visible = false;
+
+        @Override
+        public void setVirtualKeyboardVisible(final boolean visible) {
+            handler.post(new Runnable() {
+                @Override
+                public void run() {
+                    VirtualKeyboardController.this.visible = visible;
+                    InputMethodManager imm = (InputMethodManager)getContext().getSystemService(Context.INPUT_METHOD_SERVICE);
+                    if (visible) {
+                        setFocusableInTouchMode(true);
+                        requestFocus();
+                        imm.restartInput(EbitenView.this);
+                        imm.showSoftInput(EbitenView.this, InputMethodManager.SHOW_IMPLICIT);
+                    } else {
+                        imm.hideSoftInputFromWindow(getWindowToken(), 0);
+                    }
+                }
+            });
+        }
+
+        boolean isVisible() {
+            return visible;
+        }
+    }
+
+    // Announcer sends texts from the game to the screen reader.
+    private class Announcer implements {{.JavaPkg}}.ebitenmobileview.Announcer {
+        private final Handler handler = new Handler(Looper.getMainLooper());
+
+        @Override
+        public void announce(final String text) {
+            handler.post(new Runnable() {
+                @Override
+                public void run() {
+                    announceForAccessibility(text);
+                }
+            });
+        }
+    }
+
+    // EbitenInputConnection receives texts from the virtual keyboard.
+    // As the input type is TYPE_NULL, most virtual keyboards send key events instead of texts.
+    private class EbitenInputConnection extends BaseInputConnection {
+        EbitenInputConnection() {
+            super(EbitenView.this, false);
+        }
+
+        @Override
+        public boolean commitText(CharSequence text, int newCursorPosition) {
+            Ebitenmobileview.insertText(text.toString());
+            return true;
+        }
+
+        @Override
+        public boolean deleteSurroundingText(int beforeLength, int afterLength) {
+            for (int i = 0; i < beforeLength; i++) {
+                sendKeyEvent(newSoftKeyEvent(KeyEvent.ACTION_DOWN, KeyEvent.KEYCODE_DEL));
+                sendKeyEvent(newSoftKeyEvent(KeyEvent.ACTION_UP, KeyEvent.KEYCODE_DEL));
+            }
+            return true;
+        }
+
+        @Override
+        public boolean sendKeyEvent(KeyEvent event) {
+            // Key events from a virtual keyboard might not have a source. Treat them as keyboard events.
+            if (event.getSource() == InputDevice.SOURCE_UNKNOWN) {
+                event.setSource(InputDevice.SOURCE_KEYBOARD);
+            }
+            return super.sendKeyEvent(event);
+        }
+
+        private KeyEvent newSoftKeyEvent(int action, int keyCode) {
+            long now = SystemClock.uptimeMillis();
+            return new KeyEvent(now, now, action, keyCode, 0, 0, KeyCharacterMap.VIRTUAL_KEYBOARD, 0,
+                KeyEvent.FLAG_SOFT_KEYBOARD | KeyEvent.FLAG_KEEP_TOUCH_MODE, InputDevice.SOURCE_KEYBOARD);
+        }
+    }
+
+    // MemoryCallbacks notifies the game of low memory.
+    private static class MemoryCallbacks implements ComponentCallbacks2 {
+        @Override
+        public void onTrimMemory(int level) {
+            // TRIM_MEMORY_UI_HIDDEN just means that the UI is hidden.
+            if (level < ComponentCallbacks2.TRIM_MEMORY_RUNNING_LOW || level == ComponentCallbacks2.TRIM_MEMORY_UI_HIDDEN) {
+                return;
+            }
+            Ebitenmobileview.onLowMemory();
+        }
+
+        @Override
+        public void onLowMemory() {
+            Ebitenmobileview.onLowMemory();
+        }
+
+        @Override
+        public void onConfigurationChanged(Configuration newConfig) {
+        }
+    }
+
+    // DisplayCallbacks notifies the game of the display's refresh rate changes.
+    private class DisplayCallbacks implements DisplayManager.DisplayListener {
+        @Override
+        public void onDisplayAdded(int displayId) {
+        }
+
+        @Override
+        public void onDisplayRemoved(int displayId) {
+        }
+
+        @Override
+        public void onDisplayChanged(int displayId) {
+            Display display = getDisplay();
+            if (display == null || display.getDisplayId() != displayId) {
+                return;
+            }
+            updateRefreshRate();
+        }
+    }
+
+    private void updateRefreshRate() {
+        Display display = getDisplay();
+        if (display == null) {
+            return;
+        }
+        Ebitenmobileview.setRefreshRate(display.getRefreshRate());
+    }
+
+    private void updateAccessibilityPreferences() {
+        ContentResolver resolver = getContext().getContentResolver();
+        // Removing animations in the system settings sets the animator duration scale to 0.
+        boolean reducedMotion = Settings.Global.getFloat(resolver, Settings.Global.ANIMATOR_DURATION_SCALE, 1.0f) == 0.0f;
+        // "high_text_contrast_enabled" is not a public constant, and reading it is best-effort.
+        // Some devices might not have this setting or might reject reading it.
+        boolean highContrast = false;
+        try {
+            highContrast = Settings.Secure.getInt(resolver, "high_text_contrast_enabled", 0) != 0;
+        } catch (SecurityException e) {
+            Log.w("Go", "high_text_contrast_enabled is not readable: " + e.toString());
+        }
+        float fontScale = getResources().getConfiguration().fontScale;
+        Ebitenmobileview.setAccessibilityPreferences(reducedMotion, highContrast, fontScale);
+    }
+
     private static double pxToDp(double x) {
         return x / Ebitenmobileview.deviceScale();
     }
@@ -122,6 +365,39 @@ public class EbitenView extends ViewGroup implements InputManager.InputDeviceLis
         for (int id : this.inputManager.getInputDeviceIds()) {
             this.onInputDeviceAdded(id);
         }
+
+        this.sensorManager = (SensorManager)context.getSystemService(Context.SENSOR_SERVICE);
+        this.sensorController = new SensorController();
+        Ebitenmobileview.setSensorManager(this.sensorController);
+
+        this.virtualKeyboardController = new VirtualKeyboardController();
+        Ebitenmobileview.setVirtualKeyboard(this.virtualKeyboardController);
+
+        Ebitenmobileview.setAnnouncer(new Announcer());
+    }
+
+    @Override
+    protected void onAttachedToWindow() {
+        super.onAttachedToWindow();
+        getContext().getApplicationContext().registerComponentCallbacks(this.memoryCallbacks);
+        DisplayManager displayManager = (DisplayManager)getContext().getSystemService(Context.DISPLAY_SERVICE);
+        displayManager.registerDisplayListener(this.displayCallbacks, new Handler(Looper.getMainLooper()));
+        updateRefreshRate();
+        updateAccessibilityPreferences();
+    }
+
+    @Override
+    protected void onConfigurationChanged(Configuration newConfig) {
+        super.onConfigurationChanged(newConfig);
+        updateAccessibilityPreferences();
+    }
+
+    @Override
+    protected void onDetachedFromWindow() {
+        super.onDetachedFromWindow();
+        getContext().getApplicationContext().unregisterComponentCallbacks(this.memoryCallbacks);
+        DisplayManager displayManager = (DisplayManager)getContext().getSystemService(Context.DISPLAY_SERVICE);
+        displayManager.unregisterDisplayListener(this.displayCallbacks);
     }
 
     @Override
@@ -132,6 +408,71 @@ public class EbitenView extends ViewGroup implements InputManager.InputDeviceLis
         Ebitenmobileview.layout(widthInDp, heightInDp);
     }
 
+    @Override
+    public WindowInsets onApplyWindowInsets(WindowInsets insets) {
+        int left = 0;
+        int top = 0;
+        int right = 0;
+        int bottom = 0;
+        if (Build.VERSION.SDK_INT >= Build.VERSION_CODES.R) {
+            Insets i = insets.getInsets(WindowInsets.Type.systemBars() | WindowInsets.Type.displayCutout());
+            left = i.left;
+            top = i.top;
+            right = i.right;
+            bottom = i.bottom;
+        } else {
+            left = insets.getSystemWindowInsetLeft();
+            top = insets.getSystemWindowInsetTop();
+            right = insets.getSystemWindowInsetRight();
+            bottom = insets.getSystemWindowInsetBottom();
+            if (Build.VERSION.SDK_INT >= Build.VERSION_CODES.P) {
+                DisplayCutout cutout = insets.getDisplayCutout();
+                if (cutout != null) {
+                    left = Math.max(left, cutout.getSafeInsetLeft());
+                    top = Math.max(top, cutout.getSafeInsetTop());
+                    right = Math.max(right, cutout.getSafeInsetRight());
+                    bottom = Math.max(bottom, cutout.getSafeInsetBottom());
+                }
+            }
+        }
+
+        int cornerRadius = 0;
+        if (Build.VERSION.SDK_INT >= Build.VERSION_CODES.S) {
+            int[] positions = {
+                RoundedCorner.POSITION_TOP_LEFT,
+                RoundedCorner.POSITION_TOP_RIGHT,
+                RoundedCorner.POSITION_BOTTOM_RIGHT,
+                RoundedCorner.POSITION_BOTTOM_LEFT,
+            };
+            for (int position : positions) {
+                RoundedCorner corner = insets.getRoundedCorner(position);
+                if (corner != null) {
+                    cornerRadius = Math.max(cornerRadius, corner.getRadius());
+                }
+            }
+        }
+
+        Ebitenmobileview.setSafeAreaInsets(pxToDp(left), pxToDp(top), pxToDp(right), pxToDp(bottom), pxToDp(cornerRadius));
+
+        // The height of the virtual keyboard is available only on Android 11 (API level 30) or newer.
+        if (Build.VERSION.SDK_INT >= Build.VERSION_CODES.R) {
+            Ebitenmobileview.setVirtualKeyboardHeight(pxToDp(insets.getInsets(WindowInsets.Type.ime()).bottom));
+        }
+        return super.onApplyWindowInsets(insets);
+    }
+
+    @Override
+    public boolean onCheckIsTextEditor() {
+        return this.virtualKeyboardController.isVisible();
+    }
+
+    @Override
+    public InputConnection onCreateInputConnection(EditorInfo outAttrs) {
+        outAttrs.inputType = InputType.TYPE_NULL;
+        outAttrs.imeOptions = EditorInfo.IME_FLAG_NO_FULLSCREEN;
+        return new EbitenInputConnection();
+    }
+
     @Override
     public boolean onKeyDown(int keyCode, KeyEvent event) {
         Ebitenmobileview.onKeyDownOnAndroid(keyCode, event.getUnicodeChar(), event.getSource(), event.getDeviceId());
@@ -409,6 +750,7 @@ public class EbitenView extends ViewGroup implements InputManager.InputDeviceLis
     // Activity's onPause is called.
     public void suspendGame() {
         this.inputManager.unregisterInputDeviceListener(this);
+        this.sensorController.pause();
         this.ebitenSurfaceView.onPause();
         try {
             Ebitenmobileview.suspend();
@@ -422,7 +764,10 @@ public class EbitenView extends ViewGroup implements InputManager.InputDeviceLis
     // Activity's onResume is called.
     public void resumeGame() {
         this.inputManager.registerInputDeviceListener(this, null);
+        this.sensorController.resume();
         this.ebitenSurfaceView.onResume();
+        // The system settings might have been changed while the application was in the background.
+        updateAccessibilityPreferences();
         try {
             Ebitenmobileview.resume();
         } catch (final Exception e) {
@@ -439,4 +784,9 @@ public class EbitenView extends ViewGroup implements InputManager.InputDeviceLis
     private EbitenSurfaceView ebitenSurfaceView;
     private InputManager inputManager;
     private ArrayList<Gamepad> gamepads;
+    private SensorManager sensorManager;
+    private SensorController sensorController;
+    private VirtualKeyboardController virtualKeyboardController;
+    private final MemoryCallbacks memoryCallbacks = new MemoryCallbacks();
+    private final DisplayCallbacks displayCallbacks = new DisplayCallbacks();
 }
@@ -17,10 +17,47 @@
 #import <stdint.h>
 #import <UIKit/UIKit.h>
 #import <GLKit/GLKit.h>
+#import <CoreMotion/CoreMotion.h>
 
 #import "Ebitenmobileview.objc.h"
 
-@interface {{.PrefixUpper}}EbitenViewController : UIViewController<EbitenmobileviewRenderer, EbitenmobileviewSetGameNotifier>
+// {{.PrefixUpper}}EbitenKeyInputView is an invisible view to receive texts from the virtual keyboard.
+@interface {{.PrefixUpper}}EbitenKeyInputView : UIView<UIKeyInput>
+@end
+
+@implementation {{.PrefixUpper}}EbitenKeyInputView
+
+- (BOOL)canBecomeFirstResponder {
+  return YES;
+}
+
+- (BOOL)hasText {
+  // Always return YES so that deleteBackward is called even when the game doesn't have a text.
+  return YES;
+}
+
+- (void)sendKey:(long)keyCode {
+  // The key is released on the next tick so that the game doesn't miss it.
+  EbitenmobileviewTapKeyOnIOS(keyCode);
+}
+
+- (void)insertText:(NSString*)text {
+  if ([text isEqualToString:@"\n"]) {
+    // UIKeyboardHIDUsageKeyboardReturnOrEnter
+    [self sendKey:0x28];
+    return;
+  }
+  EbitenmobileviewInsertText(text);
+}
+
+- (void)deleteBackward {
+  // UIKeyboardHIDUsageKeyboardDeleteOrBackspace
+  [self sendKey:0x2a];
+}
+
+@end
+
+@interface {{.PrefixUpper}}EbitenViewController : UIViewController<EbitenmobileviewRenderer, EbitenmobileviewSetGameNotifier, EbitenmobileviewSensorManager, EbitenmobileviewVirtualKeyboard, EbitenmobileviewAnnouncer>
 @end
 
 @implementation {{.PrefixUpper}}EbitenViewController {
@@ -34,6 +71,13 @@
   NSThread*      renderThread_;
   bool           viewDidLoad_;
   bool           gameSet_;
+
+  // The indices of sensorIntervals_ match with the sensor types in internal/sensor.
+  CMMotionManager*  motionManager_;
+  NSOperationQueue* motionQueue_;
+  long              sensorIntervals_[3];
+
+  {{.PrefixUpper}}EbitenKeyInputView* keyInputView_;
 }
 
 - (id)initWithNibName:(NSString *)nibNameOrNil
@@ -42,6 +86,9 @@
                          bundle:nibBundleOrNil];
   if (self) {
     EbitenmobileviewSetSetGameNotifier(self);
+    EbitenmobileviewSetSensorManager(self);
+    EbitenmobileviewSetVirtualKeyboard(self);
+    EbitenmobileviewSetAnnouncer(self);
   }
   return self;
 }
@@ -52,6 +99,9 @@
   self = [super initWithCoder:coder];
   if (self) {
     EbitenmobileviewSetSetGameNotifier(self);
+    EbitenmobileviewSetSensorManager(self);
+    EbitenmobileviewSetVirtualKeyboard(self);
+    EbitenmobileviewSetAnnouncer(self);
   }
   return self;
 }
@@ -75,6 +125,31 @@
 - (void)viewDidLoad {
   [super viewDidLoad];
 
+  keyInputView_ = [[{{.PrefixUpper}}EbitenKeyInputView alloc] initWithFrame:CGRectZero];
+  [self.view addSubview:keyInputView_];
+
+  [[NSNotificationCenter defaultCenter] addObserver:self
+                                           selector:@selector(keyboardWillChangeFrame:)
+                                               name:UIKeyboardWillChangeFrameNotification
+                                             object:nil];
+  [[NSNotificationCenter defaultCenter] addObserver:self
+                                           selector:@selector(keyboardWillHide:)
+                                               name:UIKeyboardWillHideNotification
+                                             object:nil];
+
+  NSArray<NSNotificationName>* accessibilityNotifications = @[
+    UIAccessibilityReduceMotionStatusDidChangeNotification,
+    UIAccessibilityDarkerSystemColorsStatusDidChangeNotification,
+    UIContentSizeCategoryDidChangeNotification,
+  ];
+  for (NSNotificationName name in accessibilityNotifications) {
+    [[NSNotificationCenter defaultCenter] addObserver:self
+                                             selector:@selector(accessibilityPreferencesDidChange:)
+                                                 name:name
+                                               object:nil];
+  }
+  [self updateAccessibilityPreferences];
+
   viewDidLoad_ = true;
   if (viewDidLoad_ && gameSet_) {
     [self initView];
@@ -191,10 +266,18 @@
   EbitenmobileviewLayout(viewRect.size.width, viewRect.size.height);
 }
 
+- (void)viewSafeAreaInsetsDidChange {
+  [super viewSafeAreaInsetsDidChange];
+
+  UIEdgeInsets insets = [[self view] safeAreaInsets];
+  // iOS doesn't provide the display's corner radius via a public API.
+  EbitenmobileviewSetSafeAreaInsets(insets.left, insets.top, insets.right, insets.bottom, 0);
+}
+
 - (void)didReceiveMemoryWarning {
   [super didReceiveMemoryWarning];
-  // Dispose of any resources that can be recreated.
-  // TODO: Notify this to Go world?
+  // Let the game dispose of any resources that can be recreated.
+  EbitenmobileviewOnLowMemory();
 }
 
 - (void)drawFrame{
@@ -217,6 +300,13 @@
     return;
   }
 
+  // The interval between the current frame and the next frame reflects the current refresh rate,
+  // including ProMotion's dynamic rate.
+  CFTimeInterval interval = displayLink_.targetTimestamp - displayLink_.timestamp;
+  if (interval > 0) {
+    EbitenmobileviewSetRefreshRate(round(1.0 / interval));
+  }
+
   if (isGL) {
     dispatch_async(dispatch_get_main_queue(), ^{
       [[self glkView] setNeedsDisplay];
@@ -341,6 +431,10 @@
     active_ = false;
   }
 
+  dispatch_async(dispatch_get_main_queue(), ^{
+      [self stopSensors];
+    });
+
   NSError* err = nil;
   EbitenmobileviewSuspend(&err);
   if (err != nil) {
@@ -357,6 +451,10 @@
     active_ = true;
   }
 
+  dispatch_async(dispatch_get_main_queue(), ^{
+      [self startSensors];
+    });
+
   NSError* err = nil;
   EbitenmobileviewResume(&err);
   if (err != nil) {
@@ -383,6 +481,119 @@
   }
 }
 
+- (void)setSensorEnabled:(long)sensorType
+                 enabled:(BOOL)enabled
+  intervalInMicroseconds:(long)intervalInMicroseconds {
+  if (sensorType < 0 || sensorType >= 3) {
+    return;
+  }
+  // CMMotionManager is used on the main thread.
+  dispatch_async(dispatch_get_main_queue(), ^{
+      [self stopSensors];
+      sensorIntervals_[sensorType] = enabled ? intervalInMicroseconds : 0;
+      @synchronized(self) {
+        if (!active_) {
+          return;
+        }
+      }
+      [self startSensors];
+    });
+}
+
+- (void)startSensors {
+  if (!motionManager_) {
+    motionManager_ = [[CMMotionManager alloc] init];
+    motionQueue_ = [[NSOperationQueue alloc] init];
+  }
+
+  // Convert the values to Android's units and coordinate system.
+  if (sensorIntervals_[0] > 0 && motionManager_.accelerometerAvailable) {
+    motionManager_.accelerometerUpdateInterval = sensorIntervals_[0] / 1000000.0;
+    [motionManager_ startAccelerometerUpdatesToQueue:motionQueue_
+                                         withHandler:^(CMAccelerometerData* data, NSError* error) {
+        if (!data) {
+          return;
+        }
+        // CoreMotion's acceleration is in G and its sign is opposite to Android's.
+        const double g = -9.80665;
+        EbitenmobileviewUpdateSensor(0, data.acceleration.x * g, data.acceleration.y * g, data.acceleration.z * g);
+      }];
+  }
+  if (sensorIntervals_[1] > 0 && motionManager_.gyroAvailable) {
+    motionManager_.gyroUpdateInterval = sensorIntervals_[1] / 1000000.0;
+    [motionManager_ startGyroUpdatesToQueue:motionQueue_
+                                withHandler:^(CMGyroData* data, NSError* error) {
+        if (!data) {
+          return;
+        }
+        EbitenmobileviewUpdateSensor(1, data.rotationRate.x, data.rotationRate.y, data.rotationRate.z);
+      }];
+  }
+  if (sensorIntervals_[2] > 0 && motionManager_.magnetometerAvailable) {
+    motionManager_.magnetometerUpdateInterval = sensorIntervals_[2] / 1000000.0;
+    [motionManager_ startMagnetometerUpdatesToQueue:motionQueue_
+                                        withHandler:^(CMMagnetometerData* data, NSError* error) {
+        if (!data) {
+          return;
+        }
+        EbitenmobileviewUpdateSensor(2, data.magneticField.x, data.magneticField.y, data.magneticField.z);
+      }];
+  }
+}
+
+- (void)stopSensors {
+  if (!motionManager_) {
+    return;
+  }
+  [motionManager_ stopAccelerometerUpdates];
+  [motionManager_ stopGyroUpdates];
+  [motionManager_ stopMagnetometerUpdates];
+}
+
+- (void)setVirtualKeyboardVisible:(BOOL)visible {
+  dispatch_async(dispatch_get_main_queue(), ^{
+      if (visible) {
+        [keyInputView_ becomeFirstResponder];
+      } else {
+        [keyInputView_ resignFirstResponder];
+      }
+    });
+}
+
+- (void)announce:(NSString*)text {
+  dispatch_async(dispatch_get_main_queue(), ^{
+      UIAccessibilityPostNotification(UIAccessibilityAnnouncementNotification, text);
+    });
+}
+
+- (void)keyboardWillChangeFrame:(NSNotification*)notification {
+  CGRect frame = [notification.userInfo[UIKeyboardFrameEndUserInfoKey] CGRectValue];
+  // Calculate the height of the area of the view covered by the keyboard.
+  CGRect frameInView = [self.view convertRect:frame fromView:nil];
+  CGRect covered = CGRectIntersection(self.view.bounds, frameInView);
+  if (CGRectIsNull(covered)) {
+    EbitenmobileviewSetVirtualKeyboardHeight(0);
+    return;
+  }
+  EbitenmobileviewSetVirtualKeyboardHeight(covered.size.height);
+}
+
+- (void)keyboardWillHide:(NSNotification*)notification {
+  EbitenmobileviewSetVirtualKeyboardHeight(0);
+}
+
+- (void)accessibilityPreferencesDidChange:(NSNotification*)notification {
+  [self updateAccessibilityPreferences];
+}
+
+- (void)updateAccessibilityPreferences {
+  // 17pt is the default size of the body text style.
+  double textScaleFactor = [[UIFontMetrics defaultMetrics] scaledValueForValue:17] / 17;
+  EbitenmobileviewSetAccessibilityPreferences(UIAccessibilityIsReduceMotionEnabled(),
+                                              UIAccessibilityDarkerSystemColorsEnabled(),
+                                              textScaleFactor);
+}
+
 - (void)notifySetGame {
   dispatch_async(dispatch_get_main_queue(), ^{
       gameSet_ = true;
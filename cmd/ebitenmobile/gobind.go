@@ -121,6 +121,7 @@ func run() error {
 			if err := writeFile(filepath.Join("src", "gobind", prefixLower+"ebitenviewcontroller_ios.go"), `package main
 
 // #cgo CFLAGS: -DGLES_SILENCE_DEPRECATION
+// #cgo LDFLAGS: -framework CoreMotion
 import "C"`); err != nil {
 				return err
 			}
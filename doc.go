@@ -54,6 +54,14 @@
 // DeviceScaleFactor) that must be called on the main thread under some conditions (typically, before ebiten.RunGame
 // is called).
 //
+// # Web Workers
+//
+// On browsers, a game can run in a dedicated Web Worker and render to an OffscreenCanvas, so that a heavy Update
+// doesn't block the page's main thread. In this mode, the main thread must transfer the canvas to the worker, and
+// forward the canvas size, the focus state, and input events to the worker.
+// See examples/worker for the JavaScript code of the main thread.
+// Audio and gamepads are not available in a worker.
+//
 // # Environment variables
 //
 // `EBITENGINE_SCREENSHOT_KEY` environment variable specifies the key
@@ -75,6 +83,7 @@
 //	"directx":      DirectX. This works only on Windows.
 //	"metal":        Metal. This works only on macOS or iOS.
 //	"playstation5": PlayStation 5. This works only on PlayStation 5.
+//	"webgpu":       WebGPU. This works only on browsers. This is experimental and never chosen by "auto".
 //
 // `EBITENGINE_DIRECTX` environment variable specifies various parameters for DirectX.
 // You can specify multiple values separated by a comma. The default value is empty (i.e. no parameters).
@@ -0,0 +1,129 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebitenutil
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// FetchProgress represents a progress of a fetch.
+type FetchProgress struct {
+	// Loaded is the number of the loaded bytes.
+	Loaded int64
+
+	// Total is the total number of bytes.
+	// Total is -1 when the total size is unknown.
+	Total int64
+}
+
+// FetchResult represents a result of a fetch.
+type FetchResult struct {
+	// Data is the fetched content.
+	Data []byte
+
+	// Err is a non-nil error when the fetch fails.
+	Err error
+}
+
+// Fetch represents an asynchronous fetch started by FetchAsync.
+type Fetch struct {
+	// Progress is a channel to receive the latest progress.
+	// Only the latest progress is kept in the channel, and older progresses are discarded.
+	// Progress is closed when the fetch finishes.
+	Progress <-chan FetchProgress
+
+	// Result is a channel to receive the result.
+	// Exactly one result is sent to Result.
+	Result <-chan FetchResult
+}
+
+// FetchAsync starts fetching the content at the given URL asynchronously.
+//
+// FetchAsync doesn't block. Both the channels of the returned Fetch are buffered, so you can poll them without
+// blocking in your game's Update with select statements. This is useful to show a progress bar while loading assets.
+//
+// On browsers, FetchAsync uses the Fetch API.
+func FetchAsync(url string) *Fetch {
+	progressCh := make(chan FetchProgress, 1)
+	resultCh := make(chan FetchResult, 1)
+
+	go func() {
+		defer close(progressCh)
+		data, err := fetch(url, progressCh)
+		resultCh <- FetchResult{
+			Data: data,
+			Err:  err,
+		}
+	}()
+
+	return &Fetch{
+		Progress: progressCh,
+		Result:   resultCh,
+	}
+}
+
+func fetch(url string, progressCh chan FetchProgress) ([]byte, error) {
+	res, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, fmt.Errorf("ebitenutil: fetching %s failed: %s", url, res.Status)
+	}
+
+	total := res.ContentLength
+	var data []byte
+	if total > 0 {
+		data = make([]byte, 0, total)
+	}
+	sendFetchProgress(progressCh, FetchProgress{Loaded: 0, Total: total})
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := res.Body.Read(buf)
+		if n > 0 {
+			data = append(data, buf[:n]...)
+			sendFetchProgress(progressCh, FetchProgress{Loaded: int64(len(data)), Total: total})
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// sendFetchProgress sends the progress without blocking, replacing the previous progress if it is not received yet.
+func sendFetchProgress(ch chan FetchProgress, progress FetchProgress) {
+	for {
+		select {
+		case ch <- progress:
+			return
+		default:
+		}
+		select {
+		case <-ch:
+		default:
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+
+package ebitenutil_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+func TestFetchAsync(t *testing.T) {
+	content := bytes.Repeat([]byte("ebitengine"), 10000)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/data" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(content)
+	}))
+	defer s.Close()
+
+	f := ebitenutil.FetchAsync(s.URL + "/data")
+	var last ebitenutil.FetchProgress
+	for p := range f.Progress {
+		if p.Loaded < last.Loaded {
+			t.Errorf("progress went backwards: %d -> %d", last.Loaded, p.Loaded)
+		}
+		last = p
+	}
+	if got, want := last.Loaded, int64(len(content)); got != want {
+		t.Errorf("last progress: got: %d, want: %d", got, want)
+	}
+	r := <-f.Result
+	if r.Err != nil {
+		t.Fatal(r.Err)
+	}
+	if !bytes.Equal(r.Data, content) {
+		t.Errorf("data mismatch: got %d bytes, want %d bytes", len(r.Data), len(content))
+	}
+
+	f = ebitenutil.FetchAsync(s.URL + "/notfound")
+	if r := <-f.Result; r.Err == nil {
+		t.Errorf("FetchAsync with a missing resource must return an error")
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebitenutil
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"syscall/js"
+)
+
+func init() {
+	// There is no pure Go AVIF decoder available, but browsers can decode AVIF natively.
+	// Register the format with the browser's decoder so that AVIF images can be loaded on browsers.
+	image.RegisterFormat("avif", "????ftypavif", decodeAVIF, decodeAVIFConfig)
+	image.RegisterFormat("avif", "????ftypavis", decodeAVIF, decodeAVIFConfig)
+}
+
+func decodeAVIF(r io.Reader) (image.Image, error) {
+	bitmap, err := createImageBitmap(r, "image/avif")
+	if err != nil {
+		return nil, err
+	}
+	defer bitmap.Call("close")
+
+	w := bitmap.Get("width").Int()
+	h := bitmap.Get("height").Int()
+
+	var canvas js.Value
+	if c := js.Global().Get("OffscreenCanvas"); c.Truthy() {
+		canvas = c.New(w, h)
+	} else {
+		canvas = js.Global().Get("document").Call("createElement", "canvas")
+		canvas.Set("width", w)
+		canvas.Set("height", h)
+	}
+	ctx := canvas.Call("getContext", "2d")
+	ctx.Call("drawImage", bitmap, 0, 0)
+	data := ctx.Call("getImageData", 0, 0, w, h).Get("data")
+
+	// ImageData is not premultiplied.
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	js.CopyBytesToGo(img.Pix, js.Global().Get("Uint8Array").New(data.Get("buffer")))
+	return img, nil
+}
+
+func decodeAVIFConfig(r io.Reader) (image.Config, error) {
+	bitmap, err := createImageBitmap(r, "image/avif")
+	if err != nil {
+		return image.Config{}, err
+	}
+	defer bitmap.Call("close")
+
+	return image.Config{
+		ColorModel: color.NRGBAModel,
+		Width:      bitmap.Get("width").Int(),
+		Height:     bitmap.Get("height").Int(),
+	}, nil
+}
+
+// createImageBitmap decodes the image data from r by the browser.
+//
+// createImageBitmap blocks until the decoding finishes. Do not call this from a JavaScript callback.
+func createImageBitmap(r io.Reader, mimeType string) (js.Value, error) {
+	bs, err := io.ReadAll(r)
+	if err != nil {
+		return js.Value{}, err
+	}
+
+	arr := js.Global().Get("Uint8Array").New(len(bs))
+	js.CopyBytesToJS(arr, bs)
+	blob := js.Global().Get("Blob").New([]any{arr}, map[string]any{
+		"type": mimeType,
+	})
+
+	chBitmap := make(chan js.Value, 1)
+	cbThen := js.FuncOf(func(this js.Value, args []js.Value) any {
+		chBitmap <- args[0]
+		return nil
+	})
+	defer cbThen.Release()
+
+	chError := make(chan js.Value, 1)
+	cbCatch := js.FuncOf(func(this js.Value, args []js.Value) any {
+		chError <- args[0]
+		return nil
+	})
+	defer cbCatch.Release()
+
+	js.Global().Call("createImageBitmap", blob).Call("then", cbThen).Call("catch", cbCatch)
+	select {
+	case bitmap := <-chBitmap:
+		return bitmap, nil
+	case err := <-chError:
+		return js.Value{}, fmt.Errorf("ebitenutil: decoding %s failed: %s", mimeType, err.Call("toString").String())
+	}
+}
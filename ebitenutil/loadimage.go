@@ -26,6 +26,7 @@ import (
 //
 // Image decoders must be imported when using NewImageFromReader. For example,
 // if you want to load a PNG image, you'd need to add `_ "image/png"` to the import section.
+// WebP images can be loaded without an explicit import. AVIF images can be loaded only on browsers.
 func NewImageFromReader(reader io.Reader) (*ebiten.Image, image.Image, error) {
 	img, _, err := image.Decode(reader)
 	if err != nil {
@@ -39,6 +40,7 @@ func NewImageFromReader(reader io.Reader) (*ebiten.Image, image.Image, error) {
 //
 // Image decoders must be imported when using NewImageFromURL. For example,
 // if you want to load a PNG image, you'd need to add `_ "image/png"` to the import section.
+// WebP images can be loaded without an explicit import. AVIF images can be loaded only on browsers.
 func NewImageFromURL(url string) (*ebiten.Image, error) {
 	res, err := http.Get(url)
 	if err != nil {
@@ -26,6 +26,7 @@ import (
 //
 // Image decoders must be imported when using NewImageFromFile. For example,
 // if you want to load a PNG image, you'd need to add `_ "image/png"` to the import section.
+// WebP images can be loaded without an explicit import. AVIF images can be loaded only on browsers.
 //
 // How to solve path depends on your environment. This varies on your desktop or web browser.
 // Note that this doesn't work on mobiles.
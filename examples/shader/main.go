@@ -50,6 +50,9 @@ var (
 
 	//go:embed water.go
 	water_go []byte
+
+	//go:embed sdf.go
+	sdf_go []byte
 )
 
 // These directives are used for an shader analyzer in the future.
@@ -62,6 +65,7 @@ var (
 //ebitengine:shaderfile chromaticaberration.go
 //ebitengine:shaderfile dissolve.go
 //ebitengine:shaderfile water.go
+//ebitengine:shaderfile sdf.go
 
 const (
 	screenWidth  = 640
@@ -116,6 +120,7 @@ var shaderSrcs = [][]byte{
 	chromaticaberration_go,
 	dissolve_go,
 	water_go,
+	sdf_go,
 }
 
 type Game struct {
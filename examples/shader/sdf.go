@@ -0,0 +1,54 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build ignore
+
+//kage:unit pixels
+
+package main
+
+var Time float
+var Cursor vec2
+
+// roundedBox returns the signed distance from p to a rounded box centered at the origin.
+func roundedBox(p vec2, size vec2, radius float) float {
+	q := abs(p) - size + radius
+	return length(max(q, 0)) + min(max(q.x, q.y), 0) - radius
+}
+
+// coverage returns the coverage of a shape at a distance d.
+// fwidth gives how much d changes in a pixel, so the edge is always one pixel wide regardless of the scale.
+func coverage(d float) float {
+	w := fwidth(d)
+	return clamp(0.5-d/w, 0, 1)
+}
+
+func Fragment(dstPos vec4, srcPos vec2, color vec4) vec4 {
+	center := imageDstSize() / 2
+	p := dstPos.xy - imageDstOrigin() - center
+
+	// Rotate the box.
+	s, c := sin(Time), cos(Time)
+	q := mat2(c, -s, s, c) * p
+	box := roundedBox(q, vec2(120, 80), 24)
+
+	// A ring following the cursor.
+	ring := abs(distance(dstPos.xy-imageDstOrigin(), Cursor)-40) - 4
+
+	clr := imageSrc2UnsafeAt(srcPos)
+	clr = mix(clr, vec4(0.2, 0.4, 0.8, 1), coverage(box))
+	clr = mix(clr, vec4(1), coverage(abs(box)-1))
+	clr = mix(clr, vec4(1, 0.8, 0.2, 1), coverage(ring))
+	return clr
+}
@@ -148,44 +148,22 @@ func (t *TextField) Update() error {
 	switch {
 	case inpututil.IsKeyJustPressed(ebiten.KeyEnter):
 		if t.multilines {
-			text := t.field.Text()
-			selectionStart, selectionEnd := t.field.Selection()
-			text = text[:selectionStart] + "\n" + text[selectionEnd:]
-			selectionStart += len("\n")
-			selectionEnd = selectionStart
-			t.field.SetTextAndSelection(text, selectionStart, selectionEnd)
+			t.field.Insert("\n")
 		}
 	case inpututil.IsKeyJustPressed(ebiten.KeyBackspace):
-		text := t.field.Text()
-		selectionStart, selectionEnd := t.field.Selection()
-		if selectionStart != selectionEnd {
-			text = text[:selectionStart] + text[selectionEnd:]
-		} else if selectionStart > 0 {
-			// TODO: Remove a grapheme instead of a code point.
-			_, l := utf8.DecodeLastRuneInString(text[:selectionStart])
-			text = text[:selectionStart-l] + text[selectionEnd:]
-			selectionStart -= l
-		}
-		selectionEnd = selectionStart
-		t.field.SetTextAndSelection(text, selectionStart, selectionEnd)
+		t.field.DeleteBackward(textinput.UnitGraphemeCluster)
+	case inpututil.IsKeyJustPressed(ebiten.KeyDelete):
+		t.field.DeleteForward(textinput.UnitGraphemeCluster)
 	case inpututil.IsKeyJustPressed(ebiten.KeyLeft):
-		text := t.field.Text()
-		selectionStart, _ := t.field.Selection()
-		if selectionStart > 0 {
-			// TODO: Remove a grapheme instead of a code point.
-			_, l := utf8.DecodeLastRuneInString(text[:selectionStart])
-			selectionStart -= l
-		}
-		t.field.SetTextAndSelection(text, selectionStart, selectionStart)
+		t.field.MoveCursorBackward(textinput.UnitGraphemeCluster, ebiten.IsKeyPressed(ebiten.KeyShift))
 	case inpututil.IsKeyJustPressed(ebiten.KeyRight):
-		text := t.field.Text()
-		_, selectionEnd := t.field.Selection()
-		if selectionEnd < len(text) {
-			// TODO: Remove a grapheme instead of a code point.
-			_, l := utf8.DecodeRuneInString(text[selectionEnd:])
-			selectionEnd += l
+		t.field.MoveCursorForward(textinput.UnitGraphemeCluster, ebiten.IsKeyPressed(ebiten.KeyShift))
+	case inpututil.IsKeyJustPressed(ebiten.KeyZ) && ebiten.IsKeyPressed(ebiten.KeyControl):
+		if ebiten.IsKeyPressed(ebiten.KeyShift) {
+			t.field.Redo()
+		} else {
+			t.field.Undo()
 		}
-		t.field.SetTextAndSelection(text, selectionEnd, selectionEnd)
 	}
 
 	if !t.multilines {
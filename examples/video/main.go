@@ -25,6 +25,7 @@ import (
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/audio"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/x/video"
 )
 
 // mpgURL is a URL of an example MPEG-1 video. The license is the following:
@@ -35,25 +36,37 @@ import (
 const mpgURL = "https://example-resources.ebitengine.org/shibuya.mpg"
 
 type Game struct {
-	player *mpegPlayer
-	err    error
+	player *video.Player
 }
 
 func (g *Game) Update() error {
-	if g.err != nil {
-		return g.err
+	if err := g.player.Update(); err != nil {
+		return err
 	}
 	return nil
 }
 
 func (g *Game) Draw(screen *ebiten.Image) {
-	if g.err != nil {
-		return
+	frame := g.player.Frame()
+	sw, sh := screen.Bounds().Dx(), screen.Bounds().Dy()
+	fw, fh := frame.Bounds().Dx(), frame.Bounds().Dy()
+
+	op := ebiten.DrawImageOptions{}
+	wf, hf := float64(sw)/float64(fw), float64(sh)/float64(fh)
+	s := wf
+	if hf < wf {
+		s = hf
 	}
-	if err := g.player.Draw(screen); err != nil {
-		g.err = err
+	op.GeoM.Scale(s, s)
+	op.GeoM.Translate((float64(sw)-float64(fw)*s)/2, (float64(sh)-float64(fh)*s)/2)
+	op.Filter = ebiten.FilterLinear
+	screen.DrawImage(frame, &op)
+
+	msg := fmt.Sprintf("FPS: %0.2f", ebiten.ActualFPS())
+	if g.player.IsEnded() {
+		msg += "\nThe video has ended."
 	}
-	ebitenutil.DebugPrint(screen, fmt.Sprintf("FPS: %0.2f", ebiten.ActualFPS()))
+	ebitenutil.DebugPrint(screen, msg)
 }
 
 func (g *Game) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeight int) {
@@ -86,7 +99,7 @@ func main() {
 		fmt.Println("Play the default video. You can specify a video file as an argument.")
 	}
 
-	player, err := newMPEGPlayer(in)
+	player, err := video.NewPlayer(in)
 	if err != nil {
 		log.Fatal(err)
 	}
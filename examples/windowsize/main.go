@@ -532,6 +532,8 @@ func main() {
 		op.GraphicsLibrary = ebiten.GraphicsLibraryDirectX
 	case "metal":
 		op.GraphicsLibrary = ebiten.GraphicsLibraryMetal
+	case "webgpu":
+		op.GraphicsLibrary = ebiten.GraphicsLibraryWebGPU
 	default:
 		log.Fatalf("unexpected graphics library: %s", *flagGraphicsLibrary)
 	}
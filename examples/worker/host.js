@@ -0,0 +1,119 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// ebitengineRunInWorker runs an Ebitengine game in a Web Worker with an OffscreenCanvas.
+// The worker script should load wasm_exec.js and run the game's Wasm binary.
+//
+// The main thread forwards the canvas's size, focus, and input events to the worker,
+// and applies the cursor, pointer lock, and fullscreen requests from the worker.
+function ebitengineRunInWorker(canvas, workerURL) {
+    const worker = new Worker(workerURL);
+
+    function post(kind, props, transfer) {
+        worker.postMessage(Object.assign({ebitengine: kind}, props), transfer || []);
+    }
+
+    function size() {
+        return {
+            width: canvas.clientWidth,
+            height: canvas.clientHeight,
+            devicePixelRatio: window.devicePixelRatio,
+        };
+    }
+
+    function postFocus() {
+        post('focus', {focused: document.hasFocus() && !document.hidden});
+    }
+
+    // toPlainEvent copies the properties of an event that Ebitengine reads, as an Event object cannot be posted to a worker.
+    // deltaX and deltaY of a wheel event are in the unit specified by deltaMode.
+    function toPlainEvent(e) {
+        const touches = [];
+        if (e.targetTouches) {
+            for (const t of e.targetTouches) {
+                touches.push({identifier: t.identifier, clientX: t.clientX, clientY: t.clientY});
+            }
+        }
+        return {
+            type: e.type,
+            key: e.key,
+            code: e.code,
+            button: e.button,
+            clientX: e.clientX,
+            clientY: e.clientY,
+            movementX: e.movementX,
+            movementY: e.movementY,
+            deltaX: e.deltaX,
+            deltaY: e.deltaY,
+            deltaMode: e.deltaMode,
+            targetTouches: touches,
+        };
+    }
+
+    worker.addEventListener('message', e => {
+        const data = e.data;
+        if (!data || typeof data.ebitengine !== 'string') {
+            return;
+        }
+        switch (data.ebitengine) {
+        case 'ready':
+            const offscreen = canvas.transferControlToOffscreen();
+            post('init', Object.assign({canvas: offscreen}, size()), [offscreen]);
+            postFocus();
+            break;
+        case 'cursor':
+            canvas.style.cursor = data.value;
+            break;
+        case 'pointerlock':
+            if (data.value) {
+                canvas.requestPointerLock();
+            } else {
+                document.exitPointerLock();
+            }
+            break;
+        case 'fullscreen':
+            if (data.value) {
+                canvas.requestFullscreen();
+            } else {
+                document.exitFullscreen();
+            }
+            break;
+        }
+    });
+
+    const events = ['keydown', 'keyup', 'mousedown', 'mouseup', 'mousemove', 'wheel', 'touchstart', 'touchend', 'touchmove'];
+    for (const type of events) {
+        canvas.addEventListener(type, e => {
+            if (type === 'keydown' || type === 'mousedown' || type === 'touchstart') {
+                canvas.focus();
+            }
+            e.preventDefault();
+            post('event', {event: toPlainEvent(e)});
+        });
+    }
+    canvas.addEventListener('contextmenu', e => e.preventDefault());
+
+    window.addEventListener('resize', () => post('resize', size()));
+    window.addEventListener('focus', postFocus);
+    window.addEventListener('blur', postFocus);
+    document.addEventListener('visibilitychange', postFocus);
+    document.addEventListener('pointerlockchange', () => {
+        post('pointerlockchange', {locked: document.pointerLockElement === canvas});
+    });
+    document.addEventListener('fullscreenchange', () => {
+        post('fullscreenchange', {fullscreen: !!document.fullscreenElement});
+    });
+
+    return worker;
+}
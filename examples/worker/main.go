@@ -0,0 +1,74 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This example runs a game in a Web Worker with an OffscreenCanvas.
+//
+// Build this with GOOS=js GOARCH=wasm as main.wasm, and put it with index.html, host.js, worker.js, and Go's
+// wasm_exec.js in the same directory. The game's Update is heavy on purpose, but the page's main thread is not
+// blocked.
+//
+// On desktops and on browsers without the worker host, this works as a usual game.
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+	"math"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const (
+	screenWidth  = 640
+	screenHeight = 480
+)
+
+type Game struct {
+	count int
+}
+
+func (g *Game) Update() error {
+	g.count++
+
+	// Emulate a heavy logic.
+	start := time.Now()
+	for time.Since(start) < 10*time.Millisecond {
+	}
+	return nil
+}
+
+func (g *Game) Draw(screen *ebiten.Image) {
+	x := screenWidth/2 + 200*math.Cos(float64(g.count)/60)
+	y := screenHeight/2 + 150*math.Sin(float64(g.count)/60)
+	vector.DrawFilledCircle(screen, float32(x), float32(y), 32, color.RGBA{0x80, 0xc0, 0xff, 0xff}, true)
+
+	cx, cy := ebiten.CursorPosition()
+	msg := fmt.Sprintf("TPS: %0.2f\nFPS: %0.2f\nCursor: (%d, %d)", ebiten.ActualTPS(), ebiten.ActualFPS(), cx, cy)
+	ebitenutil.DebugPrint(screen, msg)
+}
+
+func (g *Game) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return screenWidth, screenHeight
+}
+
+func main() {
+	ebiten.SetWindowTitle("Worker (Ebitengine Demo)")
+	if err := ebiten.RunGame(&Game{}); err != nil {
+		log.Fatal(err)
+	}
+}
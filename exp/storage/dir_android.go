@@ -0,0 +1,27 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"os"
+	"path/filepath"
+)
+
+func rootDir() (string, error) {
+	// TMPDIR is the application's cache directory (e.g. /data/data/<package>/cache) on Android.
+	// As the cache directory can be cleared by the system, use the 'files' directory next to it,
+	// which corresponds to Context.getFilesDir().
+	return filepath.Join(filepath.Dir(os.TempDir()), "files"), nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storage provides a persistent key-value storage, e.g., for save data.
+// This package is experimental and the API might be changed in the future.
+//
+// The data is stored in files on desktops and mobiles, and in IndexedDB on browsers.
+//
+// All the operations are asynchronous. Each operation returns a buffered channel to receive the result,
+// so you can poll it in your game's Update without blocking. The operations on the same Storage are
+// executed in the called order.
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotFound is returned when the given key doesn't exist.
+var ErrNotFound = errors.New("storage: not found")
+
+// LoadResult represents a result of Load.
+type LoadResult struct {
+	// Data is the loaded data.
+	Data []byte
+
+	// Err is a non-nil error when loading fails.
+	// Err is ErrNotFound when the key doesn't exist.
+	Err error
+}
+
+// KeysResult represents a result of Keys.
+type KeysResult struct {
+	// Keys is the keys in the storage.
+	Keys []string
+
+	// Err is a non-nil error when listing the keys fails.
+	Err error
+}
+
+// backend is an implementation of a storage.
+// The functions of backend are called sequentially from one goroutine.
+type backend interface {
+	load(key string) ([]byte, error)
+	save(key string, data []byte) error
+	delete(key string) error
+	keys() ([]string, error)
+}
+
+// Storage is a persistent key-value storage.
+type Storage struct {
+	backend    backend
+	backendErr error
+
+	queue   []func()
+	running bool
+	m       sync.Mutex
+}
+
+// New returns a new Storage identified by the given name.
+//
+// name should be unique to your application, e.g., a reverse domain name like "com.example.mygame".
+// name must not be empty, ".", or "..".
+//
+// On desktops, the data is stored under the directory os.UserConfigDir returns.
+// On Android, the data is stored in the application's internal storage.
+// On iOS, the data is stored in the application's Application Support directory.
+// On browsers, the data is stored in an IndexedDB database named by name.
+func New(name string) *Storage {
+	if name == "" || name == "." || name == ".." {
+		panic(fmt.Sprintf("storage: invalid name: %q", name))
+	}
+	b, err := newBackend(name)
+	return &Storage{
+		backend:    b,
+		backendErr: err,
+	}
+}
+
+// do enqueues f. The enqueued functions are executed in order in another goroutine.
+// do never blocks.
+func (s *Storage) do(f func()) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.queue = append(s.queue, f)
+	if s.running {
+		return
+	}
+	s.running = true
+	go s.loop()
+}
+
+func (s *Storage) loop() {
+	for {
+		s.m.Lock()
+		if len(s.queue) == 0 {
+			s.running = false
+			s.m.Unlock()
+			return
+		}
+		f := s.queue[0]
+		s.queue[0] = nil
+		s.queue = s.queue[1:]
+		s.m.Unlock()
+
+		f()
+	}
+}
+
+// Load loads the data for the given key asynchronously.
+//
+// If the key doesn't exist, the result's Err is ErrNotFound.
+func (s *Storage) Load(key string) <-chan LoadResult {
+	ch := make(chan LoadResult, 1)
+	s.do(func() {
+		if s.backendErr != nil {
+			ch <- LoadResult{Err: s.backendErr}
+			return
+		}
+		data, err := s.backend.load(key)
+		ch <- LoadResult{
+			Data: data,
+			Err:  err,
+		}
+	})
+	return ch
+}
+
+// Save saves the data for the given key asynchronously.
+//
+// The given data is copied, and it is safe to modify data after Save returns.
+func (s *Storage) Save(key string, data []byte) <-chan error {
+	data = append([]byte(nil), data...)
+	ch := make(chan error, 1)
+	s.do(func() {
+		if s.backendErr != nil {
+			ch <- s.backendErr
+			return
+		}
+		ch <- s.backend.save(key, data)
+	})
+	return ch
+}
+
+// Delete deletes the data for the given key asynchronously.
+//
+// Deleting a non-existent key is not an error.
+func (s *Storage) Delete(key string) <-chan error {
+	ch := make(chan error, 1)
+	s.do(func() {
+		if s.backendErr != nil {
+			ch <- s.backendErr
+			return
+		}
+		ch <- s.backend.delete(key)
+	})
+	return ch
+}
+
+// Keys lists the keys in the storage asynchronously.
+//
+// The order of the keys is not specified.
+func (s *Storage) Keys() <-chan KeysResult {
+	ch := make(chan KeysResult, 1)
+	s.do(func() {
+		if s.backendErr != nil {
+			ch <- KeysResult{Err: s.backendErr}
+			return
+		}
+		keys, err := s.backend.keys()
+		ch <- KeysResult{
+			Keys: keys,
+			Err:  err,
+		}
+	})
+	return ch
+}
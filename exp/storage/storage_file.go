@@ -0,0 +1,144 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+
+package storage
+
+import (
+	"errors"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// fileBackend stores each value in a file whose name is the escaped key.
+type fileBackend struct {
+	dir string
+}
+
+func newBackend(name string) (backend, error) {
+	root, err := rootDir()
+	if err != nil {
+		return nil, err
+	}
+	return newFileBackend(filepath.Join(root, escapeFileName(name))), nil
+}
+
+func newFileBackend(dir string) *fileBackend {
+	return &fileBackend{
+		dir: dir,
+	}
+}
+
+const fileExt = ".dat"
+
+func (f *fileBackend) path(key string) string {
+	return filepath.Join(f.dir, escapeFileName(key)+fileExt)
+}
+
+// escapeFileName escapes the given string so that any string can be a valid file name.
+// Only lowercase letters, digits, '-', '_', and '.' are kept, and the other bytes are percent-encoded.
+// Uppercase letters are also encoded so that the keys don't collide on case-insensitive file systems.
+// A name like ".." is still safe thanks to the extension.
+func escapeFileName(str string) string {
+	const hex = "0123456789ABCDEF"
+	var sb strings.Builder
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '_' || c == '.' {
+			sb.WriteByte(c)
+			continue
+		}
+		sb.WriteByte('%')
+		sb.WriteByte(hex[c>>4])
+		sb.WriteByte(hex[c&0xf])
+	}
+	return sb.String()
+}
+
+func (f *fileBackend) load(key string) ([]byte, error) {
+	data, err := os.ReadFile(f.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (f *fileBackend) save(key string, data []byte) error {
+	if err := os.MkdirAll(f.dir, 0755); err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename it so that the existing data is not broken even when writing fails.
+	tmp, err := os.CreateTemp(f.dir, "tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path(key))
+}
+
+func (f *fileBackend) delete(key string) error {
+	if err := os.Remove(f.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (f *fileBackend) keys() ([]string, error) {
+	ents, err := os.ReadDir(f.dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, e := range ents {
+		if e.IsDir() {
+			continue
+		}
+		name, ok := strings.CutSuffix(e.Name(), fileExt)
+		if !ok {
+			continue
+		}
+		key, err := url.PathUnescape(name)
+		if err != nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
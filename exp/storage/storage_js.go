@@ -0,0 +1,165 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"syscall/js"
+)
+
+const objectStoreName = "data"
+
+// indexedDBBackend stores each value as a Uint8Array in an IndexedDB object store.
+type indexedDBBackend struct {
+	name string
+	db   js.Value
+}
+
+func newBackend(name string) (backend, error) {
+	if !js.Global().Get("indexedDB").Truthy() {
+		return nil, errors.New("storage: IndexedDB is not available")
+	}
+	return &indexedDBBackend{
+		name: name,
+	}, nil
+}
+
+// waitRequest waits for the given IDBRequest and returns its result.
+//
+// waitRequest blocks until the request finishes. Do not call this from a JavaScript callback.
+func waitRequest(req js.Value) (js.Value, error) {
+	ch := make(chan error, 1)
+	success := js.FuncOf(func(this js.Value, args []js.Value) any {
+		ch <- nil
+		return nil
+	})
+	defer success.Release()
+	failure := js.FuncOf(func(this js.Value, args []js.Value) any {
+		ch <- domError(req.Get("error"))
+		return nil
+	})
+	defer failure.Release()
+
+	req.Set("onsuccess", success)
+	req.Set("onerror", failure)
+	if err := <-ch; err != nil {
+		return js.Value{}, err
+	}
+	return req.Get("result"), nil
+}
+
+// waitTransaction waits for the given IDBTransaction to be committed.
+//
+// waitTransaction blocks until the transaction finishes. Do not call this from a JavaScript callback.
+func waitTransaction(tx js.Value) error {
+	ch := make(chan error, 1)
+	complete := js.FuncOf(func(this js.Value, args []js.Value) any {
+		ch <- nil
+		return nil
+	})
+	defer complete.Release()
+	failure := js.FuncOf(func(this js.Value, args []js.Value) any {
+		ch <- domError(tx.Get("error"))
+		return nil
+	})
+	defer failure.Release()
+
+	tx.Set("oncomplete", complete)
+	tx.Set("onerror", failure)
+	tx.Set("onabort", failure)
+	return <-ch
+}
+
+func domError(err js.Value) error {
+	if !err.Truthy() {
+		return errors.New("storage: IndexedDB operation failed")
+	}
+	return fmt.Errorf("storage: IndexedDB operation failed: %s: %s", err.Get("name").String(), err.Get("message").String())
+}
+
+func (i *indexedDBBackend) objectStore(mode string) (tx js.Value, store js.Value, err error) {
+	if !i.db.Truthy() {
+		req := js.Global().Get("indexedDB").Call("open", i.name, 1)
+		upgrade := js.FuncOf(func(this js.Value, args []js.Value) any {
+			req.Get("result").Call("createObjectStore", objectStoreName)
+			return nil
+		})
+		defer upgrade.Release()
+		req.Set("onupgradeneeded", upgrade)
+
+		db, err := waitRequest(req)
+		if err != nil {
+			return js.Value{}, js.Value{}, err
+		}
+		i.db = db
+	}
+
+	tx = i.db.Call("transaction", objectStoreName, mode)
+	return tx, tx.Call("objectStore", objectStoreName), nil
+}
+
+func (i *indexedDBBackend) load(key string) ([]byte, error) {
+	_, store, err := i.objectStore("readonly")
+	if err != nil {
+		return nil, err
+	}
+	v, err := waitRequest(store.Call("get", key))
+	if err != nil {
+		return nil, err
+	}
+	if v.IsUndefined() {
+		return nil, ErrNotFound
+	}
+	data := make([]byte, v.Get("byteLength").Int())
+	js.CopyBytesToGo(data, v)
+	return data, nil
+}
+
+func (i *indexedDBBackend) save(key string, data []byte) error {
+	tx, store, err := i.objectStore("readwrite")
+	if err != nil {
+		return err
+	}
+	arr := js.Global().Get("Uint8Array").New(len(data))
+	js.CopyBytesToJS(arr, data)
+	store.Call("put", arr, key)
+	return waitTransaction(tx)
+}
+
+func (i *indexedDBBackend) delete(key string) error {
+	tx, store, err := i.objectStore("readwrite")
+	if err != nil {
+		return err
+	}
+	store.Call("delete", key)
+	return waitTransaction(tx)
+}
+
+func (i *indexedDBBackend) keys() ([]string, error) {
+	_, store, err := i.objectStore("readonly")
+	if err != nil {
+		return nil, err
+	}
+	v, err := waitRequest(store.Call("getAllKeys"))
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, v.Length())
+	for j := 0; j < v.Length(); j++ {
+		keys = append(keys, v.Index(j).String())
+	}
+	return keys, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+
+package storage_test
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2/exp/storage"
+)
+
+func TestSaveAndLoad(t *testing.T) {
+	s := storage.NewWithDir(t.TempDir())
+
+	if r := <-s.Load("foo"); !errors.Is(r.Err, storage.ErrNotFound) {
+		t.Errorf("Load before Save: got: %v, want: %v", r.Err, storage.ErrNotFound)
+	}
+
+	data := []byte("save data")
+	if err := <-s.Save("foo", data); err != nil {
+		t.Fatal(err)
+	}
+	// Modifying the given slice must not affect the saved data.
+	data[0] = 'X'
+
+	r := <-s.Load("foo")
+	if r.Err != nil {
+		t.Fatal(r.Err)
+	}
+	if got, want := r.Data, []byte("save data"); !bytes.Equal(got, want) {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+
+	if err := <-s.Save("foo", []byte("overwritten")); err != nil {
+		t.Fatal(err)
+	}
+	r = <-s.Load("foo")
+	if r.Err != nil {
+		t.Fatal(r.Err)
+	}
+	if got, want := r.Data, []byte("overwritten"); !bytes.Equal(got, want) {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+
+	if err := <-s.Delete("foo"); err != nil {
+		t.Fatal(err)
+	}
+	if r := <-s.Load("foo"); !errors.Is(r.Err, storage.ErrNotFound) {
+		t.Errorf("Load after Delete: got: %v, want: %v", r.Err, storage.ErrNotFound)
+	}
+	if err := <-s.Delete("foo"); err != nil {
+		t.Errorf("Delete for a non-existent key must not fail: %v", err)
+	}
+}
+
+func TestKeys(t *testing.T) {
+	s := storage.NewWithDir(t.TempDir())
+
+	keys := []string{"a", "A", "slot/1", "..", "a b", "日本語", ""}
+	// The results are not received until all the operations are queued, to test the operations are executed in order.
+	var chs []<-chan error
+	for _, k := range keys {
+		chs = append(chs, s.Save(k, []byte(k)))
+	}
+	for _, ch := range chs {
+		if err := <-ch; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := <-s.Keys()
+	if r.Err != nil {
+		t.Fatal(r.Err)
+	}
+	got := slices.Sorted(slices.Values(r.Keys))
+	want := slices.Sorted(slices.Values(keys))
+	if !slices.Equal(got, want) {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+
+	for _, k := range keys {
+		r := <-s.Load(k)
+		if r.Err != nil {
+			t.Fatal(r.Err)
+		}
+		if got, want := string(r.Data), k; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+	}
+}
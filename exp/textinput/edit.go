@@ -0,0 +1,386 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package textinput
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// Unit is a unit of cursor movements and deletions.
+type Unit int
+
+const (
+	// UnitGraphemeCluster is a grapheme cluster, i.e., what a user perceives as a single character.
+	UnitGraphemeCluster Unit = iota
+
+	// UnitWord is a word. The word boundaries are determined by text.AppendWords.
+	UnitWord
+
+	// UnitLine is to the start or the end of the current line.
+	UnitLine
+
+	// UnitText is to the start or the end of the whole text.
+	UnitText
+)
+
+// maxUndoHistory is the maximum number of undo steps.
+const maxUndoHistory = 100
+
+type editKind int
+
+const (
+	editKindNone editKind = iota
+	editKindInsert
+	editKindDeleteBackward
+	editKindDeleteForward
+	editKindOther
+)
+
+type fieldSnapshot struct {
+	text                  string
+	selectionStartInBytes int
+	selectionEndInBytes   int
+}
+
+func (f *Field) snapshot() fieldSnapshot {
+	return fieldSnapshot{
+		text:                  f.text,
+		selectionStartInBytes: f.selectionStartInBytes,
+		selectionEndInBytes:   f.selectionEndInBytes,
+	}
+}
+
+func (f *Field) restore(s fieldSnapshot) {
+	f.text = s.text
+	f.selectionStartInBytes = s.selectionStartInBytes
+	f.selectionEndInBytes = s.selectionEndInBytes
+	f.cursorAtStart = false
+	f.lastEdit = editKindNone
+}
+
+// pushUndo records the current state before an edit of the given kind.
+// Consecutive edits of the same kind, like typing characters one by one, are coalesced into one undo step.
+func (f *Field) pushUndo(kind editKind) {
+	f.redoStack = f.redoStack[:0]
+	if kind != editKindOther && kind == f.lastEdit {
+		return
+	}
+	f.undoStack = append(f.undoStack, f.snapshot())
+	if len(f.undoStack) > maxUndoHistory {
+		f.undoStack = f.undoStack[len(f.undoStack)-maxUndoHistory:]
+	}
+	f.lastEdit = kind
+}
+
+// replaceSelection replaces the selected text with str, and puts the cursor after str.
+func (f *Field) replaceSelection(str string, kind editKind) {
+	f.replaceRange(f.selectionStartInBytes, f.selectionEndInBytes, str, kind)
+}
+
+// replaceRange replaces the text in [start, end) with str, and puts the cursor after str.
+//
+// The undo step is recorded with the current selection, so the selection is not changed before replaceRange.
+func (f *Field) replaceRange(start, end int, str string, kind editKind) {
+	if str == "" && start == end {
+		return
+	}
+	// A white space ends the coalescing of typing, so that a word is undone at a time.
+	if kind == editKindInsert && strings.IndexFunc(str, unicode.IsSpace) >= 0 {
+		f.lastEdit = editKindNone
+	}
+	f.pushUndo(kind)
+	f.text = f.text[:start] + str + f.text[end:]
+	f.selectionStartInBytes = start + len(str)
+	f.selectionEndInBytes = f.selectionStartInBytes
+	f.cursorAtStart = false
+}
+
+// cursor returns the cursor position, which is the moving end of the selection.
+func (f *Field) cursor() int {
+	if f.cursorAtStart {
+		return f.selectionStartInBytes
+	}
+	return f.selectionEndInBytes
+}
+
+// anchor returns the fixed end of the selection.
+func (f *Field) anchor() int {
+	if f.cursorAtStart {
+		return f.selectionEndInBytes
+	}
+	return f.selectionStartInBytes
+}
+
+func (f *Field) setCursor(cursor int, extendSelection bool) {
+	anchor := cursor
+	if extendSelection {
+		anchor = f.anchor()
+	}
+	f.selectionStartInBytes = min(anchor, cursor)
+	f.selectionEndInBytes = max(anchor, cursor)
+	f.cursorAtStart = cursor < anchor
+	f.lastEdit = editKindNone
+}
+
+// MoveCursorBackward moves the cursor backward by the given unit.
+// If extendSelection is true, the selection is extended to the new cursor position, e.g., for Shift+Left.
+// Otherwise, the selection is collapsed.
+func (f *Field) MoveCursorBackward(unit Unit, extendSelection bool) {
+	f.cleanUp()
+	if !extendSelection && unit == UnitGraphemeCluster && f.selectionStartInBytes != f.selectionEndInBytes {
+		f.setCursor(f.selectionStartInBytes, false)
+		return
+	}
+	f.setCursor(prevBoundary(f.text, f.cursor(), unit), extendSelection)
+}
+
+// MoveCursorForward moves the cursor forward by the given unit.
+// If extendSelection is true, the selection is extended to the new cursor position, e.g., for Shift+Right.
+// Otherwise, the selection is collapsed.
+func (f *Field) MoveCursorForward(unit Unit, extendSelection bool) {
+	f.cleanUp()
+	if !extendSelection && unit == UnitGraphemeCluster && f.selectionStartInBytes != f.selectionEndInBytes {
+		f.setCursor(f.selectionEndInBytes, false)
+		return
+	}
+	f.setCursor(nextBoundary(f.text, f.cursor(), unit), extendSelection)
+}
+
+// SelectAll selects the whole text.
+func (f *Field) SelectAll() {
+	f.cleanUp()
+	f.selectionStartInBytes = 0
+	f.selectionEndInBytes = len(f.text)
+	f.cursorAtStart = false
+	f.lastEdit = editKindNone
+}
+
+// DeleteBackward deletes the selected text, or the text before the cursor by the given unit if nothing is selected, e.g., for Backspace.
+func (f *Field) DeleteBackward(unit Unit) {
+	f.cleanUp()
+	start := f.selectionStartInBytes
+	if start == f.selectionEndInBytes {
+		start = prevBoundary(f.text, start, unit)
+	}
+	f.replaceRange(start, f.selectionEndInBytes, "", editKindDeleteBackward)
+}
+
+// DeleteForward deletes the selected text, or the text after the cursor by the given unit if nothing is selected, e.g., for Delete.
+func (f *Field) DeleteForward(unit Unit) {
+	f.cleanUp()
+	end := f.selectionEndInBytes
+	if f.selectionStartInBytes == end {
+		end = nextBoundary(f.text, end, unit)
+	}
+	f.replaceRange(f.selectionStartInBytes, end, "", editKindDeleteForward)
+}
+
+// Insert replaces the selected text with the given text, and puts the cursor after the inserted text.
+// Insert is useful for inputs that don't go through IME, e.g., a new line by Enter.
+func (f *Field) Insert(text string) {
+	f.cleanUp()
+	f.replaceSelection(text, editKindInsert)
+}
+
+// Copy returns the selected text.
+// Pass the returned text to a clipboard, e.g., for Ctrl+C.
+func (f *Field) Copy() string {
+	return f.text[f.selectionStartInBytes:f.selectionEndInBytes]
+}
+
+// Cut deletes the selected text and returns it.
+// Pass the returned text to a clipboard, e.g., for Ctrl+X.
+func (f *Field) Cut() string {
+	f.cleanUp()
+	str := f.Copy()
+	f.replaceSelection("", editKindOther)
+	return str
+}
+
+// Paste replaces the selected text with the given text from a clipboard, e.g., for Ctrl+V.
+// Paste is one undo step, unlike consecutive Insert calls.
+func (f *Field) Paste(text string) {
+	f.cleanUp()
+	f.replaceSelection(text, editKindOther)
+}
+
+// CanUndo reports whether there is an edit to undo.
+func (f *Field) CanUndo() bool {
+	return len(f.undoStack) > 0
+}
+
+// CanRedo reports whether there is an undone edit to redo.
+func (f *Field) CanRedo() bool {
+	return len(f.redoStack) > 0
+}
+
+// Undo undoes the last edit and returns true. If there is no edit to undo, Undo returns false.
+//
+// The edits by Insert, DeleteBackward, DeleteForward, Cut, Paste, SetTextAndSelection, and committed texts by IME can be undone.
+// Consecutive insertions or deletions are undone at a time.
+func (f *Field) Undo() bool {
+	f.cleanUp()
+	if len(f.undoStack) == 0 {
+		return false
+	}
+	s := f.undoStack[len(f.undoStack)-1]
+	f.undoStack = f.undoStack[:len(f.undoStack)-1]
+	f.redoStack = append(f.redoStack, f.snapshot())
+	f.restore(s)
+	return true
+}
+
+// Redo redoes the last undone edit and returns true. If there is no edit to redo, Redo returns false.
+func (f *Field) Redo() bool {
+	f.cleanUp()
+	if len(f.redoStack) == 0 {
+		return false
+	}
+	s := f.redoStack[len(f.redoStack)-1]
+	f.redoStack = f.redoStack[:len(f.redoStack)-1]
+	f.undoStack = append(f.undoStack, f.snapshot())
+	f.restore(s)
+	return true
+}
+
+// ClearHistory clears the undo and redo history, e.g., after a message in a chat box is sent.
+func (f *Field) ClearHistory() {
+	f.undoStack = f.undoStack[:0]
+	f.redoStack = f.redoStack[:0]
+	f.lastEdit = editKindNone
+}
+
+// IndexAtPosition returns the index in bytes of the text at the given position, e.g., for a mouse click.
+// The returned index is always at a grapheme cluster boundary.
+//
+// (x, y) is a position relative to the upper-left corner of the text,
+// which is rendered by text.Draw with the face and the line spacing in pixels with the default alignments.
+// IndexAtPosition supports only horizontal faces.
+func (f *Field) IndexAtPosition(x, y float64, face text.Face, lineSpacingInPixels float64) int {
+	lineStart := 0
+	if lineSpacingInPixels > 0 {
+		for n := int(math.Floor(y / lineSpacingInPixels)); n > 0; n-- {
+			i := strings.IndexByte(f.text[lineStart:], '\n')
+			if i < 0 {
+				break
+			}
+			lineStart += i + 1
+		}
+	}
+	lineEnd := len(f.text)
+	if i := strings.IndexByte(f.text[lineStart:], '\n'); i >= 0 {
+		lineEnd = lineStart + i
+	}
+
+	line := f.text[lineStart:lineEnd]
+	idx := 0
+	minDist := math.Abs(x)
+	var offset int
+	for _, c := range text.AppendGraphemeClusters(nil, line) {
+		offset += len(c)
+		if d := math.Abs(text.Advance(line[:offset], face) - x); d < minDist {
+			idx = offset
+			minDist = d
+		}
+	}
+	return lineStart + idx
+}
+
+// CursorPosition returns the position of the cursor relative to the upper-left corner of the text,
+// which is rendered by text.Draw with TextForRendering, the face, and the line spacing in pixels with the default alignments.
+// While a text is composited by IME, the position is at the composition selection.
+//
+// CursorPosition is useful to render a cursor and to pass the position to HandleInput.
+// CursorPosition supports only horizontal faces.
+func (f *Field) CursorPosition(face text.Face, lineSpacingInPixels float64) (x, y float64) {
+	str := f.TextForRendering()
+	c := f.cursor()
+	if s, _, ok := f.CompositionSelection(); ok {
+		c = f.selectionStartInBytes + s
+	}
+	str = str[:c]
+
+	lineStart := strings.LastIndexByte(str, '\n') + 1
+	return text.Advance(str[lineStart:], face), float64(strings.Count(str, "\n")) * lineSpacingInPixels
+}
+
+// prevBoundary returns the boundary before the index by the unit.
+func prevBoundary(str string, index int, unit Unit) int {
+	switch unit {
+	case UnitGraphemeCluster:
+		var offset int
+		for _, c := range text.AppendGraphemeClusters(nil, str[:index]) {
+			if offset+len(c) >= index {
+				break
+			}
+			offset += len(c)
+		}
+		return offset
+	case UnitWord:
+		// Move to the start of the word before the index.
+		var result int
+		for _, w := range wordRanges(str[:index]) {
+			result = w[0]
+		}
+		return result
+	case UnitLine:
+		return strings.LastIndexByte(str[:index], '\n') + 1
+	case UnitText:
+		return 0
+	}
+	return index
+}
+
+// nextBoundary returns the boundary after the index by the unit.
+func nextBoundary(str string, index int, unit Unit) int {
+	switch unit {
+	case UnitGraphemeCluster:
+		if index >= len(str) {
+			return len(str)
+		}
+		cs := text.AppendGraphemeClusters(nil, str[index:])
+		return index + len(cs[0])
+	case UnitWord:
+		// Move to the end of the word after the index.
+		for _, w := range wordRanges(str[index:]) {
+			return index + w[1]
+		}
+		return len(str)
+	case UnitLine:
+		if i := strings.IndexByte(str[index:], '\n'); i >= 0 {
+			return index + i
+		}
+		return len(str)
+	case UnitText:
+		return len(str)
+	}
+	return index
+}
+
+// wordRanges returns the ranges in bytes of the words in str.
+func wordRanges(str string) [][2]int {
+	var ranges [][2]int
+	var offset int
+	for _, w := range text.AppendWords(nil, str) {
+		i := offset + strings.Index(str[offset:], w)
+		ranges = append(ranges, [2]int{i, i + len(w)})
+		offset = i + len(w)
+	}
+	return ranges
+}
@@ -0,0 +1,335 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package textinput_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2/exp/textinput"
+)
+
+func TestFieldMoveCursor(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Text      string
+		Cursor    int
+		Unit      textinput.Unit
+		Backward  int
+		Forward   int
+		Extension bool
+	}{
+		{
+			Name:     "grapheme cluster",
+			Text:     "a\U0001F44D\U0001F3FDb", // A thumbs-up with a skin tone modifier is one grapheme cluster.
+			Cursor:   9,
+			Unit:     textinput.UnitGraphemeCluster,
+			Backward: 1,
+			Forward:  10,
+		},
+		{
+			Name:     "grapheme cluster at the start",
+			Text:     "abc",
+			Cursor:   0,
+			Unit:     textinput.UnitGraphemeCluster,
+			Backward: 0,
+			Forward:  1,
+		},
+		{
+			Name:     "grapheme cluster at the end",
+			Text:     "abc",
+			Cursor:   3,
+			Unit:     textinput.UnitGraphemeCluster,
+			Backward: 2,
+			Forward:  3,
+		},
+		{
+			Name:     "word in a word",
+			Text:     "hello world foo",
+			Cursor:   8,
+			Unit:     textinput.UnitWord,
+			Backward: 6,
+			Forward:  11,
+		},
+		{
+			Name:     "word between words",
+			Text:     "hello world foo",
+			Cursor:   11,
+			Unit:     textinput.UnitWord,
+			Backward: 6,
+			Forward:  15,
+		},
+		{
+			Name:     "word without words",
+			Text:     "   ",
+			Cursor:   1,
+			Unit:     textinput.UnitWord,
+			Backward: 0,
+			Forward:  3,
+		},
+		{
+			Name:     "line",
+			Text:     "abc\ndef\nghi",
+			Cursor:   5,
+			Unit:     textinput.UnitLine,
+			Backward: 4,
+			Forward:  7,
+		},
+		{
+			Name:     "text",
+			Text:     "abc\ndef\nghi",
+			Cursor:   5,
+			Unit:     textinput.UnitText,
+			Backward: 0,
+			Forward:  11,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var f textinput.Field
+			f.SetTextAndSelection(tc.Text, tc.Cursor, tc.Cursor)
+			f.MoveCursorBackward(tc.Unit, false)
+			if start, end := f.Selection(); start != tc.Backward || end != tc.Backward {
+				t.Errorf("MoveCursorBackward: got: (%d, %d), want: (%d, %d)", start, end, tc.Backward, tc.Backward)
+			}
+
+			f.SetSelection(tc.Cursor, tc.Cursor)
+			f.MoveCursorForward(tc.Unit, false)
+			if start, end := f.Selection(); start != tc.Forward || end != tc.Forward {
+				t.Errorf("MoveCursorForward: got: (%d, %d), want: (%d, %d)", start, end, tc.Forward, tc.Forward)
+			}
+		})
+	}
+}
+
+func TestFieldExtendSelection(t *testing.T) {
+	var f textinput.Field
+	f.SetTextAndSelection("abcdef", 3, 3)
+
+	f.MoveCursorBackward(textinput.UnitGraphemeCluster, true)
+	f.MoveCursorBackward(textinput.UnitGraphemeCluster, true)
+	if start, end := f.Selection(); start != 1 || end != 3 {
+		t.Errorf("got: (%d, %d), want: (1, 3)", start, end)
+	}
+
+	// The cursor is at the start of the selection, so moving forward shrinks the selection.
+	f.MoveCursorForward(textinput.UnitGraphemeCluster, true)
+	if start, end := f.Selection(); start != 2 || end != 3 {
+		t.Errorf("got: (%d, %d), want: (2, 3)", start, end)
+	}
+
+	// Moving without extending collapses the selection to its end.
+	f.MoveCursorForward(textinput.UnitGraphemeCluster, false)
+	if start, end := f.Selection(); start != 3 || end != 3 {
+		t.Errorf("got: (%d, %d), want: (3, 3)", start, end)
+	}
+}
+
+func TestFieldDelete(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Text     string
+		Start    int
+		End      int
+		Unit     textinput.Unit
+		Backward bool
+		Want     string
+		Cursor   int
+	}{
+		{
+			Name:     "backward grapheme cluster",
+			Text:     "a\U0001F44D\U0001F3FDb",
+			Start:    9,
+			End:      9,
+			Unit:     textinput.UnitGraphemeCluster,
+			Backward: true,
+			Want:     "ab",
+			Cursor:   1,
+		},
+		{
+			Name:   "forward grapheme cluster",
+			Text:   "a\U0001F44D\U0001F3FDb",
+			Start:  1,
+			End:    1,
+			Unit:   textinput.UnitGraphemeCluster,
+			Want:   "ab",
+			Cursor: 1,
+		},
+		{
+			Name:     "backward word",
+			Text:     "hello world",
+			Start:    11,
+			End:      11,
+			Unit:     textinput.UnitWord,
+			Backward: true,
+			Want:     "hello ",
+			Cursor:   6,
+		},
+		{
+			Name:   "forward word",
+			Text:   "hello world",
+			Start:  0,
+			End:    0,
+			Unit:   textinput.UnitWord,
+			Want:   " world",
+			Cursor: 0,
+		},
+		{
+			Name:     "backward selection",
+			Text:     "hello world",
+			Start:    2,
+			End:      8,
+			Unit:     textinput.UnitWord,
+			Backward: true,
+			Want:     "herld",
+			Cursor:   2,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var f textinput.Field
+			f.SetTextAndSelection(tc.Text, tc.Start, tc.End)
+			if tc.Backward {
+				f.DeleteBackward(tc.Unit)
+			} else {
+				f.DeleteForward(tc.Unit)
+			}
+			if got := f.Text(); got != tc.Want {
+				t.Errorf("Text(): got: %q, want: %q", got, tc.Want)
+			}
+			if start, end := f.Selection(); start != tc.Cursor || end != tc.Cursor {
+				t.Errorf("Selection(): got: (%d, %d), want: (%d, %d)", start, end, tc.Cursor, tc.Cursor)
+			}
+		})
+	}
+}
+
+func TestFieldUndoRestoresCursorAfterDelete(t *testing.T) {
+	var f textinput.Field
+	for _, c := range []string{"a", "b", "c"} {
+		f.Insert(c)
+	}
+	f.DeleteBackward(textinput.UnitGraphemeCluster)
+	f.Undo()
+	if start, end := f.Selection(); start != 3 || end != 3 {
+		t.Errorf("Selection() after undoing DeleteBackward: got: (%d, %d), want: (3, 3)", start, end)
+	}
+	f.Insert("d")
+	if got, want := f.Text(), "abcd"; got != want {
+		t.Errorf("Text(): got: %q, want: %q", got, want)
+	}
+
+	f.SetSelection(0, 0)
+	f.DeleteForward(textinput.UnitGraphemeCluster)
+	f.Undo()
+	if start, end := f.Selection(); start != 0 || end != 0 {
+		t.Errorf("Selection() after undoing DeleteForward: got: (%d, %d), want: (0, 0)", start, end)
+	}
+	f.Insert("e")
+	if got, want := f.Text(), "eabcd"; got != want {
+		t.Errorf("Text(): got: %q, want: %q", got, want)
+	}
+}
+
+func TestFieldUndoCoalescing(t *testing.T) {
+	var f textinput.Field
+	for _, c := range "hello wo" {
+		f.Insert(string(c))
+	}
+
+	// A white space ends a coalesced run of typing.
+	if !f.Undo() {
+		t.Fatal("Undo(): got: false, want: true")
+	}
+	if got, want := f.Text(), "hello"; got != want {
+		t.Errorf("Text(): got: %q, want: %q", got, want)
+	}
+	if !f.Undo() {
+		t.Fatal("Undo(): got: false, want: true")
+	}
+	if got, want := f.Text(), ""; got != want {
+		t.Errorf("Text(): got: %q, want: %q", got, want)
+	}
+	if f.CanUndo() {
+		t.Errorf("CanUndo(): got: true, want: false")
+	}
+
+	// Consecutive deletions are also coalesced.
+	f.SetTextAndSelection("abcde", 5, 5)
+	f.DeleteBackward(textinput.UnitGraphemeCluster)
+	f.DeleteBackward(textinput.UnitGraphemeCluster)
+	f.Undo()
+	if got, want := f.Text(), "abcde"; got != want {
+		t.Errorf("Text(): got: %q, want: %q", got, want)
+	}
+
+	// A cursor movement ends a coalesced run.
+	f.Insert("f")
+	f.MoveCursorBackward(textinput.UnitGraphemeCluster, false)
+	f.Insert("g")
+	f.Undo()
+	if got, want := f.Text(), "abcdef"; got != want {
+		t.Errorf("Text(): got: %q, want: %q", got, want)
+	}
+}
+
+func TestFieldRedo(t *testing.T) {
+	var f textinput.Field
+	f.Paste("a")
+	f.Paste("b")
+	f.Undo()
+	if !f.CanRedo() {
+		t.Fatal("CanRedo(): got: false, want: true")
+	}
+	if !f.Redo() {
+		t.Fatal("Redo(): got: false, want: true")
+	}
+	if got, want := f.Text(), "ab"; got != want {
+		t.Errorf("Text(): got: %q, want: %q", got, want)
+	}
+
+	// A new edit clears the redo history.
+	f.Undo()
+	f.Paste("c")
+	if f.CanRedo() {
+		t.Errorf("CanRedo(): got: true, want: false")
+	}
+	if f.Redo() {
+		t.Errorf("Redo(): got: true, want: false")
+	}
+	if got, want := f.Text(), "ac"; got != want {
+		t.Errorf("Text(): got: %q, want: %q", got, want)
+	}
+}
+
+func TestFieldUndoHistoryLimit(t *testing.T) {
+	var f textinput.Field
+	// Each Paste is one undo step.
+	for i := 0; i < 150; i++ {
+		f.Paste("a")
+	}
+
+	var n int
+	for f.Undo() {
+		n++
+	}
+	// The maximum number of undo steps is 100.
+	if got, want := n, 100; got != want {
+		t.Errorf("the number of undo steps: got: %d, want: %d", got, want)
+	}
+	if got, want := f.Text(), strings.Repeat("a", 50); got != want {
+		t.Errorf("Text(): got: %q, want: %q", got, want)
+	}
+}
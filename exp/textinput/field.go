@@ -16,6 +16,8 @@ package textinput
 
 import (
 	"sync"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/ui"
 )
 
 var (
@@ -38,6 +40,7 @@ func focusField(f *Field) {
 	}
 	origField = theFocusedField
 	theFocusedField = f
+	ui.Get().ShowVirtualKeyboard()
 }
 
 func blurField(f *Field) {
@@ -55,6 +58,7 @@ func blurField(f *Field) {
 	}
 	origField = theFocusedField
 	theFocusedField = nil
+	ui.Get().HideVirtualKeyboard()
 }
 
 func isFieldFocused(f *Field) bool {
@@ -69,12 +73,23 @@ func isFieldFocused(f *Field) bool {
 //
 // Field is a wrapper of the low-level API like Start.
 //
+// Field also works as an editable text model with cursor movements by grapheme clusters and words, selections, and undo/redo.
+// Field doesn't handle keyboard shortcuts by itself. Call the editing functions like MoveCursorBackward and DeleteBackward
+// for keys that are not handled by HandleInput.
+//
 // For an actual usage, see the examples "textinput".
 type Field struct {
 	text                  string
 	selectionStartInBytes int
 	selectionEndInBytes   int
 
+	// cursorAtStart reports whether the cursor is at the selection start, e.g., after the selection is extended backward.
+	cursorAtStart bool
+
+	undoStack []fieldSnapshot
+	redoStack []fieldSnapshot
+	lastEdit  editKind
+
 	ch    <-chan State
 	end   func()
 	state State
@@ -126,9 +141,7 @@ func (f *Field) HandleInput(x, y int) (handled bool, err error) {
 				}
 				handled = true
 				if state.Committed {
-					f.text = f.text[:f.selectionStartInBytes] + state.Text + f.text[f.selectionEndInBytes:]
-					f.selectionStartInBytes += len(state.Text)
-					f.selectionEndInBytes = f.selectionStartInBytes
+					f.replaceSelection(state.Text, editKindInsert)
 					f.state = State{}
 					continue
 				}
@@ -153,11 +166,15 @@ func (f *Field) HandleInput(x, y int) (handled bool, err error) {
 //
 // There can be only one Field that is focused at the same time.
 // When Focus is called and there is already a focused field, Focus removes the focus of that.
+//
+// On Android and iOS, Focus shows the on-screen virtual keyboard.
 func (f *Field) Focus() {
 	focusField(f)
 }
 
 // Blur removes the focus from the field.
+//
+// On Android and iOS, Blur hides the on-screen virtual keyboard.
 func (f *Field) Blur() {
 	blurField(f)
 }
@@ -181,9 +198,7 @@ func (f *Field) cleanUp() {
 				return
 			}
 			if ok && state.Committed {
-				f.text = f.text[:f.selectionStartInBytes] + state.Text + f.text[f.selectionEndInBytes:]
-				f.selectionStartInBytes += len(state.Text)
-				f.selectionEndInBytes = f.selectionStartInBytes
+				f.replaceSelection(state.Text, editKindInsert)
 				f.state = State{}
 			}
 			f.state = state
@@ -220,6 +235,8 @@ func (f *Field) SetSelection(startInBytes, endInBytes int) {
 	f.cleanUp()
 	f.selectionStartInBytes = startInBytes
 	f.selectionEndInBytes = endInBytes
+	f.cursorAtStart = false
+	f.lastEdit = editKindNone
 }
 
 // Text returns the current text.
@@ -248,8 +265,14 @@ func (f *Field) UncommittedTextLengthInBytes() int {
 }
 
 // SetTextAndSelection sets the text and the selection range.
+// If the text is changed, the change can be undone by Undo.
 func (f *Field) SetTextAndSelection(text string, selectionStartInBytes, selectionEndInBytes int) {
 	f.cleanUp()
+	if f.text != text {
+		f.pushUndo(editKindOther)
+	}
+	f.cursorAtStart = false
+	f.lastEdit = editKindNone
 	f.text = text
 	f.selectionStartInBytes = selectionStartInBytes
 	f.selectionEndInBytes = selectionEndInBytes
@@ -17,9 +17,36 @@ package ebiten
 import "github.com/hajimehoshi/ebiten/v2/internal/builtinshader"
 
 var (
-	ImageToBytes = imageToBytes
+	ImageToBytes   = imageToBytes
+	ScreenColorLUT = screenColorLUT
 )
 
 func BuiltinShader(filter builtinshader.Filter, address builtinshader.Address, useColorM bool) *Shader {
 	return builtinShader(filter, address, useColorM)
 }
+
+func SetValidationErrorHandler(handler func(err error)) {
+	theValidator.setHandler(handler)
+}
+
+// DrawOffscreenForTesting calls game's Draw with offscreen in the same way as the game loop does.
+func DrawOffscreenForTesting(game Game, offscreen *Image) error {
+	g := newGameForUI(game, false)
+	g.offscreen = offscreen
+	return g.DrawOffscreen()
+}
+
+func IsGameLoopRunning() bool {
+	theScheduledFuncsM.Lock()
+	defer theScheduledFuncsM.Unlock()
+	return theGameLoopRunning
+}
+
+func SetGameLoopRunning(running bool) {
+	setGameLoopRunning(running)
+}
+
+// UpdateForTesting calls game's Update in the same way as the game loop does.
+func UpdateForTesting(game Game) error {
+	return newGameForUI(game, false).Update()
+}
@@ -20,6 +20,7 @@ import (
 	"sync/atomic"
 
 	"github.com/hajimehoshi/ebiten/v2/internal/atlas"
+	"github.com/hajimehoshi/ebiten/v2/internal/clock"
 	"github.com/hajimehoshi/ebiten/v2/internal/ui"
 )
 
@@ -35,6 +36,8 @@ type gameForUI struct {
 	screen      *Image
 	imageDumper imageDumper
 	transparent bool
+
+	ticksDroppedHandler func(ticks int)
 }
 
 func newGameForUI(game Game, transparent bool) *gameForUI {
@@ -98,6 +101,10 @@ func (g *gameForUI) UpdateInputState(fn func(*ui.InputState)) {
 }
 
 func (g *gameForUI) Update() error {
+	runScheduledFuncs()
+	if n := clock.TakeDroppedTicks(); n > 0 && g.ticksDroppedHandler != nil {
+		g.ticksDroppedHandler(n)
+	}
 	if err := g.game.Update(); err != nil {
 		return err
 	}
@@ -109,6 +116,7 @@ func (g *gameForUI) Update() error {
 
 func (g *gameForUI) DrawOffscreen() error {
 	g.game.Draw(g.offscreen)
+	theValidator.flush()
 	if err := g.imageDumper.dump(g.offscreen, g.transparent); err != nil {
 		return err
 	}
@@ -120,12 +128,14 @@ func (g *gameForUI) DrawFinalScreen(scale, offsetX, offsetY float64) {
 	geoM.Scale(scale, scale)
 	geoM.Translate(offsetX, offsetY)
 
+	offscreen := theScreenColorAdjustment.apply(g.offscreen)
+
 	if d, ok := g.game.(FinalScreenDrawer); ok {
-		d.DrawFinalScreen(g.screen, g.offscreen, geoM)
+		d.DrawFinalScreen(g.screen, offscreen, geoM)
 		return
 	}
 
-	DefaultDrawFinalScreen(g.screen, g.offscreen, geoM)
+	DefaultDrawFinalScreen(g.screen, offscreen, geoM)
 }
 
 // DefaultDrawFinalScreen is the default implementation of [FinalScreenDrawer.DrawFinalScreen],
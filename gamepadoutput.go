@@ -0,0 +1,124 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/gamepad"
+)
+
+// GamepadTrigger represents a trigger of a gamepad.
+type GamepadTrigger int
+
+const (
+	GamepadTriggerLeft  GamepadTrigger = GamepadTrigger(gamepad.TriggerLeft)
+	GamepadTriggerRight GamepadTrigger = GamepadTrigger(gamepad.TriggerRight)
+)
+
+// GamepadTriggerEffectType represents a type of an adaptive trigger effect.
+type GamepadTriggerEffectType int
+
+const (
+	// GamepadTriggerEffectTypeOff disables the trigger effect.
+	GamepadTriggerEffectTypeOff GamepadTriggerEffectType = GamepadTriggerEffectType(gamepad.TriggerEffectTypeOff)
+
+	// GamepadTriggerEffectTypeResistance makes the trigger resist with Strength from the position Start to the end.
+	GamepadTriggerEffectTypeResistance GamepadTriggerEffectType = GamepadTriggerEffectType(gamepad.TriggerEffectTypeResistance)
+
+	// GamepadTriggerEffectTypeSection makes the trigger resist with Strength between the positions Start and End,
+	// like pulling the trigger of a gun.
+	GamepadTriggerEffectTypeSection GamepadTriggerEffectType = GamepadTriggerEffectType(gamepad.TriggerEffectTypeSection)
+
+	// GamepadTriggerEffectTypeVibration makes the trigger vibrate with Strength and Frequency from the position Start to the end.
+	GamepadTriggerEffectTypeVibration GamepadTriggerEffectType = GamepadTriggerEffectType(gamepad.TriggerEffectTypeVibration)
+)
+
+// GamepadTriggerEffect represents an adaptive trigger effect.
+type GamepadTriggerEffect struct {
+	// Type is the type of the effect.
+	Type GamepadTriggerEffectType
+
+	// Start is the position where the effect starts.
+	// The value is in between 0 (released) and 1 (fully pressed).
+	Start float64
+
+	// End is the position where the effect ends for GamepadTriggerEffectTypeSection.
+	// The value is in between 0 (released) and 1 (fully pressed).
+	End float64
+
+	// Strength is the strength of the resistance or the vibration.
+	// The value is in between 0 and 1.
+	Strength float64
+
+	// Frequency is the frequency of the vibration in Hz for GamepadTriggerEffectTypeVibration.
+	// The value is in between 0 and 255.
+	Frequency float64
+}
+
+// SetGamepadTriggerEffect sets the adaptive trigger effect of the gamepad (id).
+//
+// SetGamepadTriggerEffect works only with DualSense controllers on Linux so far.
+// On Linux, the hidraw device of the controller must be writable, e.g., by a udev rule.
+// Otherwise, SetGamepadTriggerEffect does nothing.
+//
+// SetGamepadTriggerEffect is concurrent-safe.
+func SetGamepadTriggerEffect(id GamepadID, trigger GamepadTrigger, effect *GamepadTriggerEffect) {
+	g := gamepad.Get(id)
+	if g == nil {
+		return
+	}
+	g.SetTriggerEffect(gamepad.Trigger(trigger), gamepad.TriggerEffect{
+		Type:      gamepad.TriggerEffectType(effect.Type),
+		Start:     effect.Start,
+		End:       effect.End,
+		Strength:  effect.Strength,
+		Frequency: effect.Frequency,
+	})
+}
+
+// SetGamepadLightBarColor sets the color of the light bar of the gamepad (id).
+// The alpha value of clr is ignored.
+//
+// SetGamepadLightBarColor works only with DualSense controllers on Linux so far.
+// On Linux, the hidraw device of the controller must be writable, e.g., by a udev rule.
+// Otherwise, SetGamepadLightBarColor does nothing.
+//
+// SetGamepadLightBarColor is concurrent-safe.
+func SetGamepadLightBarColor(id GamepadID, clr color.Color) {
+	g := gamepad.Get(id)
+	if g == nil {
+		return
+	}
+	c := color.NRGBAModel.Convert(clr).(color.NRGBA)
+	g.SetLightBarColor(c.R, c.G, c.B)
+}
+
+// SetGamepadPlayerLEDs sets the player indicator LEDs of the gamepad (id).
+// leds is a bit mask where the lowest bit represents the leftmost LED.
+// A DualSense controller has 5 LEDs.
+//
+// SetGamepadPlayerLEDs works only with DualSense controllers on Linux so far.
+// On Linux, the hidraw device of the controller must be writable, e.g., by a udev rule.
+// Otherwise, SetGamepadPlayerLEDs does nothing.
+//
+// SetGamepadPlayerLEDs is concurrent-safe.
+func SetGamepadPlayerLEDs(id GamepadID, leds int) {
+	g := gamepad.Get(id)
+	if g == nil {
+		return
+	}
+	g.SetPlayerLEDs(byte(leds))
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten
+
+import (
+	"context"
+	"runtime/pprof"
+	"runtime/trace"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/atlas"
+	"github.com/hajimehoshi/ebiten/v2/internal/graphicscommand"
+	"github.com/hajimehoshi/ebiten/v2/internal/ui"
+)
+
+// GPUTiming represents the GPU time measured for a GPU marker.
+type GPUTiming struct {
+	// Name is the name of the GPU marker.
+	Name string
+
+	// Duration is the GPU time spent between the beginning and the end of the marker.
+	// If the marker is used multiple times in one frame, Duration is the sum of them.
+	Duration time.Duration
+}
+
+// GPUMarker calls f, and brackets the GPU work issued by f with a marker named name.
+//
+// The GPU time for each marker can be retrieved by AppendGPUTimings, if the graphics library supports timestamp queries.
+// Markers can be nested.
+//
+// GPUMarker also runs f with the pprof label "ebitengine-gpu-marker" and in a runtime/trace region with the given name,
+// so that the CPU work for the marker can be found in profiles and execution traces.
+// The measured GPU time is also logged to the execution trace with the category "ebitengine/gpu".
+//
+// GPUMarker is expected to be called from Update or Draw.
+func GPUMarker(name string, f func()) {
+	pprof.Do(context.Background(), pprof.Labels("ebitengine-gpu-marker", name), func(ctx context.Context) {
+		defer trace.StartRegion(ctx, name).End()
+
+		atlas.BeginGPUMarker(name)
+		defer atlas.EndGPUMarker()
+		f()
+	})
+}
+
+// AppendGPUTimings appends the GPU timings of the latest measured frame to timings and returns the extended slice.
+// The timings are sorted by the names.
+//
+// GPU time is measured asynchronously, so the results are typically from a few frames before.
+// The timings are always from one frame, and the frames whose results are not taken in time are skipped.
+// If the graphics library doesn't support timestamp queries, AppendGPUTimings appends nothing.
+// Use IsGPUTimingAvailable to check whether GPU time can be measured.
+//
+// AppendGPUTimings is concurrent-safe.
+func AppendGPUTimings(timings []GPUTiming) []GPUTiming {
+	ts := graphicscommand.AppendGPUTimings(nil)
+	for _, t := range ts {
+		timings = append(timings, GPUTiming(t))
+	}
+	return timings
+}
+
+// IsGPUTimingAvailable reports whether GPU time can be measured with GPUMarker.
+//
+// Currently, GPU time can be measured only with OpenGL 3.3 or later on desktops.
+//
+// IsGPUTimingAvailable returns false before the game starts.
+func IsGPUTimingAvailable() bool {
+	return ui.Get().IsGPUTimerAvailable()
+}
@@ -57,6 +57,12 @@ const (
 
 	// GraphicsLibraryMetal represents the graphics library PlayStation 5.
 	GraphicsLibraryPlayStation5 GraphicsLibrary = GraphicsLibrary(ui.GraphicsLibraryPlayStation5)
+
+	// GraphicsLibraryWebGPU represents the graphics library WebGPU.
+	//
+	// WebGPU is available only on browsers. WebGPU is experimental and opt-in:
+	// GraphicsLibraryAuto still chooses OpenGL (WebGL), so specify GraphicsLibraryWebGPU explicitly to use WebGPU.
+	GraphicsLibraryWebGPU GraphicsLibrary = GraphicsLibrary(ui.GraphicsLibraryWebGPU)
 )
 
 // String returns a string representing the graphics library.
@@ -85,6 +91,39 @@ func ReadDebugInfo(d *DebugInfo) {
 	d.TotalGPUImageMemoryUsageInBytes = atlas.TotalGPUImageMemoryUsageInBytes()
 }
 
+// GraphicsCapabilities represents the capabilities of the graphics library currently in use.
+type GraphicsCapabilities struct {
+	// GraphicsLibrary represents the graphics library currently in use.
+	GraphicsLibrary GraphicsLibrary
+
+	// MaxImageSize is the maximum width and height of a texture in pixels that the graphics library supports.
+	// Note that an image larger than MaxImageSize can still be created, as Ebitengine splits it internally.
+	MaxImageSize int
+
+	// FloatTextureRenderable reports whether the graphics library can render to floating-point textures.
+	// On OpenGL ES and WebGL, FloatTextureRenderable is false so far.
+	FloatTextureRenderable bool
+
+	// GPUTiming reports whether GPU time can be measured with GPUMarker.
+	GPUTiming bool
+}
+
+// ReadGraphicsCapabilities writes the capabilities of the graphics library currently in use into a provided struct.
+//
+// The graphics library is chosen when the game starts.
+// You can request a graphics library with RunGameOptions.GraphicsLibrary, or the environment variable EBITENGINE_GRAPHICS_LIBRARY.
+//
+// Before the game starts, GraphicsLibrary is GraphicsLibraryUnknown and the other fields are zero values.
+//
+// ReadGraphicsCapabilities is concurrent-safe.
+func ReadGraphicsCapabilities(c *GraphicsCapabilities) {
+	uc := ui.Get().GraphicsCapabilities()
+	c.GraphicsLibrary = GraphicsLibrary(ui.Get().GraphicsLibrary())
+	c.MaxImageSize = uc.MaxImageSize
+	c.FloatTextureRenderable = uc.FloatTextureRenderable
+	c.GPUTiming = uc.GPUTimer
+}
+
 // ColorSpace represents the color space of the screen.
 type ColorSpace int
 
@@ -0,0 +1,50 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten
+
+import (
+	"github.com/hajimehoshi/ebiten/v2/internal/atlas"
+	"github.com/hajimehoshi/ebiten/v2/internal/ui"
+)
+
+// SetDrawDebugLabel sets a label for the following draw commands, which is shown in graphics debuggers
+// like RenderDoc and Xcode.
+// The label is effective until SetDrawDebugLabel is called again. An empty label removes the label.
+//
+// With Metal, the label is set to render command encoders.
+// With OpenGL, the label is used as a debug group, if the OpenGL version is 4.3 or later or KHR_debug is available.
+// With the other graphics libraries, SetDrawDebugLabel does nothing so far.
+//
+// Note that Ebitengine might reorder or merge draw commands, so the label is a hint for debugging.
+//
+// SetDrawDebugLabel is expected to be called from Update or Draw.
+func SetDrawDebugLabel(label string) {
+	atlas.SetDrawDebugLabel(label)
+}
+
+// TriggerGPUCapture requests a graphics debugger to capture the GPU commands of the next frame.
+//
+// TriggerGPUCapture works in these cases:
+//
+//   - The application is launched from RenderDoc on Windows or Linux.
+//   - The application uses Metal, and is launched from Xcode or with the environment variable MTL_CAPTURE_ENABLED=1.
+//
+// TriggerGPUCapture reports whether the capture is requested successfully.
+// TriggerGPUCapture returns false before the game starts.
+//
+// TriggerGPUCapture is concurrent-safe.
+func TriggerGPUCapture() bool {
+	return ui.Get().TriggerGPUCapture()
+}
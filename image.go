@@ -103,6 +103,46 @@ func (i *Image) Fill(clr color.Color) {
 	i.image.Fill(crf, cgf, cbf, caf, i.adjustedBounds())
 }
 
+// FillRect fills the given rectangle of the image with a solid color.
+//
+// rect is in the image's coordinate, and is clipped by the image's bounds.
+// The pixels out of rect are not changed.
+//
+// FillRect is more efficient than drawing a filled rectangle with DrawImage or DrawTriangles,
+// as FillRect overwrites the pixels without blending and the previous pixels in rect are not kept.
+//
+// When the image is disposed, FillRect does nothing.
+func (i *Image) FillRect(rect image.Rectangle, clr color.Color) {
+	i.copyCheck()
+	if i.isDisposed() {
+		return
+	}
+
+	rect = rect.Intersect(i.Bounds())
+	if rect.Empty() {
+		return
+	}
+
+	var crf, cgf, cbf, caf float32
+	cr, cg, cb, ca := clr.RGBA()
+	crf = float32(cr) / 0xffff
+	cgf = float32(cg) / 0xffff
+	cbf = float32(cb) / 0xffff
+	caf = float32(ca) / 0xffff
+	x, y := i.adjustPosition(rect.Min.X, rect.Min.Y)
+	i.image.Fill(crf, cgf, cbf, caf, image.Rect(x, y, x+rect.Dx(), y+rect.Dy()))
+}
+
+// ClearRect resets the pixels of the given rectangle of the image into 0.
+//
+// rect is in the image's coordinate, and is clipped by the image's bounds.
+// The pixels out of rect are not changed.
+//
+// When the image is disposed, ClearRect does nothing.
+func (i *Image) ClearRect(rect image.Rectangle) {
+	i.FillRect(rect, color.Transparent)
+}
+
 func canSkipMipmap(det float32, filter builtinshader.Filter) bool {
 	if filter != builtinshader.FilterLinear {
 		return true
@@ -155,6 +195,26 @@ type DrawImageOptions struct {
 	//
 	// The default (zero) value is false.
 	DisableMipmaps bool
+
+	// SourceRect is a region of the source image to draw, in the source image's coordinate.
+	// SourceRect is intersected with the source image's bounds.
+	//
+	// Drawing with SourceRect is the same as drawing a sub-image of the source image with SubImage,
+	// but SourceRect doesn't allocate a sub-image.
+	// This is useful to draw many sprites from a sprite sheet every frame.
+	//
+	// The default (zero) value is an empty rectangle, which means the whole source image is drawn.
+	SourceRect image.Rectangle
+
+	// CornerColorScales are scales of color for each corner of the source image.
+	// The order is the upper-left, the upper-right, the lower-left, and the lower-right in the source image's coordinate.
+	// The colors are interpolated between the corners, and are applied in addition to ColorScale.
+	//
+	// CornerColorScales is useful for gradations and simple effects like flashes
+	// without ColorM, which can break batches.
+	//
+	// The default (zero) value is identities, which doesn't change any color.
+	CornerColorScales [4]ColorScale
 }
 
 // adjustPosition converts the position in the *ebiten.Image coordinate to the *ui.Image coordinate.
@@ -230,11 +290,16 @@ func (i *Image) DrawImage(img *Image, options *DrawImageOptions) {
 	i.copyCheck()
 
 	if img.isDisposed() {
-		panic("ebiten: the given image to DrawImage must not be disposed")
+		theValidator.report("ebiten: the given image to DrawImage must not be disposed")
+		return
 	}
 	if i.isDisposed() {
 		return
 	}
+	if img.image == i.image {
+		theValidator.report("ebiten: the given image to DrawImage must be different from the receiver")
+		return
+	}
 
 	if options == nil {
 		options = &DrawImageOptions{}
@@ -259,12 +324,27 @@ func (i *Image) DrawImage(img *Image, options *DrawImageOptions) {
 	}
 
 	bounds := img.Bounds()
+	srcRegion := img.adjustedBounds()
+	if !options.SourceRect.Empty() {
+		bounds = bounds.Intersect(options.SourceRect)
+		if bounds.Empty() {
+			return
+		}
+		x, y := img.adjustPosition(bounds.Min.X, bounds.Min.Y)
+		srcRegion = image.Rect(x, y, x+bounds.Dx(), y+bounds.Dy())
+	}
 	sx0, sy0 := img.adjustPosition(bounds.Min.X, bounds.Min.Y)
 	sx1, sy1 := img.adjustPosition(bounds.Max.X, bounds.Max.Y)
 	colorm, cr, cg, cb, ca := colorMToScale(options.ColorM.affineColorM())
 	cr, cg, cb, ca = options.ColorScale.apply(cr, cg, cb, ca)
 	vs := i.ensureTmpVertices(4 * graphics.VertexFloatCount)
 	graphics.QuadVerticesFromSrcAndMatrix(vs, float32(sx0), float32(sy0), float32(sx1), float32(sy1), a, b, c, d, tx, ty, cr, cg, cb, ca)
+	if options.CornerColorScales != [4]ColorScale{} {
+		for j, s := range options.CornerColorScales {
+			v := vs[j*graphics.VertexFloatCount : (j+1)*graphics.VertexFloatCount]
+			v[4], v[5], v[6], v[7] = s.apply(cr, cg, cb, ca)
+		}
+	}
 	is := graphics.QuadIndices()
 
 	srcs := [graphics.ShaderSrcImageCount]*ui.Image{img.image}
@@ -292,7 +372,7 @@ func (i *Image) DrawImage(img *Image, options *DrawImageOptions) {
 	if !skipMipmap {
 		skipMipmap = canSkipMipmap(det, filter)
 	}
-	i.image.DrawTriangles(srcs, vs, is, blend, dr, [graphics.ShaderSrcImageCount]image.Rectangle{img.adjustedBounds()}, shader.shader, i.tmpUniforms, graphicsdriver.FillRuleFillAll, skipMipmap, false, hint)
+	i.image.DrawTriangles(srcs, vs, is, blend, dr, [graphics.ShaderSrcImageCount]image.Rectangle{srcRegion}, shader.shader, i.tmpUniforms, graphicsdriver.FillRuleFillAll, skipMipmap, false, hint)
 }
 
 // overwritesDstRegion reports whether the given parameters overwrite the destination region completely.
@@ -551,22 +631,29 @@ func (i *Image) DrawTriangles32(vertices []Vertex, indices []uint32, img *Image,
 	i.copyCheck()
 
 	if img != nil && img.isDisposed() {
-		panic("ebiten: the given image to DrawTriangles must not be disposed")
+		theValidator.report("ebiten: the given image to DrawTriangles must not be disposed")
+		return
 	}
 	if i.isDisposed() {
 		return
 	}
+	if img != nil && img.image == i.image {
+		theValidator.report("ebiten: the given image to DrawTriangles must be different from the receiver")
+		return
+	}
 
 	if len(vertices) > graphicscommand.MaxVertexCount {
 		// The last part cannot be specified by indices. Just omit them.
 		vertices = vertices[:graphicscommand.MaxVertexCount]
 	}
 	if len(indices)%3 != 0 {
-		panic("ebiten: len(indices) % 3 must be 0")
+		theValidator.report("ebiten: len(indices) % 3 must be 0")
+		return
 	}
-	for i, idx := range indices {
-		if int(idx) >= len(vertices) {
-			panic(fmt.Sprintf("ebiten: indices[%d] must be less than len(vertices) (%d) but was %d", i, len(vertices), idx))
+	for idx, v := range indices {
+		if int(v) >= len(vertices) {
+			theValidator.report(fmt.Sprintf("ebiten: indices[%d] must be less than len(vertices) (%d) but was %d", idx, len(vertices), v))
+			return
 		}
 	}
 
@@ -668,6 +755,14 @@ type DrawTrianglesShaderOptions struct {
 	// The pixel mode allows images of different sizes.
 	Images [4]*Image
 
+	// Samplers is a set of the samplers for the source images.
+	// Samplers[i] specifies how imageSrciAt samples Images[i] in the shader.
+	//
+	// A shader is compiled again for each set of non-default samplers at the first use.
+	//
+	// The default (zero) value samples the images with FilterNearest and returns 0 out of the images.
+	Samplers [4]ShaderImageSampler
+
 	// FillRule indicates the rule how an overlapped region is rendered.
 	//
 	// The rules FillRuleNonZero and FillRuleEvenOdd are useful when you want to render a complex polygon.
@@ -755,20 +850,8 @@ func (i *Image) DrawTrianglesShader32(vertices []Vertex, indices []uint32, shade
 	}
 
 	if shader.isDisposed() {
-		panic("ebiten: the given shader to DrawTrianglesShader must not be disposed")
-	}
-
-	if len(vertices) > graphicscommand.MaxVertexCount {
-		// The last part cannot be specified by indices. Just omit them.
-		vertices = vertices[:graphicscommand.MaxVertexCount]
-	}
-	if len(indices)%3 != 0 {
-		panic("ebiten: len(indices) % 3 must be 0")
-	}
-	for i, idx := range indices {
-		if int(idx) >= len(vertices) {
-			panic(fmt.Sprintf("ebiten: indices[%d] must be less than len(vertices) (%d) but was %d", i, len(vertices), idx))
-		}
+		theValidator.report("ebiten: the given shader to DrawTrianglesShader must not be disposed")
+		return
 	}
 
 	if options == nil {
@@ -782,9 +865,41 @@ func (i *Image) DrawTrianglesShader32(vertices []Vertex, indices []uint32, shade
 		blend = options.CompositeMode.blend().internalBlend()
 	}
 
+	if theValidator.isStrict() {
+		if err := shader.ValidateUniforms(options.Uniforms); err != nil {
+			theValidator.report(fmt.Sprintf("ebiten: invalid uniforms at DrawTrianglesShader: %v", err))
+			return
+		}
+	}
+
+	i.tmpUniforms = i.tmpUniforms[:0]
+	i.tmpUniforms = shader.appendUniforms(i.tmpUniforms, options.Uniforms)
+
+	i.drawTrianglesShader(vertices, indices, shader, &options.Images, &options.Samplers, i.tmpUniforms, blend, options.FillRule, options.AntiAlias, "DrawTrianglesShader")
+}
+
+// drawTrianglesShader draws triangles with the specified shader and the uniform values in dwords.
+//
+// funcName is used for panic messages.
+func (i *Image) drawTrianglesShader(vertices []Vertex, indices []uint32, shader *Shader, images *[graphics.ShaderSrcImageCount]*Image, samplers *[graphics.ShaderSrcImageCount]ShaderImageSampler, uniforms []uint32, blend graphicsdriver.Blend, fillRule FillRule, antiAlias bool, funcName string) {
+	if len(vertices) > graphicscommand.MaxVertexCount {
+		// The last part cannot be specified by indices. Just omit them.
+		vertices = vertices[:graphicscommand.MaxVertexCount]
+	}
+	if len(indices)%3 != 0 {
+		theValidator.report("ebiten: len(indices) % 3 must be 0")
+		return
+	}
+	for idx, v := range indices {
+		if int(v) >= len(vertices) {
+			theValidator.report(fmt.Sprintf("ebiten: indices[%d] must be less than len(vertices) (%d) but was %d", idx, len(vertices), v))
+			return
+		}
+	}
+
 	vs := i.ensureTmpVertices(len(vertices) * graphics.VertexFloatCount)
 	dst := i
-	src := options.Images[0]
+	src := images[0]
 	// Avoid using `for i, v := range vertices` as adding `v` creates a copy from `vertices` unnecessarily on each loop (#3103).
 	for i := range vertices {
 		dx, dy := dst.adjustPositionF32(vertices[i].DstX, vertices[i].DstY)
@@ -808,12 +923,17 @@ func (i *Image) DrawTrianglesShader32(vertices []Vertex, indices []uint32, shade
 
 	var imgs [graphics.ShaderSrcImageCount]*ui.Image
 	var imgSize image.Point
-	for i, img := range options.Images {
+	for i, img := range images {
 		if img == nil {
 			continue
 		}
 		if img.isDisposed() {
-			panic("ebiten: the given image to DrawTrianglesShader must not be disposed")
+			theValidator.report(fmt.Sprintf("ebiten: the given image to %s must not be disposed", funcName))
+			return
+		}
+		if img.image == dst.image {
+			theValidator.report(fmt.Sprintf("ebiten: the given image to %s must be different from the receiver", funcName))
+			return
 		}
 		if shader.unit == shaderir.Texels {
 			if i == 0 {
@@ -821,7 +941,8 @@ func (i *Image) DrawTrianglesShader32(vertices []Vertex, indices []uint32, shade
 			} else {
 				// TODO: Check imgw > 0 && imgh > 0
 				if img.Bounds().Size() != imgSize {
-					panic("ebiten: all the source images must be the same size with the rectangle")
+					theValidator.report("ebiten: all the source images must be the same size with the rectangle")
+					return
 				}
 			}
 		}
@@ -829,17 +950,14 @@ func (i *Image) DrawTrianglesShader32(vertices []Vertex, indices []uint32, shade
 	}
 
 	var srcRegions [graphics.ShaderSrcImageCount]image.Rectangle
-	for i, img := range options.Images {
+	for i, img := range images {
 		if img == nil {
 			continue
 		}
 		srcRegions[i] = img.adjustedBounds()
 	}
 
-	i.tmpUniforms = i.tmpUniforms[:0]
-	i.tmpUniforms = shader.appendUniforms(i.tmpUniforms, options.Uniforms)
-
-	i.image.DrawTriangles(imgs, vs, indices, blend, i.adjustedBounds(), srcRegions, shader.shader, i.tmpUniforms, graphicsdriver.FillRule(options.FillRule), true, options.AntiAlias, restorable.HintNone)
+	i.image.DrawTriangles(imgs, vs, indices, blend, i.adjustedBounds(), srcRegions, shader.shaderForSamplers(samplers), uniforms, graphicsdriver.FillRule(fillRule), true, antiAlias, restorable.HintNone)
 }
 
 // DrawRectShaderOptions represents options for DrawRectShader.
@@ -877,6 +995,14 @@ type DrawRectShaderOptions struct {
 	// Images is a set of the source images.
 	// All the images' sizes must be the same.
 	Images [4]*Image
+
+	// Samplers is a set of the samplers for the source images.
+	// Samplers[i] specifies how imageSrciAt samples Images[i] in the shader.
+	//
+	// A shader is compiled again for each set of non-default samplers at the first use.
+	//
+	// The default (zero) value samples the images with FilterNearest and returns 0 out of the images.
+	Samplers [4]ShaderImageSampler
 }
 
 // Check the number of images.
@@ -913,7 +1039,8 @@ func (i *Image) DrawRectShader(width, height int, shader *Shader, options *DrawR
 	}
 
 	if shader.isDisposed() {
-		panic("ebiten: the given shader to DrawRectShader must not be disposed")
+		theValidator.report("ebiten: the given shader to DrawRectShader must not be disposed")
+		return
 	}
 
 	if options == nil {
@@ -927,22 +1054,47 @@ func (i *Image) DrawRectShader(width, height int, shader *Shader, options *DrawR
 		blend = options.CompositeMode.blend().internalBlend()
 	}
 
+	if theValidator.isStrict() {
+		if err := shader.ValidateUniforms(options.Uniforms); err != nil {
+			theValidator.report(fmt.Sprintf("ebiten: invalid uniforms at DrawRectShader: %v", err))
+			return
+		}
+	}
+
+	i.tmpUniforms = i.tmpUniforms[:0]
+	i.tmpUniforms = shader.appendUniforms(i.tmpUniforms, options.Uniforms)
+
+	i.drawRectShader(width, height, shader, &options.Images, &options.Samplers, i.tmpUniforms, options.GeoM, options.ColorScale, options.Blend, blend, "DrawRectShader")
+}
+
+// drawRectShader draws a rectangle with the specified shader and the uniform values in dwords.
+//
+// hintBlend is used to determine whether the rectangle overwrites the destination region.
+// funcName is used for panic messages.
+func (i *Image) drawRectShader(width, height int, shader *Shader, images *[graphics.ShaderSrcImageCount]*Image, samplers *[graphics.ShaderSrcImageCount]ShaderImageSampler, uniforms []uint32, geoM GeoM, colorScale ColorScale, hintBlend Blend, blend graphicsdriver.Blend, funcName string) {
+	dst := i
 	var imgs [graphics.ShaderSrcImageCount]*ui.Image
-	for i, img := range options.Images {
+	for i, img := range images {
 		if img == nil {
 			continue
 		}
 		if img.isDisposed() {
-			panic("ebiten: the given image to DrawRectShader must not be disposed")
+			theValidator.report(fmt.Sprintf("ebiten: the given image to %s must not be disposed", funcName))
+			return
+		}
+		if img.image == dst.image {
+			theValidator.report(fmt.Sprintf("ebiten: the given image to %s must be different from the receiver", funcName))
+			return
 		}
 		if img.Bounds().Size() != image.Pt(width, height) {
-			panic("ebiten: all the source images must be the same size with the rectangle")
+			theValidator.report("ebiten: all the source images must be the same size with the rectangle")
+			return
 		}
 		imgs[i] = img.image
 	}
 
 	var srcRegions [graphics.ShaderSrcImageCount]image.Rectangle
-	for i, img := range options.Images {
+	for i, img := range images {
 		if img == nil {
 			if shader.unit == shaderir.Pixels && i == 0 {
 				// Give the source size as pixels only when the unit is pixels so that users can get the source size via imageSrc0Size (#2166).
@@ -954,7 +1106,6 @@ func (i *Image) DrawRectShader(width, height int, shader *Shader, options *DrawR
 		srcRegions[i] = img.adjustedBounds()
 	}
 
-	geoM := options.GeoM
 	if offsetX, offsetY := i.adjustPosition(0, 0); offsetX != 0 || offsetY != 0 {
 		geoM.Translate(float64(offsetX), float64(offsetY))
 	}
@@ -962,7 +1113,7 @@ func (i *Image) DrawRectShader(width, height int, shader *Shader, options *DrawR
 	if det := a*d - b*c; det == 0 {
 		return
 	}
-	cr, cg, cb, ca := options.ColorScale.elements()
+	cr, cg, cb, ca := colorScale.elements()
 	vs := i.ensureTmpVertices(4 * graphics.VertexFloatCount)
 
 	// Do not use srcRegions[0].Dx() and srcRegions[0].Dy() as these might be empty.
@@ -972,17 +1123,14 @@ func (i *Image) DrawRectShader(width, height int, shader *Shader, options *DrawR
 		a, b, c, d, tx, ty, cr, cg, cb, ca)
 	is := graphics.QuadIndices()
 
-	i.tmpUniforms = i.tmpUniforms[:0]
-	i.tmpUniforms = shader.appendUniforms(i.tmpUniforms, options.Uniforms)
-
 	dr := i.adjustedBounds()
 	hint := restorable.HintNone
 	// Do not use srcRegions[0].Dx() and srcRegions[0].Dy() as these might be empty.
-	if overwritesDstRegion(options.Blend, dr, geoM, srcRegions[0].Min.X, srcRegions[0].Min.Y, srcRegions[0].Min.X+width, srcRegions[0].Min.Y+height) {
+	if overwritesDstRegion(hintBlend, dr, geoM, srcRegions[0].Min.X, srcRegions[0].Min.Y, srcRegions[0].Min.X+width, srcRegions[0].Min.Y+height) {
 		hint = restorable.HintOverwriteDstRegion
 	}
 
-	i.image.DrawTriangles(imgs, vs, is, blend, dr, srcRegions, shader.shader, i.tmpUniforms, graphicsdriver.FillRuleFillAll, true, false, hint)
+	i.image.DrawTriangles(imgs, vs, is, blend, dr, srcRegions, shader.shaderForSamplers(samplers), uniforms, graphicsdriver.FillRuleFillAll, true, false, hint)
 }
 
 // SubImage returns an image representing the portion of the image p visible through r.
@@ -1171,6 +1319,7 @@ func (i *Image) Dispose() {
 	}
 	i.image.Deallocate()
 	i.image = nil
+	theImageTracker.remove(i)
 }
 
 // Deallocate clears the image and deallocates the internal state of the image.
@@ -1239,7 +1388,9 @@ func (i *Image) ReplacePixels(pixels []byte) {
 //
 // NewImage panics if RunGame already finishes.
 func NewImage(width, height int) *Image {
-	return newImage(image.Rect(0, 0, width, height), atlas.ImageTypeRegular)
+	i := newImage(image.Rect(0, 0, width, height), atlas.ImageTypeRegular)
+	theImageTracker.add(i)
+	return i
 }
 
 // NewImageOptions represents options for NewImage.
@@ -1273,7 +1424,9 @@ func NewImageWithOptions(bounds image.Rectangle, options *NewImageOptions) *Imag
 	if options != nil && options.Unmanaged {
 		imageType = atlas.ImageTypeUnmanaged
 	}
-	return newImage(bounds, imageType)
+	i := newImage(bounds, imageType)
+	theImageTracker.add(i)
+	return i
 }
 
 func newImage(bounds image.Rectangle, imageType atlas.ImageType) *Image {
@@ -24,6 +24,7 @@ import (
 	"math"
 	"math/rand/v2"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -371,6 +372,54 @@ func TestImageBlendLighter(t *testing.T) {
 	}
 }
 
+func TestImageBlendPresetsForEffects(t *testing.T) {
+	src := color.RGBA{R: 0x80, G: 0x40, B: 0x00, A: 0xff}
+	dst := color.RGBA{R: 0x80, G: 0xff, B: 0x40, A: 0xff}
+
+	mul := func(a, b uint8) int {
+		return int(a) * int(b) / 0xff
+	}
+	for _, tc := range []struct {
+		name  string
+		blend ebiten.Blend
+		want  color.RGBA
+	}{
+		{
+			name:  "additive",
+			blend: ebiten.BlendAdditive,
+			want:  color.RGBA{R: 0xff, G: 0xff, B: 0x40, A: 0xff},
+		},
+		{
+			name:  "multiply",
+			blend: ebiten.BlendMultiply,
+			want:  color.RGBA{R: uint8(mul(src.R, dst.R)), G: uint8(mul(src.G, dst.G)), B: uint8(mul(src.B, dst.B)), A: 0xff},
+		},
+		{
+			name:  "screen",
+			blend: ebiten.BlendScreen,
+			want:  color.RGBA{R: uint8(0xff - mul(0xff-src.R, 0xff-dst.R)), G: uint8(0xff - mul(0xff-src.G, 0xff-dst.G)), B: uint8(0xff - mul(0xff-src.B, 0xff-dst.B)), A: 0xff},
+		},
+		{
+			name:  "premultiplied over",
+			blend: ebiten.BlendPremultipliedOver,
+			want:  src,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			srcImg := ebiten.NewImage(1, 1)
+			srcImg.Fill(src)
+			dstImg := ebiten.NewImage(1, 1)
+			dstImg.Fill(dst)
+			op := &ebiten.DrawImageOptions{}
+			op.Blend = tc.blend
+			dstImg.DrawImage(srcImg, op)
+			if got := dstImg.At(0, 0).(color.RGBA); !sameColors(got, tc.want, 2) {
+				t.Errorf("got: %v, want: %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestNewImageFromEbitenImage(t *testing.T) {
 	img, _, err := openEbitenImage()
 	if err != nil {
@@ -433,6 +482,36 @@ func TestImageFill(t *testing.T) {
 	}
 }
 
+func TestImageFillRect(t *testing.T) {
+	const w, h = 16, 16
+	img := ebiten.NewImage(w, h)
+	img.Fill(color.White)
+	img.FillRect(image.Rect(4, 4, 8, 12), color.RGBA{R: 0xff, A: 0xff})
+	img.ClearRect(image.Rect(-4, -4, 2, 2))
+
+	sub := img.SubImage(image.Rect(8, 8, 16, 16)).(*ebiten.Image)
+	// The rectangle is clipped by the sub-image's bounds.
+	sub.FillRect(image.Rect(12, 12, 32, 32), color.RGBA{G: 0xff, A: 0xff})
+
+	for j := 0; j < h; j++ {
+		for i := 0; i < w; i++ {
+			got := img.At(i, j)
+			want := color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+			switch {
+			case image.Pt(i, j).In(image.Rect(4, 4, 8, 12)):
+				want = color.RGBA{R: 0xff, A: 0xff}
+			case image.Pt(i, j).In(image.Rect(0, 0, 2, 2)):
+				want = color.RGBA{}
+			case image.Pt(i, j).In(image.Rect(12, 12, 16, 16)):
+				want = color.RGBA{G: 0xff, A: 0xff}
+			}
+			if got != want {
+				t.Errorf("img At(%d, %d): got %v; want %v", i, j, got, want)
+			}
+		}
+	}
+}
+
 // Issue #740
 func TestImageClear(t *testing.T) {
 	const w, h = 128, 256
@@ -4808,3 +4887,172 @@ func Fragment(dstPos vec4, srcPos vec2, color vec4) vec4 {
 		}
 	}
 }
+
+func TestImageAppendPixelsToAndNewImageFromPixels(t *testing.T) {
+	const w, h = 16, 8
+	src := ebiten.NewImage(w, h)
+	pix := make([]byte, 4*w*h)
+	for i := range pix {
+		pix[i] = byte(i)
+	}
+	src.WritePixels(pix)
+
+	prefix := []byte{1, 2, 3}
+	for _, compressed := range []bool{false, true} {
+		t.Run(fmt.Sprintf("compressed=%t", compressed), func(t *testing.T) {
+			buf := src.AppendPixelsToWithOptions(prefix, &ebiten.AppendPixelsOptions{
+				Compressed: compressed,
+			})
+			if !bytes.Equal(buf[:len(prefix)], prefix) {
+				t.Errorf("prefix: got %v, want: %v", buf[:len(prefix)], prefix)
+			}
+			if !compressed && !bytes.Equal(buf[len(prefix):], pix) {
+				t.Errorf("AppendPixelsToWithOptions didn't append the correct pixels")
+			}
+
+			dst, err := ebiten.NewImageFromPixelsWithOptions(image.Rect(0, 0, w, h), buf[len(prefix):], &ebiten.NewImageFromPixelsOptions{
+				Compressed: compressed,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := dst.AppendPixelsTo(nil)
+			if !bytes.Equal(got, pix) {
+				t.Errorf("NewImageFromPixelsWithOptions didn't restore the correct pixels")
+			}
+
+			if _, err := ebiten.NewImageFromPixelsWithOptions(image.Rect(0, 0, w, h+1), buf[len(prefix):], &ebiten.NewImageFromPixelsOptions{
+				Compressed: compressed,
+			}); err == nil {
+				t.Errorf("NewImageFromPixelsWithOptions with a wrong size must return an error")
+			}
+		})
+	}
+}
+
+func TestImageDrawImageSourceRect(t *testing.T) {
+	const w, h = 16, 16
+	src := ebiten.NewImage(w, h)
+	pix := make([]byte, 4*w*h)
+	for j := 0; j < h; j++ {
+		for i := 0; i < w; i++ {
+			idx := 4 * (j*w + i)
+			pix[idx] = byte(i * 0x10)
+			pix[idx+1] = byte(j * 0x10)
+			pix[idx+3] = 0xff
+		}
+	}
+	src.WritePixels(pix)
+
+	r := image.Rect(4, 6, 12, 10)
+	dst0 := ebiten.NewImage(w, h)
+	op := &ebiten.DrawImageOptions{}
+	op.SourceRect = r
+	dst0.DrawImage(src, op)
+
+	dst1 := ebiten.NewImage(w, h)
+	dst1.DrawImage(src.SubImage(r).(*ebiten.Image), nil)
+
+	for j := 0; j < h; j++ {
+		for i := 0; i < w; i++ {
+			got := dst0.At(i, j)
+			want := dst1.At(i, j)
+			if got != want {
+				t.Errorf("dst0.At(%d, %d): got: %v, want: %v", i, j, got, want)
+			}
+		}
+	}
+}
+
+func TestImageDrawImageCornerColorScales(t *testing.T) {
+	src := ebiten.NewImage(2, 2)
+	src.Fill(color.White)
+
+	dst := ebiten.NewImage(2, 2)
+	op := &ebiten.DrawImageOptions{}
+	op.CornerColorScales[1].Scale(1, 0, 0, 1)
+	op.CornerColorScales[2].Scale(0, 1, 0, 1)
+	op.CornerColorScales[3].Scale(0, 0, 1, 1)
+	dst.DrawImage(src, op)
+
+	// The colors are interpolated, so check only the channels that must be dominant.
+	if got := dst.At(0, 0).(color.RGBA); got.R < 0x80 || got.G < 0x80 || got.B < 0x80 {
+		t.Errorf("dst.At(0, 0): got: %v, want: a bright color", got)
+	}
+	if got := dst.At(1, 1).(color.RGBA); got.B <= got.R || got.B <= got.G {
+		t.Errorf("dst.At(1, 1): got: %v, want: a bluish color", got)
+	}
+}
+
+func TestImageDrawTrianglesWithTransientSlices(t *testing.T) {
+	src := ebiten.NewImage(16, 16)
+	src.Fill(color.White)
+	dst := ebiten.NewImage(16, 16)
+
+	vs := ebiten.Vertices(4)
+	if got, want := cap(vs), 4; got != want {
+		t.Errorf("cap(vs): got: %d, want: %d", got, want)
+	}
+	for i := range vs {
+		if vs[i] != (ebiten.Vertex{}) {
+			t.Errorf("vs[%d]: got: %v, want: zero", i, vs[i])
+		}
+		x, y := float32(i%2)*16, float32(i/2)*16
+		vs[i] = ebiten.Vertex{
+			DstX:   x,
+			DstY:   y,
+			SrcX:   x,
+			SrcY:   y,
+			ColorR: 1,
+			ColorG: 1,
+			ColorB: 1,
+			ColorA: 1,
+		}
+	}
+	is := ebiten.Indices(6)
+	copy(is, []uint16{0, 1, 2, 1, 2, 3})
+	dst.DrawTriangles(vs, is, src, nil)
+
+	// Another allocation must not share the memory with vs.
+	vs2 := ebiten.Vertices(4)
+	vs2[0].DstX = 100
+	if vs[0].DstX == 100 {
+		t.Errorf("Vertices must return non-overlapping slices")
+	}
+
+	if got, want := dst.At(8, 8), (color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}); got != want {
+		t.Errorf("dst.At(8, 8): got: %v, want: %v", got, want)
+	}
+}
+
+func TestImageLeakTracking(t *testing.T) {
+	ebiten.SetImageLeakTrackingEnabled(true)
+	defer ebiten.SetImageLeakTrackingEnabled(false)
+
+	img0 := ebiten.NewImage(16, 16)
+	img1 := ebiten.NewImageWithOptions(image.Rect(0, 0, 16, 16), nil)
+	img2 := ebiten.NewImageFromImage(img0)
+	img1.Dispose()
+
+	got := ebiten.AppendUndisposedImages(nil)
+	if len(got) != 2 {
+		t.Fatalf("len(got): got: %d, want: %d", len(got), 2)
+	}
+	if got[0].Image != img0 {
+		t.Errorf("got[0].Image: got: %p, want: %p", got[0].Image, img0)
+	}
+	if got[1].Image != img2 {
+		t.Errorf("got[1].Image: got: %p, want: %p", got[1].Image, img2)
+	}
+	for _, u := range got {
+		if !strings.Contains(u.Stack, "TestImageLeakTracking") {
+			t.Errorf("the stack trace must include the caller but not: %s", u.Stack)
+		}
+	}
+
+	img0.Dispose()
+	img2.Dispose()
+	if got := ebiten.AppendUndisposedImages(nil); len(got) != 0 {
+		t.Errorf("len(got): got: %d, want: 0", len(got))
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten
+
+import (
+	"runtime/debug"
+	"sort"
+	"sync"
+)
+
+type imageTrace struct {
+	stack  string
+	serial uint64
+}
+
+type imageTracker struct {
+	enabled bool
+	images  map[*Image]imageTrace
+	serial  uint64
+	m       sync.Mutex
+}
+
+var theImageTracker imageTracker
+
+func (t *imageTracker) setEnabled(enabled bool) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.enabled = enabled
+	if !enabled {
+		t.images = nil
+	}
+}
+
+func (t *imageTracker) add(img *Image) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	if !t.enabled {
+		return
+	}
+	if t.images == nil {
+		t.images = map[*Image]imageTrace{}
+	}
+	t.serial++
+	t.images[img] = imageTrace{
+		stack:  string(debug.Stack()),
+		serial: t.serial,
+	}
+}
+
+func (t *imageTracker) remove(img *Image) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	delete(t.images, img)
+}
+
+func (t *imageTracker) appendUndisposedImages(images []UndisposedImage) []UndisposedImage {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	n := len(images)
+	serials := map[*Image]uint64{}
+	for img, trace := range t.images {
+		images = append(images, UndisposedImage{
+			Image: img,
+			Stack: trace.stack,
+		})
+		serials[img] = trace.serial
+	}
+	sort.Slice(images[n:], func(i, j int) bool {
+		return serials[images[n+i].Image] < serials[images[n+j].Image]
+	})
+	return images
+}
+
+// UndisposedImage represents an image that has not been disposed yet.
+type UndisposedImage struct {
+	// Image is the undisposed image.
+	Image *Image
+
+	// Stack is the stack trace when the image was created.
+	Stack string
+}
+
+// SetImageLeakTrackingEnabled enables or disables tracking of undisposed images.
+//
+// When the tracking is enabled, images created by NewImage, NewImageWithOptions, NewImageFromImage and NewImageFromImageWithOptions
+// after the call are tracked with the stack traces at their creations, until they are disposed.
+// The tracked images can be retrieved by AppendUndisposedImages.
+// Images created before the tracking is enabled are not tracked.
+//
+// An image that is not disposed keeps its GPU resources even when it is no longer used.
+// The tracking is useful to find such forgotten images like offscreens, which would accumulate in a long-running game.
+//
+// The tracking takes a stack trace at every image creation, and keeps references to the tracked images.
+// Use the tracking only for debugging.
+//
+// When the tracking is disabled, all the tracked information is discarded.
+//
+// The tracking is disabled by default.
+//
+// SetImageLeakTrackingEnabled is concurrent-safe.
+func SetImageLeakTrackingEnabled(enabled bool) {
+	theImageTracker.setEnabled(enabled)
+}
+
+// AppendUndisposedImages appends the tracked images that have not been disposed yet to images,
+// in the order of their creations, and returns the extended buffer.
+// Giving a slice that already has enough capacity works efficiently.
+//
+// AppendUndisposedImages works only when the tracking is enabled by SetImageLeakTrackingEnabled.
+// Otherwise, AppendUndisposedImages appends nothing.
+//
+// AppendUndisposedImages is concurrent-safe.
+func AppendUndisposedImages(images []UndisposedImage) []UndisposedImage {
+	return theImageTracker.appendUndisposedImages(images)
+}
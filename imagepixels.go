@@ -0,0 +1,140 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"image"
+	"io"
+	"slices"
+)
+
+// AppendPixelsOptions represents options for AppendPixelsToWithOptions.
+type AppendPixelsOptions struct {
+	// Compressed represents whether the appended pixels are compressed or not.
+	// The pixels are compressed in the DEFLATE format (RFC 1951).
+	//
+	// The default (zero) value is false, that means the pixels are appended as they are.
+	Compressed bool
+}
+
+// AppendPixelsTo appends the image's pixels to buf and returns the extended buffer.
+//
+// The appended pixels represent RGBA pre-multiplied alpha values, and
+// the length of the appended pixels is 4 * (bounds width) * (bounds height).
+// The pixels are read directly into buf without any intermediate buffers when buf has enough capacity.
+//
+// AppendPixelsTo is useful to take a snapshot of an image e.g. for save states.
+// The snapshot can be restored by NewImageFromPixels or WritePixels.
+//
+// AppendPixelsTo has the same restrictions as ReadPixels.
+func (i *Image) AppendPixelsTo(buf []byte) []byte {
+	b := i.Bounds()
+	n := 4 * b.Dx() * b.Dy()
+	buf = slices.Grow(buf, n)[:len(buf)+n]
+	i.ReadPixels(buf[len(buf)-n:])
+	return buf
+}
+
+// AppendPixelsToWithOptions appends the image's pixels to buf with the given options and returns the extended buffer.
+//
+// If options is nil, the default setting is used.
+//
+// AppendPixelsToWithOptions has the same restrictions as ReadPixels.
+func (i *Image) AppendPixelsToWithOptions(buf []byte, options *AppendPixelsOptions) []byte {
+	if options == nil || !options.Compressed {
+		return i.AppendPixelsTo(buf)
+	}
+
+	pix := i.AppendPixelsTo(nil)
+	b := bytes.NewBuffer(buf)
+	w, err := flate.NewWriter(b, flate.BestSpeed)
+	if err != nil {
+		panic(fmt.Sprintf("ebiten: flate.NewWriter failed: %v", err))
+	}
+	// Writing to bytes.Buffer never fails.
+	_, _ = w.Write(pix)
+	_ = w.Close()
+	return b.Bytes()
+}
+
+// NewImageFromPixels creates a new image with the given size and pixels.
+//
+// The given pixels are treated as RGBA pre-multiplied alpha values, e.g. the pixels appended by AppendPixelsTo.
+// len(pixels) must be 4 * width * height. If len(pixels) is not correct, NewImageFromPixels panics.
+//
+// Unlike NewImageFromImage, the pixels are uploaded without any conversions.
+//
+// NewImageFromPixels panics if RunGame already finishes.
+func NewImageFromPixels(width, height int, pixels []byte) *Image {
+	i, err := NewImageFromPixelsWithOptions(image.Rect(0, 0, width, height), pixels, nil)
+	if err != nil {
+		panic(fmt.Sprintf("ebiten: NewImageFromPixels failed: %v", err))
+	}
+	return i
+}
+
+// NewImageFromPixelsOptions represents options for NewImageFromPixelsWithOptions.
+type NewImageFromPixelsOptions struct {
+	// Unmanaged represents whether the image is unmanaged or not.
+	// The default (zero) value is false, that means the image is managed.
+	//
+	// See NewImageOptions.Unmanaged for more details.
+	Unmanaged bool
+
+	// Compressed represents whether the given pixels are compressed by AppendPixelsToWithOptions or not.
+	// The default (zero) value is false, that means the given pixels are not compressed.
+	Compressed bool
+}
+
+// NewImageFromPixelsWithOptions creates a new image with the given bounds, pixels and options.
+//
+// If options is nil, the default setting is used.
+//
+// NewImageFromPixelsWithOptions returns an error when the pixels are broken or
+// the length of the (decompressed) pixels is not 4 * (bounds width) * (bounds height).
+//
+// NewImageFromPixelsWithOptions panics if RunGame already finishes.
+func NewImageFromPixelsWithOptions(bounds image.Rectangle, pixels []byte, options *NewImageFromPixelsOptions) (*Image, error) {
+	if options == nil {
+		options = &NewImageFromPixelsOptions{}
+	}
+
+	n := 4 * bounds.Dx() * bounds.Dy()
+	if options.Compressed {
+		r := flate.NewReader(bytes.NewReader(pixels))
+		defer func() {
+			_ = r.Close()
+		}()
+		// Read one more byte to detect excess pixels.
+		pix := make([]byte, n+1)
+		m, err := io.ReadFull(r, pix)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return nil, fmt.Errorf("ebiten: decompressing pixels failed: %w", err)
+		}
+		pixels = pix[:m]
+	}
+	if len(pixels) != n {
+		return nil, fmt.Errorf("ebiten: len(pixels) must be %d but %d at NewImageFromPixelsWithOptions", n, len(pixels))
+	}
+
+	i := NewImageWithOptions(bounds, &NewImageOptions{
+		Unmanaged: options.Unmanaged,
+	})
+	i.WritePixels(pixels)
+	return i, nil
+}
@@ -95,6 +95,47 @@ func Wheel() (xoff, yoff float64) {
 	return theInputState.wheel()
 }
 
+// WheelInPixels returns x and y offsets of the mouse wheel or touchpad scroll in device-independent pixels.
+// It returns 0 if the wheel isn't being rolled.
+//
+// While Wheel's offsets are roughly in lines, WheelInPixels's offsets are as precise as the device reports,
+// which is useful for smooth scrolling like native applications.
+// The signs of the offsets are the same as Wheel's.
+//
+// If the device reports offsets in lines, e.g. a mouse wheel with notches, a line is converted to 40 device-independent pixels.
+//
+// WheelInPixels is concurrent-safe.
+func WheelInPixels() (xoff, yoff float64) {
+	return theInputState.wheelInPixels()
+}
+
+// WheelPhaseType represents a phase of a touchpad scroll.
+type WheelPhaseType int
+
+const (
+	// WheelPhaseNone represents that the wheel is not being scrolled, or the phase is unknown e.g. for a mouse wheel.
+	WheelPhaseNone WheelPhaseType = WheelPhaseType(ui.WheelPhaseNone)
+
+	// WheelPhaseScrolling represents that the user is scrolling with their fingers on the touchpad.
+	WheelPhaseScrolling WheelPhaseType = WheelPhaseType(ui.WheelPhaseScrolling)
+
+	// WheelPhaseMomentum represents that the scroll continues by inertia after the fingers are lifted.
+	WheelPhaseMomentum WheelPhaseType = WheelPhaseType(ui.WheelPhaseMomentum)
+)
+
+// WheelPhase returns the phase of the wheel scroll in the current tick.
+//
+// WheelPhase is useful e.g. to stop the momentum scrolling by the application's own physics
+// when the OS already applies momentum.
+//
+// Currently, WheelPhase reports WheelPhaseScrolling and WheelPhaseMomentum only on macOS.
+// On the other environments, WheelPhase always returns WheelPhaseNone.
+//
+// WheelPhase is concurrent-safe.
+func WheelPhase() WheelPhaseType {
+	return theInputState.wheelPhase()
+}
+
 // IsMouseButtonPressed returns a boolean indicating whether mouseButton is pressed.
 //
 // If you want to know whether the mouseButton started being pressed in the current tick,
@@ -139,6 +180,62 @@ func GamepadName(id GamepadID) string {
 	return g.Name()
 }
 
+// GamepadConnectionType represents how a gamepad is connected.
+type GamepadConnectionType int
+
+const (
+	GamepadConnectionTypeUnknown  GamepadConnectionType = GamepadConnectionType(gamepad.ConnectionTypeUnknown)
+	GamepadConnectionTypeWired    GamepadConnectionType = GamepadConnectionType(gamepad.ConnectionTypeWired)
+	GamepadConnectionTypeWireless GamepadConnectionType = GamepadConnectionType(gamepad.ConnectionTypeWireless)
+)
+
+// GamepadBatteryState represents a state of a gamepad's battery.
+type GamepadBatteryState int
+
+const (
+	GamepadBatteryStateUnknown     GamepadBatteryState = GamepadBatteryState(gamepad.BatteryStateUnknown)
+	GamepadBatteryStateNoBattery   GamepadBatteryState = GamepadBatteryState(gamepad.BatteryStateNoBattery)
+	GamepadBatteryStateDischarging GamepadBatteryState = GamepadBatteryState(gamepad.BatteryStateDischarging)
+	GamepadBatteryStateCharging    GamepadBatteryState = GamepadBatteryState(gamepad.BatteryStateCharging)
+	GamepadBatteryStateFull        GamepadBatteryState = GamepadBatteryState(gamepad.BatteryStateFull)
+)
+
+// GamepadPowerInfo represents a power and connection state of a gamepad.
+type GamepadPowerInfo struct {
+	// ConnectionType is how the gamepad is connected.
+	ConnectionType GamepadConnectionType
+
+	// BatteryState is the state of the gamepad's battery.
+	BatteryState GamepadBatteryState
+
+	// BatteryLevel is the remaining battery level in between 0 and 1.
+	// BatteryLevel is valid when BatteryState is GamepadBatteryStateDischarging, GamepadBatteryStateCharging, or GamepadBatteryStateFull.
+	// Some platforms report only a coarse level like 0.25, 0.5, and 1.
+	BatteryLevel float64
+}
+
+// GamepadPower returns the power and connection state of the gamepad (id).
+// GamepadPower is useful e.g. to warn players about a low controller battery.
+//
+// GamepadPower works only on Windows with XInput gamepads and on Linux with gamepads exposing a power supply in sysfs so far.
+// On the other environments, or if the gamepad doesn't report its state, GamepadPower returns the zero value,
+// which means the unknown states.
+// The state is updated about every second.
+//
+// GamepadPower is concurrent-safe.
+func GamepadPower(id GamepadID) GamepadPowerInfo {
+	g := gamepad.Get(id)
+	if g == nil {
+		return GamepadPowerInfo{}
+	}
+	p := g.PowerInfo()
+	return GamepadPowerInfo{
+		ConnectionType: GamepadConnectionType(p.ConnectionType),
+		BatteryState:   GamepadBatteryState(p.BatteryState),
+		BatteryLevel:   p.BatteryLevel,
+	}
+}
+
 // AppendGamepadIDs appends available gamepad IDs to gamepadIDs, and returns the extended buffer.
 // Giving a slice that already has enough capacity works efficiently.
 //
@@ -435,6 +532,18 @@ func (i *inputState) wheel() (float64, float64) {
 	return i.state.WheelX, i.state.WheelY
 }
 
+func (i *inputState) wheelInPixels() (float64, float64) {
+	i.m.Lock()
+	defer i.m.Unlock()
+	return i.state.WheelPixelX, i.state.WheelPixelY
+}
+
+func (i *inputState) wheelPhase() WheelPhaseType {
+	i.m.Lock()
+	defer i.m.Unlock()
+	return WheelPhaseType(i.state.WheelPhase)
+}
+
 func (i *inputState) isMouseButtonPressed(mouseButton MouseButton) bool {
 	i.m.Lock()
 	defer i.m.Unlock()
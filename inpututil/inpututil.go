@@ -26,8 +26,15 @@ import (
 type gamepadState struct {
 	buttonDurations         [ebiten.GamepadButtonMax + 1]int
 	standardButtonDurations [ebiten.StandardGamepadButtonMax + 1]int
+
+	// activeAxes is a bit set of the standard axes whose absolute values exceed axisThreshold.
+	activeAxes uint64
 }
 
+// axisThreshold is the threshold of an axis value to treat the axis as being operated.
+// This is large enough to ignore a stick drift.
+const axisThreshold = 0.5
+
 type touchState struct {
 	duration int
 	x        int
@@ -47,6 +54,15 @@ type inputState struct {
 	touchStates     map[ebiten.TouchID]touchState
 	prevTouchStates map[ebiten.TouchID]touchState
 
+	cursorX      int
+	cursorY      int
+	cursorInited bool
+
+	preferredInputDevice        InputDevice
+	preferredGamepadID          ebiten.GamepadID
+	preferredGamepadIDValid     bool
+	preferredInputDeviceChanged bool
+
 	gamepadIDsBuf []ebiten.GamepadID
 	touchIDsBuf   []ebiten.TouchID
 
@@ -71,11 +87,17 @@ func (i *inputState) update() {
 	i.m.Lock()
 	defer i.m.Unlock()
 
+	device := InputDeviceNone
+	var gamepadID ebiten.GamepadID
+
 	// Keyboard
 	copy(i.prevKeyDurations[:], i.keyDurations[:])
 	for idx := range i.keyDurations {
 		if ebiten.IsKeyPressed(ebiten.Key(idx)) {
 			i.keyDurations[idx]++
+			if i.keyDurations[idx] == 1 {
+				device = InputDeviceKeyboardMouse
+			}
 		} else {
 			i.keyDurations[idx] = 0
 		}
@@ -86,10 +108,22 @@ func (i *inputState) update() {
 	for idx := range i.mouseButtonDurations {
 		if ebiten.IsMouseButtonPressed(ebiten.MouseButton(idx)) {
 			i.mouseButtonDurations[idx]++
+			if i.mouseButtonDurations[idx] == 1 {
+				device = InputDeviceKeyboardMouse
+			}
 		} else {
 			i.mouseButtonDurations[idx] = 0
 		}
 	}
+	cx, cy := ebiten.CursorPosition()
+	if i.cursorInited && (cx != i.cursorX || cy != i.cursorY) {
+		device = InputDeviceKeyboardMouse
+	}
+	i.cursorX, i.cursorY = cx, cy
+	i.cursorInited = true
+	if wx, wy := ebiten.Wheel(); wx != 0 || wy != 0 {
+		device = InputDeviceKeyboardMouse
+	}
 
 	// Gamepads
 
@@ -119,6 +153,39 @@ func (i *inputState) update() {
 			}
 		}
 
+		// Only the axes in the standard layout are checked.
+		// The rest value of a raw axis depends on the device, e.g., a trigger might rest at -1,
+		// and a raw axis cannot be distinguished from a stick drift.
+		var activeAxes uint64
+		if ebiten.IsStandardGamepadLayoutAvailable(id) {
+			for a := range ebiten.StandardGamepadAxisMax + 1 {
+				v := ebiten.StandardGamepadAxisValue(id, a)
+				if v > axisThreshold || v < -axisThreshold {
+					activeAxes |= 1 << a
+				}
+			}
+		}
+
+		// A gamepad is regarded as used when a button is just pressed or an axis just starts to be operated.
+		used := activeAxes&^state.activeAxes != 0
+		for _, d := range state.buttonDurations {
+			if d == 1 {
+				used = true
+				break
+			}
+		}
+		for _, d := range state.standardButtonDurations {
+			if d == 1 {
+				used = true
+				break
+			}
+		}
+		if used {
+			device = InputDeviceGamepad
+			gamepadID = id
+		}
+		state.activeAxes = activeAxes
+
 		i.gamepadStates[id] = state
 	}
 
@@ -141,6 +208,9 @@ func (i *inputState) update() {
 	for _, id := range i.touchIDsBuf {
 		state := i.touchStates[id]
 		state.duration++
+		if state.duration == 1 {
+			device = InputDeviceTouch
+		}
 		state.x, state.y = ebiten.TouchPosition(id)
 		i.touchStates[id] = state
 	}
@@ -151,6 +221,19 @@ func (i *inputState) update() {
 			delete(i.touchStates, id)
 		}
 	}
+
+	// Preferred input device
+	i.preferredInputDeviceChanged = false
+	if device != InputDeviceNone {
+		if device != i.preferredInputDevice || (device == InputDeviceGamepad && gamepadID != i.preferredGamepadID) {
+			i.preferredInputDeviceChanged = true
+		}
+		i.preferredInputDevice = device
+		if device == InputDeviceGamepad {
+			i.preferredGamepadID = gamepadID
+			i.preferredGamepadIDValid = true
+		}
+	}
 }
 
 // AppendPressedKeys append currently pressed keyboard keys to keys and returns the extended buffer.
@@ -694,3 +777,66 @@ func TouchPositionInPreviousTick(id ebiten.TouchID) (int, int) {
 	state := theInputState.prevTouchStates[id]
 	return state.x, state.y
 }
+
+// InputDevice represents a kind of input devices.
+type InputDevice int
+
+const (
+	// InputDeviceNone means that no input device has been used yet.
+	InputDeviceNone InputDevice = iota
+
+	// InputDeviceKeyboardMouse represents a keyboard and a mouse.
+	InputDeviceKeyboardMouse
+
+	// InputDeviceGamepad represents a gamepad.
+	InputDeviceGamepad
+
+	// InputDeviceTouch represents a touch screen.
+	InputDeviceTouch
+)
+
+// PreferredInputDevice returns the kind of the input device that the player used last.
+//
+// An input device is regarded as used when a key, a mouse button, or a gamepad button is just pressed, when the mouse
+// cursor moves, when the mouse wheel is scrolled, when a gamepad stick starts to be operated, or when a touch starts.
+// Gamepad sticks are checked only for gamepads with the standard layout.
+//
+// PreferredInputDevice is useful to swap button prompts automatically, e.g., between keyboard keys and gamepad
+// buttons.
+//
+// PreferredInputDevice must be called in a game's Update, not Draw.
+//
+// PreferredInputDevice is concurrent safe.
+func PreferredInputDevice() InputDevice {
+	theInputState.m.RLock()
+	defer theInputState.m.RUnlock()
+	return theInputState.preferredInputDevice
+}
+
+// PreferredGamepadID returns the ID of the gamepad that the player used last.
+// PreferredGamepadID returns false as the second value if no gamepad has been used yet.
+//
+// The returned gamepad might be already disconnected.
+//
+// PreferredGamepadID must be called in a game's Update, not Draw.
+//
+// PreferredGamepadID is concurrent safe.
+func PreferredGamepadID() (ebiten.GamepadID, bool) {
+	theInputState.m.RLock()
+	defer theInputState.m.RUnlock()
+
+	return theInputState.preferredGamepadID, theInputState.preferredGamepadIDValid
+}
+
+// IsPreferredInputDeviceJustChanged reports whether the preferred input device is changed just in the current tick.
+//
+// IsPreferredInputDeviceJustChanged also returns true when the player switches to another gamepad.
+//
+// IsPreferredInputDeviceJustChanged must be called in a game's Update, not Draw.
+//
+// IsPreferredInputDeviceJustChanged is concurrent safe.
+func IsPreferredInputDeviceJustChanged() bool {
+	theInputState.m.RLock()
+	defer theInputState.m.RUnlock()
+	return theInputState.preferredInputDeviceChanged
+}
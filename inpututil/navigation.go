@@ -0,0 +1,333 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inpututil
+
+import (
+	"image"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	defaultVirtualCursorSpeed = 8
+	defaultStickDeadZone      = 0.2
+
+	// stickDirectionThreshold is the stick value to regard the stick as tilted in a direction for navigation.
+	stickDirectionThreshold = 0.5
+)
+
+// gamepadStick returns the left stick's values of the gamepad.
+func gamepadStick(id ebiten.GamepadID) (float64, float64) {
+	if ebiten.IsStandardGamepadLayoutAvailable(id) {
+		return ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickHorizontal),
+			ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickVertical)
+	}
+	if ebiten.GamepadAxisCount(id) < 2 {
+		return 0, 0
+	}
+	return ebiten.GamepadAxisValue(id, 0), ebiten.GamepadAxisValue(id, 1)
+}
+
+// gamepadDPad returns the directions of the gamepad's d-pad.
+// gamepadDPad returns zeros if the gamepad doesn't have the standard layout.
+func gamepadDPad(id ebiten.GamepadID) (int, int) {
+	if !ebiten.IsStandardGamepadLayoutAvailable(id) {
+		return 0, 0
+	}
+	var x, y int
+	if ebiten.IsStandardGamepadButtonPressed(id, ebiten.StandardGamepadButtonLeftLeft) {
+		x--
+	}
+	if ebiten.IsStandardGamepadButtonPressed(id, ebiten.StandardGamepadButtonLeftRight) {
+		x++
+	}
+	if ebiten.IsStandardGamepadButtonPressed(id, ebiten.StandardGamepadButtonLeftTop) {
+		y--
+	}
+	if ebiten.IsStandardGamepadButtonPressed(id, ebiten.StandardGamepadButtonLeftBottom) {
+		y++
+	}
+	return x, y
+}
+
+// isGamepadDecisionJustPressed reports whether the decision button of the gamepad is just pressed.
+// The decision button is the bottom button of the right cluster in the standard layout, or the button 0 otherwise.
+func isGamepadDecisionJustPressed(id ebiten.GamepadID) bool {
+	if ebiten.IsStandardGamepadLayoutAvailable(id) {
+		return IsStandardGamepadButtonJustPressed(id, ebiten.StandardGamepadButtonRightBottom)
+	}
+	return IsGamepadButtonJustPressed(id, ebiten.GamepadButton0)
+}
+
+// VirtualCursor is a cursor moved by a gamepad's left stick and d-pad, which emulates a mouse cursor.
+//
+// VirtualCursor is useful to make a mouse-oriented UI usable with a gamepad.
+// The zero value is a cursor at (0, 0).
+type VirtualCursor struct {
+	// Speed is the maximum speed of the cursor in pixels per tick.
+	//
+	// If Speed is 0, 8 is used.
+	Speed float64
+
+	// DeadZone is the absolute stick value under which the stick is regarded as neutral.
+	//
+	// If DeadZone is 0, 0.2 is used.
+	DeadZone float64
+
+	// Bounds is the area where the cursor can move.
+	//
+	// If Bounds is empty, the cursor position is not restricted.
+	Bounds image.Rectangle
+
+	x, y          float64
+	justClicked   bool
+	justMoved     bool
+	prevX, prevY  int
+	positionValid bool
+}
+
+// Update updates the cursor position with the gamepad's state.
+//
+// Update must be called once in every game's Update, not Draw.
+func (c *VirtualCursor) Update(id ebiten.GamepadID) {
+	speed := c.Speed
+	if speed == 0 {
+		speed = defaultVirtualCursorSpeed
+	}
+	deadZone := c.DeadZone
+	if deadZone == 0 {
+		deadZone = defaultStickDeadZone
+	}
+
+	dx, dy := gamepadStick(id)
+	if math.Hypot(dx, dy) < deadZone {
+		dx, dy = 0, 0
+	}
+	if x, y := gamepadDPad(id); x != 0 || y != 0 {
+		dx, dy = float64(x), float64(y)
+	}
+
+	c.x += dx * speed
+	c.y += dy * speed
+	if !c.Bounds.Empty() {
+		c.x = min(max(c.x, float64(c.Bounds.Min.X)), float64(c.Bounds.Max.X-1))
+		c.y = min(max(c.y, float64(c.Bounds.Min.Y)), float64(c.Bounds.Max.Y-1))
+	}
+
+	x, y := c.Position()
+	c.justMoved = c.positionValid && (x != c.prevX || y != c.prevY)
+	c.prevX, c.prevY = x, y
+	c.positionValid = true
+
+	c.justClicked = isGamepadDecisionJustPressed(id)
+}
+
+// Position returns the cursor position.
+func (c *VirtualCursor) Position() (x, y int) {
+	return int(math.Floor(c.x)), int(math.Floor(c.y))
+}
+
+// SetPosition sets the cursor position, e.g., to the mouse cursor position when the player switches to a gamepad.
+func (c *VirtualCursor) SetPosition(x, y int) {
+	c.x = float64(x)
+	c.y = float64(y)
+}
+
+// IsJustMoved reports whether the cursor is moved in the last Update.
+func (c *VirtualCursor) IsJustMoved() bool {
+	return c.justMoved
+}
+
+// IsJustClicked reports whether the decision button is just pressed in the last Update.
+//
+// The decision button is StandardGamepadButtonRightBottom for a gamepad with the standard layout, or GamepadButton0 otherwise.
+func (c *VirtualCursor) IsJustClicked() bool {
+	return c.justClicked
+}
+
+// FocusDirection represents a direction to move the focus.
+type FocusDirection int
+
+const (
+	// FocusDirectionUp is the direction to the top.
+	FocusDirectionUp FocusDirection = iota
+
+	// FocusDirectionDown is the direction to the bottom.
+	FocusDirectionDown
+
+	// FocusDirectionLeft is the direction to the left.
+	FocusDirectionLeft
+
+	// FocusDirectionRight is the direction to the right.
+	FocusDirectionRight
+)
+
+// FocusNavigator moves the focus among rectangles, e.g., buttons in a menu, with a gamepad.
+//
+// The focus moves to the nearest rectangle in the direction of the left stick or the d-pad.
+// Holding the stick or the d-pad repeats moving the focus.
+//
+// The zero value is a navigator without rectangles.
+type FocusNavigator struct {
+	// RepeatOptions is the options for repeats while the stick or the d-pad is held.
+	//
+	// If the fields are 0, the OS's key repeat settings are used. See KeyRepeatOptions.
+	RepeatOptions KeyRepeatOptions
+
+	// OnFocusChanged is called when the focus is changed, e.g., to update the visual focus or to play a sound.
+	// prev and next are the indices of the rectangles, and -1 means no focus.
+	//
+	// OnFocusChanged can be nil.
+	OnFocusChanged func(prev, next int)
+
+	rects []image.Rectangle
+
+	// focused is the index of the focused rectangle plus 1, so that the zero value means no focus.
+	focused int
+
+	// directionDurations is the durations in ticks while each direction is held.
+	directionDurations [4]int
+
+	justActivated bool
+}
+
+// SetRects sets the rectangles to navigate among.
+//
+// SetRects can be called every tick, e.g., when the layout is changed.
+// The focus is kept if the focused index is still valid, and is cleared otherwise.
+func (f *FocusNavigator) SetRects(rects []image.Rectangle) {
+	f.rects = append(f.rects[:0], rects...)
+	if f.Focused() >= len(f.rects) {
+		f.SetFocused(-1)
+	}
+}
+
+// Focused returns the index of the focused rectangle, or -1 if no rectangle is focused.
+func (f *FocusNavigator) Focused() int {
+	return f.focused - 1
+}
+
+// SetFocused sets the focus to the rectangle at the index. -1 clears the focus.
+//
+// SetFocused panics if index is out of range.
+func (f *FocusNavigator) SetFocused(index int) {
+	if index < -1 || index >= len(f.rects) {
+		panic("inpututil: index out of range at SetFocused")
+	}
+	prev := f.Focused()
+	if prev == index {
+		return
+	}
+	f.focused = index + 1
+	if f.OnFocusChanged != nil {
+		f.OnFocusChanged(prev, index)
+	}
+}
+
+// Move moves the focus to the nearest rectangle in the direction.
+// If no rectangle is focused, the first rectangle is focused.
+//
+// Move is useful to navigate with other inputs, e.g., a keyboard's arrow keys.
+// Move reports whether the focus is changed.
+func (f *FocusNavigator) Move(direction FocusDirection) bool {
+	if len(f.rects) == 0 {
+		return false
+	}
+
+	current := f.Focused()
+	if current < 0 {
+		f.SetFocused(0)
+		return true
+	}
+
+	from := f.rects[current]
+	fx := float64(from.Min.X+from.Max.X) / 2
+	fy := float64(from.Min.Y+from.Max.Y) / 2
+
+	next := -1
+	var nextScore float64
+	for i, r := range f.rects {
+		if i == current {
+			continue
+		}
+		dx := float64(r.Min.X+r.Max.X)/2 - fx
+		dy := float64(r.Min.Y+r.Max.Y)/2 - fy
+
+		// primary is the distance in the direction, and secondary is the distance perpendicular to the direction.
+		var primary, secondary float64
+		switch direction {
+		case FocusDirectionUp:
+			primary, secondary = -dy, dx
+		case FocusDirectionDown:
+			primary, secondary = dy, dx
+		case FocusDirectionLeft:
+			primary, secondary = -dx, dy
+		case FocusDirectionRight:
+			primary, secondary = dx, dy
+		}
+		if primary <= 0 {
+			continue
+		}
+
+		// Prefer rectangles aligned with the current one.
+		// 2 is an arbitrary weight.
+		score := primary + 2*math.Abs(secondary)
+		if next < 0 || score < nextScore {
+			next = i
+			nextScore = score
+		}
+	}
+	if next < 0 {
+		return false
+	}
+	f.SetFocused(next)
+	return true
+}
+
+// Update moves the focus with the gamepad's state.
+//
+// Update must be called once in every game's Update, not Draw.
+func (f *FocusNavigator) Update(id ebiten.GamepadID) {
+	sx, sy := gamepadStick(id)
+	dx, dy := gamepadDPad(id)
+	held := [4]bool{
+		FocusDirectionUp:    dy < 0 || sy <= -stickDirectionThreshold,
+		FocusDirectionDown:  dy > 0 || sy >= stickDirectionThreshold,
+		FocusDirectionLeft:  dx < 0 || sx <= -stickDirectionThreshold,
+		FocusDirectionRight: dx > 0 || sx >= stickDirectionThreshold,
+	}
+
+	for i := range f.directionDurations {
+		if !held[i] {
+			f.directionDurations[i] = 0
+			continue
+		}
+		f.directionDurations[i]++
+		d := f.directionDurations[i]
+		if d == 1 || isRepeatedDuration(d, &f.RepeatOptions) {
+			f.Move(FocusDirection(i))
+		}
+	}
+
+	f.justActivated = f.Focused() >= 0 && isGamepadDecisionJustPressed(id)
+}
+
+// IsJustActivated reports whether the decision button is just pressed on the focused rectangle in the last Update.
+//
+// The decision button is StandardGamepadButtonRightBottom for a gamepad with the standard layout, or GamepadButton0 otherwise.
+func (f *FocusNavigator) IsJustActivated() bool {
+	return f.justActivated
+}
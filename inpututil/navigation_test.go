@@ -0,0 +1,210 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inpututil_test
+
+import (
+	"image"
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+func TestFocusNavigatorMove(t *testing.T) {
+	// The rectangles are laid out as below:
+	//
+	//	0 1
+	//	    3
+	//	2 4
+	grid := []image.Rectangle{
+		image.Rect(0, 0, 10, 10),
+		image.Rect(20, 0, 30, 10),
+		image.Rect(0, 20, 10, 30),
+		image.Rect(40, 15, 50, 25),
+		image.Rect(20, 20, 30, 30),
+	}
+
+	testCases := []struct {
+		Name        string
+		Rects       []image.Rectangle
+		Focused     int
+		Direction   inpututil.FocusDirection
+		WantOK      bool
+		WantFocused int
+	}{
+		{
+			Name:        "right",
+			Rects:       grid,
+			Focused:     0,
+			Direction:   inpututil.FocusDirectionRight,
+			WantOK:      true,
+			WantFocused: 1,
+		},
+		{
+			Name:        "down",
+			Rects:       grid,
+			Focused:     0,
+			Direction:   inpututil.FocusDirectionDown,
+			WantOK:      true,
+			WantFocused: 2,
+		},
+		{
+			Name:        "up without candidates",
+			Rects:       grid,
+			Focused:     0,
+			Direction:   inpututil.FocusDirectionUp,
+			WantOK:      false,
+			WantFocused: 0,
+		},
+		{
+			Name:        "left without candidates",
+			Rects:       grid,
+			Focused:     0,
+			Direction:   inpututil.FocusDirectionLeft,
+			WantOK:      false,
+			WantFocused: 0,
+		},
+		{
+			Name:        "up",
+			Rects:       grid,
+			Focused:     4,
+			Direction:   inpututil.FocusDirectionUp,
+			WantOK:      true,
+			WantFocused: 1,
+		},
+		{
+			Name:        "right to a misaligned rectangle",
+			Rects:       grid,
+			Focused:     4,
+			Direction:   inpututil.FocusDirectionRight,
+			WantOK:      true,
+			WantFocused: 3,
+		},
+		{
+			Name:        "left",
+			Rects:       grid,
+			Focused:     4,
+			Direction:   inpututil.FocusDirectionLeft,
+			WantOK:      true,
+			WantFocused: 2,
+		},
+		{
+			// The score is primary + 2*|secondary|.
+			// The rectangle 1 is farther (30) than the rectangle 2 (about 18), but is aligned with the focused one.
+			// The score of 1 is 30, and the score of 2 is 10 + 2*15 = 40.
+			Name: "aligned rectangle is preferred",
+			Rects: []image.Rectangle{
+				image.Rect(0, 0, 10, 10),
+				image.Rect(30, 0, 40, 10),
+				image.Rect(10, 15, 20, 25),
+			},
+			Focused:     0,
+			Direction:   inpututil.FocusDirectionRight,
+			WantOK:      true,
+			WantFocused: 1,
+		},
+		{
+			Name:        "no focus",
+			Rects:       grid,
+			Focused:     -1,
+			Direction:   inpututil.FocusDirectionLeft,
+			WantOK:      true,
+			WantFocused: 0,
+		},
+		{
+			Name:        "no rectangles",
+			Rects:       nil,
+			Focused:     -1,
+			Direction:   inpututil.FocusDirectionRight,
+			WantOK:      false,
+			WantFocused: -1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var f inpututil.FocusNavigator
+			f.SetRects(tc.Rects)
+			f.SetFocused(tc.Focused)
+			if got := f.Move(tc.Direction); got != tc.WantOK {
+				t.Errorf("Move(): got: %v, want: %v", got, tc.WantOK)
+			}
+			if got := f.Focused(); got != tc.WantFocused {
+				t.Errorf("Focused(): got: %d, want: %d", got, tc.WantFocused)
+			}
+		})
+	}
+}
+
+func TestFocusNavigatorSetRects(t *testing.T) {
+	rects := []image.Rectangle{
+		image.Rect(0, 0, 10, 10),
+		image.Rect(20, 0, 30, 10),
+		image.Rect(40, 0, 50, 10),
+	}
+
+	var changes [][2]int
+	f := inpututil.FocusNavigator{
+		OnFocusChanged: func(prev, next int) {
+			changes = append(changes, [2]int{prev, next})
+		},
+	}
+	f.SetRects(rects)
+	f.SetFocused(1)
+
+	// The focus is kept if the focused index is still valid.
+	f.SetRects(rects[:2])
+	if got, want := f.Focused(), 1; got != want {
+		t.Errorf("Focused(): got: %d, want: %d", got, want)
+	}
+
+	// The focus is cleared if the rectangles shrink past the focused index.
+	f.SetRects(rects[:1])
+	if got, want := f.Focused(), -1; got != want {
+		t.Errorf("Focused(): got: %d, want: %d", got, want)
+	}
+
+	if want := [][2]int{{-1, 1}, {1, -1}}; !slices.Equal(changes, want) {
+		t.Errorf("OnFocusChanged calls: got: %v, want: %v", changes, want)
+	}
+}
+
+func TestFocusNavigatorSetFocusedOutOfRange(t *testing.T) {
+	for _, index := range []int{-2, 2} {
+		var f inpututil.FocusNavigator
+		f.SetRects([]image.Rectangle{
+			image.Rect(0, 0, 10, 10),
+			image.Rect(20, 0, 30, 10),
+		})
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("SetFocused(%d) must panic", index)
+				}
+			}()
+			f.SetFocused(index)
+		}()
+	}
+}
+
+func TestVirtualCursorPosition(t *testing.T) {
+	var c inpututil.VirtualCursor
+	if x, y := c.Position(); x != 0 || y != 0 {
+		t.Errorf("Position(): got: (%d, %d), want: (0, 0)", x, y)
+	}
+	c.SetPosition(-3, 4)
+	if x, y := c.Position(); x != -3 || y != 4 {
+		t.Errorf("Position(): got: (%d, %d), want: (-3, 4)", x, y)
+	}
+}
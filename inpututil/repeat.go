@@ -0,0 +1,176 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inpututil
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	defaultKeyRepeatDelay    = 500 * time.Millisecond
+	defaultKeyRepeatInterval = 33 * time.Millisecond
+)
+
+var (
+	systemKeyRepeatOnce     sync.Once
+	systemKeyRepeatDelay    time.Duration
+	systemKeyRepeatInterval time.Duration
+)
+
+func ensureSystemKeyRepeat() {
+	systemKeyRepeatOnce.Do(func() {
+		delay, interval, ok := systemKeyRepeatSettings()
+		if !ok {
+			delay, interval = defaultKeyRepeatDelay, defaultKeyRepeatInterval
+		}
+		systemKeyRepeatDelay = delay
+		systemKeyRepeatInterval = interval
+	})
+}
+
+// SystemKeyRepeatDelay returns the OS's initial delay before a held key starts repeating.
+//
+// If the OS setting is not available, SystemKeyRepeatDelay returns 500 milliseconds.
+// Currently the OS setting is available only on Windows.
+//
+// SystemKeyRepeatDelay is concurrent safe.
+func SystemKeyRepeatDelay() time.Duration {
+	ensureSystemKeyRepeat()
+	return systemKeyRepeatDelay
+}
+
+// SystemKeyRepeatInterval returns the OS's interval between repeats of a held key.
+//
+// If the OS setting is not available, SystemKeyRepeatInterval returns 33 milliseconds.
+// Currently the OS setting is available only on Windows.
+//
+// SystemKeyRepeatInterval is concurrent safe.
+func SystemKeyRepeatInterval() time.Duration {
+	ensureSystemKeyRepeat()
+	return systemKeyRepeatInterval
+}
+
+// KeyRepeatOptions represents options for key repeats.
+type KeyRepeatOptions struct {
+	// Delay is the duration between a key press and its first repeat.
+	//
+	// If Delay is 0, SystemKeyRepeatDelay() is used.
+	Delay time.Duration
+
+	// Interval is the duration between two successive repeats.
+	//
+	// If Interval is 0, SystemKeyRepeatInterval() is used.
+	Interval time.Duration
+}
+
+// durationToTicks converts d to the number of ticks, which is at least 1.
+func durationToTicks(d time.Duration) int {
+	return durationToTicksWithTPS(d, ebiten.TPS(), ebiten.Monitor().RefreshRate())
+}
+
+// durationToTicksWithTPS converts d to the number of ticks with the given TPS, which is at least 1.
+//
+// refreshRate is used only when tps is SyncWithRefreshRate.
+func durationToTicksWithTPS(d time.Duration, tps int, refreshRate float64) int {
+	switch tps {
+	case ebiten.SyncWithFPS:
+		// A tick length is not fixed. Assume DefaultTPS.
+		tps = ebiten.DefaultTPS
+	case ebiten.SyncWithRefreshRate:
+		// As the clock does, use DefaultTPS when the refresh rate is unknown.
+		tps = ebiten.DefaultTPS
+		if refreshRate > 0 {
+			tps = int(math.Round(refreshRate))
+		}
+	}
+	return max(int(math.Round(d.Seconds()*float64(tps))), 1)
+}
+
+func isRepeatedDuration(duration int, options *KeyRepeatOptions) bool {
+	// The first tick is a press, not a repeat.
+	if duration <= 1 {
+		return false
+	}
+
+	var delay, interval time.Duration
+	if options != nil {
+		delay = options.Delay
+		interval = options.Interval
+	}
+	if delay == 0 {
+		delay = SystemKeyRepeatDelay()
+	}
+	if interval == 0 {
+		interval = SystemKeyRepeatInterval()
+	}
+
+	t := duration - 1 - durationToTicks(delay)
+	if t < 0 {
+		return false
+	}
+	return t%durationToTicks(interval) == 0
+}
+
+// IsKeyRepeated returns a boolean value indicating whether a repeat of the given key happens in the current tick.
+//
+// Unlike ebiten.IsKeyPressed, which reports the physical state of the key, IsKeyRepeated reports only repeats
+// generated while the key is held: it returns false at the tick when the key is just pressed,
+// becomes true once after the delay, and then becomes true once every interval.
+// This is useful for menu navigation or text editing.
+//
+// If options is nil, the OS's settings are used. See SystemKeyRepeatDelay and SystemKeyRepeatInterval.
+//
+// IsKeyRepeated must be called in a game's Update, not Draw.
+//
+// IsKeyRepeated is concurrent safe.
+func IsKeyRepeated(key ebiten.Key, options *KeyRepeatOptions) bool {
+	return isRepeatedDuration(KeyPressDuration(key), options)
+}
+
+// IsKeyJustPressedOrRepeated returns a boolean value indicating whether the given key is pressed just in the current tick
+// or a repeat of the key happens in the current tick.
+//
+// IsKeyJustPressedOrRepeated must be called in a game's Update, not Draw.
+//
+// IsKeyJustPressedOrRepeated is concurrent safe.
+func IsKeyJustPressedOrRepeated(key ebiten.Key, options *KeyRepeatOptions) bool {
+	d := KeyPressDuration(key)
+	return d == 1 || isRepeatedDuration(d, options)
+}
+
+// AppendRepeatedKeys append keys that are repeated in the current tick to keys and returns the extended buffer.
+// Giving a slice that already has enough capacity works efficiently.
+//
+// See IsKeyRepeated for the details of repeats.
+//
+// AppendRepeatedKeys must be called in a game's Update, not Draw.
+//
+// AppendRepeatedKeys is concurrent safe.
+func AppendRepeatedKeys(keys []ebiten.Key, options *KeyRepeatOptions) []ebiten.Key {
+	theInputState.m.RLock()
+	defer theInputState.m.RUnlock()
+
+	for i, d := range theInputState.keyDurations {
+		if !isRepeatedDuration(d, options) {
+			continue
+		}
+		keys = append(keys, ebiten.Key(i))
+	}
+	return keys
+}
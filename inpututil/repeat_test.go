@@ -0,0 +1,87 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inpututil_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+func TestDurationToTicks(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Duration    time.Duration
+		TPS         int
+		RefreshRate float64
+		Want        int
+	}{
+		{
+			Name:     "60 TPS",
+			Duration: 500 * time.Millisecond,
+			TPS:      60,
+			Want:     30,
+		},
+		{
+			Name:     "120 TPS",
+			Duration: 500 * time.Millisecond,
+			TPS:      120,
+			Want:     60,
+		},
+		{
+			Name:     "short duration",
+			Duration: time.Millisecond,
+			TPS:      60,
+			Want:     1,
+		},
+		{
+			Name:        "SyncWithFPS",
+			Duration:    500 * time.Millisecond,
+			TPS:         ebiten.SyncWithFPS,
+			RefreshRate: 144,
+			Want:        30,
+		},
+		{
+			Name:        "SyncWithRefreshRate",
+			Duration:    500 * time.Millisecond,
+			TPS:         ebiten.SyncWithRefreshRate,
+			RefreshRate: 144,
+			Want:        72,
+		},
+		{
+			Name:        "SyncWithRefreshRate with a fractional refresh rate",
+			Duration:    time.Second,
+			TPS:         ebiten.SyncWithRefreshRate,
+			RefreshRate: 59.94,
+			Want:        60,
+		},
+		{
+			Name:        "SyncWithRefreshRate with an unknown refresh rate",
+			Duration:    500 * time.Millisecond,
+			TPS:         ebiten.SyncWithRefreshRate,
+			RefreshRate: 0,
+			Want:        30,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if got := inpututil.DurationToTicksWithTPS(tc.Duration, tc.TPS, tc.RefreshRate); got != tc.Want {
+				t.Errorf("got: %d, want: %d", got, tc.Want)
+			}
+		})
+	}
+}
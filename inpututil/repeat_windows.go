@@ -0,0 +1,63 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inpututil
+
+import (
+	"runtime"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+const (
+	_SPI_GETKEYBOARDDELAY = 0x0016
+	_SPI_GETKEYBOARDSPEED = 0x000A
+)
+
+var (
+	user32 = windows.NewLazySystemDLL("user32.dll")
+
+	procSystemParametersInfoW = user32.NewProc("SystemParametersInfoW")
+)
+
+func _SystemParametersInfoW(uiAction uint32, uiParam uint32, pvParam unsafe.Pointer, fWinIni uint32) bool {
+	r, _, _ := procSystemParametersInfoW.Call(uintptr(uiAction), uintptr(uiParam), uintptr(pvParam), uintptr(fWinIni))
+	runtime.KeepAlive(pvParam)
+	return int32(r) != 0
+}
+
+func systemKeyRepeatSettings() (delay, interval time.Duration, ok bool) {
+	if procSystemParametersInfoW.Find() != nil {
+		return 0, 0, false
+	}
+
+	// The keyboard delay is 0 (about 250ms) to 3 (about 1s).
+	var d uint32
+	if !_SystemParametersInfoW(_SPI_GETKEYBOARDDELAY, 0, unsafe.Pointer(&d), 0) {
+		return 0, 0, false
+	}
+
+	// The keyboard speed is 0 (about 2.5 repetitions per second) to 31 (about 30 repetitions per second).
+	var s uint32
+	if !_SystemParametersInfoW(_SPI_GETKEYBOARDSPEED, 0, unsafe.Pointer(&s), 0) {
+		return 0, 0, false
+	}
+
+	delay = time.Duration(d+1) * 250 * time.Millisecond
+	rate := 2.5 + float64(min(s, 31))*(30-2.5)/31
+	interval = time.Duration(float64(time.Second) / rate)
+	return delay, interval, true
+}
@@ -828,6 +828,56 @@ func BeginFrame(graphicsDriver graphicsdriver.Graphics) error {
 	return nil
 }
 
+// TakeContextRestored reports whether the images were restored from the context lost since the last call,
+// and resets the state.
+//
+// TakeContextRestored must be called after BeginFrame.
+func TakeContextRestored() bool {
+	return restorable.TakeContextRestored()
+}
+
+// BeginGPUMarker begins a GPU marker to measure GPU time for the following drawing commands.
+func BeginGPUMarker(name string) {
+	backendsM.Lock()
+	defer backendsM.Unlock()
+
+	if !inFrame {
+		appendDeferred(func() {
+			restorable.BeginGPUMarker(name)
+		})
+		return
+	}
+	restorable.BeginGPUMarker(name)
+}
+
+// EndGPUMarker ends the last begun GPU marker.
+func EndGPUMarker() {
+	backendsM.Lock()
+	defer backendsM.Unlock()
+
+	if !inFrame {
+		appendDeferred(func() {
+			restorable.EndGPUMarker()
+		})
+		return
+	}
+	restorable.EndGPUMarker()
+}
+
+// SetDrawDebugLabel sets the debug label for the following draw commands.
+func SetDrawDebugLabel(label string) {
+	backendsM.Lock()
+	defer backendsM.Unlock()
+
+	if !inFrame {
+		appendDeferred(func() {
+			restorable.SetDrawDebugLabel(label)
+		})
+		return
+	}
+	restorable.SetDrawDebugLabel(label)
+}
+
 func DumpImages(graphicsDriver graphicsdriver.Graphics, dir string) (string, error) {
 	backendsM.Lock()
 	defer backendsM.Unlock()
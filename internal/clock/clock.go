@@ -16,25 +16,35 @@
 package clock
 
 import (
+	"math"
 	"sync"
 	"time"
 )
 
 const (
-	DefaultTPS  = 60
-	SyncWithFPS = -1
+	DefaultTPS          = 60
+	SyncWithFPS         = -1
+	SyncWithRefreshRate = -2
 )
 
 var (
 	// tps represents TPS (ticks per second).
 	tps = DefaultTPS
 
+	// refreshRate is the current display's refresh rate in Hz. 0 means unknown.
+	refreshRate float64
+
 	lastNow int64
 
 	// lastSystemTime is the last system time in the previous UpdateFrame.
 	// lastSystemTime indicates the logical time in the game, so this can be bigger than the current time.
 	lastSystemTime int64
 
+	// tickStartTime is the time when the progress of the current tick starts.
+	// This is usually the same as lastSystemTime, but can be earlier when the tick is executed earlier than
+	// lastSystemTime by the stabilization.
+	tickStartTime int64
+
 	actualFPS   float64
 	actualTPS   float64
 	prevTPS     int64
@@ -42,13 +52,37 @@ var (
 	fpsCount    = 0
 	tpsCount    = 0
 
+	// interpolationAlpha is the progress of the current tick in [0, 1).
+	interpolationAlpha float64
+
+	catchUpPolicy   CatchUpPolicyType
+	maxCatchUpTicks int
+
+	// droppedTicks is the number of the dropped ticks that are not taken by TakeDroppedTicks yet.
+	droppedTicks int
+
 	m sync.Mutex
 )
 
+// suspensionThreshold is the delay to regard the application as suspended rather than falling behind.
+const suspensionThreshold = int64(time.Second)
+
+// CatchUpPolicyType represents what happens when the game's Update falls behind.
+type CatchUpPolicyType int
+
+const (
+	// CatchUpPolicySlowDown drops the excess ticks so that the game slows down.
+	CatchUpPolicySlowDown CatchUpPolicyType = iota
+
+	// CatchUpPolicySkipFrames carries the excess ticks over to the next frames so that the game catches up later.
+	CatchUpPolicySkipFrames
+)
+
 func init() {
 	n := now()
 	lastNow = n
 	lastSystemTime = n
+	tickStartTime = n
 	lastUpdated = n
 }
 
@@ -79,13 +113,22 @@ func calcCountFromTPS(tps int64, now int64) int {
 
 	count := 0
 	syncWithSystemClock := false
+	fallingBehind := false
 
 	// Detect whether the previous time is too old.
 	// Use either 5 ticks or 5/60 sec in the case when TPS is too big like 300 (#1444).
-	if diff > max(int64(time.Second)*5/tps, int64(time.Second)*5/60) || prevTPS != tps {
+	threshold := max(int64(time.Second)*5/tps, int64(time.Second)*5/60)
+	if catchUpPolicy == CatchUpPolicySkipFrames {
+		// The excess ticks are carried over, so allow a bigger delay.
+		threshold = suspensionThreshold
+	}
+	if diff > threshold || prevTPS != tps {
 		// The previous time is too old.
 		// Let's force to sync the game time with the system clock.
 		syncWithSystemClock = true
+		// If the delay is too long, the application was likely suspended, and the skipped ticks are not regarded
+		// as dropped ticks. Otherwise, the game falls behind.
+		fallingBehind = prevTPS == tps && diff <= suspensionThreshold
 	} else {
 		count = int(diff * tps / int64(time.Second))
 	}
@@ -101,15 +144,49 @@ func calcCountFromTPS(tps int64, now int64) int {
 		count = 1
 	}
 
+	if fallingBehind {
+		// Update is still called count times at this frame. The rest of the ticks are dropped.
+		droppedTicks += max(int(diff*tps/int64(time.Second))-count, 0)
+	}
+
+	if maxCatchUpTicks > 0 && count > maxCatchUpTicks {
+		switch catchUpPolicy {
+		case CatchUpPolicySlowDown:
+			droppedTicks += count - maxCatchUpTicks
+			syncWithSystemClock = true
+		case CatchUpPolicySkipFrames:
+			// The rest of the ticks are processed in the next frames.
+		}
+		count = maxCatchUpTicks
+	}
+
 	if syncWithSystemClock {
 		lastSystemTime = now
 	} else {
 		lastSystemTime += int64(count) * int64(time.Second) / tps
 	}
+	if count > 0 || syncWithSystemClock {
+		tickStartTime = min(now, lastSystemTime)
+	}
 
 	return count
 }
 
+// calcInterpolationAlpha returns the progress of the current tick.
+// calcInterpolationAlpha must be called after calcCountFromTPS.
+//
+// The progress is usually the elapsed time since the logical time of the last tick divided by the tick length.
+// When the last tick is executed earlier than its logical time by the stabilization, the progress is measured from the
+// time when the tick was executed to the logical time of the next tick so that the progress still increases monotonically.
+func calcInterpolationAlpha(tps int64, now int64) float64 {
+	d := lastSystemTime + int64(time.Second)/tps - tickStartTime
+	if d <= 0 {
+		return 0
+	}
+	a := float64(now-tickStartTime) / float64(d)
+	return min(max(a, 0), math.Nextafter(1, 0))
+}
+
 func updateFPSAndTPS(now int64, count int) {
 	fpsCount++
 	tpsCount += count
@@ -130,13 +207,38 @@ func updateFPSAndTPS(now int64, count int) {
 // indicating how many times the game should update based on the current tps.
 //
 // If tps is SyncWithFPS, UpdateFrame always returns 1.
-// If tps <= 0 and not SyncWithFPS, UpdateFrame always returns 0.
+// If tps is SyncWithRefreshRate, the display's refresh rate given at SetRefreshRate is used as TPS.
+// If tps <= 0 and not SyncWithFPS or SyncWithRefreshRate, UpdateFrame always returns 0.
 //
 // UpdateFrame is expected to be called once per frame.
 func UpdateFrame() int {
 	m.Lock()
 	defer m.Unlock()
+	return updateFrame(tps)
+}
+
+// UpdateFrameWithoutRendering is like UpdateFrame, but is for a game loop without rendering.
+//
+// As there are no frames to synchronize with, SyncWithFPS and SyncWithRefreshRate are treated as DefaultTPS.
+// The TPS set by SetTPS is not changed.
+func UpdateFrameWithoutRendering() int {
+	m.Lock()
+	defer m.Unlock()
+	return updateFrame(tpsWithoutRendering())
+}
 
+// tpsWithoutRendering returns the TPS used for a game loop without rendering.
+//
+// tpsWithoutRendering must be called with the lock held.
+func tpsWithoutRendering() int {
+	if tps == SyncWithFPS || tps == SyncWithRefreshRate {
+		return DefaultTPS
+	}
+	return tps
+}
+
+// updateFrame must be called with the lock held.
+func updateFrame(tps int) int {
 	n := now()
 	if lastNow > n {
 		// This ensures that now() must be monotonic (#875).
@@ -145,16 +247,62 @@ func UpdateFrame() int {
 	lastNow = n
 
 	c := 0
+	interpolationAlpha = 0
 	if tps == SyncWithFPS {
 		c = 1
+	} else if tps == SyncWithRefreshRate {
+		t := refreshRateTPS()
+		c = calcCountFromTPS(t, n)
+		interpolationAlpha = calcInterpolationAlpha(t, n)
 	} else if tps > 0 {
 		c = calcCountFromTPS(int64(tps), n)
+		interpolationAlpha = calcInterpolationAlpha(int64(tps), n)
 	}
 	updateFPSAndTPS(n, c)
 
 	return c
 }
 
+// DurationUntilNextTick returns the duration until the logical time of the next tick.
+//
+// If tps is SyncWithFPS or tps <= 0, there is no schedule of ticks and DurationUntilNextTick returns the tick length for DefaultTPS.
+func DurationUntilNextTick() time.Duration {
+	m.Lock()
+	defer m.Unlock()
+	return durationUntilNextTick(tps)
+}
+
+// DurationUntilNextTickWithoutRendering is like DurationUntilNextTick, but is for a game loop without rendering.
+// See also UpdateFrameWithoutRendering.
+func DurationUntilNextTickWithoutRendering() time.Duration {
+	m.Lock()
+	defer m.Unlock()
+	return durationUntilNextTick(tpsWithoutRendering())
+}
+
+// durationUntilNextTick must be called with the lock held.
+func durationUntilNextTick(tps int) time.Duration {
+	var t int64
+	switch {
+	case tps == SyncWithRefreshRate:
+		t = refreshRateTPS()
+	case tps > 0:
+		t = int64(tps)
+	default:
+		return time.Second / DefaultTPS
+	}
+	return max(time.Duration(lastSystemTime+int64(time.Second)/t-now()), 0)
+}
+
+// InterpolationAlpha returns the progress of the current tick in [0, 1), calculated at the last UpdateFrame.
+//
+// If tps is SyncWithFPS or tps <= 0, InterpolationAlpha always returns 0.
+func InterpolationAlpha() float64 {
+	m.Lock()
+	defer m.Unlock()
+	return interpolationAlpha
+}
+
 func SetTPS(newTPS int) {
 	m.Lock()
 	defer m.Unlock()
@@ -166,3 +314,55 @@ func TPS() int {
 	defer m.Unlock()
 	return tps
 }
+
+func SetCatchUpPolicy(policy CatchUpPolicyType) {
+	m.Lock()
+	defer m.Unlock()
+	catchUpPolicy = policy
+}
+
+func CatchUpPolicy() CatchUpPolicyType {
+	m.Lock()
+	defer m.Unlock()
+	return catchUpPolicy
+}
+
+func SetMaxCatchUpTicks(ticks int) {
+	m.Lock()
+	defer m.Unlock()
+	maxCatchUpTicks = ticks
+}
+
+func MaxCatchUpTicks() int {
+	m.Lock()
+	defer m.Unlock()
+	return maxCatchUpTicks
+}
+
+// TakeDroppedTicks returns the number of the dropped ticks since the last TakeDroppedTicks call.
+func TakeDroppedTicks() int {
+	m.Lock()
+	defer m.Unlock()
+	n := droppedTicks
+	droppedTicks = 0
+	return n
+}
+
+// SetRefreshRate sets the current display's refresh rate in Hz.
+// rate is 0 when the refresh rate is unknown.
+//
+// SetRefreshRate is expected to be called once per frame before UpdateFrame.
+func SetRefreshRate(rate float64) {
+	m.Lock()
+	defer m.Unlock()
+	refreshRate = rate
+}
+
+// refreshRateTPS returns TPS for SyncWithRefreshRate.
+// If the refresh rate is unknown, refreshRateTPS returns DefaultTPS.
+func refreshRateTPS() int64 {
+	if refreshRate <= 0 {
+		return DefaultTPS
+	}
+	return int64(math.Round(refreshRate))
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestInterpolationAlphaMonotonic(t *testing.T) {
+	const (
+		tps = 60
+		// FPS is 2.4 times TPS.
+		fps = 144
+	)
+
+	// offset is the phase of the frames against the ticks.
+	for _, offset := range []time.Duration{0, time.Second / tps / 4, time.Second / tps * 51 / 100} {
+		t.Run(fmt.Sprintf("offset %s", offset), func(t *testing.T) {
+			lastSystemTime = 0
+			tickStartTime = 0
+			prevTPS = tps
+
+			var prevAlpha float64
+			for i := 1; i <= fps*2; i++ {
+				now := int64(offset) + int64(i)*int64(time.Second)/fps
+				count := calcCountFromTPS(tps, now)
+				alpha := calcInterpolationAlpha(tps, now)
+				if alpha < 0 || alpha >= 1 {
+					t.Fatalf("frame %d: alpha must be in [0, 1) but %f", i, alpha)
+				}
+				if count == 0 && alpha <= prevAlpha {
+					t.Errorf("frame %d: alpha must increase within a tick: got: %f, previous: %f", i, alpha, prevAlpha)
+				}
+				prevAlpha = alpha
+			}
+		})
+	}
+}
+
+func TestDroppedTicks(t *testing.T) {
+	const tps = 60
+
+	defer func() {
+		catchUpPolicy = CatchUpPolicySlowDown
+		maxCatchUpTicks = 0
+		droppedTicks = 0
+	}()
+
+	catchUpPolicy = CatchUpPolicySlowDown
+	maxCatchUpTicks = 2
+
+	// The game falls behind by 4 ticks. The ticks exceeding the limit are dropped.
+	lastSystemTime = 0
+	prevTPS = tps
+	droppedTicks = 0
+	if got, want := calcCountFromTPS(tps, 4*int64(time.Second)/tps+int64(time.Millisecond)), 2; got != want {
+		t.Errorf("calcCountFromTPS: got: %d, want: %d", got, want)
+	}
+	if got, want := TakeDroppedTicks(), 2; got != want {
+		t.Errorf("TakeDroppedTicks(): got: %d, want: %d", got, want)
+	}
+
+	// The game falls behind by 10 ticks without the limit. One Update is called, and the rest of the ticks are dropped.
+	maxCatchUpTicks = 0
+	lastSystemTime = 0
+	prevTPS = tps
+	droppedTicks = 0
+	if got, want := calcCountFromTPS(tps, 10*int64(time.Second)/tps+int64(time.Millisecond)), 1; got != want {
+		t.Errorf("calcCountFromTPS: got: %d, want: %d", got, want)
+	}
+	if got, want := TakeDroppedTicks(), 9; got != want {
+		t.Errorf("TakeDroppedTicks(): got: %d, want: %d", got, want)
+	}
+
+	// The previous time is too old, e.g., the application was suspended. This is not counted as dropped ticks.
+	lastSystemTime = 0
+	prevTPS = tps
+	droppedTicks = 0
+	calcCountFromTPS(tps, 10*int64(time.Second))
+	if got, want := TakeDroppedTicks(), 0; got != want {
+		t.Errorf("TakeDroppedTicks(): got: %d, want: %d", got, want)
+	}
+}
+
+func TestDurationUntilNextTick(t *testing.T) {
+	SetTPS(60)
+	defer SetTPS(DefaultTPS)
+
+	m.Lock()
+	lastSystemTime = now()
+	m.Unlock()
+
+	if got := DurationUntilNextTick(); got <= 0 || got > time.Second/60 {
+		t.Errorf("DurationUntilNextTick(): got: %s, want: (0, %s]", got, time.Second/60)
+	}
+
+	m.Lock()
+	lastSystemTime = now() - int64(time.Second)
+	m.Unlock()
+
+	if got := DurationUntilNextTick(); got != 0 {
+		t.Errorf("DurationUntilNextTick(): got: %s, want: 0", got)
+	}
+}
+
+func TestUpdateFrameWithoutRenderingKeepsTPS(t *testing.T) {
+	defer SetTPS(DefaultTPS)
+
+	for _, tps := range []int{SyncWithFPS, SyncWithRefreshRate} {
+		SetTPS(tps)
+
+		m.Lock()
+		lastSystemTime = now()
+		m.Unlock()
+		UpdateFrameWithoutRendering()
+
+		if got := TPS(); got != tps {
+			t.Errorf("TPS(): got: %d, want: %d", got, tps)
+		}
+		if got := DurationUntilNextTickWithoutRendering(); got <= 0 || got > time.Second/DefaultTPS {
+			t.Errorf("DurationUntilNextTickWithoutRendering(): got: %s, want: (0, %s]", got, time.Second/DefaultTPS)
+		}
+	}
+}
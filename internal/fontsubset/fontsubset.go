@@ -0,0 +1,718 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fontsubset
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"slices"
+
+	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/font/opentype"
+	"github.com/go-text/typesetting/font/opentype/tables"
+)
+
+var (
+	tagCFF  = opentype.MustNewTag("CFF ")
+	tagCFF2 = opentype.MustNewTag("CFF2")
+	tagCmap = opentype.MustNewTag("cmap")
+	tagDSIG = opentype.MustNewTag("DSIG")
+	tagGlyf = opentype.MustNewTag("glyf")
+	tagGvar = opentype.MustNewTag("gvar")
+	tagHead = opentype.MustNewTag("head")
+	tagHmtx = opentype.MustNewTag("hmtx")
+	tagLoca = opentype.MustNewTag("loca")
+	tagMaxp = opentype.MustNewTag("maxp")
+)
+
+// Subset creates a subset of the given TrueType font that includes only the glyphs for the runes in text,
+// and returns the font binary of the subset.
+//
+// The glyphs which the given runes can be substituted with by OpenType features are also included.
+// The glyph IDs are kept in the subset, and the glyphs not included become empty.
+func Subset(source io.Reader, text string) ([]byte, error) {
+	s, err := newSubsetSource(source)
+	if err != nil {
+		return nil, err
+	}
+
+	gids := make([]bool, len(s.glyphs))
+	// .notdef must be always included.
+	gids[0] = true
+
+	cmap := map[rune]font.GID{}
+	for _, r := range text {
+		gid, ok := s.font.Cmap.Lookup(r)
+		if !ok || int(gid) >= len(gids) {
+			continue
+		}
+		cmap[r] = gid
+		gids[gid] = true
+	}
+
+	if err := s.closeGlyphs(gids); err != nil {
+		return nil, err
+	}
+
+	glyphs := make([][]byte, len(s.glyphs))
+	var gvarGlyphs [][]byte
+	if s.gvar != nil {
+		gvarGlyphs = make([][]byte, len(s.glyphs))
+	}
+	for gid, ok := range gids {
+		if !ok {
+			continue
+		}
+		glyphs[gid] = s.glyphs[gid]
+		if s.gvar != nil {
+			gvarGlyphs[gid] = s.gvar.glyphs[gid]
+		}
+	}
+
+	return s.build(glyphs, gvarGlyphs, cmap)
+}
+
+// Merge merges the given font subsets created from the same font by Subset,
+// and returns the font binary of the merged subset.
+func Merge(subsets ...io.Reader) ([]byte, error) {
+	if len(subsets) == 0 {
+		return nil, errors.New("fontsubset: no subsets are given")
+	}
+
+	sources := make([]*subsetSource, len(subsets))
+	for i, subset := range subsets {
+		s, err := newSubsetSource(subset)
+		if err != nil {
+			return nil, err
+		}
+		sources[i] = s
+	}
+
+	base := sources[0]
+	baseHmtx, _ := base.loader.RawTable(tagHmtx)
+	for _, s := range sources[1:] {
+		hmtx, _ := s.loader.RawTable(tagHmtx)
+		if len(s.glyphs) != len(base.glyphs) || !slices.Equal(hmtx, baseHmtx) || (s.gvar == nil) != (base.gvar == nil) {
+			return nil, errors.New("fontsubset: the subsets must be created from the same font")
+		}
+	}
+
+	glyphs := make([][]byte, len(base.glyphs))
+	var gvarGlyphs [][]byte
+	if base.gvar != nil {
+		gvarGlyphs = make([][]byte, len(base.glyphs))
+	}
+	for gid := range glyphs {
+		for _, s := range sources {
+			if len(s.glyphs[gid]) == 0 {
+				continue
+			}
+			glyphs[gid] = s.glyphs[gid]
+			if s.gvar != nil {
+				gvarGlyphs[gid] = s.gvar.glyphs[gid]
+			}
+			break
+		}
+	}
+
+	cmap := map[rune]font.GID{}
+	for _, s := range sources {
+		iter := s.font.Cmap.Iter()
+		for iter.Next() {
+			r, gid := iter.Char()
+			if _, ok := cmap[r]; ok {
+				continue
+			}
+			if int(gid) >= len(glyphs) {
+				continue
+			}
+			cmap[r] = gid
+		}
+	}
+
+	return base.build(glyphs, gvarGlyphs, cmap)
+}
+
+type subsetSource struct {
+	loader *opentype.Loader
+	font   *font.Font
+
+	// glyphs is the 'glyf' table data indexed by glyph IDs.
+	glyphs [][]byte
+
+	// gvar is the 'gvar' table data. gvar is nil if the font doesn't have a 'gvar' table.
+	gvar *gvarTable
+}
+
+type gvarTable struct {
+	majorVersion     uint16
+	minorVersion     uint16
+	axisCount        uint16
+	sharedTupleCount uint16
+	sharedTuples     []byte
+
+	// glyphs is the glyph variation data indexed by glyph IDs.
+	glyphs [][]byte
+}
+
+var errInvalidFont = errors.New("fontsubset: invalid font")
+
+func newSubsetSource(source io.Reader) (*subsetSource, error) {
+	// font.Resource has io.Seeker and io.ReaderAt in addition to io.Reader.
+	src, ok := source.(font.Resource)
+	if !ok {
+		bs, err := io.ReadAll(source)
+		if err != nil {
+			return nil, err
+		}
+		src = bytes.NewReader(bs)
+	}
+
+	l, err := opentype.NewLoader(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.HasTable(tagCFF) || l.HasTable(tagCFF2) {
+		return nil, errors.New("fontsubset: subsetting a font with CFF outlines is not supported")
+	}
+	if !l.HasTable(tagGlyf) || !l.HasTable(tagLoca) {
+		return nil, errors.New("fontsubset: a font without TrueType outlines is not supported")
+	}
+
+	f, err := font.NewFont(l)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := l.RawTable(tagHead)
+	if err != nil {
+		return nil, err
+	}
+	if len(head) < 54 {
+		return nil, errInvalidFont
+	}
+	maxp, err := l.RawTable(tagMaxp)
+	if err != nil {
+		return nil, err
+	}
+	if len(maxp) < 6 {
+		return nil, errInvalidFont
+	}
+	loca, err := l.RawTable(tagLoca)
+	if err != nil {
+		return nil, err
+	}
+	glyf, err := l.RawTable(tagGlyf)
+	if err != nil {
+		return nil, err
+	}
+
+	numGlyphs := int(binary.BigEndian.Uint16(maxp[4:]))
+	longLoca := binary.BigEndian.Uint16(head[50:]) != 0
+	offsets, err := parseOffsets(loca, numGlyphs+1, longLoca)
+	if err != nil {
+		return nil, err
+	}
+	if !longLoca {
+		// The short offsets are the actual offsets divided by 2.
+		for i := range offsets {
+			offsets[i] *= 2
+		}
+	}
+	glyphs, err := splitByOffsets(glyf, offsets)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &subsetSource{
+		loader: l,
+		font:   f,
+		glyphs: glyphs,
+	}
+
+	if l.HasTable(tagGvar) {
+		gvar, err := l.RawTable(tagGvar)
+		if err != nil {
+			return nil, err
+		}
+		t, err := parseGvar(gvar, numGlyphs)
+		if err != nil {
+			return nil, err
+		}
+		s.gvar = t
+	}
+
+	return s, nil
+}
+
+func parseGvar(gvar []byte, numGlyphs int) (*gvarTable, error) {
+	if len(gvar) < 20 {
+		return nil, errInvalidFont
+	}
+	t := &gvarTable{
+		majorVersion:     binary.BigEndian.Uint16(gvar[0:]),
+		minorVersion:     binary.BigEndian.Uint16(gvar[2:]),
+		axisCount:        binary.BigEndian.Uint16(gvar[4:]),
+		sharedTupleCount: binary.BigEndian.Uint16(gvar[6:]),
+	}
+	sharedTuplesOffset := int(binary.BigEndian.Uint32(gvar[8:]))
+	if int(binary.BigEndian.Uint16(gvar[12:])) != numGlyphs {
+		return nil, errInvalidFont
+	}
+	longOffsets := binary.BigEndian.Uint16(gvar[14:])&1 != 0
+	dataOffset := int(binary.BigEndian.Uint32(gvar[16:]))
+
+	sharedTuplesSize := int(t.axisCount) * int(t.sharedTupleCount) * 2
+	if sharedTuplesOffset+sharedTuplesSize > len(gvar) || dataOffset > len(gvar) {
+		return nil, errInvalidFont
+	}
+	t.sharedTuples = gvar[sharedTuplesOffset : sharedTuplesOffset+sharedTuplesSize]
+
+	offsets, err := parseOffsets(gvar[20:], numGlyphs+1, longOffsets)
+	if err != nil {
+		return nil, err
+	}
+	if !longOffsets {
+		// The short offsets are the actual offsets divided by 2.
+		for i := range offsets {
+			offsets[i] *= 2
+		}
+	}
+	glyphs, err := splitByOffsets(gvar[dataOffset:], offsets)
+	if err != nil {
+		return nil, err
+	}
+	t.glyphs = glyphs
+
+	return t, nil
+}
+
+func parseOffsets(data []byte, count int, long bool) ([]int, error) {
+	offsets := make([]int, count)
+	if long {
+		if len(data) < count*4 {
+			return nil, errInvalidFont
+		}
+		for i := range offsets {
+			offsets[i] = int(binary.BigEndian.Uint32(data[i*4:]))
+		}
+		return offsets, nil
+	}
+
+	if len(data) < count*2 {
+		return nil, errInvalidFont
+	}
+	for i := range offsets {
+		offsets[i] = int(binary.BigEndian.Uint16(data[i*2:]))
+	}
+	return offsets, nil
+}
+
+func splitByOffsets(data []byte, offsets []int) ([][]byte, error) {
+	items := make([][]byte, len(offsets)-1)
+	for i := range items {
+		start, end := offsets[i], offsets[i+1]
+		if start > end || end > len(data) {
+			return nil, errInvalidFont
+		}
+		items[i] = data[start:end]
+	}
+	return items, nil
+}
+
+// closeGlyphs adds glyphs that the given glyphs depend on, to gids.
+func (s *subsetSource) closeGlyphs(gids []bool) error {
+	for {
+		changed := s.closeGlyphsByGSUB(gids)
+		c, err := s.closeGlyphsByComponents(gids)
+		if err != nil {
+			return err
+		}
+		if !changed && !c {
+			return nil
+		}
+	}
+}
+
+// closeGlyphsByGSUB adds glyphs that the given glyphs can be substituted with, to gids.
+//
+// Contextual lookups are not considered explicitly, as the lookups referred from them are processed anyway.
+// Then the result might include more glyphs than necessary.
+func (s *subsetSource) closeGlyphsByGSUB(gids []bool) bool {
+	var changed bool
+
+	has := func(gid tables.GlyphID) bool {
+		return int(gid) < len(gids) && gids[gid]
+	}
+	add := func(gid tables.GlyphID) {
+		if int(gid) >= len(gids) || gids[gid] {
+			return
+		}
+		gids[gid] = true
+		changed = true
+	}
+
+	for _, lookup := range s.font.GSUB.Lookups {
+		for _, subtable := range lookup.Subtables {
+			switch subtable := subtable.(type) {
+			case tables.SingleSubs:
+				switch data := subtable.Data.(type) {
+				case tables.SingleSubstData1:
+					forEachCoveredGlyph(data.Coverage, func(gid tables.GlyphID, index int) {
+						if has(gid) {
+							// The addition is modulo 65536.
+							add(tables.GlyphID(uint16(int(gid) + int(data.DeltaGlyphID))))
+						}
+					})
+				case tables.SingleSubstData2:
+					forEachCoveredGlyph(data.Coverage, func(gid tables.GlyphID, index int) {
+						if has(gid) && index < len(data.SubstituteGlyphIDs) {
+							add(data.SubstituteGlyphIDs[index])
+						}
+					})
+				}
+			case tables.MultipleSubs:
+				forEachCoveredGlyph(subtable.Coverage, func(gid tables.GlyphID, index int) {
+					if !has(gid) || index >= len(subtable.Sequences) {
+						return
+					}
+					for _, g := range subtable.Sequences[index].SubstituteGlyphIDs {
+						add(g)
+					}
+				})
+			case tables.AlternateSubs:
+				forEachCoveredGlyph(subtable.Coverage, func(gid tables.GlyphID, index int) {
+					if !has(gid) || index >= len(subtable.AlternateSets) {
+						return
+					}
+					for _, g := range subtable.AlternateSets[index].AlternateGlyphIDs {
+						add(g)
+					}
+				})
+			case tables.LigatureSubs:
+				forEachCoveredGlyph(subtable.Coverage, func(gid tables.GlyphID, index int) {
+					if !has(gid) || index >= len(subtable.LigatureSets) {
+						return
+					}
+				ligatures:
+					for _, lig := range subtable.LigatureSets[index].Ligatures {
+						for _, g := range lig.ComponentGlyphIDs {
+							if !has(g) {
+								continue ligatures
+							}
+						}
+						add(lig.LigatureGlyph)
+					}
+				})
+			case tables.ReverseChainSingleSubs:
+				forEachCoveredGlyph(subtable.Cov(), func(gid tables.GlyphID, index int) {
+					if has(gid) && index < len(subtable.SubstituteGlyphIDs) {
+						add(subtable.SubstituteGlyphIDs[index])
+					}
+				})
+			}
+		}
+	}
+	return changed
+}
+
+func forEachCoveredGlyph(coverage tables.Coverage, f func(gid tables.GlyphID, index int)) {
+	switch c := coverage.(type) {
+	case tables.Coverage1:
+		for i, gid := range c.Glyphs {
+			f(gid, i)
+		}
+	case tables.Coverage2:
+		for _, r := range c.Ranges {
+			for gid := int(r.StartGlyphID); gid <= int(r.EndGlyphID); gid++ {
+				f(tables.GlyphID(gid), int(r.StartCoverageIndex)+gid-int(r.StartGlyphID))
+			}
+		}
+	}
+}
+
+// closeGlyphsByComponents adds the components of the given composite glyphs, to gids.
+func (s *subsetSource) closeGlyphsByComponents(gids []bool) (bool, error) {
+	var changed bool
+	for gid, ok := range gids {
+		if !ok {
+			continue
+		}
+		g := s.glyphs[gid]
+		// A composite glyph has a negative number of contours.
+		if len(g) < 10 || int16(binary.BigEndian.Uint16(g)) >= 0 {
+			continue
+		}
+
+		const (
+			flagArg1And2AreWords   = 0x0001
+			flagWeHaveAScale       = 0x0008
+			flagMoreComponents     = 0x0020
+			flagWeHaveAnXAndYScale = 0x0040
+			flagWeHaveATwoByTwo    = 0x0080
+		)
+
+		offset := 10
+		for {
+			if offset+4 > len(g) {
+				return false, errInvalidFont
+			}
+			flags := binary.BigEndian.Uint16(g[offset:])
+			c := int(binary.BigEndian.Uint16(g[offset+2:]))
+			if c >= len(gids) {
+				return false, errInvalidFont
+			}
+			if !gids[c] {
+				gids[c] = true
+				changed = true
+			}
+			offset += 4
+
+			if flags&flagArg1And2AreWords != 0 {
+				offset += 4
+			} else {
+				offset += 2
+			}
+			switch {
+			case flags&flagWeHaveAScale != 0:
+				offset += 2
+			case flags&flagWeHaveAnXAndYScale != 0:
+				offset += 4
+			case flags&flagWeHaveATwoByTwo != 0:
+				offset += 8
+			}
+
+			if flags&flagMoreComponents == 0 {
+				break
+			}
+		}
+	}
+	return changed, nil
+}
+
+// build builds a font binary with the given glyphs, the given glyph variation data, and the given character map.
+// The other tables are copied from the source font.
+func (s *subsetSource) build(glyphs [][]byte, gvarGlyphs [][]byte, cmap map[rune]font.GID) ([]byte, error) {
+	glyf, loca := buildGlyfAndLoca(glyphs)
+
+	var ts []opentype.Table
+	for _, tag := range s.loader.Tables() {
+		var content []byte
+		switch tag {
+		case tagDSIG:
+			// The digital signature is no longer valid.
+			continue
+		case tagCmap:
+			content = buildCmap(cmap)
+		case tagGlyf:
+			content = glyf
+		case tagLoca:
+			content = loca
+		case tagGvar:
+			content = buildGvar(s.gvar, gvarGlyphs)
+		case tagHead:
+			head, err := s.loader.RawTable(tag)
+			if err != nil {
+				return nil, err
+			}
+			content = slices.Clone(head)
+			// Reset checkSumAdjustment. This is calculated later.
+			binary.BigEndian.PutUint32(content[8:], 0)
+			// Use the long format for 'loca'.
+			binary.BigEndian.PutUint16(content[50:], 1)
+		default:
+			t, err := s.loader.RawTable(tag)
+			if err != nil {
+				return nil, err
+			}
+			content = t
+		}
+		ts = append(ts, opentype.Table{
+			Tag:     tag,
+			Content: content,
+		})
+	}
+	return writeFont(ts), nil
+}
+
+func buildGlyfAndLoca(glyphs [][]byte) ([]byte, []byte) {
+	var glyf []byte
+	loca := make([]byte, 0, (len(glyphs)+1)*4)
+	for _, g := range glyphs {
+		loca = binary.BigEndian.AppendUint32(loca, uint32(len(glyf)))
+		glyf = append(glyf, g...)
+		glyf = appendPadding(glyf)
+	}
+	loca = binary.BigEndian.AppendUint32(loca, uint32(len(glyf)))
+	return glyf, loca
+}
+
+func buildGvar(t *gvarTable, glyphs [][]byte) []byte {
+	const headerSize = 20
+	sharedTuplesOffset := headerSize + (len(glyphs)+1)*4
+	dataOffset := sharedTuplesOffset + len(t.sharedTuples)
+
+	gvar := make([]byte, 0, dataOffset)
+	gvar = binary.BigEndian.AppendUint16(gvar, t.majorVersion)
+	gvar = binary.BigEndian.AppendUint16(gvar, t.minorVersion)
+	gvar = binary.BigEndian.AppendUint16(gvar, t.axisCount)
+	gvar = binary.BigEndian.AppendUint16(gvar, t.sharedTupleCount)
+	gvar = binary.BigEndian.AppendUint32(gvar, uint32(sharedTuplesOffset))
+	gvar = binary.BigEndian.AppendUint16(gvar, uint16(len(glyphs)))
+	// Use the long format for the offsets.
+	gvar = binary.BigEndian.AppendUint16(gvar, 1)
+	gvar = binary.BigEndian.AppendUint32(gvar, uint32(dataOffset))
+
+	var offset int
+	for _, g := range glyphs {
+		gvar = binary.BigEndian.AppendUint32(gvar, uint32(offset))
+		offset += len(g)
+	}
+	gvar = binary.BigEndian.AppendUint32(gvar, uint32(offset))
+
+	gvar = append(gvar, t.sharedTuples...)
+	for _, g := range glyphs {
+		gvar = append(gvar, g...)
+	}
+	return gvar
+}
+
+// buildCmap builds a 'cmap' table with one format 12 subtable for the Unicode full repertoire.
+func buildCmap(cmap map[rune]font.GID) []byte {
+	runes := make([]rune, 0, len(cmap))
+	for r := range cmap {
+		runes = append(runes, r)
+	}
+	slices.Sort(runes)
+
+	type group struct {
+		startRune rune
+		endRune   rune
+		startGID  font.GID
+	}
+	var groups []group
+	for _, r := range runes {
+		gid := cmap[r]
+		if len(groups) > 0 {
+			g := &groups[len(groups)-1]
+			if g.endRune+1 == r && g.startGID+font.GID(r-g.startRune) == gid {
+				g.endRune = r
+				continue
+			}
+		}
+		groups = append(groups, group{
+			startRune: r,
+			endRune:   r,
+			startGID:  gid,
+		})
+	}
+
+	var t []byte
+	// The header.
+	t = binary.BigEndian.AppendUint16(t, 0) // version
+	t = binary.BigEndian.AppendUint16(t, 1) // numTables
+	// The encoding record.
+	t = binary.BigEndian.AppendUint16(t, 3)  // platformID: Windows
+	t = binary.BigEndian.AppendUint16(t, 10) // encodingID: Unicode full repertoire
+	t = binary.BigEndian.AppendUint32(t, 12) // subtableOffset
+	// The format 12 subtable.
+	t = binary.BigEndian.AppendUint16(t, 12)                        // format
+	t = binary.BigEndian.AppendUint16(t, 0)                         // reserved
+	t = binary.BigEndian.AppendUint32(t, uint32(16+12*len(groups))) // length
+	t = binary.BigEndian.AppendUint32(t, 0)                         // language
+	t = binary.BigEndian.AppendUint32(t, uint32(len(groups)))       // numGroups
+	for _, g := range groups {
+		t = binary.BigEndian.AppendUint32(t, uint32(g.startRune))
+		t = binary.BigEndian.AppendUint32(t, uint32(g.endRune))
+		t = binary.BigEndian.AppendUint32(t, uint32(g.startGID))
+	}
+	return t
+}
+
+// writeFont writes a TrueType font binary with the given tables.
+func writeFont(ts []opentype.Table) []byte {
+	slices.SortFunc(ts, func(a, b opentype.Table) int {
+		switch {
+		case a.Tag < b.Tag:
+			return -1
+		case a.Tag > b.Tag:
+			return 1
+		}
+		return 0
+	})
+
+	const (
+		headerSize = 12
+		recordSize = 16
+	)
+
+	numTables := len(ts)
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= numTables {
+		entrySelector++
+	}
+	searchRange := (1 << entrySelector) * 16
+
+	buf := make([]byte, 0, headerSize+recordSize*numTables)
+	buf = binary.BigEndian.AppendUint32(buf, 0x00010000) // sfntVersion
+	buf = binary.BigEndian.AppendUint16(buf, uint16(numTables))
+	buf = binary.BigEndian.AppendUint16(buf, uint16(searchRange))
+	buf = binary.BigEndian.AppendUint16(buf, uint16(entrySelector))
+	buf = binary.BigEndian.AppendUint16(buf, uint16(numTables*16-searchRange))
+
+	offset := headerSize + recordSize*numTables
+	headOffset := -1
+	for _, t := range ts {
+		if t.Tag == tagHead {
+			headOffset = offset
+		}
+		buf = binary.BigEndian.AppendUint32(buf, uint32(t.Tag))
+		buf = binary.BigEndian.AppendUint32(buf, checksum(t.Content))
+		buf = binary.BigEndian.AppendUint32(buf, uint32(offset))
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(t.Content)))
+		offset += (len(t.Content) + 3) &^ 3
+	}
+
+	for _, t := range ts {
+		buf = append(buf, t.Content...)
+		buf = appendPadding(buf)
+	}
+
+	if headOffset >= 0 {
+		binary.BigEndian.PutUint32(buf[headOffset+8:], 0xb1b0afba-checksum(buf))
+	}
+
+	return buf
+}
+
+func appendPadding(buf []byte) []byte {
+	for len(buf)%4 != 0 {
+		buf = append(buf, 0)
+	}
+	return buf
+}
+
+func checksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var v [4]byte
+		copy(v[:], data[i:])
+		sum += binary.BigEndian.Uint32(v[:])
+	}
+	return sum
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fontsubset_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-text/typesetting/font"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/fontsubset"
+)
+
+func readTestFont(t *testing.T, name string) []byte {
+	t.Helper()
+	bs, err := os.ReadFile(filepath.Join("..", "..", "text", "v2", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bs
+}
+
+func hasGlyph(t *testing.T, fontdata []byte, r rune) bool {
+	t.Helper()
+	f, err := font.ParseTTF(bytes.NewReader(fontdata))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ok := f.NominalGlyph(r)
+	return ok
+}
+
+func TestSubsetAndMerge(t *testing.T) {
+	fontdata := readTestFont(t, "MPLUS1p-Regular.ttf")
+
+	subset0, err := fontsubset.Subset(bytes.NewReader(fontdata), "Hello, 世界")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subset0) >= len(fontdata) {
+		t.Errorf("len(subset0) (%d) must be less than len(fontdata) (%d)", len(subset0), len(fontdata))
+	}
+	subset1, err := fontsubset.Subset(bytes.NewReader(fontdata), "World")
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged, err := fontsubset.Merge(bytes.NewReader(subset0), bytes.NewReader(subset1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range "Hello, 世界" {
+		if !hasGlyph(t, subset0, r) {
+			t.Errorf("subset0 must have a glyph for %q", r)
+		}
+	}
+	if hasGlyph(t, subset0, 'W') {
+		t.Errorf("subset0 must not have a glyph for 'W'")
+	}
+	for _, r := range "Hello, World 世界" {
+		if !hasGlyph(t, merged, r) {
+			t.Errorf("merged must have a glyph for %q", r)
+		}
+	}
+}
+
+func TestMergeWithDifferentFonts(t *testing.T) {
+	var subsets [][]byte
+	for _, name := range []string{"MPLUS1p-Regular.ttf", "Roboto-Regular.ttf"} {
+		subset, err := fontsubset.Subset(bytes.NewReader(readTestFont(t, name)), "Hello")
+		if err != nil {
+			t.Fatal(err)
+		}
+		subsets = append(subsets, subset)
+	}
+	if _, err := fontsubset.Merge(bytes.NewReader(subsets[0]), bytes.NewReader(subsets[1])); err == nil {
+		t.Errorf("fontsubset.Merge must return an error for subsets from different fonts")
+	}
+}
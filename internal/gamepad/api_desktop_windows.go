@@ -23,6 +23,19 @@ import (
 )
 
 const (
+	_BATTERY_DEVTYPE_GAMEPAD = 0x00
+
+	_BATTERY_LEVEL_EMPTY  = 0x00
+	_BATTERY_LEVEL_LOW    = 0x01
+	_BATTERY_LEVEL_MEDIUM = 0x02
+	_BATTERY_LEVEL_FULL   = 0x03
+
+	_BATTERY_TYPE_DISCONNECTED = 0x00
+	_BATTERY_TYPE_WIRED        = 0x01
+	_BATTERY_TYPE_ALKALINE     = 0x02
+	_BATTERY_TYPE_NIMH         = 0x03
+	_BATTERY_TYPE_UNKNOWN      = 0xFF
+
 	_DI_OK           = 0
 	_DI_NOEFFECT     = _SI_FALSE
 	_DI_PROPNOEFFECT = _SI_FALSE
@@ -474,6 +487,11 @@ type _RAWINPUTDEVICELIST struct {
 	dwType  uint32
 }
 
+type _XINPUT_BATTERY_INFORMATION struct {
+	BatteryType  byte
+	BatteryLevel byte
+}
+
 type _XINPUT_CAPABILITIES struct {
 	typ       byte
 	subType   byte
@@ -23,6 +23,9 @@ import (
 )
 
 const (
+	_BUS_USB       = 0x03
+	_BUS_BLUETOOTH = 0x05
+
 	_ABS_X     = 0x00
 	_ABS_Y     = 0x01
 	_ABS_Z     = 0x02
@@ -0,0 +1,201 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gamepad
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+)
+
+type Trigger int
+
+const (
+	TriggerLeft Trigger = iota
+	TriggerRight
+)
+
+type TriggerEffectType int
+
+const (
+	TriggerEffectTypeOff TriggerEffectType = iota
+	TriggerEffectTypeResistance
+	TriggerEffectTypeSection
+	TriggerEffectTypeVibration
+)
+
+type TriggerEffect struct {
+	Type      TriggerEffectType
+	Start     float64
+	End       float64
+	Strength  float64
+	Frequency float64
+}
+
+const (
+	dualSenseVendorID        = 0x054c
+	dualSenseProductID       = 0x0ce6
+	dualSenseEdgeProductID   = 0x0df2
+	dualSenseUSBReportSize   = 48
+	dualSenseBTReportSize    = 78
+	dualSenseCommonSize      = 47
+	dualSenseTriggerDataSize = 11
+)
+
+func isDualSense(vendor, product uint16) bool {
+	return vendor == dualSenseVendorID && (product == dualSenseProductID || product == dualSenseEdgeProductID)
+}
+
+// dualSenseOutput is a state of DualSense's output features.
+//
+// See also the Linux kernel's drivers/hid/hid-playstation.c for the output report format.
+type dualSenseOutput struct {
+	lightBar        [3]byte
+	lightBarValid   bool
+	playerLEDs      byte
+	playerLEDsValid bool
+	triggers        [2]TriggerEffect
+	triggersValid   [2]bool
+
+	// seq is the sequence number for Bluetooth reports.
+	seq byte
+}
+
+func unitToByte(v float64) byte {
+	return byte(min(max(v, 0), 1)*255 + 0.5)
+}
+
+func (t *TriggerEffect) dualSenseData() [dualSenseTriggerDataSize]byte {
+	var d [dualSenseTriggerDataSize]byte
+	// The effects are the simple ones, which are supported by all the DualSense firmware versions.
+	switch t.Type {
+	case TriggerEffectTypeOff:
+		d[0] = 0x05
+	case TriggerEffectTypeResistance:
+		d[0] = 0x01
+		d[1] = unitToByte(t.Start)
+		d[2] = unitToByte(t.Strength)
+	case TriggerEffectTypeSection:
+		d[0] = 0x02
+		d[1] = unitToByte(t.Start)
+		d[2] = unitToByte(t.End)
+		d[3] = unitToByte(t.Strength)
+	case TriggerEffectTypeVibration:
+		d[0] = 0x06
+		d[1] = byte(min(max(t.Frequency, 0), 255))
+		d[2] = unitToByte(t.Strength)
+		d[3] = unitToByte(t.Start)
+	}
+	return d
+}
+
+// appendCommon appends the common part of the output report for USB and Bluetooth.
+func (d *dualSenseOutput) appendCommon(report []byte) []byte {
+	var c [dualSenseCommonSize]byte
+
+	// valid_flag0
+	if d.triggersValid[TriggerRight] {
+		c[0] |= 0x04
+	}
+	if d.triggersValid[TriggerLeft] {
+		c[0] |= 0x08
+	}
+
+	// valid_flag1
+	if d.lightBarValid {
+		c[1] |= 0x04
+	}
+	if d.playerLEDsValid {
+		c[1] |= 0x10
+	}
+
+	// The right trigger's effect is at 10, and the left trigger's effect is at 21.
+	r := d.triggers[TriggerRight].dualSenseData()
+	copy(c[10:], r[:])
+	l := d.triggers[TriggerLeft].dualSenseData()
+	copy(c[21:], l[:])
+
+	c[43] = d.playerLEDs
+	c[44] = d.lightBar[0]
+	c[45] = d.lightBar[1]
+	c[46] = d.lightBar[2]
+
+	return append(report, c[:]...)
+}
+
+func (d *dualSenseOutput) usbReport() []byte {
+	report := make([]byte, 0, dualSenseUSBReportSize)
+	report = append(report, 0x02)
+	report = d.appendCommon(report)
+	return report
+}
+
+func (d *dualSenseOutput) bluetoothReport() []byte {
+	report := make([]byte, 0, dualSenseBTReportSize)
+	report = append(report, 0x31, d.seq<<4, 0x10)
+	d.seq = (d.seq + 1) & 0x0f
+	report = d.appendCommon(report)
+	// Pad the reserved bytes before the CRC32.
+	report = append(report, make([]byte, dualSenseBTReportSize-4-len(report))...)
+
+	// The CRC32 is calculated with the seed byte 0xa2 for output reports.
+	h := crc32.NewIEEE()
+	_, _ = h.Write([]byte{0xa2})
+	_, _ = h.Write(report)
+	return binary.LittleEndian.AppendUint32(report, h.Sum32())
+}
+
+// dualSenseOutputWriter is implemented by a native gamepad that can write DualSense output reports.
+type dualSenseOutputWriter interface {
+	writeDualSenseOutput(output *dualSenseOutput)
+}
+
+// SetLightBarColor is concurrent-safe.
+func (g *Gamepad) SetLightBarColor(r, gr, b byte) {
+	g.m.Lock()
+	defer g.m.Unlock()
+
+	g.dualSense.lightBar = [3]byte{r, gr, b}
+	g.dualSense.lightBarValid = true
+	g.writeDualSenseOutput()
+}
+
+// SetPlayerLEDs is concurrent-safe.
+func (g *Gamepad) SetPlayerLEDs(leds byte) {
+	g.m.Lock()
+	defer g.m.Unlock()
+
+	g.dualSense.playerLEDs = leds & 0x1f
+	g.dualSense.playerLEDsValid = true
+	g.writeDualSenseOutput()
+}
+
+// SetTriggerEffect is concurrent-safe.
+func (g *Gamepad) SetTriggerEffect(trigger Trigger, effect TriggerEffect) {
+	g.m.Lock()
+	defer g.m.Unlock()
+
+	if trigger != TriggerLeft && trigger != TriggerRight {
+		return
+	}
+	g.dualSense.triggers[trigger] = effect
+	g.dualSense.triggersValid[trigger] = true
+	g.writeDualSenseOutput()
+}
+
+func (g *Gamepad) writeDualSenseOutput() {
+	if w, ok := g.native.(dualSenseOutputWriter); ok {
+		w.writeDualSenseOutput(&g.dualSense)
+	}
+}
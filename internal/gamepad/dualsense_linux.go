@@ -0,0 +1,64 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !android && !nintendosdk && !playstation5
+
+package gamepad
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+func (g *nativeGamepadImpl) writeDualSenseOutput(output *dualSenseOutput) {
+	if !isDualSense(g.vendor, g.product) {
+		return
+	}
+
+	if g.hidrawFD == 0 {
+		// The hidraw device for the event device is at e.g. /sys/class/input/event0/device/device/hidraw/hidraw0.
+		dir := filepath.Join("/sys/class/input", filepath.Base(g.path), "device", "device", "hidraw")
+		ents, err := os.ReadDir(dir)
+		if err != nil {
+			return
+		}
+		for _, ent := range ents {
+			if !strings.HasPrefix(ent.Name(), "hidraw") {
+				continue
+			}
+			// hidraw devices are often not writable without a udev rule. Ignore the error in this case.
+			fd, err := unix.Open(filepath.Join("/dev", ent.Name()), unix.O_WRONLY|unix.O_NONBLOCK, 0)
+			if err != nil {
+				return
+			}
+			g.hidrawFD = fd
+			break
+		}
+		if g.hidrawFD == 0 {
+			return
+		}
+	}
+
+	var report []byte
+	if g.bustype == _BUS_BLUETOOTH {
+		report = output.bluetoothReport()
+	} else {
+		report = output.usbReport()
+	}
+	// Ignore the error as the output is not critical.
+	_, _ = unix.Write(g.hidrawFD, report)
+}
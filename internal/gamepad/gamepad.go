@@ -185,6 +185,8 @@ type Gamepad struct {
 	m     sync.Mutex
 
 	native nativeGamepad
+
+	dualSense dualSenseOutput
 }
 
 type mappingInput interface {
@@ -103,9 +103,10 @@ type nativeGamepadsDesktop struct {
 	dinput8API *_IDirectInput8W
 	xinput     windows.Handle
 
-	procDirectInput8Create    uintptr
-	procXInputGetCapabilities uintptr
-	procXInputGetState        uintptr
+	procDirectInput8Create          uintptr
+	procXInputGetCapabilities       uintptr
+	procXInputGetState              uintptr
+	procXInputGetBatteryInformation uintptr
 
 	origWndProc         uintptr
 	wndProcCallback     uintptr
@@ -169,6 +170,10 @@ func (g *nativeGamepadsDesktop) init(gamepads *gamepads) error {
 				}
 				g.procXInputGetState = p
 			}
+			// XInputGetBatteryInformation is available only in xinput1_4.dll.
+			if p, err := windows.GetProcAddress(h, "XInputGetBatteryInformation"); err == nil {
+				g.procXInputGetBatteryInformation = p
+			}
 			break
 		}
 	}
@@ -224,6 +229,16 @@ func (g *nativeGamepadsDesktop) xinputGetState(dwUserIndex uint32, pState *_XINP
 	return nil
 }
 
+func (g *nativeGamepadsDesktop) xinputGetBatteryInformation(dwUserIndex uint32, devType byte, pBatteryInformation *_XINPUT_BATTERY_INFORMATION) error {
+	// XInputGetBatteryInformation doesn't call SetLastError and returns an error code directly.
+	r, _, _ := syscall.Syscall(g.procXInputGetBatteryInformation, 3,
+		uintptr(dwUserIndex), uintptr(devType), uintptr(unsafe.Pointer(pBatteryInformation)))
+	if e := syscall.Errno(uint32(r)); e != windows.ERROR_SUCCESS {
+		return fmt.Errorf("gamepad: XInputGetBatteryInformation failed: %w", e)
+	}
+	return nil
+}
+
 func (g *nativeGamepadsDesktop) detectConnection(gamepads *gamepads) error {
 	if g.dinput8 != 0 {
 		if g.enumDevicesCallback == 0 {
@@ -608,6 +623,9 @@ type nativeGamepadDesktop struct {
 
 	xinputIndex int
 	xinputState _XINPUT_STATE
+
+	powerInfoCache   PowerInfo
+	powerInfoQueried time.Time
 }
 
 func (*nativeGamepadDesktop) hasOwnStandardLayoutMapping() bool {
@@ -734,9 +752,48 @@ func (g *nativeGamepadDesktop) update(gamepads *gamepads) (err error) {
 		return nil
 	}
 	g.xinputState = state
+
+	if n := gamepads.native.(*nativeGamepadsDesktop); n.procXInputGetBatteryInformation != 0 && time.Since(g.powerInfoQueried) >= powerInfoInterval {
+		g.powerInfoQueried = time.Now()
+		var info _XINPUT_BATTERY_INFORMATION
+		// Ignore the error, as the battery information is not critical.
+		if err := n.xinputGetBatteryInformation(uint32(g.xinputIndex), _BATTERY_DEVTYPE_GAMEPAD, &info); err == nil {
+			g.powerInfoCache = xinputBatteryInformationToPowerInfo(&info)
+		}
+	}
 	return nil
 }
 
+func xinputBatteryInformationToPowerInfo(info *_XINPUT_BATTERY_INFORMATION) PowerInfo {
+	var p PowerInfo
+	switch info.BatteryType {
+	case _BATTERY_TYPE_WIRED:
+		p.ConnectionType = ConnectionTypeWired
+		p.BatteryState = BatteryStateNoBattery
+		return p
+	case _BATTERY_TYPE_ALKALINE, _BATTERY_TYPE_NIMH:
+		p.ConnectionType = ConnectionTypeWireless
+		p.BatteryState = BatteryStateDischarging
+	default:
+		return p
+	}
+	switch info.BatteryLevel {
+	case _BATTERY_LEVEL_EMPTY:
+		p.BatteryLevel = 0
+	case _BATTERY_LEVEL_LOW:
+		p.BatteryLevel = 0.25
+	case _BATTERY_LEVEL_MEDIUM:
+		p.BatteryLevel = 0.5
+	case _BATTERY_LEVEL_FULL:
+		p.BatteryLevel = 1
+	}
+	return p
+}
+
+func (g *nativeGamepadDesktop) powerInfo() PowerInfo {
+	return g.powerInfoCache
+}
+
 func (g *nativeGamepadDesktop) axisCount() int {
 	if g.usesDInput() {
 		return len(g.dinputAxes)
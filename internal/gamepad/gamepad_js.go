@@ -52,6 +52,11 @@ func (g *nativeGamepadsImpl) update(gamepads *gamepads) error {
 		return nil
 	}
 
+	// getGamepads doesn't exist in Web Workers.
+	if js.Global().Get("WorkerGlobalScope").Truthy() {
+		return nil
+	}
+
 	// getGamepads might not exist under a non-secure context (#2100).
 	if !nav.Get("getGamepads").Truthy() {
 		js.Global().Get("console").Call("warn", "navigator.getGamepads is not available. This might require a secure (HTTPS) context.")
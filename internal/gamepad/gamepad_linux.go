@@ -177,8 +177,11 @@ func (*nativeGamepadsImpl) openGamepad(gamepads *gamepads, path string) (err err
 	}
 
 	n := &nativeGamepadImpl{
-		path: path,
-		fd:   fd,
+		path:    path,
+		fd:      fd,
+		bustype: id.bustype,
+		vendor:  id.vendor,
+		product: id.product,
 	}
 	gp := gamepads.add(name, sdlID)
 	gp.native = n
@@ -289,10 +292,16 @@ func (g *nativeGamepadsImpl) update(gamepads *gamepads) error {
 type nativeGamepadImpl struct {
 	fd      int
 	path    string
-	keyMap  [_KEY_CNT - _BTN_MISC]int
-	absMap  [_ABS_CNT]int
-	absInfo [_ABS_CNT]input_absinfo
-	dropped bool
+	bustype uint16
+	vendor  uint16
+	product uint16
+
+	// hidrawFD is the file descriptor of the hidraw device to write output reports.
+	hidrawFD int
+	keyMap   [_KEY_CNT - _BTN_MISC]int
+	absMap   [_ABS_CNT]int
+	absInfo  [_ABS_CNT]input_absinfo
+	dropped  bool
 
 	axes    [_ABS_CNT]float64
 	buttons [_KEY_CNT - _BTN_MISC]bool
@@ -304,6 +313,9 @@ type nativeGamepadImpl struct {
 
 	stdAxisMap   map[gamepaddb.StandardAxis]mappingInput
 	stdButtonMap map[gamepaddb.StandardButton]mappingInput
+
+	powerInfoCache   PowerInfo
+	powerInfoQueried time.Time
 }
 
 func (g *nativeGamepadImpl) close() {
@@ -311,6 +323,10 @@ func (g *nativeGamepadImpl) close() {
 		_ = unix.Close(g.fd)
 	}
 	g.fd = 0
+	if g.hidrawFD != 0 {
+		_ = unix.Close(g.hidrawFD)
+	}
+	g.hidrawFD = 0
 }
 
 func (g *nativeGamepadImpl) update(gamepad *gamepads) error {
@@ -0,0 +1,59 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gamepad
+
+import (
+	"time"
+)
+
+type ConnectionType int
+
+const (
+	ConnectionTypeUnknown ConnectionType = iota
+	ConnectionTypeWired
+	ConnectionTypeWireless
+)
+
+type BatteryState int
+
+const (
+	BatteryStateUnknown BatteryState = iota
+	BatteryStateNoBattery
+	BatteryStateDischarging
+	BatteryStateCharging
+	BatteryStateFull
+)
+
+type PowerInfo struct {
+	ConnectionType ConnectionType
+	BatteryState   BatteryState
+
+	// BatteryLevel is in between 0 and 1.
+	BatteryLevel float64
+}
+
+// powerInfoInterval is the interval to query the power information, as querying it might be expensive.
+const powerInfoInterval = time.Second
+
+// PowerInfo is concurrent-safe.
+func (g *Gamepad) PowerInfo() PowerInfo {
+	g.m.Lock()
+	defer g.m.Unlock()
+
+	if n, ok := g.native.(interface{ powerInfo() PowerInfo }); ok {
+		return n.powerInfo()
+	}
+	return PowerInfo{}
+}
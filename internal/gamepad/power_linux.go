@@ -0,0 +1,84 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !android && !nintendosdk && !playstation5
+
+package gamepad
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func (g *nativeGamepadImpl) powerInfo() PowerInfo {
+	if !g.powerInfoQueried.IsZero() && time.Since(g.powerInfoQueried) < powerInfoInterval {
+		return g.powerInfoCache
+	}
+	g.powerInfoQueried = time.Now()
+
+	var info PowerInfo
+	switch g.bustype {
+	case _BUS_USB:
+		info.ConnectionType = ConnectionTypeWired
+	case _BUS_BLUETOOTH:
+		info.ConnectionType = ConnectionTypeWireless
+	}
+
+	// A gamepad with a battery has a power supply in sysfs, e.g., /sys/class/input/event0/device/device/power_supply/ps-controller-battery-xx.
+	dir := filepath.Join("/sys/class/input", filepath.Base(g.path), "device", "device", "power_supply")
+	ents, err := os.ReadDir(dir)
+	if err != nil || len(ents) == 0 {
+		if info.ConnectionType == ConnectionTypeWired {
+			info.BatteryState = BatteryStateNoBattery
+		}
+		g.powerInfoCache = info
+		return info
+	}
+
+	supply := filepath.Join(dir, ents[0].Name())
+	if bs, err := os.ReadFile(filepath.Join(supply, "capacity")); err == nil {
+		if v, err := strconv.Atoi(strings.TrimSpace(string(bs))); err == nil {
+			info.BatteryLevel = float64(min(max(v, 0), 100)) / 100
+		}
+	} else if bs, err := os.ReadFile(filepath.Join(supply, "capacity_level")); err == nil {
+		switch strings.TrimSpace(string(bs)) {
+		case "Critical":
+			info.BatteryLevel = 0.05
+		case "Low":
+			info.BatteryLevel = 0.25
+		case "Normal":
+			info.BatteryLevel = 0.5
+		case "High":
+			info.BatteryLevel = 0.75
+		case "Full":
+			info.BatteryLevel = 1
+		}
+	}
+	if bs, err := os.ReadFile(filepath.Join(supply, "status")); err == nil {
+		switch strings.TrimSpace(string(bs)) {
+		case "Charging":
+			info.BatteryState = BatteryStateCharging
+		case "Discharging", "Not charging":
+			info.BatteryState = BatteryStateDischarging
+		case "Full":
+			info.BatteryState = BatteryStateFull
+		}
+	}
+
+	g.powerInfoCache = info
+	return info
+}
@@ -74,7 +74,16 @@ const (
 	_GET_MODULE_HANDLE_EX_FLAG_UNCHANGED_REFCOUNT              = 0x00000002
 	_GWL_EXSTYLE                                               = -20
 	_GWL_STYLE                                                 = -16
+	_HTBOTTOM                                                  = 15
+	_HTBOTTOMLEFT                                              = 16
+	_HTBOTTOMRIGHT                                             = 17
+	_HTCAPTION                                                 = 2
 	_HTCLIENT                                                  = 1
+	_HTLEFT                                                    = 10
+	_HTRIGHT                                                   = 11
+	_HTTOP                                                     = 12
+	_HTTOPLEFT                                                 = 13
+	_HTTOPRIGHT                                                = 14
 	_HORZSIZE                                                  = 4
 	_HWND_NOTOPMOST                               windows.HWND = (1 << intSize) - 2
 	_HWND_TOP                                     windows.HWND = 0
@@ -309,6 +318,7 @@ const (
 	_WM_MOUSEWHEEL                                             = 0x020A
 	_WM_MOVE                                                   = 0x0003
 	_WM_NCCREATE                                               = 0x0081
+	_WM_NCHITTEST                                              = 0x0084
 	_WM_PAINT                                                  = 0x000f
 	_WM_QUIT                                                   = 0x0012
 	_WM_RBUTTONDOWN                                            = 0x0204
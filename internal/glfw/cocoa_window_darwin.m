@@ -237,6 +237,16 @@ static const NSRange kEmptyRange = { NSNotFound, 0 };
     }
 }
 
+- (void)windowWillStartLiveResize:(NSNotification *)notification
+{
+    _glfwInputWindowLiveResize(window, GLFW_TRUE);
+}
+
+- (void)windowDidEndLiveResize:(NSNotification *)notification
+{
+    _glfwInputWindowLiveResize(window, GLFW_FALSE);
+}
+
 - (void)windowDidMove:(NSNotification *)notification
 {
     if (window->context.source == GLFW_NATIVE_CONTEXT_API)
@@ -579,14 +589,14 @@ static const NSRange kEmptyRange = { NSNotFound, 0 };
     double deltaX = [event scrollingDeltaX];
     double deltaY = [event scrollingDeltaY];
 
-    if ([event hasPreciseScrollingDeltas])
-    {
-        deltaX *= 0.1;
-        deltaY *= 0.1;
-    }
+    int phase = GLFW_SCROLL_PHASE_NONE;
+    if ([event momentumPhase] != NSEventPhaseNone)
+        phase = GLFW_SCROLL_PHASE_MOMENTUM;
+    else if ([event phase] != NSEventPhaseNone)
+        phase = GLFW_SCROLL_PHASE_SCROLLING;
 
-    if (fabs(deltaX) > 0.0 || fabs(deltaY) > 0.0)
-        _glfwInputScroll(window, deltaX, deltaY);
+    if (fabs(deltaX) > 0.0 || fabs(deltaY) > 0.0 || phase != GLFW_SCROLL_PHASE_NONE)
+        _glfwInputPreciseScroll(window, deltaX, deltaY, [event hasPreciseScrollingDeltas], phase);
 }
 
 - (NSDragOperation)draggingEntered:(id <NSDraggingInfo>)sender
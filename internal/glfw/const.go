@@ -24,11 +24,13 @@ type (
 	Action          int
 	ErrorCode       int
 	Hint            int
+	HitTest         int
 	InputMode       int
 	Key             int
 	ModifierKey     int
 	MouseButton     int
 	PeripheralEvent int
+	ScrollPhase     int
 	StandardCursor  int
 )
 
@@ -143,6 +145,28 @@ const (
 	ReleaseBehaviorNone  = 0x00035002
 )
 
+// HitTest represents a result of the hit test of a window.
+// HitTestDefault means that the default hit test is used.
+const (
+	HitTestDefault     = HitTest(0)
+	HitTestClient      = HitTest(1)
+	HitTestCaption     = HitTest(2)
+	HitTestLeft        = HitTest(3)
+	HitTestRight       = HitTest(4)
+	HitTestTop         = HitTest(5)
+	HitTestBottom      = HitTest(6)
+	HitTestTopLeft     = HitTest(7)
+	HitTestTopRight    = HitTest(8)
+	HitTestBottomLeft  = HitTest(9)
+	HitTestBottomRight = HitTest(10)
+)
+
+const (
+	ScrollPhaseNone      = ScrollPhase(0)
+	ScrollPhaseScrolling = ScrollPhase(1)
+	ScrollPhaseMomentum  = ScrollPhase(2)
+)
+
 const (
 	NotInitialized     = ErrorCode(0x00010001)
 	NoCurrentContext   = ErrorCode(0x00010002)
@@ -925,6 +925,10 @@ extern "C" {
 #define GLFW_CURSOR_HIDDEN          0x00034002
 #define GLFW_CURSOR_DISABLED        0x00034003
 
+#define GLFW_SCROLL_PHASE_NONE      0
+#define GLFW_SCROLL_PHASE_SCROLLING 1
+#define GLFW_SCROLL_PHASE_MOMENTUM  2
+
 #define GLFW_ANY_RELEASE_BEHAVIOR            0
 #define GLFW_RELEASE_BEHAVIOR_FLUSH 0x00035001
 #define GLFW_RELEASE_BEHAVIOR_NONE  0x00035002
@@ -1160,6 +1164,24 @@ typedef void (* GLFWwindowclosefun)(GLFWwindow* window);
  */
 typedef void (* GLFWwindowrefreshfun)(GLFWwindow* window);
 
+/*! @brief The function pointer type for window live resize callbacks.
+ *
+ *  This is an extension for Ebitengine.  A window live resize callback
+ *  function has the following signature:
+ *  @code
+ *  void function_name(GLFWwindow* window, int resizing)
+ *  @endcode
+ *
+ *  @param[in] window The window that is resized.
+ *  @param[in] resizing `GLFW_TRUE` if the user started resizing the window
+ *  interactively, or `GLFW_FALSE` if the user finished it.
+ *
+ *  @sa @ref glfwSetWindowLiveResizeCallback
+ *
+ *  @ingroup window
+ */
+typedef void (* GLFWwindowliveresizefun)(GLFWwindow* window, int resizing);
+
 /*! @brief The function pointer type for window focus callbacks.
  *
  *  This is the function pointer type for window focus callbacks.  A window
@@ -1356,6 +1378,28 @@ typedef void (* GLFWcursorenterfun)(GLFWwindow* window, int entered);
  */
 typedef void (* GLFWscrollfun)(GLFWwindow* window, double xoffset, double yoffset);
 
+/*! @brief The function pointer type for precise scroll callbacks.
+ *
+ *  This is an extension for Ebitengine.  A precise scroll callback function
+ *  has the following signature:
+ *  @code
+ *  void function_name(GLFWwindow* window, double xoffset, double yoffset, int precise, int phase)
+ *  @endcode
+ *
+ *  @param[in] window The window that received the event.
+ *  @param[in] xoffset The scroll offset along the x-axis.
+ *  @param[in] yoffset The scroll offset along the y-axis.
+ *  @param[in] precise `GLFW_TRUE` if the offsets are in points, or
+ *  `GLFW_FALSE` if the offsets are in lines.
+ *  @param[in] phase The scroll phase, one of `GLFW_SCROLL_PHASE_NONE`,
+ *  `GLFW_SCROLL_PHASE_SCROLLING` or `GLFW_SCROLL_PHASE_MOMENTUM`.
+ *
+ *  @sa @ref glfwSetPreciseScrollCallback
+ *
+ *  @ingroup input
+ */
+typedef void (* GLFWprecisescrollfun)(GLFWwindow* window, double xoffset, double yoffset, int precise, int phase);
+
 /*! @brief The function pointer type for keyboard key callbacks.
  *
  *  This is the function pointer type for keyboard key callbacks.  A keyboard
@@ -3570,6 +3614,29 @@ GLFWAPI GLFWwindowclosefun glfwSetWindowCloseCallback(GLFWwindow* window, GLFWwi
  */
 GLFWAPI GLFWwindowrefreshfun glfwSetWindowRefreshCallback(GLFWwindow* window, GLFWwindowrefreshfun callback);
 
+/*! @brief Sets the live resize callback for the specified window.
+ *
+ *  This is an extension for Ebitengine.  This function sets the live resize
+ *  callback of the specified window, which is called when the user starts or
+ *  finishes resizing the window interactively, e.g. by dragging its border.
+ *
+ *  This callback is called only on macOS.  On X11, there is no way to
+ *  detect interactive resizing.
+ *
+ *  @param[in] window The window whose callback to set.
+ *  @param[in] callback The new callback, or `NULL` to remove the currently set
+ *  callback.
+ *  @return The previously set callback, or `NULL` if no callback was set or the
+ *  library had not been [initialized](@ref intro_init).
+ *
+ *  @errors Possible errors include @ref GLFW_NOT_INITIALIZED.
+ *
+ *  @thread_safety This function must only be called from the main thread.
+ *
+ *  @ingroup window
+ */
+GLFWAPI GLFWwindowliveresizefun glfwSetWindowLiveResizeCallback(GLFWwindow* window, GLFWwindowliveresizefun callback);
+
 /*! @brief Sets the focus callback for the specified window.
  *
  *  This function sets the focus callback of the specified window, which is
@@ -4621,6 +4688,27 @@ GLFWAPI GLFWcursorenterfun glfwSetCursorEnterCallback(GLFWwindow* window, GLFWcu
  */
 GLFWAPI GLFWscrollfun glfwSetScrollCallback(GLFWwindow* window, GLFWscrollfun callback);
 
+/*! @brief Sets the precise scroll callback.
+ *
+ *  This is an extension for Ebitengine.  This function sets the precise
+ *  scroll callback of the specified window, which is called with the scroll
+ *  offsets before they are scaled for the scroll callback, and with the scroll
+ *  phase.
+ *
+ *  @param[in] window The window whose callback to set.
+ *  @param[in] callback The new precise scroll callback, or `NULL` to remove
+ *  the currently set callback.
+ *  @return The previously set callback, or `NULL` if no callback was set or the
+ *  library had not been [initialized](@ref intro_init).
+ *
+ *  @errors Possible errors include @ref GLFW_NOT_INITIALIZED.
+ *
+ *  @thread_safety This function must only be called from the main thread.
+ *
+ *  @ingroup input
+ */
+GLFWAPI GLFWprecisescrollfun glfwSetPreciseScrollCallback(GLFWwindow* window, GLFWprecisescrollfun callback);
+
 /*! @brief Sets the path drop callback.
  *
  *  This function sets the path drop callback of the specified window, which is
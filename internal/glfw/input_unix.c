@@ -76,6 +76,26 @@ void _glfwInputChar(_GLFWwindow* window, uint32_t codepoint, int mods, GLFWbool
 //
 void _glfwInputScroll(_GLFWwindow* window, double xoffset, double yoffset)
 {
+    _glfwInputPreciseScroll(window, xoffset, yoffset, GLFW_FALSE, GLFW_SCROLL_PHASE_NONE);
+}
+
+// Notifies shared code of a scroll event with the scroll phase
+//
+void _glfwInputPreciseScroll(_GLFWwindow* window, double xoffset, double yoffset, GLFWbool precise, int phase)
+{
+    if (window->callbacks.preciseScroll)
+        window->callbacks.preciseScroll((GLFWwindow*) window, xoffset, yoffset, precise, phase);
+
+    // Precise offsets are in points. Scale them to be close to the offsets in lines.
+    if (precise)
+    {
+        xoffset *= 0.1;
+        yoffset *= 0.1;
+    }
+
+    if (xoffset == 0.0 && yoffset == 0.0)
+        return;
+
     if (window->callbacks.scroll)
         window->callbacks.scroll((GLFWwindow*) window, xoffset, yoffset);
 }
@@ -597,6 +617,17 @@ GLFWAPI GLFWscrollfun glfwSetScrollCallback(GLFWwindow* handle,
     return cbfun;
 }
 
+GLFWAPI GLFWprecisescrollfun glfwSetPreciseScrollCallback(GLFWwindow* handle,
+                                                          GLFWprecisescrollfun cbfun)
+{
+    _GLFWwindow* window = (_GLFWwindow*) handle;
+    assert(window != NULL);
+
+    _GLFW_REQUIRE_INIT_OR_RETURN(NULL);
+    _GLFW_SWAP_POINTERS(window->callbacks.preciseScroll, cbfun);
+    return cbfun;
+}
+
 GLFWAPI GLFWdropfun glfwSetDropCallback(GLFWwindow* handle, GLFWdropfun cbfun)
 {
     _GLFWwindow* window = (_GLFWwindow*) handle;
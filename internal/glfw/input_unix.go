@@ -17,6 +17,7 @@ package glfw
 // void goCursorPosCB(void* window, double xpos, double ypos);
 // void goCursorEnterCB(void* window, int entered);
 // void goScrollCB(void* window, double xoff, double yoff);
+// void goPreciseScrollCB(void* window, double xoff, double yoff, int precise, int phase);
 // void goDropCB(void* window, int count, char** names);
 //
 // static void glfwSetKeyCallbackCB(GLFWwindow *window) {
@@ -47,6 +48,10 @@ package glfw
 //   glfwSetScrollCallback(window, (GLFWscrollfun)goScrollCB);
 // }
 //
+// static void glfwSetPreciseScrollCallbackCB(GLFWwindow *window) {
+//   glfwSetPreciseScrollCallback(window, (GLFWprecisescrollfun)goPreciseScrollCB);
+// }
+//
 // static void glfwSetDropCallbackCB(GLFWwindow *window) {
 //   glfwSetDropCallback(window, (GLFWdropfun)goDropCB);
 // }
@@ -87,6 +92,12 @@ func goScrollCB(window unsafe.Pointer, xoff, yoff C.double) {
 	w.fScrollHolder(w, float64(xoff), float64(yoff))
 }
 
+//export goPreciseScrollCB
+func goPreciseScrollCB(window unsafe.Pointer, xoff, yoff C.double, precise, phase C.int) {
+	w := windows.get((*C.GLFWwindow)(window))
+	w.fPreciseScrollHolder(w, float64(xoff), float64(yoff), precise != 0, ScrollPhase(phase))
+}
+
 //export goKeyCB
 func goKeyCB(window unsafe.Pointer, key, scancode, action, mods C.int) {
 	w := windows.get((*C.GLFWwindow)(window))
@@ -466,6 +477,26 @@ func (w *Window) SetScrollCallback(cbfun ScrollCallback) (previous ScrollCallbac
 	return previous, nil
 }
 
+// PreciseScrollCallback is the scroll callback with the offsets before scaling and the scroll phase.
+// If precise is true, the offsets are in points. Otherwise, the offsets are in lines.
+type PreciseScrollCallback func(w *Window, xoff float64, yoff float64, precise bool, phase ScrollPhase)
+
+// SetPreciseScrollCallback sets the precise scroll callback which is called when a scrolling
+// device is used. This is an extension for Ebitengine.
+func (w *Window) SetPreciseScrollCallback(cbfun PreciseScrollCallback) (previous PreciseScrollCallback, err error) {
+	previous = w.fPreciseScrollHolder
+	w.fPreciseScrollHolder = cbfun
+	if cbfun == nil {
+		C.glfwSetPreciseScrollCallback(w.data, nil)
+	} else {
+		C.glfwSetPreciseScrollCallbackCB(w.data)
+	}
+	if err := fetchErrorIgnoringPlatformError(); err != nil {
+		return nil, err
+	}
+	return previous, nil
+}
+
 // DropCallback is the drop callback.
 type DropCallback func(w *Window, names []string)
 
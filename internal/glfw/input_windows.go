@@ -65,6 +65,9 @@ func (w *Window) inputChar(codepoint rune, mods ModifierKey, plain bool) {
 }
 
 func (w *Window) inputScroll(xoffset, yoffset float64) {
+	if w.callbacks.preciseScroll != nil {
+		w.callbacks.preciseScroll(w, xoffset, yoffset, false, ScrollPhaseNone)
+	}
 	if w.callbacks.scroll != nil {
 		w.callbacks.scroll(w, xoffset, yoffset)
 	}
@@ -481,6 +484,15 @@ func (w *Window) SetScrollCallback(cbfun ScrollCallback) (ScrollCallback, error)
 	return old, nil
 }
 
+func (w *Window) SetPreciseScrollCallback(cbfun PreciseScrollCallback) (PreciseScrollCallback, error) {
+	if !_glfw.initialized {
+		return nil, NotInitialized
+	}
+	old := w.callbacks.preciseScroll
+	w.callbacks.preciseScroll = cbfun
+	return old, nil
+}
+
 func (w *Window) SetDropCallback(cbfun DropCallback) (DropCallback, error) {
 	if !_glfw.initialized {
 		return nil, NotInitialized
@@ -306,6 +306,7 @@ struct _GLFWwindow
         GLFWwindowsizefun         size;
         GLFWwindowclosefun        close;
         GLFWwindowrefreshfun      refresh;
+        GLFWwindowliveresizefun   liveResize;
         GLFWwindowfocusfun        focus;
         GLFWwindowiconifyfun      iconify;
         GLFWwindowmaximizefun     maximize;
@@ -315,6 +316,7 @@ struct _GLFWwindow
         GLFWcursorposfun          cursorPos;
         GLFWcursorenterfun        cursorEnter;
         GLFWscrollfun             scroll;
+        GLFWprecisescrollfun      preciseScroll;
         GLFWkeyfun                key;
         GLFWcharfun               character;
         GLFWcharmodsfun           charmods;
@@ -537,6 +539,7 @@ void _glfwInputWindowContentScale(_GLFWwindow* window,
 void _glfwInputWindowIconify(_GLFWwindow* window, GLFWbool iconified);
 void _glfwInputWindowMaximize(_GLFWwindow* window, GLFWbool maximized);
 void _glfwInputWindowDamage(_GLFWwindow* window);
+void _glfwInputWindowLiveResize(_GLFWwindow* window, GLFWbool resizing);
 void _glfwInputWindowCloseRequest(_GLFWwindow* window);
 void _glfwInputWindowMonitor(_GLFWwindow* window, _GLFWmonitor* monitor);
 
@@ -545,6 +548,7 @@ void _glfwInputKey(_GLFWwindow* window,
 void _glfwInputChar(_GLFWwindow* window,
                     uint32_t codepoint, int mods, GLFWbool plain);
 void _glfwInputScroll(_GLFWwindow* window, double xoffset, double yoffset);
+void _glfwInputPreciseScroll(_GLFWwindow* window, double xoffset, double yoffset, GLFWbool precise, int phase);
 void _glfwInputMouseClick(_GLFWwindow* window, int button, int action, int mods);
 void _glfwInputCursorPos(_GLFWwindow* window, double xpos, double ypos);
 void _glfwInputCursorEnter(_GLFWwindow* window, GLFWbool entered);
@@ -100,6 +100,8 @@ type (
 	SizeCallback            func(w *Window, width int, height int)
 	CloseCallback           func(w *Window)
 	RefreshCallback         func(w *Window)
+	LiveResizeCallback      func(w *Window, resizing bool)
+	HitTestCallback         func(w *Window, xpos float64, ypos float64) HitTest
 	FocusCallback           func(w *Window, focused bool)
 	IconifyCallback         func(w *Window, iconified bool)
 	MaximizeCallback        func(w *Window, iconified bool)
@@ -109,6 +111,7 @@ type (
 	CursorPosCallback       func(w *Window, xpos float64, ypos float64)
 	CursorEnterCallback     func(w *Window, entered bool)
 	ScrollCallback          func(w *Window, xoff float64, yoff float64)
+	PreciseScrollCallback   func(w *Window, xoff float64, yoff float64, precise bool, phase ScrollPhase)
 	KeyCallback             func(w *Window, key Key, scancode int, action Action, mods ModifierKey)
 	CharCallback            func(w *Window, char rune)
 	CharModsCallback        func(w *Window, char rune, mods ModifierKey)
@@ -151,23 +154,26 @@ type Window struct {
 	context context
 
 	callbacks struct {
-		pos         PosCallback
-		size        SizeCallback
-		close       CloseCallback
-		refresh     RefreshCallback
-		focus       FocusCallback
-		iconify     IconifyCallback
-		maximize    MaximizeCallback
-		fbsize      FramebufferSizeCallback
-		scale       ContentScaleCallback
-		mouseButton MouseButtonCallback
-		cursorPos   CursorPosCallback
-		cursorEnter CursorEnterCallback
-		scroll      ScrollCallback
-		key         KeyCallback
-		character   CharCallback
-		charmods    CharModsCallback
-		drop        DropCallback
+		pos           PosCallback
+		size          SizeCallback
+		close         CloseCallback
+		refresh       RefreshCallback
+		liveResize    LiveResizeCallback
+		hitTest       HitTestCallback
+		focus         FocusCallback
+		iconify       IconifyCallback
+		maximize      MaximizeCallback
+		fbsize        FramebufferSizeCallback
+		scale         ContentScaleCallback
+		mouseButton   MouseButtonCallback
+		cursorPos     CursorPosCallback
+		cursorEnter   CursorEnterCallback
+		scroll        ScrollCallback
+		preciseScroll PreciseScrollCallback
+		key           KeyCallback
+		character     CharCallback
+		charmods      CharModsCallback
+		drop          DropCallback
 	}
 
 	platform platformWindowState
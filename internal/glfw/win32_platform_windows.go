@@ -24,6 +24,7 @@ type platformWindowState struct {
 	maximized      bool
 	transparent    bool // Whether to enable framebuffer transparency on DWM
 	scaleToMonitor bool
+	liveResizing   bool
 
 	// Cached size used to filter out duplicate events
 	width  int
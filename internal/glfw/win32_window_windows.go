@@ -990,6 +990,11 @@ func windowProc(hWnd windows.HWND, uMsg uint32, wParam _WPARAM, lParam _LPARAM)
 		}
 
 	case _WM_EXITSIZEMOVE, _WM_EXITMENULOOP:
+		if window.platform.liveResizing {
+			window.platform.liveResizing = false
+			window.inputWindowLiveResize(false)
+		}
+
 		if window.platform.frameAction {
 			break
 		}
@@ -1068,6 +1073,12 @@ func windowProc(hWnd windows.HWND, uMsg uint32, wParam _WPARAM, lParam _LPARAM)
 		return 0
 
 	case _WM_SIZING:
+		// WM_ENTERSIZEMOVE is sent both for moving and resizing. Detect resizing by the first WM_SIZING.
+		if !window.platform.liveResizing {
+			window.platform.liveResizing = true
+			window.inputWindowLiveResize(true)
+		}
+
 		if window.numer == DontCare || window.denom == DontCare {
 			break
 		}
@@ -1128,6 +1139,46 @@ func windowProc(hWnd windows.HWND, uMsg uint32, wParam _WPARAM, lParam _LPARAM)
 	case _WM_ERASEBKGND:
 		return 1
 
+	case _WM_NCHITTEST:
+		if window.callbacks.hitTest == nil {
+			break
+		}
+		// lParam is the cursor position in the screen coordinate.
+		p := _POINT{
+			x: int32(int16(_LOWORD(uint32(lParam)))),
+			y: int32(int16(_HIWORD(uint32(lParam)))),
+		}
+		if err := _ScreenToClient(window.platform.handle, &p); err != nil {
+			_glfw.errors = append(_glfw.errors, err)
+			return 0
+		}
+		var ht uintptr
+		switch window.callbacks.hitTest(window, float64(p.x), float64(p.y)) {
+		case HitTestClient:
+			ht = _HTCLIENT
+		case HitTestCaption:
+			ht = _HTCAPTION
+		case HitTestLeft:
+			ht = _HTLEFT
+		case HitTestRight:
+			ht = _HTRIGHT
+		case HitTestTop:
+			ht = _HTTOP
+		case HitTestBottom:
+			ht = _HTBOTTOM
+		case HitTestTopLeft:
+			ht = _HTTOPLEFT
+		case HitTestTopRight:
+			ht = _HTTOPRIGHT
+		case HitTestBottomLeft:
+			ht = _HTBOTTOMLEFT
+		case HitTestBottomRight:
+			ht = _HTBOTTOMRIGHT
+		}
+		if ht != 0 {
+			return ht
+		}
+
 	case _WM_NCACTIVATE, _WM_NCPAINT:
 		// Prevent title bar from being drawn after restoring a minimized
 		// undecorated window
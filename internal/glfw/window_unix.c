@@ -106,6 +106,14 @@ void _glfwInputWindowDamage(_GLFWwindow* window)
         window->callbacks.refresh((GLFWwindow*) window);
 }
 
+// Notifies shared code that the user started or finished resizing the window
+//
+void _glfwInputWindowLiveResize(_GLFWwindow* window, GLFWbool resizing)
+{
+    if (window->callbacks.liveResize)
+        window->callbacks.liveResize((GLFWwindow*) window, resizing);
+}
+
 // Notifies shared code that the user wishes to close a window
 //
 void _glfwInputWindowCloseRequest(_GLFWwindow* window)
@@ -999,6 +1007,17 @@ GLFWAPI GLFWwindowrefreshfun glfwSetWindowRefreshCallback(GLFWwindow* handle,
     return cbfun;
 }
 
+GLFWAPI GLFWwindowliveresizefun glfwSetWindowLiveResizeCallback(GLFWwindow* handle,
+                                                                GLFWwindowliveresizefun cbfun)
+{
+    _GLFWwindow* window = (_GLFWwindow*) handle;
+    assert(window != NULL);
+
+    _GLFW_REQUIRE_INIT_OR_RETURN(NULL);
+    _GLFW_SWAP_POINTERS(window->callbacks.liveResize, cbfun);
+    return cbfun;
+}
+
 GLFWAPI GLFWwindowfocusfun glfwSetWindowFocusCallback(GLFWwindow* handle,
                                                       GLFWwindowfocusfun cbfun)
 {
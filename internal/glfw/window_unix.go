@@ -36,6 +36,10 @@ package glfw
 //   glfwSetWindowRefreshCallback(window, (GLFWwindowrefreshfun)goWindowRefreshCB);
 // }
 //
+// static void glfwSetWindowLiveResizeCallbackCB(GLFWwindow *window) {
+//   glfwSetWindowLiveResizeCallback(window, (GLFWwindowliveresizefun)goWindowLiveResizeCB);
+// }
+//
 // static void glfwSetWindowFocusCallbackCB(GLFWwindow *window) {
 //   glfwSetWindowFocusCallback(window, (GLFWwindowfocusfun)goWindowFocusCB);
 // }
@@ -102,18 +106,20 @@ type Window struct {
 	fMaximizeHolder        func(w *Window, maximized bool)
 	fContentScaleHolder    func(w *Window, x float32, y float32)
 	fRefreshHolder         func(w *Window)
+	fLiveResizeHolder      func(w *Window, resizing bool)
 	fFocusHolder           func(w *Window, focused bool)
 	fIconifyHolder         func(w *Window, iconified bool)
 
 	// Input.
-	fMouseButtonHolder func(w *Window, button MouseButton, action Action, mod ModifierKey)
-	fCursorPosHolder   func(w *Window, xpos float64, ypos float64)
-	fCursorEnterHolder func(w *Window, entered bool)
-	fScrollHolder      func(w *Window, xoff float64, yoff float64)
-	fKeyHolder         func(w *Window, key Key, scancode int, action Action, mods ModifierKey)
-	fCharHolder        func(w *Window, char rune)
-	fCharModsHolder    func(w *Window, char rune, mods ModifierKey)
-	fDropHolder        func(w *Window, names []string)
+	fMouseButtonHolder   func(w *Window, button MouseButton, action Action, mod ModifierKey)
+	fCursorPosHolder     func(w *Window, xpos float64, ypos float64)
+	fCursorEnterHolder   func(w *Window, entered bool)
+	fScrollHolder        func(w *Window, xoff float64, yoff float64)
+	fPreciseScrollHolder func(w *Window, xoff float64, yoff float64, precise bool, phase ScrollPhase)
+	fKeyHolder           func(w *Window, key Key, scancode int, action Action, mods ModifierKey)
+	fCharHolder          func(w *Window, char rune)
+	fCharModsHolder      func(w *Window, char rune, mods ModifierKey)
+	fDropHolder          func(w *Window, names []string)
 }
 
 // Handle returns a *C.GLFWwindow reference (i.e. the GLFW window itself).
@@ -165,6 +171,12 @@ func goWindowRefreshCB(window unsafe.Pointer) {
 	w.fRefreshHolder(w)
 }
 
+//export goWindowLiveResizeCB
+func goWindowLiveResizeCB(window unsafe.Pointer, resizing C.int) {
+	w := windows.get((*C.GLFWwindow)(window))
+	w.fLiveResizeHolder(w, resizing != 0)
+}
+
 //export goWindowFocusCB
 func goWindowFocusCB(window unsafe.Pointer, focused C.int) {
 	w := windows.get((*C.GLFWwindow)(window))
@@ -860,6 +872,27 @@ func (w *Window) SetRefreshCallback(cbfun RefreshCallback) (previous RefreshCall
 	return previous, nil
 }
 
+// LiveResizeCallback is the window live resize callback.
+type LiveResizeCallback func(w *Window, resizing bool)
+
+// SetLiveResizeCallback sets the live resize callback of the window, which
+// is called when the user starts or finishes resizing the window interactively.
+//
+// This is an extension for Ebitengine, and works only on macOS so far.
+func (w *Window) SetLiveResizeCallback(cbfun LiveResizeCallback) (previous LiveResizeCallback, err error) {
+	previous = w.fLiveResizeHolder
+	w.fLiveResizeHolder = cbfun
+	if cbfun == nil {
+		C.glfwSetWindowLiveResizeCallback(w.data, nil)
+	} else {
+		C.glfwSetWindowLiveResizeCallbackCB(w.data)
+	}
+	if err := fetchErrorIgnoringPlatformError(); err != nil {
+		return nil, err
+	}
+	return previous, nil
+}
+
 // FocusCallback is the window focus callback.
 type FocusCallback func(w *Window, focused bool)
 
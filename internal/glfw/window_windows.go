@@ -76,6 +76,12 @@ func (w *Window) inputWindowDamage() {
 	}
 }
 
+func (w *Window) inputWindowLiveResize(resizing bool) {
+	if w.callbacks.liveResize != nil {
+		w.callbacks.liveResize(w, resizing)
+	}
+}
+
 func (w *Window) inputWindowCloseRequest() {
 	w.shouldClose = true
 
@@ -795,6 +801,27 @@ func (w *Window) SetRefreshCallback(cbfun RefreshCallback) (RefreshCallback, err
 	return old, nil
 }
 
+func (w *Window) SetLiveResizeCallback(cbfun LiveResizeCallback) (LiveResizeCallback, error) {
+	if !_glfw.initialized {
+		return nil, NotInitialized
+	}
+	old := w.callbacks.liveResize
+	w.callbacks.liveResize = cbfun
+	return old, nil
+}
+
+// SetHitTestCallback sets the hit test callback of the window.
+// The callback is called with the position in the content area, and returns the hit test result at the position.
+// SetHitTestCallback is available only on Windows.
+func (w *Window) SetHitTestCallback(cbfun HitTestCallback) (HitTestCallback, error) {
+	if !_glfw.initialized {
+		return nil, NotInitialized
+	}
+	old := w.callbacks.hitTest
+	w.callbacks.hitTest = cbfun
+	return old, nil
+}
+
 func (w *Window) SetFocusCallback(cbfun FocusCallback) (FocusCallback, error) {
 	if !_glfw.initialized {
 		return nil, NotInitialized
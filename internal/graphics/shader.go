@@ -22,7 +22,30 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/internal/shaderir"
 )
 
-func shaderSuffix(unit shaderir.Unit) (string, error) {
+const (
+	ShaderSamplerFilterNearest = 0
+	ShaderSamplerFilterLinear  = 1
+)
+
+const (
+	ShaderSamplerAddressClampToZero = 0
+	ShaderSamplerAddressRepeat      = 1
+)
+
+// ShaderSampler represents how imageSrcNAt samples a source image.
+//
+// The zero value is the default sampler, which samples the image with the nearest filter and returns 0 out of the image.
+type ShaderSampler struct {
+	Filter  int
+	Address int
+}
+
+// shaderSuffix returns the shader source to be appended to a Kage program.
+//
+// samplers specifies how imageSrcNAt samples the source images.
+// The sampler path is generated only for non-default samplers, so that imageSrcNAt with the default sampler doesn't have extra costs.
+// samplers can be nil, which means that all the samplers are the default.
+func shaderSuffix(unit shaderir.Unit, samplers *[ShaderSrcImageCount]ShaderSampler) (string, error) {
 	shaderSuffix := fmt.Sprintf(`
 var __imageDstTextureSize vec2
 
@@ -127,27 +150,74 @@ func imageSrc%[1]dUnsafeAt(pos vec2) vec4 {
 	return __texelAt(__t%[1]d, %[2]s)
 }
 `, i, pos)
+		var size, texel string
 		switch unit {
 		case shaderir.Pixels:
+			size = fmt.Sprintf("__imageSrcRegionSizes[%d]", i)
+			texel = "vec2(1)"
+		case shaderir.Texels:
+			// With the texel mode, all the source region sizes are the same (#1870).
+			// As pos is in texels of the 0th texture, always use the 0th image region size.
+			size = "__imageSrcRegionSizes[0]"
+			texel = "1 / __imageSrcTextureSizes[0]"
+		}
+
+		var sampler ShaderSampler
+		if samplers != nil {
+			sampler = samplers[i]
+		}
+		if sampler == (ShaderSampler{}) {
 			shaderSuffix += fmt.Sprintf(`
 func imageSrc%[1]dAt(pos vec2) vec4 {
 	// pos is the position of the source texture (= 0th image's texture).
 	// If pos is in the region, the result is (1, 1). Otherwise, either element is 0.
-	in := step(__imageSrcRegionOrigins[0], pos) - step(__imageSrcRegionOrigins[0] + __imageSrcRegionSizes[%[1]d], pos)
+	in := step(__imageSrcRegionOrigins[0], pos) - step(__imageSrcRegionOrigins[0] + %[3]s, pos)
 	return __texelAt(__t%[1]d, %[2]s) * in.x * in.y
 }
-`, i, pos)
-		case shaderir.Texels:
-			shaderSuffix += fmt.Sprintf(`
-func imageSrc%[1]dAt(pos vec2) vec4 {
+`, i, pos, size)
+			continue
+		}
+
+		var wrap string
+		if sampler.Address == ShaderSamplerAddressRepeat {
+			wrap = "pos = mod(pos - origin, size) + origin"
+		}
+		shaderSuffix += fmt.Sprintf(`
+func __imageSrc%[1]dNearestAt(pos vec2) vec4 {
 	// pos is the position of the source texture (= 0th image's texture).
+	origin := __imageSrcRegionOrigins[0]
+	size := %[3]s
+	%[4]s
 	// If pos is in the region, the result is (1, 1). Otherwise, either element is 0.
-	// With the texel mode, all the source region sizes are the same (#1870).
-	// As pos is in texels of the 0th texture, always use the 0th image region size.
-	in := step(__imageSrcRegionOrigins[0], pos) - step(__imageSrcRegionOrigins[0] + __imageSrcRegionSizes[0], pos)
+	in := step(origin, pos) - step(origin + size, pos)
 	return __texelAt(__t%[1]d, %[2]s) * in.x * in.y
 }
-`, i, pos)
+`, i, pos, size, wrap)
+
+		switch sampler.Filter {
+		case ShaderSamplerFilterNearest:
+			shaderSuffix += fmt.Sprintf(`
+func imageSrc%[1]dAt(pos vec2) vec4 {
+	return __imageSrc%[1]dNearestAt(pos)
+}
+`, i)
+		case ShaderSamplerFilterLinear:
+			shaderSuffix += fmt.Sprintf(`
+func imageSrc%[1]dAt(pos vec2) vec4 {
+	// pos is the position of the source texture (= 0th image's texture).
+	texel := %[2]s
+	p0 := pos - texel/2
+	p1 := pos + texel/2
+	c0 := __imageSrc%[1]dNearestAt(p0)
+	c1 := __imageSrc%[1]dNearestAt(vec2(p1.x, p0.y))
+	c2 := __imageSrc%[1]dNearestAt(vec2(p0.x, p1.y))
+	c3 := __imageSrc%[1]dNearestAt(p1)
+	rate := fract(p1 / texel)
+	return mix(mix(c0, c1, rate.x), mix(c2, c3, rate.x), rate.y)
+}
+`, i, texel)
+		default:
+			return "", fmt.Errorf("graphics: unexpected sampler filter: %d", sampler.Filter)
 		}
 	}
 
@@ -161,12 +231,12 @@ func __vertex(dstPos vec2, srcPos vec2, color vec4, custom vec4) (vec4, vec2, ve
 	return shaderSuffix, nil
 }
 
-func completeShaderSource(fragmentSrc []byte) ([]byte, error) {
+func completeShaderSource(fragmentSrc []byte, samplers *[ShaderSrcImageCount]ShaderSampler) ([]byte, error) {
 	unit, err := shader.ParseCompilerDirectives(fragmentSrc)
 	if err != nil {
 		return nil, err
 	}
-	suffix, err := shaderSuffix(unit)
+	suffix, err := shaderSuffix(unit, samplers)
 	if err != nil {
 		return nil, err
 	}
@@ -179,7 +249,18 @@ func completeShaderSource(fragmentSrc []byte) ([]byte, error) {
 }
 
 func CompileShader(fragmentSrc []byte) (*shaderir.Program, error) {
-	src, err := completeShaderSource(fragmentSrc)
+	return compileShader(fragmentSrc, nil)
+}
+
+// CompileShaderWithSamplers compiles the shader with the given samplers for imageSrcNAt.
+//
+// The uniform variables of the result are the same as CompileShader's.
+func CompileShaderWithSamplers(fragmentSrc []byte, samplers *[ShaderSrcImageCount]ShaderSampler) (*shaderir.Program, error) {
+	return compileShader(fragmentSrc, samplers)
+}
+
+func compileShader(fragmentSrc []byte, samplers *[ShaderSrcImageCount]ShaderSampler) (*shaderir.Program, error) {
+	src, err := completeShaderSource(fragmentSrc, samplers)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +285,7 @@ func CompileShader(fragmentSrc []byte) (*shaderir.Program, error) {
 }
 
 func CalcSourceHash(fragmentSrc []byte) (shaderir.SourceHash, error) {
-	src, err := completeShaderSource(fragmentSrc)
+	src, err := completeShaderSource(fragmentSrc, nil)
 	if err != nil {
 		return shaderir.SourceHash{}, err
 	}
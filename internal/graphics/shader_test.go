@@ -0,0 +1,95 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphics_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/graphics"
+	"github.com/hajimehoshi/ebiten/v2/internal/shaderir"
+)
+
+func TestCompileShaderWithSamplers(t *testing.T) {
+	for _, unit := range []string{"pixels", "texels"} {
+		src := []byte(fmt.Sprintf(`//kage:unit %s
+
+package main
+
+var Scale float
+
+func Fragment(dstPos vec4, srcPos vec2, color vec4) vec4 {
+	return imageSrc0At(srcPos) * Scale + imageSrc1At(srcPos)
+}
+`, unit))
+
+		base, err := graphics.CompileShader(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testCases := []struct {
+			Name     string
+			Samplers [graphics.ShaderSrcImageCount]graphics.ShaderSampler
+		}{
+			{
+				Name: "default",
+			},
+			{
+				Name: "linear",
+				Samplers: [graphics.ShaderSrcImageCount]graphics.ShaderSampler{
+					{Filter: graphics.ShaderSamplerFilterLinear},
+				},
+			},
+			{
+				Name: "repeat",
+				Samplers: [graphics.ShaderSrcImageCount]graphics.ShaderSampler{
+					{},
+					{Address: graphics.ShaderSamplerAddressRepeat},
+				},
+			},
+			{
+				Name: "linear and repeat",
+				Samplers: [graphics.ShaderSrcImageCount]graphics.ShaderSampler{
+					{Filter: graphics.ShaderSamplerFilterLinear, Address: graphics.ShaderSamplerAddressRepeat},
+					{Filter: graphics.ShaderSamplerFilterLinear, Address: graphics.ShaderSamplerAddressRepeat},
+				},
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(fmt.Sprintf("%s/%s", unit, tc.Name), func(t *testing.T) {
+				ir, err := graphics.CompileShaderWithSamplers(src, &tc.Samplers)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				// The uniform variables must be the same as the shader without samplers.
+				if got, want := ir.UniformNames, base.UniformNames; !slices.Equal(got, want) {
+					t.Errorf("uniform names: got: %v, want: %v", got, want)
+				}
+				if got, want := ir.Uniforms, base.Uniforms; !slices.EqualFunc(got, want, func(a, b shaderir.Type) bool { return a.Equal(&b) }) {
+					t.Errorf("uniform types: got: %v, want: %v", got, want)
+				}
+
+				// The default samplers must not add any sampler path.
+				isDefault := tc.Samplers == [graphics.ShaderSrcImageCount]graphics.ShaderSampler{}
+				if got, want := len(ir.Funcs) == len(base.Funcs), isDefault; got != want {
+					t.Errorf("len(ir.Funcs) == len(base.Funcs): got: %t, want: %t", got, want)
+				}
+			})
+		}
+	}
+}
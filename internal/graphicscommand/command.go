@@ -439,3 +439,16 @@ func MaxImageSize(graphicsDriver graphicsdriver.Graphics) int {
 	}, true)
 	return size
 }
+
+// IsFloatTextureRenderable reports whether float textures can be rendering targets with the graphics driver.
+func IsFloatTextureRenderable(graphicsDriver graphicsdriver.Graphics) bool {
+	c, ok := graphicsDriver.(graphicsdriver.FloatTextureChecker)
+	if !ok {
+		return false
+	}
+	var renderable bool
+	runOnRenderThread(func() {
+		renderable = c.IsFloatTextureRenderable()
+	}, true)
+	return renderable
+}
@@ -253,6 +253,9 @@ func (q *commandQueue) flush(graphicsDriver graphicsdriver.Graphics, endFrame bo
 		if err1 := graphicsDriver.End(endFrame); err1 != nil && err == nil {
 			err = err1
 		}
+		if endFrame {
+			collectGPUTimings(graphicsDriver)
+		}
 
 		// Release the commands explicitly (#1803).
 		// Apparently, the part of a slice between len and cap-1 still holds references.
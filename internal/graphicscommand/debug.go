@@ -0,0 +1,73 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphicscommand
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver"
+	"github.com/hajimehoshi/ebiten/v2/internal/renderdoc"
+)
+
+// setDrawDebugLabelCommand represents a command to set the debug label for the following draw commands.
+type setDrawDebugLabelCommand struct {
+	label string
+}
+
+func (c *setDrawDebugLabelCommand) String() string {
+	return fmt.Sprintf("set-draw-debug-label: label: %q", c.label)
+}
+
+// Exec executes the setDrawDebugLabelCommand.
+func (c *setDrawDebugLabelCommand) Exec(commandQueue *commandQueue, graphicsDriver graphicsdriver.Graphics, indexOffset int) error {
+	if l, ok := graphicsDriver.(graphicsdriver.DebugLabeler); ok {
+		l.SetDrawDebugLabel(c.label)
+	}
+	return nil
+}
+
+func (c *setDrawDebugLabelCommand) NeedsSync() bool {
+	return false
+}
+
+// SetDrawDebugLabel enqueues a command to set the debug label for the following draw commands.
+func SetDrawDebugLabel(label string) {
+	theCommandQueueManager.enqueueCommand(&setDrawDebugLabelCommand{
+		label: label,
+	})
+}
+
+// TriggerGPUCapture requests a graphics debugger to capture the next frame.
+// TriggerGPUCapture returns false if no graphics debugger is available.
+func TriggerGPUCapture(graphicsDriver graphicsdriver.Graphics) bool {
+	if renderdoc.IsAvailable() {
+		renderdoc.TriggerCapture()
+		return true
+	}
+
+	c, ok := graphicsDriver.(graphicsdriver.GPUCapturer)
+	if !ok {
+		return false
+	}
+	var triggered bool
+	runOnRenderThread(func() {
+		if !c.IsGPUCaptureAvailable() {
+			return
+		}
+		c.TriggerGPUCapture()
+		triggered = true
+	}, true)
+	return triggered
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphicscommand
+
+import (
+	"context"
+	"fmt"
+	"runtime/trace"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver"
+)
+
+var (
+	gpuTimings    = map[string]time.Duration{}
+	gpuTimingsM   sync.Mutex
+	tmpGPUTimings []graphicsdriver.GPUTiming
+)
+
+// gpuMarkerCommand represents a command to begin or end a GPU marker.
+type gpuMarkerCommand struct {
+	name  string
+	begin bool
+}
+
+func (c *gpuMarkerCommand) String() string {
+	if c.begin {
+		return fmt.Sprintf("begin-gpu-marker: name: %q", c.name)
+	}
+	return "end-gpu-marker"
+}
+
+// Exec executes the gpuMarkerCommand.
+func (c *gpuMarkerCommand) Exec(commandQueue *commandQueue, graphicsDriver graphicsdriver.Graphics, indexOffset int) error {
+	t, ok := graphicsDriver.(graphicsdriver.GPUTimer)
+	if !ok {
+		return nil
+	}
+	if c.begin {
+		t.BeginGPUMarker(c.name)
+	} else {
+		t.EndGPUMarker()
+	}
+	return nil
+}
+
+func (c *gpuMarkerCommand) NeedsSync() bool {
+	return false
+}
+
+// BeginGPUMarker enqueues a command to begin a GPU marker.
+func BeginGPUMarker(name string) {
+	theCommandQueueManager.enqueueCommand(&gpuMarkerCommand{
+		name:  name,
+		begin: true,
+	})
+}
+
+// EndGPUMarker enqueues a command to end the last begun GPU marker.
+func EndGPUMarker() {
+	theCommandQueueManager.enqueueCommand(&gpuMarkerCommand{})
+}
+
+// IsGPUTimerAvailable reports whether the graphics driver can measure GPU time.
+func IsGPUTimerAvailable(graphicsDriver graphicsdriver.Graphics) bool {
+	t, ok := graphicsDriver.(graphicsdriver.GPUTimer)
+	if !ok {
+		return false
+	}
+	var available bool
+	runOnRenderThread(func() {
+		available = t.IsGPUTimerAvailable()
+	}, true)
+	return available
+}
+
+// collectGPUTimings ends the current frame and collects the GPU timings of the latest available frame from the graphics driver.
+//
+// collectGPUTimings must be called from the render thread at the end of a frame.
+func collectGPUTimings(graphicsDriver graphicsdriver.Graphics) {
+	t, ok := graphicsDriver.(graphicsdriver.GPUTimer)
+	if !ok {
+		return
+	}
+	t.EndGPUFrame()
+	timings, ok := t.AppendGPUTimings(tmpGPUTimings[:0])
+	tmpGPUTimings = timings
+	if !ok {
+		return
+	}
+
+	gpuTimingsM.Lock()
+	defer gpuTimingsM.Unlock()
+
+	// The timings are replaced with the ones of the latest frame.
+	// If a marker with the same name is used multiple times, sum up the durations.
+	clear(gpuTimings)
+	for _, timing := range tmpGPUTimings {
+		gpuTimings[timing.Name] += timing.Duration
+	}
+
+	if trace.IsEnabled() {
+		for _, timing := range tmpGPUTimings {
+			trace.Log(context.Background(), "ebitengine/gpu", fmt.Sprintf("%s: %s", timing.Name, timing.Duration))
+		}
+	}
+}
+
+// AppendGPUTimings appends the latest measured GPU timings to timings, sorted by the names.
+//
+// AppendGPUTimings is concurrent-safe.
+func AppendGPUTimings(timings []graphicsdriver.GPUTiming) []graphicsdriver.GPUTiming {
+	gpuTimingsM.Lock()
+	defer gpuTimingsM.Unlock()
+
+	n := len(timings)
+	for name, d := range gpuTimings {
+		timings = append(timings, graphicsdriver.GPUTiming{
+			Name:     name,
+			Duration: d,
+		})
+	}
+	slices.SortFunc(timings[n:], func(a, b graphicsdriver.GPUTiming) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return timings
+}
@@ -503,6 +503,10 @@ func (g *graphics11) MaxImageSize() int {
 	}
 }
 
+func (g *graphics11) IsFloatTextureRenderable() bool {
+	return true
+}
+
 func (g *graphics11) NewShader(program *shaderir.Program) (graphicsdriver.Shader, error) {
 	vsh, psh, err := compileShader(program)
 	if err != nil {
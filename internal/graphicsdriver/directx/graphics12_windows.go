@@ -1063,6 +1063,10 @@ func (g *graphics12) MaxImageSize() int {
 	return _D3D12_REQ_TEXTURE2D_U_OR_V_DIMENSION
 }
 
+func (g *graphics12) IsFloatTextureRenderable() bool {
+	return true
+}
+
 func (g *graphics12) NewShader(program *shaderir.Program) (graphicsdriver.Shader, error) {
 	vsh, psh, err := compileShader(program)
 	if err != nil {
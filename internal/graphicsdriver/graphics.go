@@ -17,6 +17,7 @@ package graphicsdriver
 import (
 	"fmt"
 	"image"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2/internal/graphics"
 	"github.com/hajimehoshi/ebiten/v2/internal/shaderir"
@@ -75,6 +76,55 @@ type Resetter interface {
 	Reset() error
 }
 
+// GPUTimer is an optional interface of Graphics to measure GPU time with named markers.
+type GPUTimer interface {
+	// IsGPUTimerAvailable reports whether GPU time can be measured.
+	IsGPUTimerAvailable() bool
+
+	// BeginGPUMarker starts measuring GPU time for the named marker.
+	// Markers can be nested.
+	BeginGPUMarker(name string)
+
+	// EndGPUMarker ends measuring GPU time for the last begun marker.
+	EndGPUMarker()
+
+	// EndGPUFrame ends the current frame.
+	// The markers ended after the last EndGPUFrame call belong to the frame.
+	EndGPUFrame()
+
+	// AppendGPUTimings appends the GPU timings of the latest ended frame whose results are all available,
+	// in the order of EndGPUMarker.
+	// AppendGPUTimings reports false if there is no such frame.
+	// The results of the frames older than the frame are discarded.
+	AppendGPUTimings(timings []GPUTiming) ([]GPUTiming, bool)
+}
+
+type GPUTiming struct {
+	Name     string
+	Duration time.Duration
+}
+
+// FloatTextureChecker is an optional interface of Graphics to check whether float textures can be rendering targets.
+type FloatTextureChecker interface {
+	IsFloatTextureRenderable() bool
+}
+
+// DebugLabeler is an optional interface of Graphics to label GPU commands for graphics debuggers.
+type DebugLabeler interface {
+	// SetDrawDebugLabel sets the label for the following draw commands.
+	// An empty label means no label.
+	SetDrawDebugLabel(label string)
+}
+
+// GPUCapturer is an optional interface of Graphics to capture GPU commands by the platform's graphics debugger.
+type GPUCapturer interface {
+	// IsGPUCaptureAvailable reports whether a GPU capture can be started.
+	IsGPUCaptureAvailable() bool
+
+	// TriggerGPUCapture requests to capture the GPU commands of the next frame.
+	TriggerGPUCapture()
+}
+
 type Image interface {
 	ID() ImageID
 	Dispose()
@@ -0,0 +1,69 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metal
+
+import (
+	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver"
+	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver/metal/mtl"
+)
+
+var (
+	_ graphicsdriver.DebugLabeler = (*Graphics)(nil)
+	_ graphicsdriver.GPUCapturer  = (*Graphics)(nil)
+)
+
+func (g *Graphics) SetDrawDebugLabel(label string) {
+	if g.drawDebugLabel == label {
+		return
+	}
+	// A label is set for each render command encoder.
+	g.flushRenderCommandEncoderIfNeeded()
+	g.drawDebugLabel = label
+}
+
+func (g *Graphics) IsGPUCaptureAvailable() bool {
+	// A capture to the developer tools is available only when the application is launched from Xcode,
+	// or the environment variable MTL_CAPTURE_ENABLED=1 is set.
+	return mtl.SharedCaptureManager().SupportsDestination(mtl.CaptureDestinationDeveloperTools)
+}
+
+func (g *Graphics) TriggerGPUCapture() {
+	g.gpuCaptureRequested = true
+}
+
+func (g *Graphics) startGPUCaptureIfNeeded() {
+	if !g.gpuCaptureRequested || g.gpuCapturing {
+		return
+	}
+	g.gpuCaptureRequested = false
+
+	m := mtl.SharedCaptureManager()
+	if m.IsCapturing() {
+		return
+	}
+	if err := m.StartCapture(g.view.getMTLDevice(), mtl.CaptureDestinationDeveloperTools); err != nil {
+		// A capture is a debugging feature. Ignore the error not to stop the game.
+		return
+	}
+	g.gpuCapturing = true
+}
+
+func (g *Graphics) stopGPUCaptureIfNeeded() {
+	if !g.gpuCapturing {
+		return
+	}
+	mtl.SharedCaptureManager().StopCapture()
+	g.gpuCapturing = false
+}
@@ -65,6 +65,10 @@ type Graphics struct {
 	maxImageSize int
 	tmpTextures  []mtl.Texture
 
+	drawDebugLabel      string
+	gpuCaptureRequested bool
+	gpuCapturing        bool
+
 	pool cocoa.NSAutoreleasePool
 }
 
@@ -120,11 +124,15 @@ func (g *Graphics) Begin() error {
 	// NSAutoreleasePool is required to release drawable correctly (#847).
 	// https://developer.apple.com/library/archive/documentation/3DDrawing/Conceptual/MTLBestPracticesGuide/Drawables.html
 	g.pool = cocoa.NSAutoreleasePool_new()
+	g.startGPUCaptureIfNeeded()
 	return nil
 }
 
 func (g *Graphics) End(present bool) error {
 	g.flushIfNeeded(present)
+	if present {
+		g.stopGPUCaptureIfNeeded()
+	}
 	g.screenDrawable = ca.MetalDrawable{}
 	g.pool.Release()
 	g.pool.ID = 0
@@ -300,6 +308,7 @@ func (g *Graphics) NewImage(width, height int) (graphicsdriver.Image, error) {
 		height:   height,
 		texture:  t,
 	}
+	t.SetLabel(fmt.Sprintf("Ebitengine image %d", i.id))
 	g.addImage(i)
 	return i, nil
 }
@@ -514,6 +523,9 @@ func (g *Graphics) draw(dst *Image, dstRegions []graphicsdriver.DstRegion, srcs
 			g.cb = g.cq.CommandBuffer()
 		}
 		g.rce = g.cb.RenderCommandEncoderWithDescriptor(rpd)
+		if g.drawDebugLabel != "" {
+			g.rce.SetLabel(g.drawDebugLabel)
+		}
 	}
 
 	w, h := dst.internalSize()
@@ -690,6 +702,10 @@ func (g *Graphics) MaxImageSize() int {
 	return g.maxImageSize
 }
 
+func (g *Graphics) IsFloatTextureRenderable() bool {
+	return true
+}
+
 func (g *Graphics) NewShader(program *shaderir.Program) (graphicsdriver.Shader, error) {
 	s, err := newShader(g.view.getMTLDevice(), g.genNextShaderID(), program)
 	if err != nil {
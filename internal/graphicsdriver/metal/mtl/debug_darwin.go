@@ -0,0 +1,119 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mtl
+
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/ebitengine/purego/objc"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/cocoa"
+)
+
+var (
+	class_MTLCaptureManager    = objc.GetClass("MTLCaptureManager")
+	class_MTLCaptureDescriptor = objc.GetClass("MTLCaptureDescriptor")
+)
+
+var (
+	sel_isCapturing                      = objc.RegisterName("isCapturing")
+	sel_setCaptureObject                 = objc.RegisterName("setCaptureObject:")
+	sel_setDestination                   = objc.RegisterName("setDestination:")
+	sel_setLabel                         = objc.RegisterName("setLabel:")
+	sel_sharedCaptureManager             = objc.RegisterName("sharedCaptureManager")
+	sel_startCaptureWithDescriptor_error = objc.RegisterName("startCaptureWithDescriptor:error:")
+	sel_stopCapture                      = objc.RegisterName("stopCapture")
+	sel_supportsDestination              = objc.RegisterName("supportsDestination:")
+)
+
+// CaptureDestination is the kind of destination for captured GPU command data.
+//
+// Reference: https://developer.apple.com/documentation/metal/mtlcapturedestination?language=objc.
+type CaptureDestination uint8
+
+const (
+	CaptureDestinationDeveloperTools   CaptureDestination = 1
+	CaptureDestinationGPUTraceDocument CaptureDestination = 2
+)
+
+// CaptureManager is an object you use to capture Metal command data in your app.
+//
+// Reference: https://developer.apple.com/documentation/metal/mtlcapturemanager?language=objc.
+type CaptureManager struct {
+	captureManager objc.ID
+}
+
+// SharedCaptureManager returns the shared capture manager for this process.
+//
+// Reference: https://developer.apple.com/documentation/metal/mtlcapturemanager/2869731-sharedcapturemanager?language=objc.
+func SharedCaptureManager() CaptureManager {
+	return CaptureManager{objc.ID(class_MTLCaptureManager).Send(sel_sharedCaptureManager)}
+}
+
+// SupportsDestination returns a Boolean value that indicates whether the capture manager supports the given destination.
+//
+// Reference: https://developer.apple.com/documentation/metal/mtlcapturemanager/3237259-supportsdestination?language=objc.
+func (c CaptureManager) SupportsDestination(destination CaptureDestination) bool {
+	return c.captureManager.Send(sel_supportsDestination, uintptr(destination)) != 0
+}
+
+// StartCapture starts capturing the commands of the given device.
+//
+// Reference: https://developer.apple.com/documentation/metal/mtlcapturemanager/3237260-startcapturewithdescriptor?language=objc.
+func (c CaptureManager) StartCapture(device Device, destination CaptureDestination) error {
+	d := objc.ID(class_MTLCaptureDescriptor).Send(sel_new)
+	defer d.Send(sel_release)
+	d.Send(sel_setCaptureObject, device.device)
+	d.Send(sel_setDestination, uintptr(destination))
+
+	var err cocoa.NSError
+	if c.captureManager.Send(sel_startCaptureWithDescriptor_error, d, unsafe.Pointer(&err)) == 0 {
+		return errors.New(cocoa.NSString{ID: err.Send(sel_localizedDescription)}.String())
+	}
+	return nil
+}
+
+// StopCapture stops the current capture.
+//
+// Reference: https://developer.apple.com/documentation/metal/mtlcapturemanager/2869728-stopcapture?language=objc.
+func (c CaptureManager) StopCapture() {
+	c.captureManager.Send(sel_stopCapture)
+}
+
+// IsCapturing reports whether a capture is in progress.
+//
+// Reference: https://developer.apple.com/documentation/metal/mtlcapturemanager/2869729-iscapturing?language=objc.
+func (c CaptureManager) IsCapturing() bool {
+	return c.captureManager.Send(sel_isCapturing) != 0
+}
+
+// SetLabel sets a string that identifies the command encoder.
+//
+// Reference: https://developer.apple.com/documentation/metal/mtlcommandencoder/1458027-label?language=objc.
+func (ce CommandEncoder) SetLabel(label string) {
+	str := cocoa.NSString_alloc().InitWithUTF8String(label)
+	defer str.ID.Send(sel_release)
+	ce.commandEncoder.Send(sel_setLabel, str.ID)
+}
+
+// SetLabel sets a string that identifies the texture.
+//
+// Reference: https://developer.apple.com/documentation/metal/mtlresource/1515814-label?language=objc.
+func (t Texture) SetLabel(label string) {
+	str := cocoa.NSString_alloc().InitWithUTF8String(label)
+	defer str.ID.Send(sel_release)
+	t.texture.Send(sel_setLabel, str.ID)
+}
@@ -111,6 +111,9 @@ type context struct {
 	maxTextureSize     int
 	maxTextureSizeOnce sync.Once
 	initOnce           sync.Once
+
+	// floatTextureRenderable reports whether float textures can be rendered.
+	floatTextureRenderable bool
 }
 
 func (c *context) bindTexture(t textureNative) {
@@ -200,6 +203,10 @@ func (c *context) reset() error {
 	c.blend(graphicsdriver.BlendSourceOver)
 	c.screenFramebuffer = framebufferNative(c.ctx.GetInteger(gl.FRAMEBUFFER_BINDING))
 	// TODO: Need to update screenFramebufferWidth/Height?
+
+	// OpenGL ES and WebGL require the extension EXT_color_buffer_float to render float textures.
+	// In WebGL, the extension must be enabled for each context, so check this at every reset.
+	c.floatTextureRenderable = !c.ctx.IsES() || c.ctx.HasExtension("EXT_color_buffer_float")
 	return nil
 }
 
@@ -0,0 +1,45 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !playstation5
+
+package opengl
+
+import (
+	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver"
+	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver/opengl/gl"
+)
+
+var _ graphicsdriver.DebugLabeler = (*Graphics)(nil)
+
+func (g *Graphics) SetDrawDebugLabel(label string) {
+	ctx := g.context.ctx
+	if d, ok := ctx.(*gl.DebugContext); ok {
+		ctx = d.Context
+	}
+	dg, ok := ctx.(gl.DebugGroupContext)
+	if !ok || !dg.IsDebugGroupAvailable() {
+		return
+	}
+
+	if g.debugGroupPushed {
+		dg.PopDebugGroup()
+		g.debugGroupPushed = false
+	}
+	if label == "" {
+		return
+	}
+	dg.PushDebugGroup(label)
+	g.debugGroupPushed = true
+}
@@ -401,6 +401,15 @@ func (d *DebugContext) GetUniformLocation(arg0 uint32, arg1 string) int32 {
 	return out0
 }
 
+func (d *DebugContext) HasExtension(arg0 string) bool {
+	out0 := d.Context.HasExtension(arg0)
+	fmt.Fprintln(os.Stderr, "HasExtension")
+	if e := d.Context.GetError(); e != NO_ERROR {
+		panic(fmt.Sprintf("gl: GetError() returned %d at HasExtension", e))
+	}
+	return out0
+}
+
 func (d *DebugContext) IsES() bool {
 	out0 := d.Context.IsES()
 	return out0
@@ -0,0 +1,63 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build (darwin || freebsd || linux || netbsd || openbsd || windows) && !nintendosdk && !playstation5
+
+package gl
+
+import (
+	"unsafe"
+
+	"github.com/ebitengine/purego"
+)
+
+var _ DebugGroupContext = (*defaultContext)(nil)
+
+type debugGroupProcs struct {
+	gpPopDebugGroup  uintptr
+	gpPushDebugGroup uintptr
+}
+
+// load loads the functions for debug groups.
+// As debug groups are optional, load doesn't return an error even if the functions are missing.
+func (d *debugGroupProcs) load(c *defaultContext) {
+	for _, f := range []struct {
+		name string
+		proc *uintptr
+	}{
+		{"glPopDebugGroup", &d.gpPopDebugGroup},
+		{"glPushDebugGroup", &d.gpPushDebugGroup},
+	} {
+		proc, err := c.getProcAddress(f.name)
+		if err != nil || proc == 0 {
+			*d = debugGroupProcs{}
+			return
+		}
+		*f.proc = proc
+	}
+}
+
+func (c *defaultContext) IsDebugGroupAvailable() bool {
+	return c.debugGroup.gpPushDebugGroup != 0
+}
+
+func (c *defaultContext) PushDebugGroup(message string) {
+	m, free := cStr(message)
+	defer free()
+	purego.SyscallN(c.debugGroup.gpPushDebugGroup, DEBUG_SOURCE_APPLICATION, 0, uintptr(len(message)), uintptr(unsafe.Pointer(m)))
+}
+
+func (c *defaultContext) PopDebugGroup() {
+	purego.SyscallN(c.debugGroup.gpPopDebugGroup)
+}
@@ -17,6 +17,7 @@ package gl
 // typedef int GLsizei;
 // typedef float GLfloat;
 // typedef char GLchar;
+// typedef unsigned char GLubyte;
 // typedef ptrdiff_t GLintptr;
 // typedef ptrdiff_t GLsizeiptr;
 //
@@ -196,6 +197,10 @@ package gl
 //   typedef void (*fn)(GLuint shader, GLenum pname, GLint* params);
 //   ((fn)(fnptr))(shader, pname, params);
 // }
+// static const GLubyte* glowGetStringi(uintptr_t fnptr, GLenum name, GLuint index) {
+//   typedef const GLubyte* (*fn)(GLenum name, GLuint index);
+//   return ((fn)(fnptr))(name, index);
+// }
 // static GLint glowGetUniformLocation(uintptr_t fnptr, GLuint program, const GLchar* name) {
 //   typedef GLint (*fn)(GLuint program, const GLchar* name);
 //   return ((fn)(fnptr))(program, name);
@@ -360,6 +365,7 @@ type defaultContext struct {
 	gpGetProgramiv             C.uintptr_t
 	gpGetShaderInfoLog         C.uintptr_t
 	gpGetShaderiv              C.uintptr_t
+	gpGetStringi               C.uintptr_t
 	gpGetUniformLocation       C.uintptr_t
 	gpIsProgram                C.uintptr_t
 	gpLinkProgram              C.uintptr_t
@@ -629,6 +635,20 @@ func (c *defaultContext) GetUniformLocation(program uint32, name string) int32 {
 	return int32(ret)
 }
 
+func (c *defaultContext) HasExtension(name string) bool {
+	n := c.GetInteger(NUM_EXTENSIONS)
+	for i := 0; i < n; i++ {
+		ret := C.glowGetStringi(c.gpGetStringi, EXTENSIONS, C.GLuint(i))
+		if ret == nil {
+			continue
+		}
+		if C.GoString((*C.char)(unsafe.Pointer(ret))) == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *defaultContext) IsProgram(program uint32) bool {
 	ret := C.glowIsProgram(c.gpIsProgram, C.GLuint(program))
 	return ret == TRUE
@@ -804,6 +824,7 @@ func (c *defaultContext) LoadFunctions() error {
 	c.gpGetProgramiv = C.uintptr_t(g.get("glGetProgramiv"))
 	c.gpGetShaderInfoLog = C.uintptr_t(g.get("glGetShaderInfoLog"))
 	c.gpGetShaderiv = C.uintptr_t(g.get("glGetShaderiv"))
+	c.gpGetStringi = C.uintptr_t(g.get("glGetStringi"))
 	c.gpGetUniformLocation = C.uintptr_t(g.get("glGetUniformLocation"))
 	c.gpIsProgram = C.uintptr_t(g.get("glIsProgram"))
 	c.gpLinkProgram = C.uintptr_t(g.get("glLinkProgram"))
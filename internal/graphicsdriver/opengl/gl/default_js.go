@@ -59,6 +59,7 @@ type defaultContext struct {
 	fnFramebufferTexture2D     js.Value
 	fnFlush                    js.Value
 	fnGetError                 js.Value
+	fnGetExtension             js.Value
 	fnGetParameter             js.Value
 	fnGetProgramInfoLog        js.Value
 	fnGetProgramParameter      js.Value
@@ -187,6 +188,7 @@ func NewDefaultContext(v js.Value) (Context, error) {
 		fnFramebufferTexture2D:     v.Get("framebufferTexture2D").Call("bind", v),
 		fnFlush:                    v.Get("flush").Call("bind", v),
 		fnGetError:                 v.Get("getError").Call("bind", v),
+		fnGetExtension:             v.Get("getExtension").Call("bind", v),
 		fnGetParameter:             v.Get("getParameter").Call("bind", v),
 		fnGetProgramInfoLog:        v.Get("getProgramInfoLog").Call("bind", v),
 		fnGetProgramParameter:      v.Get("getProgramParameter").Call("bind", v),
@@ -475,6 +477,11 @@ func (c *defaultContext) GetUniformLocation(program uint32, name string) int32 {
 	return int32((program << 5) | idx)
 }
 
+func (c *defaultContext) HasExtension(name string) bool {
+	// In WebGL, getExtension enables the extension as well as reports whether the extension is available.
+	return !c.fnGetExtension.Invoke(name).IsNull()
+}
+
 func (c *defaultContext) IsProgram(program uint32) bool {
 	return c.fnIsProgram.Invoke(c.programs.get(program)).Bool()
 }
@@ -68,6 +68,7 @@ type defaultContext struct {
 	gpGetProgramiv             uintptr
 	gpGetShaderInfoLog         uintptr
 	gpGetShaderiv              uintptr
+	gpGetStringi               uintptr
 	gpGetUniformLocation       uintptr
 	gpIsProgram                uintptr
 	gpLinkProgram              uintptr
@@ -97,6 +98,9 @@ type defaultContext struct {
 	gpVertexAttribPointer      uintptr
 	gpViewport                 uintptr
 
+	timerQuery timerQueryProcs
+	debugGroup debugGroupProcs
+
 	isES bool
 }
 
@@ -337,6 +341,20 @@ func (c *defaultContext) GetUniformLocation(program uint32, name string) int32 {
 	return int32(ret)
 }
 
+func (c *defaultContext) HasExtension(name string) bool {
+	n := c.GetInteger(NUM_EXTENSIONS)
+	for i := 0; i < n; i++ {
+		ret, _, _ := purego.SyscallN(c.gpGetStringi, EXTENSIONS, uintptr(i))
+		if ret == 0 {
+			continue
+		}
+		if goStr(*(**byte)(unsafe.Pointer(&ret))) == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *defaultContext) IsProgram(program uint32) bool {
 	ret, _, _ := purego.SyscallN(c.gpIsProgram, uintptr(program))
 	return byte(ret) != 0
@@ -512,6 +530,7 @@ func (c *defaultContext) LoadFunctions() error {
 	c.gpGetProgramiv = g.get("glGetProgramiv")
 	c.gpGetShaderInfoLog = g.get("glGetShaderInfoLog")
 	c.gpGetShaderiv = g.get("glGetShaderiv")
+	c.gpGetStringi = g.get("glGetStringi")
 	c.gpGetUniformLocation = g.get("glGetUniformLocation")
 	c.gpIsProgram = g.get("glIsProgram")
 	c.gpLinkProgram = g.get("glLinkProgram")
@@ -541,6 +560,9 @@ func (c *defaultContext) LoadFunctions() error {
 	c.gpVertexAttribPointer = g.get("glVertexAttribPointer")
 	c.gpViewport = g.get("glViewport")
 
+	c.timerQuery.load(c)
+	c.debugGroup.load(c)
+
 	return g.error()
 }
 
@@ -559,3 +581,12 @@ func cStr(str string) (cstr *byte, free func()) {
 		bs = nil
 	}
 }
+
+// goStr converts a null-terminated C string to a Go string.
+func goStr(cstr *byte) string {
+	var n int
+	for *(*byte)(unsafe.Add(unsafe.Pointer(cstr), n)) != 0 {
+		n++
+	}
+	return string(unsafe.Slice(cstr, n))
+}
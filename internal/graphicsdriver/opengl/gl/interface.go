@@ -72,6 +72,7 @@ type Context interface {
 	GetShaderInfoLog(shader uint32) string
 	GetShaderi(shader uint32, pname uint32) int
 	GetUniformLocation(program uint32, name string) int32
+	HasExtension(name string) bool
 	IsProgram(program uint32) bool
 	LinkProgram(program uint32)
 	PixelStorei(pname uint32, param int32)
@@ -100,3 +101,24 @@ type Context interface {
 	VertexAttribPointer(index uint32, size int32, xtype uint32, normalized bool, stride int32, offset int)
 	Viewport(x int32, y int32, width int32, height int32)
 }
+
+// TimerQueryContext is an optional interface of Context for timer queries.
+//
+// Timer queries are available on OpenGL 3.3 or later, and not available on OpenGL ES without extensions.
+type TimerQueryContext interface {
+	IsTimerQueryAvailable() bool
+	CreateQuery() uint32
+	DeleteQuery(query uint32)
+	QueryCounter(query uint32, target uint32)
+	IsQueryResultAvailable(query uint32) bool
+	QueryResultUint64(query uint32) uint64
+}
+
+// DebugGroupContext is an optional interface of Context for debug groups.
+//
+// Debug groups are available on OpenGL 4.3 or later, or with the KHR_debug extension.
+type DebugGroupContext interface {
+	IsDebugGroupAvailable() bool
+	PushDebugGroup(message string)
+	PopDebugGroup()
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build (darwin || freebsd || linux || netbsd || openbsd || windows) && !nintendosdk && !playstation5
+
+package gl
+
+import (
+	"unsafe"
+
+	"github.com/ebitengine/purego"
+)
+
+var _ TimerQueryContext = (*defaultContext)(nil)
+
+type timerQueryProcs struct {
+	gpDeleteQueries       uintptr
+	gpGenQueries          uintptr
+	gpGetQueryObjectuiv   uintptr
+	gpGetQueryObjectui64v uintptr
+	gpQueryCounter        uintptr
+}
+
+// load loads the functions for timer queries.
+// As timer queries are optional, load doesn't return an error even if the functions are missing.
+func (t *timerQueryProcs) load(c *defaultContext) {
+	// OpenGL ES doesn't have timer queries without extensions.
+	if c.isES {
+		return
+	}
+	for _, f := range []struct {
+		name string
+		proc *uintptr
+	}{
+		{"glDeleteQueries", &t.gpDeleteQueries},
+		{"glGenQueries", &t.gpGenQueries},
+		{"glGetQueryObjectuiv", &t.gpGetQueryObjectuiv},
+		{"glGetQueryObjectui64v", &t.gpGetQueryObjectui64v},
+		{"glQueryCounter", &t.gpQueryCounter},
+	} {
+		proc, err := c.getProcAddress(f.name)
+		if err != nil || proc == 0 {
+			*t = timerQueryProcs{}
+			return
+		}
+		*f.proc = proc
+	}
+}
+
+func (c *defaultContext) IsTimerQueryAvailable() bool {
+	return c.timerQuery.gpQueryCounter != 0
+}
+
+func (c *defaultContext) CreateQuery() uint32 {
+	var query uint32
+	purego.SyscallN(c.timerQuery.gpGenQueries, 1, uintptr(unsafe.Pointer(&query)))
+	return query
+}
+
+func (c *defaultContext) DeleteQuery(query uint32) {
+	purego.SyscallN(c.timerQuery.gpDeleteQueries, 1, uintptr(unsafe.Pointer(&query)))
+}
+
+func (c *defaultContext) QueryCounter(query uint32, target uint32) {
+	purego.SyscallN(c.timerQuery.gpQueryCounter, uintptr(query), uintptr(target))
+}
+
+func (c *defaultContext) IsQueryResultAvailable(query uint32) bool {
+	var available uint32
+	purego.SyscallN(c.timerQuery.gpGetQueryObjectuiv, uintptr(query), QUERY_RESULT_AVAILABLE, uintptr(unsafe.Pointer(&available)))
+	return available != FALSE
+}
+
+func (c *defaultContext) QueryResultUint64(query uint32) uint64 {
+	var result uint64
+	purego.SyscallN(c.timerQuery.gpGetQueryObjectui64v, uintptr(query), QUERY_RESULT, uintptr(unsafe.Pointer(&result)))
+	return result
+}
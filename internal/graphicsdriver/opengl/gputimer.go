@@ -0,0 +1,147 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !playstation5
+
+package opengl
+
+import (
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver"
+	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver/opengl/gl"
+)
+
+var _ graphicsdriver.GPUTimer = (*Graphics)(nil)
+
+type gpuTimerQuery struct {
+	name  string
+	begin uint32
+	end   uint32
+}
+
+type gpuTimer struct {
+	stack []gpuTimerQuery
+
+	// current is the ended queries in the current frame.
+	current []gpuTimerQuery
+
+	// frames is the ended frames whose results are not taken yet.
+	frames [][]gpuTimerQuery
+
+	queries []uint32
+}
+
+func (g *Graphics) timerQueryContext() (gl.TimerQueryContext, bool) {
+	ctx := g.context.ctx
+	if d, ok := ctx.(*gl.DebugContext); ok {
+		ctx = d.Context
+	}
+	t, ok := ctx.(gl.TimerQueryContext)
+	if !ok || !t.IsTimerQueryAvailable() {
+		return nil, false
+	}
+	return t, true
+}
+
+func (g *Graphics) newTimestampQuery(ctx gl.TimerQueryContext) uint32 {
+	var q uint32
+	if n := len(g.gpuTimer.queries); n > 0 {
+		q = g.gpuTimer.queries[n-1]
+		g.gpuTimer.queries = g.gpuTimer.queries[:n-1]
+	} else {
+		q = ctx.CreateQuery()
+	}
+	ctx.QueryCounter(q, gl.TIMESTAMP)
+	return q
+}
+
+func (g *Graphics) IsGPUTimerAvailable() bool {
+	_, ok := g.timerQueryContext()
+	return ok
+}
+
+func (g *Graphics) BeginGPUMarker(name string) {
+	ctx, ok := g.timerQueryContext()
+	if !ok {
+		return
+	}
+	g.gpuTimer.stack = append(g.gpuTimer.stack, gpuTimerQuery{
+		name:  name,
+		begin: g.newTimestampQuery(ctx),
+	})
+}
+
+func (g *Graphics) EndGPUMarker() {
+	ctx, ok := g.timerQueryContext()
+	if !ok {
+		return
+	}
+	n := len(g.gpuTimer.stack)
+	if n == 0 {
+		return
+	}
+	q := g.gpuTimer.stack[n-1]
+	g.gpuTimer.stack = g.gpuTimer.stack[:n-1]
+	q.end = g.newTimestampQuery(ctx)
+	g.gpuTimer.current = append(g.gpuTimer.current, q)
+}
+
+func (g *Graphics) EndGPUFrame() {
+	if _, ok := g.timerQueryContext(); !ok {
+		return
+	}
+	g.gpuTimer.frames = append(g.gpuTimer.frames, g.gpuTimer.current)
+	g.gpuTimer.current = nil
+}
+
+func (g *Graphics) AppendGPUTimings(timings []graphicsdriver.GPUTiming) ([]graphicsdriver.GPUTiming, bool) {
+	ctx, ok := g.timerQueryContext()
+	if !ok {
+		return timings, false
+	}
+
+	// Find the latest frame whose results are all available.
+	last := -1
+	for i, f := range g.gpuTimer.frames {
+		// The queries are issued in order, so the results of a frame are available when the last query's result is available.
+		if len(f) > 0 && !ctx.IsQueryResultAvailable(f[len(f)-1].end) {
+			break
+		}
+		last = i
+	}
+	if last < 0 {
+		return timings, false
+	}
+
+	for _, q := range g.gpuTimer.frames[last] {
+		begin := ctx.QueryResultUint64(q.begin)
+		end := ctx.QueryResultUint64(q.end)
+		timings = append(timings, graphicsdriver.GPUTiming{
+			Name:     q.name,
+			Duration: time.Duration(end - begin),
+		})
+	}
+
+	// The results of the older frames are discarded.
+	for _, f := range g.gpuTimer.frames[:last+1] {
+		for _, q := range f {
+			g.gpuTimer.queries = append(g.gpuTimer.queries, q.begin, q.end)
+		}
+	}
+	n := copy(g.gpuTimer.frames, g.gpuTimer.frames[last+1:])
+	clear(g.gpuTimer.frames[n:])
+	g.gpuTimer.frames = g.gpuTimer.frames[:n]
+	return timings, true
+}
@@ -54,6 +54,10 @@ type Graphics struct {
 	// textureNative cannot be a map key unfortunately.
 	activatedTextures []activatedTexture
 
+	gpuTimer gpuTimer
+
+	debugGroupPushed bool
+
 	graphicsPlatform
 }
 
@@ -317,6 +321,10 @@ func (g *Graphics) MaxImageSize() int {
 	return g.context.getMaxTextureSize()
 }
 
+func (g *Graphics) IsFloatTextureRenderable() bool {
+	return g.context.floatTextureRenderable
+}
+
 func (g *Graphics) NewShader(program *shaderir.Program) (graphicsdriver.Shader, error) {
 	s, err := newShader(g.genNextShaderID(), g, program)
 	if err != nil {
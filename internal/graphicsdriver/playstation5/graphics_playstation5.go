@@ -124,6 +124,10 @@ func (g *Graphics) MaxImageSize() int {
 	return 4096 // TODO: Get the value from the SDK.
 }
 
+func (g *Graphics) IsFloatTextureRenderable() bool {
+	return true
+}
+
 func (g *Graphics) NewShader(program *shaderir.Program) (graphicsdriver.Shader, error) {
 	s := precompiledShaders[program.SourceHash]
 	defer runtime.KeepAlive(s)
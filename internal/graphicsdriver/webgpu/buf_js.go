@@ -0,0 +1,46 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webgpu
+
+import (
+	"syscall/js"
+	"unsafe"
+)
+
+var (
+	tmpUint8ArrayByteLength = 16
+
+	// tmpUint8Array is a temporary buffer used at queue.writeBuffer or queue.writeTexture.
+	// These functions copy the given data immediately, then the buffer can be reused.
+	tmpUint8Array = uint8Array.New(tmpUint8ArrayByteLength)
+)
+
+// tmpUint8ArrayFromSlice returns a Uint8Array whose content is the same as the given slice's memory.
+// The returned value is valid until the next call of tmpUint8ArrayFromSlice.
+func tmpUint8ArrayFromSlice[T byte | uint32 | float32](data []T) js.Value {
+	var zero T
+	bs := unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(data))), len(data)*int(unsafe.Sizeof(zero)))
+
+	if l := tmpUint8ArrayByteLength; l < len(bs) {
+		for l < len(bs) {
+			l *= 2
+		}
+		tmpUint8ArrayByteLength = l
+		tmpUint8Array = uint8Array.New(l)
+	}
+
+	js.CopyBytesToJS(tmpUint8Array, bs)
+	return tmpUint8Array.Call("subarray", 0, len(bs))
+}
@@ -0,0 +1,834 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webgpu
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"syscall/js"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/graphics"
+	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver"
+	"github.com/hajimehoshi/ebiten/v2/internal/shaderir"
+	"github.com/hajimehoshi/ebiten/v2/internal/shaderir/wgsl"
+)
+
+// Constants from the WebGPU specification.
+// https://www.w3.org/TR/webgpu/
+const (
+	bufferUsageMapRead  = 0x0001
+	bufferUsageCopyDst  = 0x0008
+	bufferUsageIndex    = 0x0010
+	bufferUsageVertex   = 0x0020
+	bufferUsageStorage  = 0x0080
+	textureUsageCopySrc = 0x01
+	textureUsageCopyDst = 0x02
+	textureUsageBinding = 0x04
+	textureUsageRender  = 0x10
+	shaderStageVertex   = 0x1
+	shaderStageFragment = 0x2
+	mapModeRead         = 0x0001
+)
+
+const (
+	textureFormat = "rgba8unorm"
+	stencilFormat = "stencil8"
+)
+
+type stencilMode int
+
+const (
+	noStencil stencilMode = iota
+	incrementStencil
+	invertStencil
+	drawWithStencil
+)
+
+var (
+	object     = js.Global().Get("Object")
+	uint8Array = js.Global().Get("Uint8Array")
+)
+
+type Graphics struct {
+	canvas     js.Value
+	context    js.Value
+	device     js.Value
+	queue      js.Value
+	colorSpace graphicsdriver.ColorSpace
+
+	// screenFormat is the preferred texture format of the canvas.
+	screenFormat string
+
+	encoder js.Value
+	pass    js.Value
+
+	screenTexture js.Value
+	screenCleared bool
+
+	bindGroupLayout js.Value
+	pipelineLayout  js.Value
+	sampler         js.Value
+	dummyTexture    js.Value
+
+	vb js.Value
+	ib js.Value
+
+	vbSize int
+	ibSize int
+
+	// uniformBuffer is a storage buffer shared by all the draw calls for uniform variables.
+	// Each draw call writes its uniform variables at a different offset, and binds the buffer with a dynamic offset.
+	uniformBuffer      js.Value
+	uniformBufferSize  int
+	uniformBindingSize int
+	uniformOffset      int
+	uniformAlignment   int
+
+	// bindGroups are the bind groups cached by the source images.
+	// The bind groups are invalidated when the uniform buffer or the source images are changed.
+	bindGroups map[[graphics.ShaderSrcImageCount]graphicsdriver.ImageID]js.Value
+
+	// disposedTextures are textures that are destroyed after the current command encoder is submitted.
+	disposedTextures []js.Value
+
+	lastDst      *Image
+	lastFillRule graphicsdriver.FillRule
+
+	images      map[graphicsdriver.ImageID]*Image
+	nextImageID graphicsdriver.ImageID
+
+	shaders      map[graphicsdriver.ShaderID]*Shader
+	nextShaderID graphicsdriver.ShaderID
+
+	transparent  bool
+	maxImageSize int
+}
+
+// await waits for the given promise to be resolved or rejected.
+func await(promise js.Value) (js.Value, error) {
+	ch := make(chan js.Value, 1)
+	errCh := make(chan error, 1)
+
+	then := js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) > 0 {
+			ch <- args[0]
+		} else {
+			ch <- js.Undefined()
+		}
+		return nil
+	})
+	defer then.Release()
+
+	catch := js.FuncOf(func(this js.Value, args []js.Value) any {
+		var msg string
+		if len(args) > 0 {
+			msg = args[0].Call("toString").String()
+		}
+		errCh <- errors.New(msg)
+		return nil
+	})
+	defer catch.Release()
+
+	promise.Call("then", then).Call("catch", catch)
+
+	select {
+	case v := <-ch:
+		return v, nil
+	case err := <-errCh:
+		return js.Undefined(), err
+	}
+}
+
+// NewGraphics creates an implementation of graphicsdriver.Graphics for WebGPU.
+// The returned graphics value is nil iff the error is not nil.
+func NewGraphics(canvas js.Value, colorSpace graphicsdriver.ColorSpace) (graphicsdriver.Graphics, error) {
+	gpu := js.Global().Get("navigator").Get("gpu")
+	if !gpu.Truthy() {
+		return nil, fmt.Errorf("webgpu: navigator.gpu is not available")
+	}
+
+	adapter, err := await(gpu.Call("requestAdapter"))
+	if err != nil {
+		return nil, fmt.Errorf("webgpu: requestAdapter failed: %w", err)
+	}
+	if !adapter.Truthy() {
+		return nil, fmt.Errorf("webgpu: no adapter is available")
+	}
+
+	// Request the maximum texture size the adapter supports instead of the default limit.
+	maxImageSize := adapter.Get("limits").Get("maxTextureDimension2D").Int()
+	limits := object.New()
+	limits.Set("maxTextureDimension2D", maxImageSize)
+	desc := object.New()
+	desc.Set("requiredLimits", limits)
+	device, err := await(adapter.Call("requestDevice", desc))
+	if err != nil {
+		return nil, fmt.Errorf("webgpu: requestDevice failed: %w", err)
+	}
+
+	context := canvas.Call("getContext", "webgpu")
+	if !context.Truthy() {
+		return nil, fmt.Errorf("webgpu: getContext for webgpu failed")
+	}
+
+	return &Graphics{
+		canvas:           canvas,
+		context:          context,
+		device:           device,
+		queue:            device.Get("queue"),
+		colorSpace:       colorSpace,
+		screenFormat:     gpu.Call("getPreferredCanvasFormat").String(),
+		maxImageSize:     maxImageSize,
+		uniformAlignment: device.Get("limits").Get("minStorageBufferOffsetAlignment").Int(),
+	}, nil
+}
+
+func (g *Graphics) Initialize() error {
+	config := object.New()
+	config.Set("device", g.device)
+	config.Set("format", g.screenFormat)
+	config.Set("usage", textureUsageRender)
+	if g.transparent {
+		config.Set("alphaMode", "premultiplied")
+	} else {
+		config.Set("alphaMode", "opaque")
+	}
+	switch g.colorSpace {
+	case graphicsdriver.ColorSpaceSRGB:
+		config.Set("colorSpace", "srgb")
+	case graphicsdriver.ColorSpaceDisplayP3:
+		config.Set("colorSpace", "display-p3")
+	}
+	g.context.Call("configure", config)
+
+	// All the shaders share the same bind group layout:
+	//
+	//   binding 0:    uniform variables (storage buffer with a dynamic offset)
+	//   binding 1-4:  source textures
+	//   binding 5:    sampler
+	var entries []any
+	{
+		e := object.New()
+		e.Set("binding", wgsl.UniformBinding)
+		e.Set("visibility", shaderStageVertex|shaderStageFragment)
+		b := object.New()
+		b.Set("type", "read-only-storage")
+		b.Set("hasDynamicOffset", true)
+		e.Set("buffer", b)
+		entries = append(entries, e)
+	}
+	for i := 0; i < graphics.ShaderSrcImageCount; i++ {
+		e := object.New()
+		e.Set("binding", wgsl.TextureBinding(i))
+		e.Set("visibility", shaderStageVertex|shaderStageFragment)
+		t := object.New()
+		t.Set("sampleType", "float")
+		e.Set("texture", t)
+		entries = append(entries, e)
+	}
+	{
+		e := object.New()
+		e.Set("binding", wgsl.SamplerBinding)
+		e.Set("visibility", shaderStageVertex|shaderStageFragment)
+		s := object.New()
+		s.Set("type", "filtering")
+		e.Set("sampler", s)
+		entries = append(entries, e)
+	}
+	bglDesc := object.New()
+	bglDesc.Set("entries", js.ValueOf(entries))
+	g.bindGroupLayout = g.device.Call("createBindGroupLayout", bglDesc)
+
+	plDesc := object.New()
+	plDesc.Set("bindGroupLayouts", js.ValueOf([]any{g.bindGroupLayout}))
+	g.pipelineLayout = g.device.Call("createPipelineLayout", plDesc)
+
+	samplerDesc := object.New()
+	samplerDesc.Set("magFilter", "nearest")
+	samplerDesc.Set("minFilter", "nearest")
+	g.sampler = g.device.Call("createSampler", samplerDesc)
+
+	// A texture is needed for every binding even if a source image is not specified.
+	g.dummyTexture = g.createTexture(1, 1, textureFormat, textureUsageBinding)
+
+	return nil
+}
+
+func (g *Graphics) createTexture(width, height int, format string, usage int) js.Value {
+	size := object.New()
+	size.Set("width", width)
+	size.Set("height", height)
+	desc := object.New()
+	desc.Set("size", size)
+	desc.Set("format", format)
+	desc.Set("usage", usage)
+	return g.device.Call("createTexture", desc)
+}
+
+func (g *Graphics) createBuffer(size int, usage int) js.Value {
+	desc := object.New()
+	desc.Set("size", size)
+	desc.Set("usage", usage)
+	return g.device.Call("createBuffer", desc)
+}
+
+func (g *Graphics) Begin() error {
+	return nil
+}
+
+func (g *Graphics) End(present bool) error {
+	g.flushIfNeeded()
+	// The canvas texture is presented automatically when the current task finishes.
+	g.screenTexture = js.Undefined()
+	g.screenCleared = false
+	return nil
+}
+
+func (g *Graphics) SetTransparent(transparent bool) {
+	g.transparent = transparent
+}
+
+func pow2(x int) int {
+	p2 := 1
+	for p2 < x {
+		p2 *= 2
+	}
+	return p2
+}
+
+func (g *Graphics) SetVertices(vertices []float32, indices []uint32) error {
+	if len(vertices) == 0 || len(indices) == 0 {
+		return nil
+	}
+
+	// queue.writeBuffer is executed before the next submission. Submit the recorded commands first
+	// so that they use the previous vertices.
+	g.flushIfNeeded()
+
+	vbSize := 4 * len(vertices)
+	ibSize := 4 * len(indices)
+
+	if g.vbSize < vbSize {
+		if g.vb.Truthy() {
+			g.vb.Call("destroy")
+		}
+		g.vbSize = pow2(vbSize)
+		g.vb = g.createBuffer(g.vbSize, bufferUsageVertex|bufferUsageCopyDst)
+	}
+	if g.ibSize < ibSize {
+		if g.ib.Truthy() {
+			g.ib.Call("destroy")
+		}
+		g.ibSize = pow2(ibSize)
+		g.ib = g.createBuffer(g.ibSize, bufferUsageIndex|bufferUsageCopyDst)
+	}
+
+	g.queue.Call("writeBuffer", g.vb, 0, tmpUint8ArrayFromSlice(vertices))
+	g.queue.Call("writeBuffer", g.ib, 0, tmpUint8ArrayFromSlice(indices))
+
+	return nil
+}
+
+func (g *Graphics) endPassIfNeeded() {
+	if !g.pass.Truthy() {
+		return
+	}
+	g.pass.Call("end")
+	g.pass = js.Undefined()
+	g.lastDst = nil
+}
+
+func (g *Graphics) flushIfNeeded() {
+	g.endPassIfNeeded()
+	if !g.encoder.Truthy() {
+		return
+	}
+
+	g.queue.Call("submit", js.ValueOf([]any{g.encoder.Call("finish")}))
+	g.encoder = js.Undefined()
+
+	// queue.writeBuffer after the submission doesn't affect the submitted commands, so the uniform buffer can be reused.
+	g.uniformOffset = 0
+
+	// Destroying a texture after the submission is allowed. The actual destruction is deferred
+	// until the GPU finishes using it.
+	for _, t := range g.disposedTextures {
+		t.Call("destroy")
+	}
+	g.disposedTextures = g.disposedTextures[:0]
+}
+
+func (g *Graphics) ensureEncoder() js.Value {
+	if !g.encoder.Truthy() {
+		g.encoder = g.device.Call("createCommandEncoder")
+	}
+	return g.encoder
+}
+
+func (g *Graphics) checkSize(width, height int) {
+	if width < 1 {
+		panic(fmt.Sprintf("webgpu: width (%d) must be equal or more than %d", width, 1))
+	}
+	if height < 1 {
+		panic(fmt.Sprintf("webgpu: height (%d) must be equal or more than %d", height, 1))
+	}
+	m := g.MaxImageSize()
+	if width > m {
+		panic(fmt.Sprintf("webgpu: width (%d) must be less than or equal to %d", width, m))
+	}
+	if height > m {
+		panic(fmt.Sprintf("webgpu: height (%d) must be less than or equal to %d", height, m))
+	}
+}
+
+func (g *Graphics) genNextImageID() graphicsdriver.ImageID {
+	g.nextImageID++
+	return g.nextImageID
+}
+
+func (g *Graphics) genNextShaderID() graphicsdriver.ShaderID {
+	g.nextShaderID++
+	return g.nextShaderID
+}
+
+func (g *Graphics) NewImage(width, height int) (graphicsdriver.Image, error) {
+	g.checkSize(width, height)
+	t := g.createTexture(graphics.InternalImageSize(width), graphics.InternalImageSize(height), textureFormat,
+		textureUsageBinding|textureUsageRender|textureUsageCopySrc|textureUsageCopyDst)
+	i := &Image{
+		id:       g.genNextImageID(),
+		graphics: g,
+		width:    width,
+		height:   height,
+		texture:  t,
+	}
+	g.addImage(i)
+	return i, nil
+}
+
+func (g *Graphics) NewScreenFramebufferImage(width, height int) (graphicsdriver.Image, error) {
+	i := &Image{
+		id:       g.genNextImageID(),
+		graphics: g,
+		width:    width,
+		height:   height,
+		screen:   true,
+	}
+	g.addImage(i)
+	return i, nil
+}
+
+func (g *Graphics) addImage(img *Image) {
+	if g.images == nil {
+		g.images = map[graphicsdriver.ImageID]*Image{}
+	}
+	if _, ok := g.images[img.id]; ok {
+		panic(fmt.Sprintf("webgpu: image ID %d was already registered", img.id))
+	}
+	g.images[img.id] = img
+}
+
+func (g *Graphics) removeImage(img *Image) {
+	delete(g.images, img.id)
+}
+
+func (g *Graphics) currentScreenTexture() js.Value {
+	if !g.screenTexture.Truthy() {
+		g.screenTexture = g.context.Call("getCurrentTexture")
+	}
+	return g.screenTexture
+}
+
+// writeUniforms writes the uniform variables to the uniform buffer, and returns the offset in bytes.
+// writeUniforms might submit the recorded commands when the uniform buffer is full.
+func (g *Graphics) writeUniforms(uniforms []uint32) int {
+	size := 4 * len(uniforms)
+	if g.uniformBindingSize < size {
+		g.uniformBindingSize = pow2(size)
+		clear(g.bindGroups)
+	}
+
+	if g.uniformOffset+g.uniformBindingSize > g.uniformBufferSize {
+		// The buffer is full. Submit the commands to reuse the buffer from the beginning.
+		g.flushIfNeeded()
+		if g.uniformBindingSize > g.uniformBufferSize {
+			if g.uniformBuffer.Truthy() {
+				g.uniformBuffer.Call("destroy")
+			}
+			g.uniformBufferSize = max(pow2(g.uniformBindingSize), 1<<20)
+			g.uniformBuffer = g.createBuffer(g.uniformBufferSize, bufferUsageStorage|bufferUsageCopyDst)
+			clear(g.bindGroups)
+		}
+	}
+
+	offset := g.uniformOffset
+	g.queue.Call("writeBuffer", g.uniformBuffer, offset, tmpUint8ArrayFromSlice(uniforms))
+	g.uniformOffset = (offset + size + g.uniformAlignment - 1) / g.uniformAlignment * g.uniformAlignment
+	return offset
+}
+
+func (g *Graphics) bindGroup(srcs [graphics.ShaderSrcImageCount]*Image) js.Value {
+	var key [graphics.ShaderSrcImageCount]graphicsdriver.ImageID
+	for i, src := range srcs {
+		if src != nil && !src.screen {
+			key[i] = src.id
+		}
+	}
+	if bg, ok := g.bindGroups[key]; ok {
+		return bg
+	}
+
+	var entries []any
+	{
+		e := object.New()
+		e.Set("binding", wgsl.UniformBinding)
+		r := object.New()
+		r.Set("buffer", g.uniformBuffer)
+		r.Set("size", g.uniformBindingSize)
+		e.Set("resource", r)
+		entries = append(entries, e)
+	}
+	for i, src := range srcs {
+		t := g.dummyTexture
+		if key[i] != 0 {
+			t = src.texture
+		}
+		e := object.New()
+		e.Set("binding", wgsl.TextureBinding(i))
+		e.Set("resource", t.Call("createView"))
+		entries = append(entries, e)
+	}
+	{
+		e := object.New()
+		e.Set("binding", wgsl.SamplerBinding)
+		e.Set("resource", g.sampler)
+		entries = append(entries, e)
+	}
+	desc := object.New()
+	desc.Set("layout", g.bindGroupLayout)
+	desc.Set("entries", js.ValueOf(entries))
+	bg := g.device.Call("createBindGroup", desc)
+
+	if g.bindGroups == nil {
+		g.bindGroups = map[[graphics.ShaderSrcImageCount]graphicsdriver.ImageID]js.Value{}
+	}
+	g.bindGroups[key] = bg
+	return bg
+}
+
+func (g *Graphics) removeBindGroups(id graphicsdriver.ImageID) {
+	for key := range g.bindGroups {
+		for _, k := range key {
+			if k == id {
+				delete(g.bindGroups, key)
+				break
+			}
+		}
+	}
+}
+
+func (g *Graphics) draw(dst *Image, dstRegions []graphicsdriver.DstRegion, srcs [graphics.ShaderSrcImageCount]*Image, indexOffset int, shader *Shader, uniforms []uint32, blend graphicsdriver.Blend, fillRule graphicsdriver.FillRule) error {
+	// Write the uniform variables first, as this might end the current render pass.
+	uniforms = adjustUniforms(shader.ir.Uniforms, shader.uniformOffsets, shader.uniformSize, uniforms)
+	uniformOffset := g.writeUniforms(uniforms)
+
+	// When preparing a stencil buffer, end the current render pass to make sure the stencil buffer is cleared
+	// when loading.
+	if g.lastDst != dst || g.lastFillRule != fillRule || fillRule != graphicsdriver.FillRuleFillAll {
+		g.endPassIfNeeded()
+	}
+	g.lastDst = dst
+	g.lastFillRule = fillRule
+
+	if !g.pass.Truthy() {
+		ca := object.New()
+		if dst.screen {
+			ca.Set("view", g.currentScreenTexture().Call("createView"))
+			if g.screenCleared {
+				ca.Set("loadOp", "load")
+			} else {
+				ca.Set("loadOp", "clear")
+				ca.Set("clearValue", js.ValueOf([]any{0, 0, 0, 0}))
+				g.screenCleared = true
+			}
+		} else {
+			ca.Set("view", dst.texture.Call("createView"))
+			ca.Set("loadOp", "load")
+		}
+		ca.Set("storeOp", "store")
+
+		desc := object.New()
+		desc.Set("colorAttachments", js.ValueOf([]any{ca}))
+
+		if fillRule != graphicsdriver.FillRuleFillAll {
+			dst.ensureStencil()
+			dsa := object.New()
+			dsa.Set("view", dst.stencil.Call("createView"))
+			dsa.Set("stencilLoadOp", "clear")
+			dsa.Set("stencilStoreOp", "discard")
+			dsa.Set("stencilClearValue", 0)
+			desc.Set("depthStencilAttachment", dsa)
+		}
+
+		g.pass = g.ensureEncoder().Call("beginRenderPass", desc)
+	}
+
+	w, h := dst.internalSize()
+	g.pass.Call("setViewport", 0, 0, w, h, 0, 1)
+	g.pass.Call("setVertexBuffer", 0, g.vb)
+	g.pass.Call("setIndexBuffer", g.ib, "uint32")
+
+	g.pass.Call("setBindGroup", 0, g.bindGroup(srcs), js.ValueOf([]any{uniformOffset}))
+
+	var (
+		firstPipeline    js.Value
+		drawWithStencilP js.Value
+	)
+	switch fillRule {
+	case graphicsdriver.FillRuleFillAll:
+		firstPipeline = shader.renderPipeline(g, blend, noStencil, dst.screen)
+	case graphicsdriver.FillRuleNonZero:
+		firstPipeline = shader.renderPipeline(g, blend, incrementStencil, dst.screen)
+	case graphicsdriver.FillRuleEvenOdd:
+		firstPipeline = shader.renderPipeline(g, blend, invertStencil, dst.screen)
+	}
+	if fillRule != graphicsdriver.FillRuleFillAll {
+		drawWithStencilP = shader.renderPipeline(g, blend, drawWithStencil, dst.screen)
+	}
+
+	for _, dstRegion := range dstRegions {
+		r := dstRegion.Region.Intersect(image.Rect(0, 0, w, h))
+		if r.Empty() {
+			indexOffset += dstRegion.IndexCount
+			continue
+		}
+		g.pass.Call("setScissorRect", r.Min.X, r.Min.Y, r.Dx(), r.Dy())
+
+		g.pass.Call("setPipeline", firstPipeline)
+		g.pass.Call("drawIndexed", dstRegion.IndexCount, 1, indexOffset)
+		if fillRule != graphicsdriver.FillRuleFillAll {
+			g.pass.Call("setPipeline", drawWithStencilP)
+			g.pass.Call("drawIndexed", dstRegion.IndexCount, 1, indexOffset)
+		}
+
+		indexOffset += dstRegion.IndexCount
+	}
+
+	return nil
+}
+
+func (g *Graphics) DrawTriangles(dstID graphicsdriver.ImageID, srcIDs [graphics.ShaderSrcImageCount]graphicsdriver.ImageID, shaderID graphicsdriver.ShaderID, dstRegions []graphicsdriver.DstRegion, indexOffset int, blend graphicsdriver.Blend, uniforms []uint32, fillRule graphicsdriver.FillRule) error {
+	if shaderID == graphicsdriver.InvalidShaderID {
+		return fmt.Errorf("webgpu: shader ID is invalid")
+	}
+
+	dst := g.images[dstID]
+
+	var srcs [graphics.ShaderSrcImageCount]*Image
+	for i, srcID := range srcIDs {
+		srcs[i] = g.images[srcID]
+	}
+
+	if err := g.draw(dst, dstRegions, srcs, indexOffset, g.shaders[shaderID], uniforms, blend, fillRule); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (g *Graphics) SetVsyncEnabled(enabled bool) {
+	// In browsers, vsync is always enabled.
+}
+
+func (g *Graphics) NeedsClearingScreen() bool {
+	return false
+}
+
+func (g *Graphics) MaxImageSize() int {
+	return g.maxImageSize
+}
+
+func (g *Graphics) IsFloatTextureRenderable() bool {
+	return true
+}
+
+func (g *Graphics) NewShader(program *shaderir.Program) (graphicsdriver.Shader, error) {
+	s, err := newShader(g.device, g.genNextShaderID(), program)
+	if err != nil {
+		return nil, err
+	}
+	g.addShader(s)
+	return s, nil
+}
+
+func (g *Graphics) addShader(shader *Shader) {
+	if g.shaders == nil {
+		g.shaders = map[graphicsdriver.ShaderID]*Shader{}
+	}
+	if _, ok := g.shaders[shader.id]; ok {
+		panic(fmt.Sprintf("webgpu: shader ID %d was already registered", shader.id))
+	}
+	g.shaders[shader.id] = shader
+}
+
+func (g *Graphics) removeShader(shader *Shader) {
+	delete(g.shaders, shader.id)
+}
+
+type Image struct {
+	id       graphicsdriver.ImageID
+	graphics *Graphics
+	width    int
+	height   int
+	screen   bool
+	texture  js.Value
+	stencil  js.Value
+
+	stencilWidth  int
+	stencilHeight int
+}
+
+func (i *Image) ID() graphicsdriver.ImageID {
+	return i.id
+}
+
+func (i *Image) internalSize() (int, int) {
+	if i.screen {
+		t := i.graphics.currentScreenTexture()
+		return t.Get("width").Int(), t.Get("height").Int()
+	}
+	return graphics.InternalImageSize(i.width), graphics.InternalImageSize(i.height)
+}
+
+func (i *Image) Dispose() {
+	// The textures might be used by the recorded commands. Destroy them after the submission.
+	if i.stencil.Truthy() {
+		i.graphics.disposedTextures = append(i.graphics.disposedTextures, i.stencil)
+		i.stencil = js.Undefined()
+	}
+	if i.texture.Truthy() {
+		i.graphics.disposedTextures = append(i.graphics.disposedTextures, i.texture)
+		i.texture = js.Undefined()
+	}
+	i.graphics.removeBindGroups(i.id)
+	i.graphics.removeImage(i)
+}
+
+func (i *Image) ReadPixels(args []graphicsdriver.PixelsArgs) error {
+	if i.screen {
+		return errors.New("webgpu: reading pixels from the screen is not supported")
+	}
+
+	g := i.graphics
+	g.flushIfNeeded()
+
+	// bytesPerRow must be a multiple of 256 in copyTextureToBuffer.
+	const rowAlignment = 256
+
+	type readBuffer struct {
+		buffer      js.Value
+		bytesPerRow int
+	}
+	bufs := make([]readBuffer, 0, len(args))
+	encoder := g.ensureEncoder()
+	for _, arg := range args {
+		if got, want := len(arg.Pixels), 4*arg.Region.Dx()*arg.Region.Dy(); got != want {
+			return fmt.Errorf("webgpu: len(buf) must be %d but %d at ReadPixels", want, got)
+		}
+
+		bytesPerRow := (4*arg.Region.Dx() + rowAlignment - 1) / rowAlignment * rowAlignment
+		b := g.createBuffer(bytesPerRow*arg.Region.Dy(), bufferUsageMapRead|bufferUsageCopyDst)
+
+		src := object.New()
+		src.Set("texture", i.texture)
+		origin := object.New()
+		origin.Set("x", arg.Region.Min.X)
+		origin.Set("y", arg.Region.Min.Y)
+		src.Set("origin", origin)
+
+		dst := object.New()
+		dst.Set("buffer", b)
+		dst.Set("bytesPerRow", bytesPerRow)
+
+		size := object.New()
+		size.Set("width", arg.Region.Dx())
+		size.Set("height", arg.Region.Dy())
+
+		encoder.Call("copyTextureToBuffer", src, dst, size)
+		bufs = append(bufs, readBuffer{
+			buffer:      b,
+			bytesPerRow: bytesPerRow,
+		})
+	}
+	g.flushIfNeeded()
+
+	for idx, arg := range args {
+		b := bufs[idx]
+		if _, err := await(b.buffer.Call("mapAsync", mapModeRead)); err != nil {
+			return fmt.Errorf("webgpu: mapAsync failed: %w", err)
+		}
+		data := uint8Array.New(b.buffer.Call("getMappedRange"))
+		w, h := arg.Region.Dx(), arg.Region.Dy()
+		for j := 0; j < h; j++ {
+			js.CopyBytesToGo(arg.Pixels[4*w*j:4*w*(j+1)], data.Call("subarray", b.bytesPerRow*j, b.bytesPerRow*j+4*w))
+		}
+		b.buffer.Call("unmap")
+		b.buffer.Call("destroy")
+	}
+	return nil
+}
+
+func (i *Image) WritePixels(args []graphicsdriver.PixelsArgs) error {
+	g := i.graphics
+
+	// queue.writeTexture is executed before the next submission. Submit the recorded commands first
+	// so that they use the previous pixels.
+	g.flushIfNeeded()
+
+	for _, a := range args {
+		dst := object.New()
+		dst.Set("texture", i.texture)
+		origin := object.New()
+		origin.Set("x", a.Region.Min.X)
+		origin.Set("y", a.Region.Min.Y)
+		dst.Set("origin", origin)
+
+		layout := object.New()
+		layout.Set("bytesPerRow", 4*a.Region.Dx())
+
+		size := object.New()
+		size.Set("width", a.Region.Dx())
+		size.Set("height", a.Region.Dy())
+
+		g.queue.Call("writeTexture", dst, tmpUint8ArrayFromSlice(a.Pixels), layout, size)
+	}
+
+	return nil
+}
+
+func (i *Image) ensureStencil() {
+	// The screen size might be changed.
+	w, h := i.internalSize()
+	if i.stencil.Truthy() {
+		if i.stencilWidth == w && i.stencilHeight == h {
+			return
+		}
+		i.graphics.disposedTextures = append(i.graphics.disposedTextures, i.stencil)
+	}
+	i.stencil = i.graphics.createTexture(w, h, stencilFormat, textureUsageRender)
+	i.stencilWidth = w
+	i.stencilHeight = h
+}
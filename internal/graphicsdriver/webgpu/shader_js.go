@@ -0,0 +1,316 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webgpu
+
+import (
+	"fmt"
+	"strings"
+	"syscall/js"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/graphics"
+	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver"
+	"github.com/hajimehoshi/ebiten/v2/internal/shaderir"
+	"github.com/hajimehoshi/ebiten/v2/internal/shaderir/wgsl"
+)
+
+type shaderPipelineKey struct {
+	blend       graphicsdriver.Blend
+	stencilMode stencilMode
+	screen      bool
+}
+
+type Shader struct {
+	id graphicsdriver.ShaderID
+
+	ir        *shaderir.Program
+	module    js.Value
+	pipelines map[shaderPipelineKey]js.Value
+
+	uniformOffsets []int
+	uniformSize    int
+}
+
+func newShader(device js.Value, id graphicsdriver.ShaderID, program *shaderir.Program) (*Shader, error) {
+	offsets, size := wgsl.UniformVariableOffsetsInDwords(program)
+	s := &Shader{
+		id:             id,
+		ir:             program,
+		pipelines:      map[shaderPipelineKey]js.Value{},
+		uniformOffsets: offsets,
+		uniformSize:    size,
+	}
+	if err := s.init(device); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Shader) ID() graphicsdriver.ShaderID {
+	return s.id
+}
+
+func (s *Shader) Dispose() {
+	// GPUShaderModule and GPURenderPipeline don't have explicit destructors. They are garbage-collected.
+	s.module = js.Undefined()
+	s.pipelines = nil
+}
+
+func (s *Shader) init(device js.Value) error {
+	src := wgsl.Compile(s.ir)
+
+	desc := object.New()
+	desc.Set("code", src)
+	s.module = device.Call("createShaderModule", desc)
+
+	info, err := await(s.module.Call("getCompilationInfo"))
+	if err != nil {
+		return fmt.Errorf("webgpu: getCompilationInfo failed: %w", err)
+	}
+	var msgs []string
+	ms := info.Get("messages")
+	for i := 0; i < ms.Length(); i++ {
+		m := ms.Index(i)
+		if m.Get("type").String() != "error" {
+			continue
+		}
+		msgs = append(msgs, fmt.Sprintf("%d:%d: %s", m.Get("lineNum").Int(), m.Get("linePos").Int(), m.Get("message").String()))
+	}
+	if len(msgs) > 0 {
+		return fmt.Errorf("webgpu: createShaderModule failed: %s, source: %s", strings.Join(msgs, "\n"), src)
+	}
+	return nil
+}
+
+func blendFactorToWebGPUBlendFactor(c graphicsdriver.BlendFactor) string {
+	switch c {
+	case graphicsdriver.BlendFactorZero:
+		return "zero"
+	case graphicsdriver.BlendFactorOne:
+		return "one"
+	case graphicsdriver.BlendFactorSourceColor:
+		return "src"
+	case graphicsdriver.BlendFactorOneMinusSourceColor:
+		return "one-minus-src"
+	case graphicsdriver.BlendFactorSourceAlpha:
+		return "src-alpha"
+	case graphicsdriver.BlendFactorOneMinusSourceAlpha:
+		return "one-minus-src-alpha"
+	case graphicsdriver.BlendFactorDestinationColor:
+		return "dst"
+	case graphicsdriver.BlendFactorOneMinusDestinationColor:
+		return "one-minus-dst"
+	case graphicsdriver.BlendFactorDestinationAlpha:
+		return "dst-alpha"
+	case graphicsdriver.BlendFactorOneMinusDestinationAlpha:
+		return "one-minus-dst-alpha"
+	case graphicsdriver.BlendFactorSourceAlphaSaturated:
+		return "src-alpha-saturated"
+	default:
+		panic(fmt.Sprintf("webgpu: invalid blend factor: %d", c))
+	}
+}
+
+func blendOperationToWebGPUBlendOperation(o graphicsdriver.BlendOperation) string {
+	switch o {
+	case graphicsdriver.BlendOperationAdd:
+		return "add"
+	case graphicsdriver.BlendOperationSubtract:
+		return "subtract"
+	case graphicsdriver.BlendOperationReverseSubtract:
+		return "reverse-subtract"
+	case graphicsdriver.BlendOperationMin:
+		return "min"
+	case graphicsdriver.BlendOperationMax:
+		return "max"
+	default:
+		panic(fmt.Sprintf("webgpu: invalid blend operation: %d", o))
+	}
+}
+
+func blendComponent(src, dst graphicsdriver.BlendFactor, op graphicsdriver.BlendOperation) js.Value {
+	c := object.New()
+	c.Set("operation", blendOperationToWebGPUBlendOperation(op))
+	// In WebGPU, the factors must be 'one' for the min and max operations.
+	if op == graphicsdriver.BlendOperationMin || op == graphicsdriver.BlendOperationMax {
+		c.Set("srcFactor", "one")
+		c.Set("dstFactor", "one")
+		return c
+	}
+	c.Set("srcFactor", blendFactorToWebGPUBlendFactor(src))
+	c.Set("dstFactor", blendFactorToWebGPUBlendFactor(dst))
+	return c
+}
+
+func stencilFaceState(compare, passOp string) js.Value {
+	s := object.New()
+	s.Set("compare", compare)
+	s.Set("failOp", "keep")
+	s.Set("depthFailOp", "keep")
+	s.Set("passOp", passOp)
+	return s
+}
+
+func (s *Shader) renderPipeline(g *Graphics, blend graphicsdriver.Blend, stencilMode stencilMode, screen bool) js.Value {
+	key := shaderPipelineKey{
+		blend:       blend,
+		stencilMode: stencilMode,
+		screen:      screen,
+	}
+	if p, ok := s.pipelines[key]; ok {
+		return p
+	}
+
+	var attrs []any
+	var offset int
+	for i, a := range s.ir.Attributes {
+		attr := object.New()
+		attr.Set("shaderLocation", i)
+		attr.Set("offset", 4*offset)
+		n := a.DwordCount()
+		if n == 1 {
+			attr.Set("format", "float32")
+		} else {
+			attr.Set("format", fmt.Sprintf("float32x%d", n))
+		}
+		attrs = append(attrs, attr)
+		offset += n
+	}
+	vbLayout := object.New()
+	vbLayout.Set("arrayStride", 4*graphics.VertexFloatCount)
+	vbLayout.Set("attributes", js.ValueOf(attrs))
+
+	vertex := object.New()
+	vertex.Set("module", s.module)
+	vertex.Set("entryPoint", wgsl.VertexName)
+	vertex.Set("buffers", js.ValueOf([]any{vbLayout}))
+
+	format := textureFormat
+	if screen {
+		format = g.screenFormat
+	}
+	b := object.New()
+	b.Set("color", blendComponent(blend.BlendFactorSourceRGB, blend.BlendFactorDestinationRGB, blend.BlendOperationRGB))
+	b.Set("alpha", blendComponent(blend.BlendFactorSourceAlpha, blend.BlendFactorDestinationAlpha, blend.BlendOperationAlpha))
+	target := object.New()
+	target.Set("format", format)
+	target.Set("blend", b)
+	if stencilMode == incrementStencil || stencilMode == invertStencil {
+		// Only the stencil buffer is updated.
+		target.Set("writeMask", 0)
+	}
+
+	fragment := object.New()
+	fragment.Set("module", s.module)
+	fragment.Set("entryPoint", wgsl.FragmentName)
+	fragment.Set("targets", js.ValueOf([]any{target}))
+
+	primitive := object.New()
+	primitive.Set("topology", "triangle-list")
+	primitive.Set("cullMode", "none")
+
+	desc := object.New()
+	desc.Set("layout", g.pipelineLayout)
+	desc.Set("vertex", vertex)
+	desc.Set("fragment", fragment)
+	desc.Set("primitive", primitive)
+
+	// The stencil reference value is always 0 (default).
+	if stencilMode != noStencil {
+		ds := object.New()
+		ds.Set("format", stencilFormat)
+		switch stencilMode {
+		case incrementStencil:
+			ds.Set("stencilFront", stencilFaceState("always", "increment-wrap"))
+			ds.Set("stencilBack", stencilFaceState("always", "decrement-wrap"))
+		case invertStencil:
+			ds.Set("stencilFront", stencilFaceState("always", "invert"))
+			ds.Set("stencilBack", stencilFaceState("always", "invert"))
+		case drawWithStencil:
+			ds.Set("stencilFront", stencilFaceState("not-equal", "keep"))
+			ds.Set("stencilBack", stencilFaceState("not-equal", "keep"))
+		}
+		desc.Set("depthStencil", ds)
+	}
+
+	p := g.device.Call("createRenderPipeline", desc)
+	s.pipelines[key] = p
+	return p
+}
+
+// adjustUniforms returns adjusted uniform variables to match the WGSL's memory layout.
+func adjustUniforms(uniformTypes []shaderir.Type, uniformOffsets []int, uniformSize int, uniforms []uint32) []uint32 {
+	// A storage buffer binding must not be empty. Use at least 16 bytes.
+	size := uniformSize
+	if size < 4 {
+		size = 4
+	}
+	fs := make([]uint32, size)
+
+	var idx int
+	for i, typ := range uniformTypes {
+		offset := uniformOffsets[i]
+		n := typ.DwordCount()
+		switch typ.Main {
+		case shaderir.Float, shaderir.Int, shaderir.Vec2, shaderir.IVec2, shaderir.Vec3, shaderir.IVec3,
+			shaderir.Vec4, shaderir.IVec4, shaderir.Mat2:
+			copy(fs[offset:], uniforms[idx:idx+n])
+		case shaderir.Mat3:
+			// Each column is aligned to 16 bytes.
+			for j := 0; j < 3; j++ {
+				copy(fs[offset+4*j:], uniforms[idx+3*j:idx+3*(j+1)])
+			}
+		case shaderir.Mat4:
+			if i == graphics.ProjectionMatrixUniformVariableIndex {
+				// In WebGPU, the NDC's Y direction (upward) and the framebuffer's Y direction (downward) don't
+				// match. Then, the Y direction must be inverted.
+				// Invert the sign bits as float32 values.
+				u := uniforms[idx : idx+16]
+				copy(fs[offset:], []uint32{
+					u[0], u[1] ^ uint32(1<<31), u[2], u[3],
+					u[4], u[5] ^ uint32(1<<31), u[6], u[7],
+					u[8], u[9] ^ uint32(1<<31), u[10], u[11],
+					u[12], u[13] ^ uint32(1<<31), u[14], u[15],
+				})
+			} else {
+				copy(fs[offset:], uniforms[idx:idx+n])
+			}
+		case shaderir.Array:
+			switch typ.Sub[0].Main {
+			case shaderir.Float, shaderir.Int, shaderir.Vec2, shaderir.IVec2, shaderir.Vec4, shaderir.IVec4,
+				shaderir.Mat2, shaderir.Mat4:
+				copy(fs[offset:], uniforms[idx:idx+n])
+			case shaderir.Vec3, shaderir.IVec3:
+				// The stride of vec3 arrays is 16 bytes.
+				for j := 0; j < typ.Length; j++ {
+					copy(fs[offset+4*j:], uniforms[idx+3*j:idx+3*(j+1)])
+				}
+			case shaderir.Mat3:
+				for j := 0; j < typ.Length; j++ {
+					for k := 0; k < 3; k++ {
+						copy(fs[offset+12*j+4*k:], uniforms[idx+9*j+3*k:idx+9*j+3*(k+1)])
+					}
+				}
+			default:
+				panic(fmt.Sprintf("webgpu: not implemented type for uniform variables: %s", typ.String()))
+			}
+		default:
+			panic(fmt.Sprintf("webgpu: not implemented type for uniform variables: %s", typ.String()))
+		}
+
+		idx += n
+	}
+
+	return fs
+}
@@ -42,6 +42,27 @@ func RunBeforeUpdateHooks() error {
 	return nil
 }
 
+var onAfterFrameHooks []func()
+
+// AppendHookOnAfterFrame appends a hook function that is run after the main update and draw functions
+// every frame.
+func AppendHookOnAfterFrame(f func()) {
+	m.Lock()
+	onAfterFrameHooks = append(onAfterFrameHooks, f)
+	m.Unlock()
+}
+
+func RunAfterFrameHooks() {
+	m.Lock()
+	hooks := onAfterFrameHooks
+	m.Unlock()
+
+	// Run the hooks without the lock, as a hook might call other functions in this package.
+	for _, f := range hooks {
+		f()
+	}
+}
+
 var (
 	audioSuspended bool
 	onSuspendAudio func() error
@@ -85,3 +106,34 @@ func ResumeAudio() error {
 	}
 	return nil
 }
+
+// LifecycleEvent represents an application lifecycle event.
+type LifecycleEvent int
+
+const (
+	LifecycleEventBackground LifecycleEvent = iota
+	LifecycleEventForeground
+	LifecycleEventLowMemory
+	LifecycleEventGraphicsContextLost
+	LifecycleEventGraphicsContextRestored
+)
+
+var onLifecycleEventHooks []func(event LifecycleEvent)
+
+// AppendHookOnLifecycleEvent appends a hook function that is run when an application lifecycle event happens.
+func AppendHookOnLifecycleEvent(f func(event LifecycleEvent)) {
+	m.Lock()
+	onLifecycleEventHooks = append(onLifecycleEventHooks, f)
+	m.Unlock()
+}
+
+func RunLifecycleEventHooks(event LifecycleEvent) {
+	m.Lock()
+	hooks := onLifecycleEventHooks
+	m.Unlock()
+
+	// Run the hooks without the lock, as a hook might call other functions in this package.
+	for _, f := range hooks {
+		f(event)
+	}
+}
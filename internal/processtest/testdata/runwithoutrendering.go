@@ -0,0 +1,54 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build ignore
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// This program is run without a display.
+
+type Game struct {
+	count int
+}
+
+func (g *Game) Update() error {
+	if got, want := ebiten.Tick(), int64(g.count); got != want {
+		panic(fmt.Sprintf("ebiten.Tick(): got: %d, want: %d", got, want))
+	}
+	g.count++
+	if g.count == 16 {
+		return ebiten.Termination
+	}
+	return nil
+}
+
+func (g *Game) Draw(screen *ebiten.Image) {
+	panic("Draw must not be called")
+}
+
+func (g *Game) Layout(width, height int) (int, int) {
+	panic("Layout must not be called")
+}
+
+func main() {
+	if err := ebiten.RunGameWithoutRendering(&Game{}); err != nil {
+		panic(err)
+	}
+}
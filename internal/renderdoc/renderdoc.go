@@ -0,0 +1,56 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package renderdoc provides a minimal binding of RenderDoc's in-application API.
+//
+// The API is available only when the application is launched from RenderDoc,
+// as this package never loads RenderDoc's library by itself.
+//
+// See https://renderdoc.org/docs/in_application_api.html.
+package renderdoc
+
+import (
+	"sync"
+)
+
+const (
+	// apiVersion_1_1_2 is eRENDERDOC_API_Version_1_1_2.
+	apiVersion_1_1_2 = 10102
+
+	// triggerCaptureIndex is the index of TriggerCapture in RENDERDOC_API_1_1_2.
+	triggerCaptureIndex = 15
+)
+
+var (
+	// api is a pointer to RENDERDOC_API_1_1_2, which is a table of function pointers.
+	api     *[triggerCaptureIndex + 1]uintptr
+	apiOnce sync.Once
+)
+
+// IsAvailable reports whether RenderDoc is attached to the application.
+func IsAvailable() bool {
+	apiOnce.Do(func() {
+		api = loadAPI()
+	})
+	return api != nil
+}
+
+// TriggerCapture captures the next frame.
+// TriggerCapture does nothing if RenderDoc is not attached.
+func TriggerCapture() {
+	if !IsAvailable() {
+		return
+	}
+	callAPI(api[triggerCaptureIndex])
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !android
+
+package renderdoc
+
+import (
+	"unsafe"
+
+	"github.com/ebitengine/purego"
+)
+
+// rtldNoload is RTLD_NOLOAD on Linux.
+const rtldNoload = 0x4
+
+func loadAPI() *[triggerCaptureIndex + 1]uintptr {
+	// Use RTLD_NOLOAD not to load RenderDoc's library if it is not injected yet.
+	lib, err := purego.Dlopen("librenderdoc.so", purego.RTLD_NOW|rtldNoload)
+	if err != nil {
+		return nil
+	}
+	getAPI, err := purego.Dlsym(lib, "RENDERDOC_GetAPI")
+	if err != nil {
+		return nil
+	}
+	var api *[triggerCaptureIndex + 1]uintptr
+	if r, _, _ := purego.SyscallN(getAPI, apiVersion_1_1_2, uintptr(unsafe.Pointer(&api))); r != 1 {
+		return nil
+	}
+	return api
+}
+
+func callAPI(f uintptr) {
+	purego.SyscallN(f)
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package renderdoc
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+func loadAPI() *[triggerCaptureIndex + 1]uintptr {
+	name, err := windows.UTF16PtrFromString("renderdoc.dll")
+	if err != nil {
+		return nil
+	}
+	// GetModuleHandleEx doesn't load the library if it is not injected yet.
+	var module windows.Handle
+	if err := windows.GetModuleHandleEx(0, name, &module); err != nil {
+		return nil
+	}
+	getAPI, err := windows.GetProcAddress(module, "RENDERDOC_GetAPI")
+	if err != nil {
+		return nil
+	}
+	var api *[triggerCaptureIndex + 1]uintptr
+	if r, _, _ := syscall.SyscallN(getAPI, apiVersion_1_1_2, uintptr(unsafe.Pointer(&api))); r != 1 {
+		return nil
+	}
+	return api
+}
+
+func callAPI(f uintptr) {
+	_, _, _ = syscall.SyscallN(f)
+}
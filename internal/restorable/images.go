@@ -30,7 +30,7 @@ import (
 var forceRestoration = false
 
 // disabled indicates that restoration is disabled or not.
-// Restoration is enabled by default for some platforms like Android for safety.
+// Restoration is enabled by default for some platforms like Android and browsers for safety.
 // Before SetGame, it is not possible to determine whether restoration is needed or not.
 var disabled atomic.Bool
 
@@ -47,7 +47,7 @@ func needsRestoration() bool {
 		return true
 	}
 	// TODO: If Vulkan is introduced, restoration might not be needed.
-	if runtime.GOOS == "android" {
+	if runtime.GOOS == "android" || runtime.GOOS == "js" {
 		return !disabled.Load()
 	}
 	return false
@@ -60,9 +60,10 @@ func AlwaysReadPixelsFromGPU() bool {
 
 // images is a set of Image objects.
 type images struct {
-	images      map[*Image]struct{}
-	shaders     map[*Shader]struct{}
-	contextLost atomic.Bool
+	images          map[*Image]struct{}
+	shaders         map[*Shader]struct{}
+	contextLost     atomic.Bool
+	contextRestored atomic.Bool
 }
 
 // theImages represents the images for the current process.
@@ -112,14 +113,27 @@ func RestoreIfNeeded(graphicsDriver graphicsdriver.Graphics) error {
 		return nil
 	}
 
-	if !forceRestoration && !theImages.contextLost.Load() {
+	contextLost := theImages.contextLost.Load()
+	if !forceRestoration && !contextLost {
 		return nil
 	}
 
 	if err := graphicscommand.ResetGraphicsDriverState(graphicsDriver); err != nil {
 		return err
 	}
-	return theImages.restore(graphicsDriver)
+	if err := theImages.restore(graphicsDriver); err != nil {
+		return err
+	}
+	if contextLost {
+		theImages.contextRestored.Store(true)
+	}
+	return nil
+}
+
+// TakeContextRestored reports whether the images were restored from the context lost since the last call,
+// and resets the state.
+func TakeContextRestored() bool {
+	return theImages.contextRestored.Swap(false)
 }
 
 // DumpImages dumps all the current images to the specified directory.
@@ -294,3 +308,18 @@ func MaxImageSize(graphicsDriver graphicsdriver.Graphics) int {
 func OnContextLost() {
 	theImages.contextLost.Store(true)
 }
+
+// BeginGPUMarker begins a GPU marker to measure GPU time.
+func BeginGPUMarker(name string) {
+	graphicscommand.BeginGPUMarker(name)
+}
+
+// EndGPUMarker ends the last begun GPU marker.
+func EndGPUMarker() {
+	graphicscommand.EndGPUMarker()
+}
+
+// SetDrawDebugLabel sets the debug label for the following draw commands.
+func SetDrawDebugLabel(label string) {
+	graphicscommand.SetDrawDebugLabel(label)
+}
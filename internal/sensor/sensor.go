@@ -0,0 +1,208 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sensor manages the states of device motion sensors.
+package sensor
+
+import (
+	"sync"
+	"time"
+)
+
+// Type represents a type of a motion sensor.
+type Type int
+
+const (
+	// Accelerometer measures the acceleration including the gravity in m/s^2.
+	Accelerometer Type = iota
+
+	// Gyroscope measures the rotation rate in rad/s.
+	Gyroscope
+
+	// Magnetometer measures the ambient magnetic field in μT.
+	Magnetometer
+
+	typeCount
+)
+
+// Value is a sensor value in the device's coordinate system.
+//
+// The coordinate system follows Android's: X points to the right, Y points up, and Z points toward the outside of the
+// screen's front face, in the device's natural orientation.
+type Value struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+// Driver enables or disables a sensor on the platform.
+type Driver interface {
+	SetSensorEnabled(sensorType Type, enabled bool, interval time.Duration)
+}
+
+type sensorState struct {
+	enabled  bool
+	interval time.Duration
+	value    Value
+	hasValue bool
+}
+
+var theSensors sensors
+
+type sensors struct {
+	driver Driver
+	states [typeCount]sensorState
+
+	m sync.Mutex
+}
+
+// DefaultInterval is the sampling interval used when a non-positive interval is given.
+const DefaultInterval = time.Second / 60
+
+func isValidType(sensorType Type) bool {
+	return sensorType >= 0 && sensorType < typeCount
+}
+
+// SetDriver sets the platform driver.
+// The current configurations are applied to the new driver immediately.
+func SetDriver(driver Driver) {
+	theSensors.setDriver(driver)
+}
+
+func (s *sensors) setDriver(driver Driver) {
+	s.m.Lock()
+	s.driver = driver
+	type config struct {
+		sensorType Type
+		interval   time.Duration
+	}
+	var configs []config
+	for i := range s.states {
+		if s.states[i].enabled {
+			configs = append(configs, config{
+				sensorType: Type(i),
+				interval:   s.states[i].interval,
+			})
+		}
+	}
+	s.m.Unlock()
+
+	if driver == nil {
+		return
+	}
+	// Call the driver without the lock, as the driver might call Update synchronously.
+	for _, c := range configs {
+		driver.SetSensorEnabled(c.sensorType, true, c.interval)
+	}
+}
+
+// Enable enables the sensor with the given sampling interval.
+// If interval is not positive, DefaultInterval is used.
+// The interval is a hint and the actual interval might be different.
+func Enable(sensorType Type, interval time.Duration) {
+	theSensors.enable(sensorType, interval)
+}
+
+func (s *sensors) enable(sensorType Type, interval time.Duration) {
+	if !isValidType(sensorType) {
+		return
+	}
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	s.m.Lock()
+	st := &s.states[sensorType]
+	if st.enabled && st.interval == interval {
+		s.m.Unlock()
+		return
+	}
+	st.enabled = true
+	st.interval = interval
+	d := s.driver
+	s.m.Unlock()
+
+	if d != nil {
+		d.SetSensorEnabled(sensorType, true, interval)
+	}
+}
+
+// Disable disables the sensor.
+// The last value is discarded.
+func Disable(sensorType Type) {
+	theSensors.disable(sensorType)
+}
+
+func (s *sensors) disable(sensorType Type) {
+	if !isValidType(sensorType) {
+		return
+	}
+
+	s.m.Lock()
+	st := &s.states[sensorType]
+	if !st.enabled {
+		s.m.Unlock()
+		return
+	}
+	*st = sensorState{}
+	d := s.driver
+	s.m.Unlock()
+
+	if d != nil {
+		d.SetSensorEnabled(sensorType, false, 0)
+	}
+}
+
+// Read returns the latest value of the sensor.
+// Read returns false when the sensor is disabled or no value is reported yet.
+func Read(sensorType Type) (Value, bool) {
+	return theSensors.read(sensorType)
+}
+
+func (s *sensors) read(sensorType Type) (Value, bool) {
+	if !isValidType(sensorType) {
+		return Value{}, false
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	st := &s.states[sensorType]
+	if !st.enabled || !st.hasValue {
+		return Value{}, false
+	}
+	return st.value, true
+}
+
+// Update updates the sensor value. Update is called by the platform.
+// Update is ignored when the sensor is disabled.
+func Update(sensorType Type, value Value) {
+	theSensors.update(sensorType, value)
+}
+
+func (s *sensors) update(sensorType Type, value Value) {
+	if !isValidType(sensorType) {
+		return
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	st := &s.states[sensorType]
+	if !st.enabled {
+		return
+	}
+	st.value = value
+	st.hasValue = true
+}
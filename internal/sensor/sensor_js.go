@@ -0,0 +1,144 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"math"
+	"syscall/js"
+	"time"
+)
+
+func init() {
+	SetDriver(&jsDriver{})
+}
+
+// jsDriver uses the Generic Sensor API if available, or DeviceMotionEvent otherwise.
+//
+// See https://www.w3.org/TR/generic-sensor/ and https://www.w3.org/TR/orientation-event/.
+type jsDriver struct {
+	sensors [typeCount]js.Value
+	funcs   [typeCount]js.Func
+
+	deviceMotionTypes int
+	deviceMotionFunc  js.Func
+}
+
+var genericSensorClassNames = [...]string{
+	Accelerometer: "Accelerometer",
+	Gyroscope:     "Gyroscope",
+	Magnetometer:  "Magnetometer",
+}
+
+func (j *jsDriver) SetSensorEnabled(sensorType Type, enabled bool, interval time.Duration) {
+	if enabled {
+		j.disable(sensorType)
+		j.enable(sensorType, interval)
+		return
+	}
+	j.disable(sensorType)
+}
+
+func (j *jsDriver) enable(sensorType Type, interval time.Duration) {
+	if class := js.Global().Get(genericSensorClassNames[sensorType]); class.Truthy() && j.enableGenericSensor(sensorType, class, interval) {
+		return
+	}
+
+	// Fall back to DeviceMotionEvent, which doesn't have a magnetometer.
+	if sensorType == Magnetometer {
+		return
+	}
+	if !js.Global().Get("DeviceMotionEvent").Truthy() {
+		return
+	}
+	if j.deviceMotionTypes&(1<<sensorType) != 0 {
+		return
+	}
+	if j.deviceMotionTypes == 0 {
+		j.deviceMotionFunc = js.FuncOf(func(this js.Value, args []js.Value) any {
+			j.onDeviceMotion(args[0])
+			return nil
+		})
+		js.Global().Call("addEventListener", "devicemotion", j.deviceMotionFunc)
+	}
+	j.deviceMotionTypes |= 1 << sensorType
+}
+
+func (j *jsDriver) enableGenericSensor(sensorType Type, class js.Value, interval time.Duration) (ok bool) {
+	// The constructor throws an exception e.g. when the feature is disallowed by a permissions policy.
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+
+	opts := js.Global().Get("Object").New()
+	opts.Set("frequency", float64(time.Second)/float64(interval))
+	s := class.New(opts)
+	f := js.FuncOf(func(this js.Value, args []js.Value) any {
+		Update(sensorType, Value{
+			X: s.Get("x").Float(),
+			Y: s.Get("y").Float(),
+			Z: s.Get("z").Float(),
+		})
+		return nil
+	})
+	s.Call("addEventListener", "reading", f)
+	s.Call("start")
+	j.sensors[sensorType] = s
+	j.funcs[sensorType] = f
+	return true
+}
+
+func (j *jsDriver) disable(sensorType Type) {
+	if s := j.sensors[sensorType]; s.Truthy() {
+		s.Call("stop")
+		s.Call("removeEventListener", "reading", j.funcs[sensorType])
+		j.funcs[sensorType].Release()
+		j.sensors[sensorType] = js.Value{}
+		j.funcs[sensorType] = js.Func{}
+	}
+
+	if j.deviceMotionTypes&(1<<sensorType) == 0 {
+		return
+	}
+	j.deviceMotionTypes &^= 1 << sensorType
+	if j.deviceMotionTypes == 0 {
+		js.Global().Call("removeEventListener", "devicemotion", j.deviceMotionFunc)
+		j.deviceMotionFunc.Release()
+		j.deviceMotionFunc = js.Func{}
+	}
+}
+
+func (j *jsDriver) onDeviceMotion(e js.Value) {
+	if j.deviceMotionTypes&(1<<Accelerometer) != 0 {
+		if a := e.Get("accelerationIncludingGravity"); a.Truthy() && !a.Get("x").IsNull() {
+			Update(Accelerometer, Value{
+				X: a.Get("x").Float(),
+				Y: a.Get("y").Float(),
+				Z: a.Get("z").Float(),
+			})
+		}
+	}
+	if j.deviceMotionTypes&(1<<Gyroscope) != 0 {
+		// rotationRate is in degrees per second. alpha, beta, and gamma are the rotations around Z, X, and Y axes.
+		if r := e.Get("rotationRate"); r.Truthy() && !r.Get("alpha").IsNull() {
+			Update(Gyroscope, Value{
+				X: r.Get("beta").Float() * math.Pi / 180,
+				Y: r.Get("gamma").Float() * math.Pi / 180,
+				Z: r.Get("alpha").Float() * math.Pi / 180,
+			})
+		}
+	}
+}
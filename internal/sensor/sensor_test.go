@@ -0,0 +1,93 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+type driverCall struct {
+	sensorType Type
+	enabled    bool
+	interval   time.Duration
+}
+
+type testDriver struct {
+	calls []driverCall
+}
+
+func (t *testDriver) SetSensorEnabled(sensorType Type, enabled bool, interval time.Duration) {
+	t.calls = append(t.calls, driverCall{
+		sensorType: sensorType,
+		enabled:    enabled,
+		interval:   interval,
+	})
+}
+
+func TestSensors(t *testing.T) {
+	var s sensors
+
+	// Enabling before setting a driver is applied when a driver is set.
+	s.enable(Gyroscope, 0)
+	d := &testDriver{}
+	s.setDriver(d)
+	if got, want := d.calls, []driverCall{{Gyroscope, true, DefaultInterval}}; !slices.Equal(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+
+	if _, ok := s.read(Gyroscope); ok {
+		t.Errorf("read before update must fail")
+	}
+	s.update(Gyroscope, Value{1, 2, 3})
+	if got, ok := s.read(Gyroscope); !ok || got != (Value{1, 2, 3}) {
+		t.Errorf("got: %v, %t, want: %v, true", got, ok, Value{1, 2, 3})
+	}
+
+	// An update for a disabled sensor is ignored.
+	s.update(Accelerometer, Value{4, 5, 6})
+	if _, ok := s.read(Accelerometer); ok {
+		t.Errorf("read for a disabled sensor must fail")
+	}
+
+	// Enabling with the same interval doesn't call the driver again.
+	d.calls = nil
+	s.enable(Gyroscope, DefaultInterval)
+	s.enable(Gyroscope, time.Millisecond)
+	if got, want := d.calls, []driverCall{{Gyroscope, true, time.Millisecond}}; !slices.Equal(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+
+	// Disabling discards the last value.
+	d.calls = nil
+	s.disable(Gyroscope)
+	s.disable(Gyroscope)
+	if got, want := d.calls, []driverCall{{Gyroscope, false, 0}}; !slices.Equal(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+	s.enable(Gyroscope, 0)
+	if _, ok := s.read(Gyroscope); ok {
+		t.Errorf("read after re-enabling must fail until an update")
+	}
+
+	// Invalid types are ignored.
+	d.calls = nil
+	s.enable(typeCount, 0)
+	s.update(-1, Value{})
+	if len(d.calls) != 0 {
+		t.Errorf("got: %v, want: no calls", d.calls)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/internal/shaderir/glsl"
 	"github.com/hajimehoshi/ebiten/v2/internal/shaderir/hlsl"
 	"github.com/hajimehoshi/ebiten/v2/internal/shaderir/msl"
+	"github.com/hajimehoshi/ebiten/v2/internal/shaderir/wgsl"
 )
 
 func glslVertexNormalize(str string) string {
@@ -52,6 +53,12 @@ func metalNormalize(str string) string {
 	return strings.TrimSpace(str)
 }
 
+func wgslNormalize(str string) string {
+	prelude := wgsl.Prelude(shaderir.Texels)
+	str = strings.TrimPrefix(str, prelude)
+	return strings.TrimSpace(str)
+}
+
 func compare(t *testing.T, title, got, want string) {
 	var msg string
 	gotlines := strings.Split(got, "\n")
@@ -90,6 +97,7 @@ func TestCompile(t *testing.T) {
 		FS    []byte
 		HLSL  []byte
 		Metal []byte
+		WGSL  []byte
 	}
 
 	fnames := map[string]struct{}{}
@@ -157,6 +165,15 @@ func TestCompile(t *testing.T) {
 			tc.Metal = metal
 		}
 
+		wgsln := name + ".expected.wgsl"
+		if _, ok := fnames[wgsln]; ok {
+			w, err := os.ReadFile(filepath.Join("testdata", wgsln))
+			if err != nil {
+				t.Fatal(err)
+			}
+			tc.WGSL = w
+		}
+
 		tests = append(tests, tc)
 	}
 
@@ -193,9 +210,17 @@ func TestCompile(t *testing.T) {
 				}
 			}
 
+			if tc.WGSL != nil {
+				w := wgsl.Compile(s)
+				if got, want := wgslNormalize(w), wgslNormalize(string(tc.WGSL)); got != want {
+					compare(t, "WGSL", got, want)
+				}
+			}
+
 			// Just check that Compile doesn't cause panic.
 			// TODO: Should the results be tested?
 			msl.Compile(s)
+			wgsl.Compile(s)
 		})
 	}
 }
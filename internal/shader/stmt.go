@@ -232,6 +232,17 @@ func (cs *compileState) parseStmt(block *block, fname string, stmt ast.Stmt, inP
 		}
 		stmts = append(stmts, ss...)
 
+	case *ast.SwitchStmt:
+		s, ok := cs.desugarSwitch(stmt)
+		if !ok {
+			return nil, false
+		}
+		ss, ok := cs.parseStmt(block, fname, s, inParams, outParams, returnType)
+		if !ok {
+			return nil, false
+		}
+		stmts = append(stmts, ss...)
+
 	case *ast.IfStmt:
 		if stmt.Init != nil {
 			init := stmt.Init
@@ -748,6 +759,121 @@ func canAssign(lt *shaderir.Type, rt *shaderir.Type, rc gconstant.Value) bool {
 	return false
 }
 
+// switchTagVariableName is the name of the local variable to hold the tag value of a switch statement.
+// A nested switch statement declares the variable in an inner block, so the name can be shared.
+const switchTagVariableName = "__switchTag"
+
+// desugarSwitch converts a switch statement to an equivalent block statement with if-else statements.
+func (cs *compileState) desugarSwitch(stmt *ast.SwitchStmt) (ast.Stmt, bool) {
+	var list []ast.Stmt
+	if stmt.Init != nil {
+		list = append(list, stmt.Init)
+	}
+
+	var clauses []*ast.CaseClause
+	var defaultClause *ast.CaseClause
+	for _, s := range stmt.Body.List {
+		c := s.(*ast.CaseClause)
+		if !cs.checkSwitchCaseBody(c.Body) {
+			return nil, false
+		}
+		if c.List == nil {
+			defaultClause = c
+			continue
+		}
+		clauses = append(clauses, c)
+	}
+
+	// Evaluate the tag only once.
+	// If there are no clauses to compare, the tag is not evaluated as an expression in Kage doesn't have side effects.
+	hasTag := stmt.Tag != nil && len(clauses) > 0
+	if hasTag {
+		list = append(list, &ast.AssignStmt{
+			Lhs:    []ast.Expr{ast.NewIdent(switchTagVariableName)},
+			TokPos: stmt.Tag.Pos(),
+			Tok:    token.DEFINE,
+			Rhs:    []ast.Expr{stmt.Tag},
+		})
+	}
+
+	var elseStmt ast.Stmt
+	if defaultClause != nil {
+		elseStmt = &ast.BlockStmt{
+			Lbrace: defaultClause.Colon,
+			List:   defaultClause.Body,
+		}
+	}
+	for i := len(clauses) - 1; i >= 0; i-- {
+		c := clauses[i]
+		var cond ast.Expr
+		for _, e := range c.List {
+			if hasTag {
+				e = &ast.BinaryExpr{
+					X:     &ast.Ident{NamePos: e.Pos(), Name: switchTagVariableName},
+					OpPos: e.Pos(),
+					Op:    token.EQL,
+					Y:     e,
+				}
+			}
+			if cond == nil {
+				cond = e
+				continue
+			}
+			cond = &ast.BinaryExpr{
+				X:     cond,
+				OpPos: e.Pos(),
+				Op:    token.LOR,
+				Y:     e,
+			}
+		}
+		s := &ast.IfStmt{
+			If:   c.Case,
+			Cond: cond,
+			Body: &ast.BlockStmt{
+				Lbrace: c.Colon,
+				List:   c.Body,
+			},
+		}
+		if elseStmt != nil {
+			s.Else = elseStmt
+		}
+		elseStmt = s
+	}
+	if elseStmt != nil {
+		list = append(list, elseStmt)
+	}
+
+	return &ast.BlockStmt{
+		Lbrace: stmt.Switch,
+		List:   list,
+	}, true
+}
+
+// checkSwitchCaseBody reports whether the case clause body can be converted to an if-else statement.
+func (cs *compileState) checkSwitchCaseBody(body []ast.Stmt) bool {
+	ok := true
+	for _, s := range body {
+		ast.Inspect(s, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.ForStmt, *ast.FuncLit:
+				// A break statement in a for statement is valid.
+				return false
+			case *ast.BranchStmt:
+				switch n.Tok {
+				case token.BREAK:
+					cs.addError(n.Pos(), "break statement in a switch statement is not supported")
+					ok = false
+				case token.FALLTHROUGH:
+					cs.addError(n.Pos(), "fallthrough statement is not supported")
+					ok = false
+				}
+			}
+			return true
+		})
+	}
+	return ok
+}
+
 func (cs *compileState) parseFor(block *block, fname string, stmt *ast.ForStmt, inParams, outParams []variable, returnType shaderir.Type, checkLocalVariableUsage bool) ([]shaderir.Stmt, bool) {
 	msg := "for-statement must follow this format: for (varname) := (constant); (varname) (op) (constant); (varname) (op) (constant) { ..."
 	if stmt.Init == nil {
@@ -4482,3 +4482,41 @@ func Fragment(dstPos vec4, srcPos vec2, color vec4) vec4 {
 		}
 	}
 }
+
+func TestSyntaxSwitch(t *testing.T) {
+	cases := []struct {
+		stmt string
+		err  bool
+	}{
+		{stmt: "a := 1; switch a { case 0: a = 2; case 1, 2: a = 3; default: a = 4 }; _ = a", err: false},
+		{stmt: "a := 1; switch a { default: a = 4; case 0: a = 2 }; _ = a", err: false},
+		{stmt: "a := 1; switch { case a > 0: a = 2; case a < 0: a = 3 }; _ = a", err: false},
+		{stmt: "a := 1; switch b := a + 1; b { case 2: a = 2 }; _ = a", err: false},
+		{stmt: "a := 1; switch a { case 0: switch a { case 1: a = 2 } }; _ = a", err: false},
+		{stmt: "a := 1; switch a { }; _ = a", err: false},
+		{stmt: "a := 1.0; switch a { case 1: a = 2 }; _ = a", err: false},
+		{stmt: "a := vec2(1); switch a { case vec2(0): a = vec2(2) }; _ = a", err: false},
+		{stmt: "a := 1; switch a { case 0: for i := 0; i < 4; i++ { break } }; _ = a", err: false},
+		{stmt: "a := 1; switch a { case 0: break }; _ = a", err: true},
+		{stmt: "a := 1; switch a { case 0: if a == 0 { break } }; _ = a", err: true},
+		{stmt: "a := 1; switch a { case 0: fallthrough; case 1: a = 2 }; _ = a", err: true},
+		{stmt: "a := 1; switch a { case 1.5: a = 2 }; _ = a", err: true},
+		{stmt: "a := 1; switch { case a: a = 2 }; _ = a", err: true},
+	}
+
+	for _, c := range cases {
+		stmt := c.stmt
+		src := fmt.Sprintf(`package main
+
+func Fragment(dstPos vec4, srcPos vec2, color vec4) vec4 {
+	%s
+	return dstPos
+}`, stmt)
+		_, err := compileToIR([]byte(src))
+		if err == nil && c.err {
+			t.Errorf("%s must return an error but does not", stmt)
+		} else if err != nil && !c.err {
+			t.Errorf("%s must not return nil but returned %v", stmt, err)
+		}
+	}
+}
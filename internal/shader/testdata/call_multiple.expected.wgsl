@@ -0,0 +1,22 @@
+diagnostic(off, derivative_uniformity);
+
+@group(0) @binding(5) var texture_sampler: sampler;
+
+fn F0(p0: vec2<f32>) -> vec2<f32> {
+	var l0: vec2<f32> = p0;
+	var l1: f32;
+	var l2: f32;
+	var l3: f32;
+	var l4: f32;
+	F1((l0).x, &l1, &l2);
+	l3 = l1;
+	l4 = l2;
+	return vec2<f32>(l3, l4);
+}
+
+fn F1(p0: f32, l1: ptr<function, f32>, l2: ptr<function, f32>) {
+	var l0: f32 = p0;
+	(*l1) = l0;
+	(*l2) = l0;
+	return;
+}
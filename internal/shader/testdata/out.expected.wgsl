@@ -0,0 +1,10 @@
+diagnostic(off, derivative_uniformity);
+
+@group(0) @binding(5) var texture_sampler: sampler;
+
+fn F0(l0: ptr<function, f32>, l1: ptr<function, array<f32, 4>>, l2: ptr<function, vec4<f32>>) {
+	(*l0) = f32();
+	(*l1) = array<f32, 4>();
+	(*l2) = vec4<f32>();
+	return;
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wgsl
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/shaderir"
+)
+
+func opString(op shaderir.Op) string {
+	switch op {
+	case shaderir.Add:
+		return "+"
+	case shaderir.Sub:
+		return "-"
+	case shaderir.NotOp:
+		return "!"
+	case shaderir.ComponentWiseMul, shaderir.MatrixMul:
+		return "*"
+	case shaderir.Div:
+		return "/"
+	case shaderir.ModOp:
+		return "%"
+	case shaderir.LeftShift:
+		return "<<"
+	case shaderir.RightShift:
+		return ">>"
+	case shaderir.LessThanOp:
+		return "<"
+	case shaderir.LessThanEqualOp:
+		return "<="
+	case shaderir.GreaterThanOp:
+		return ">"
+	case shaderir.GreaterThanEqualOp:
+		return ">="
+	case shaderir.EqualOp:
+		return "=="
+	case shaderir.NotEqualOp:
+		return "!="
+	case shaderir.And:
+		return "&"
+	case shaderir.Xor:
+		return "^"
+	case shaderir.Or:
+		return "|"
+	case shaderir.AndAnd:
+		return "&&"
+	case shaderir.OrOr:
+		return "||"
+	}
+	return fmt.Sprintf("?(unexpected operator: %d)", op)
+}
+
+func typeString(t *shaderir.Type) string {
+	switch t.Main {
+	case shaderir.Array:
+		st := typeString(&t.Sub[0])
+		return fmt.Sprintf("array<%s, %d>", st, t.Length)
+	case shaderir.Struct:
+		panic("wgsl: a struct is not implemented")
+	default:
+		return basicTypeString(t.Main)
+	}
+}
+
+func basicTypeString(t shaderir.BasicType) string {
+	switch t {
+	case shaderir.None:
+		return "?(none)"
+	case shaderir.Bool:
+		return "bool"
+	case shaderir.Int:
+		return "i32"
+	case shaderir.Float:
+		return "f32"
+	case shaderir.Vec2:
+		return "vec2<f32>"
+	case shaderir.Vec3:
+		return "vec3<f32>"
+	case shaderir.Vec4:
+		return "vec4<f32>"
+	case shaderir.IVec2:
+		return "vec2<i32>"
+	case shaderir.IVec3:
+		return "vec3<i32>"
+	case shaderir.IVec4:
+		return "vec4<i32>"
+	case shaderir.Mat2:
+		return "mat2x2<f32>"
+	case shaderir.Mat3:
+		return "mat3x3<f32>"
+	case shaderir.Mat4:
+		return "mat4x4<f32>"
+	case shaderir.Array:
+		return "?(array)"
+	case shaderir.Struct:
+		return "?(struct)"
+	default:
+		return fmt.Sprintf("?(unknown type: %d)", t)
+	}
+}
+
+func builtinFuncString(f shaderir.BuiltinFunc) string {
+	switch f {
+	case shaderir.BoolF:
+		return "bool"
+	case shaderir.IntF:
+		return "i32"
+	case shaderir.FloatF:
+		return "f32"
+	case shaderir.Vec2F:
+		return "vec2<f32>"
+	case shaderir.Vec3F:
+		return "vec3<f32>"
+	case shaderir.Vec4F:
+		return "vec4<f32>"
+	case shaderir.IVec2F:
+		return "vec2<i32>"
+	case shaderir.IVec3F:
+		return "vec3<i32>"
+	case shaderir.IVec4F:
+		return "vec4<i32>"
+	case shaderir.Mat2F:
+		return "mat2x2<f32>"
+	case shaderir.Mat3F:
+		return "mat3x3<f32>"
+	case shaderir.Mat4F:
+		return "mat4x4<f32>"
+	case shaderir.Inversesqrt:
+		return "inverseSqrt"
+	case shaderir.Faceforward:
+		return "faceForward"
+	case shaderir.Dfdx:
+		return "dpdx"
+	case shaderir.Dfdy:
+		return "dpdy"
+	case shaderir.TexelAt:
+		return "?(__texelAt)"
+	}
+	return string(f)
+}
+
+// vectorType returns a vector type whose element type is the same as t and whose element count is n.
+// If n is 1, vectorType returns the scalar type.
+func vectorType(t shaderir.BasicType, n int) shaderir.Type {
+	var float bool
+	switch t {
+	case shaderir.Float, shaderir.Vec2, shaderir.Vec3, shaderir.Vec4:
+		float = true
+	case shaderir.Int, shaderir.IVec2, shaderir.IVec3, shaderir.IVec4:
+		float = false
+	default:
+		return shaderir.Type{}
+	}
+	if float {
+		switch n {
+		case 1:
+			return shaderir.Type{Main: shaderir.Float}
+		case 2:
+			return shaderir.Type{Main: shaderir.Vec2}
+		case 3:
+			return shaderir.Type{Main: shaderir.Vec3}
+		case 4:
+			return shaderir.Type{Main: shaderir.Vec4}
+		}
+		return shaderir.Type{}
+	}
+	switch n {
+	case 1:
+		return shaderir.Type{Main: shaderir.Int}
+	case 2:
+		return shaderir.Type{Main: shaderir.IVec2}
+	case 3:
+		return shaderir.Type{Main: shaderir.IVec3}
+	case 4:
+		return shaderir.Type{Main: shaderir.IVec4}
+	}
+	return shaderir.Type{}
+}
+
+func isScalar(t *shaderir.Type) bool {
+	return t.Main == shaderir.Float || t.Main == shaderir.Int
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wgsl
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/shaderir"
+)
+
+// alignmentAndSizeInDwords returns the alignment and the size of the given type in the storage address space.
+func alignmentAndSizeInDwords(t *shaderir.Type) (int, int) {
+	// https://www.w3.org/TR/WGSL/#alignment-and-size
+	switch t.Main {
+	case shaderir.Float, shaderir.Int:
+		return 1, 1
+	case shaderir.Vec2, shaderir.IVec2:
+		return 2, 2
+	case shaderir.Vec3, shaderir.IVec3:
+		return 4, 3
+	case shaderir.Vec4, shaderir.IVec4:
+		return 4, 4
+	case shaderir.Mat2:
+		return 2, 4
+	case shaderir.Mat3:
+		// Each column is a vec3, whose size is rounded up to its alignment.
+		return 4, 12
+	case shaderir.Mat4:
+		return 4, 16
+	case shaderir.Array:
+		align, size := alignmentAndSizeInDwords(&t.Sub[0])
+		// The stride of an array is the element size rounded up to its alignment.
+		return align, t.Length * roundUp(size, align)
+	default:
+		panic(fmt.Sprintf("wgsl: unexpected type: %s", t.String()))
+	}
+}
+
+func roundUp(x, align int) int {
+	return ((x + align - 1) / align) * align
+}
+
+// UniformVariableOffsetsInDwords returns the offsets of the uniform variables in DWORD units in the WGSL storage layout,
+// and the size of the whole uniform variables in DWORD units.
+func UniformVariableOffsetsInDwords(program *shaderir.Program) ([]int, int) {
+	var offsetsInDwords []int
+	var headInDwords int
+	maxAlign := 1
+	for _, u := range program.Uniforms {
+		align, size := alignmentAndSizeInDwords(&u)
+		headInDwords = roundUp(headInDwords, align)
+		offsetsInDwords = append(offsetsInDwords, headInDwords)
+		headInDwords += size
+		if maxAlign < align {
+			maxAlign = align
+		}
+	}
+	// The size of a structure is rounded up to its alignment.
+	size := roundUp(headInDwords, maxAlign)
+	return offsetsInDwords, size
+}
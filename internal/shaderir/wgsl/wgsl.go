@@ -0,0 +1,808 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wgsl compiles a shaderir program into WebGPU Shading Language.
+package wgsl
+
+import (
+	"fmt"
+	"go/constant"
+	"go/token"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/shaderir"
+)
+
+const (
+	vertexIn  = "attributes"
+	vertexOut = "varyings"
+)
+
+const (
+	VertexName   = "Vertex"
+	FragmentName = "Fragment"
+)
+
+// UniformBinding is the binding index of the uniform variables' buffer in the bind group 0.
+const UniformBinding = 0
+
+// TextureBinding returns the binding index of the i-th texture in the bind group 0.
+func TextureBinding(i int) int {
+	return 1 + i
+}
+
+// SamplerBinding is the binding index of the sampler in the bind group 0.
+// The sampler is available only when the unit is texels.
+const SamplerBinding = 1 + 4
+
+type compileContext struct {
+	structNames map[string]string
+	structTypes []shaderir.Type
+
+	// forVarTypes is the types of the for-loop counters in the current scope.
+	// A for-loop counter's type is not recorded in the block's local variables.
+	forVarTypes map[int]shaderir.Type
+}
+
+func (c *compileContext) structName(p *shaderir.Program, t *shaderir.Type) string {
+	if t.Main != shaderir.Struct {
+		panic("wgsl: the given type at structName must be a struct")
+	}
+	s := t.String()
+	if n, ok := c.structNames[s]; ok {
+		return n
+	}
+	n := fmt.Sprintf("S%d", len(c.structNames))
+	c.structNames[s] = n
+	c.structTypes = append(c.structTypes, *t)
+	return n
+}
+
+func Prelude(unit shaderir.Unit) string {
+	str := `diagnostic(off, derivative_uniformity);`
+	if unit == shaderir.Texels {
+		str += fmt.Sprintf(`
+
+@group(0) @binding(%d) var texture_sampler: sampler;`, SamplerBinding)
+	}
+	return str
+}
+
+func Compile(p *shaderir.Program) (shader string) {
+	c := &compileContext{
+		structNames: map[string]string{},
+		forVarTypes: map[int]shaderir.Type{},
+	}
+
+	var lines []string
+	lines = append(lines, strings.Split(Prelude(p.Unit), "\n")...)
+	lines = append(lines, "", "{{.Structs}}")
+
+	if len(p.Uniforms) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, "struct Uniforms {")
+		for i, u := range p.Uniforms {
+			lines = append(lines, fmt.Sprintf("\tU%d: %s,", i, c.typ(p, &u)))
+		}
+		lines = append(lines, "}")
+		lines = append(lines, "")
+		// A storage buffer is used instead of a uniform buffer since a uniform buffer requires 16-byte strides for arrays.
+		lines = append(lines, fmt.Sprintf("@group(0) @binding(%d) var<storage, read> uniforms: Uniforms;", UniformBinding))
+	}
+
+	if p.TextureCount > 0 {
+		lines = append(lines, "")
+		for i := 0; i < p.TextureCount; i++ {
+			lines = append(lines, fmt.Sprintf("@group(0) @binding(%d) var T%d: texture_2d<f32>;", TextureBinding(i), i))
+		}
+	}
+
+	if len(p.Attributes) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, "struct Attributes {")
+		for i, a := range p.Attributes {
+			lines = append(lines, fmt.Sprintf("\t@location(%[1]d) M%[1]d: %[2]s,", i, c.typ(p, &a)))
+		}
+		lines = append(lines, "}")
+	}
+
+	hasVertex := p.VertexFunc.Block != nil && len(p.VertexFunc.Block.Stmts) > 0
+	hasFragment := p.FragmentFunc.Block != nil && len(p.FragmentFunc.Block.Stmts) > 0
+
+	if hasVertex || hasFragment {
+		lines = append(lines, "")
+		lines = append(lines, "struct Varyings {")
+		lines = append(lines, "\t@builtin(position) Position: vec4<f32>,")
+		for i, v := range p.Varyings {
+			var interpolate string
+			switch v.Main {
+			case shaderir.Int, shaderir.IVec2, shaderir.IVec3, shaderir.IVec4:
+				interpolate = " @interpolate(flat)"
+			}
+			lines = append(lines, fmt.Sprintf("\t@location(%[1]d)%[2]s M%[1]d: %[3]s,", i, interpolate, c.typ(p, &v)))
+		}
+		lines = append(lines, "}")
+	}
+
+	if len(p.Funcs) > 0 {
+		for _, f := range p.Funcs {
+			if len(lines) > 0 && lines[len(lines)-1] != "" {
+				lines = append(lines, "")
+			}
+			lines = append(lines, c.function(p, &f)...)
+		}
+	}
+
+	if hasVertex {
+		lines = append(lines, "")
+		if len(p.Attributes) > 0 {
+			lines = append(lines, fmt.Sprintf("@vertex fn %s(A: Attributes) -> Varyings {", VertexName))
+			lines = append(lines, fmt.Sprintf("\tvar %s: Attributes = A;", vertexIn))
+		} else {
+			lines = append(lines, fmt.Sprintf("@vertex fn %s() -> Varyings {", VertexName))
+		}
+		lines = append(lines, fmt.Sprintf("\tvar %s: Varyings;", vertexOut))
+		lines = append(lines, c.block(p, p.VertexFunc.Block, p.VertexFunc.Block, 0)...)
+		if last := fmt.Sprintf("\treturn %s;", vertexOut); lines[len(lines)-1] != last {
+			lines = append(lines, last)
+		}
+		lines = append(lines, "}")
+	}
+
+	if hasFragment {
+		lines = append(lines, "")
+		lines = append(lines, fmt.Sprintf("@fragment fn %s(V: Varyings) -> @location(0) vec4<f32> {", FragmentName))
+		lines = append(lines, fmt.Sprintf("\tvar %s: Varyings = V;", vertexOut))
+		lines = append(lines, c.block(p, p.FragmentFunc.Block, p.FragmentFunc.Block, 0)...)
+		lines = append(lines, "}")
+	}
+
+	ls := strings.Join(lines, "\n")
+
+	// Struct types are determined after converting the program.
+	if len(c.structTypes) > 0 {
+		var stlines []string
+		for i, t := range c.structTypes {
+			stlines = append(stlines, fmt.Sprintf("struct S%d {", i))
+			for j, st := range t.Sub {
+				stlines = append(stlines, fmt.Sprintf("\tM%d: %s,", j, c.typ(p, &st)))
+			}
+			stlines = append(stlines, "}")
+		}
+		ls = strings.ReplaceAll(ls, "{{.Structs}}", strings.Join(stlines, "\n"))
+	} else {
+		ls = strings.ReplaceAll(ls, "{{.Structs}}", "")
+	}
+
+	nls := regexp.MustCompile(`\n\n+`)
+	ls = nls.ReplaceAllString(ls, "\n\n")
+	ls = strings.TrimSpace(ls) + "\n"
+
+	return ls
+}
+
+func (c *compileContext) typ(p *shaderir.Program, t *shaderir.Type) string {
+	switch t.Main {
+	case shaderir.Struct:
+		return c.structName(p, t)
+	case shaderir.Array:
+		return fmt.Sprintf("array<%s, %d>", c.typ(p, &t.Sub[0]), t.Length)
+	default:
+		return typeString(t)
+	}
+}
+
+func (c *compileContext) function(p *shaderir.Program, f *shaderir.Func) []string {
+	var args []string
+	var copies []string
+
+	// Parameters are immutable in WGSL. Copy them to variables so that they can be modified.
+	var idx int
+	for _, t := range f.InParams {
+		args = append(args, fmt.Sprintf("p%d: %s", idx, c.typ(p, &t)))
+		copies = append(copies, fmt.Sprintf("\tvar l%[1]d: %[2]s = p%[1]d;", idx, c.typ(p, &t)))
+		idx++
+	}
+	for _, t := range f.OutParams {
+		args = append(args, fmt.Sprintf("l%d: ptr<function, %s>", idx, c.typ(p, &t)))
+		idx++
+	}
+
+	sig := fmt.Sprintf("fn F%d(%s)", f.Index, strings.Join(args, ", "))
+	if f.Return.Main != shaderir.None {
+		sig += " -> " + c.typ(p, &f.Return)
+	}
+
+	var lines []string
+	lines = append(lines, fmt.Sprintf("%s {", sig))
+	lines = append(lines, copies...)
+	lines = append(lines, c.block(p, f.Block, f.Block, 0)...)
+	lines = append(lines, "}")
+
+	return lines
+}
+
+func constantToNumberLiteral(v constant.Value) string {
+	switch v.Kind() {
+	case constant.Bool:
+		if constant.BoolVal(v) {
+			return "true"
+		}
+		return "false"
+	case constant.Int:
+		x, _ := constant.Int64Val(v)
+		return fmt.Sprintf("%d", x)
+	case constant.Float:
+		x, _ := constant.Float64Val(v)
+		if i := math.Floor(x); i == x {
+			return fmt.Sprintf("%d.0", int64(i))
+		}
+		return fmt.Sprintf("%.10e", x)
+	}
+	return fmt.Sprintf("?(unexpected literal: %s)", v)
+}
+
+func constantType(v constant.Value) shaderir.Type {
+	switch v.Kind() {
+	case constant.Bool:
+		return shaderir.Type{Main: shaderir.Bool}
+	case constant.Int:
+		return shaderir.Type{Main: shaderir.Int}
+	case constant.Float:
+		return shaderir.Type{Main: shaderir.Float}
+	}
+	return shaderir.Type{}
+}
+
+func funcByIndex(p *shaderir.Program, index int) *shaderir.Func {
+	for i := range p.Funcs {
+		if p.Funcs[i].Index == index {
+			return &p.Funcs[i]
+		}
+	}
+	return nil
+}
+
+func isOutParam(p *shaderir.Program, topBlock *shaderir.Block, idx int) bool {
+	for _, f := range p.Funcs {
+		if f.Block != topBlock {
+			continue
+		}
+		return len(f.InParams) <= idx && idx < len(f.InParams)+len(f.OutParams)
+	}
+	return false
+}
+
+func localVariableName(p *shaderir.Program, topBlock *shaderir.Block, idx int) string {
+	switch topBlock {
+	case p.VertexFunc.Block:
+		na := len(p.Attributes)
+		nv := len(p.Varyings)
+		switch {
+		case idx < na:
+			return fmt.Sprintf("%s.M%d", vertexIn, idx)
+		case idx == na:
+			return fmt.Sprintf("%s.Position", vertexOut)
+		case idx < na+nv+1:
+			return fmt.Sprintf("%s.M%d", vertexOut, idx-na-1)
+		default:
+			return fmt.Sprintf("l%d", idx-(na+nv+1))
+		}
+	case p.FragmentFunc.Block:
+		nv := len(p.Varyings)
+		switch {
+		case idx == 0:
+			return fmt.Sprintf("%s.Position", vertexOut)
+		case idx < nv+1:
+			return fmt.Sprintf("%s.M%d", vertexOut, idx-1)
+		default:
+			return fmt.Sprintf("l%d", idx-(nv+1))
+		}
+	default:
+		if isOutParam(p, topBlock, idx) {
+			return fmt.Sprintf("(*l%d)", idx)
+		}
+		return fmt.Sprintf("l%d", idx)
+	}
+}
+
+func swizzling(s string) string {
+	// WGSL doesn't have strq.
+	if strings.IndexByte("stq", s[0]) >= 0 {
+		return strings.NewReplacer("s", "x", "t", "y", "r", "z", "q", "w").Replace(s)
+	}
+	return s
+}
+
+func (c *compileContext) block(p *shaderir.Program, topBlock, block *shaderir.Block, level int) []string {
+	if block == nil {
+		return nil
+	}
+
+	idt := strings.Repeat("\t", level+1)
+
+	var lines []string
+	for i, t := range block.LocalVars {
+		// The type is None e.g., when the variable is a for-loop counter.
+		if t.Main != shaderir.None {
+			// A variable is initialized with the zero value implicitly.
+			name := localVariableName(p, topBlock, block.LocalVarIndexOffset+i)
+			lines = append(lines, fmt.Sprintf("%svar %s: %s;", idt, name, c.typ(p, &t)))
+		}
+	}
+
+	var typeOf func(e *shaderir.Expr) shaderir.Type
+	typeOf = func(e *shaderir.Expr) shaderir.Type {
+		switch e.Type {
+		case shaderir.NumberExpr:
+			return constantType(e.Const)
+		case shaderir.UniformVariable:
+			return p.Uniforms[e.Index]
+		case shaderir.LocalVariable:
+			if t, ok := c.forVarTypes[e.Index]; ok {
+				return t
+			}
+			return p.LocalVariableType(topBlock, block, e.Index)
+		case shaderir.Unary:
+			if e.Op == shaderir.NotOp {
+				return shaderir.Type{Main: shaderir.Bool}
+			}
+			return typeOf(&e.Exprs[0])
+		case shaderir.Binary:
+			switch e.Op {
+			case shaderir.LessThanOp, shaderir.LessThanEqualOp, shaderir.GreaterThanOp, shaderir.GreaterThanEqualOp,
+				shaderir.EqualOp, shaderir.NotEqualOp, shaderir.VectorEqualOp, shaderir.VectorNotEqualOp,
+				shaderir.AndAnd, shaderir.OrOr:
+				return shaderir.Type{Main: shaderir.Bool}
+			case shaderir.LeftShift, shaderir.RightShift:
+				return typeOf(&e.Exprs[0])
+			case shaderir.MatrixMul:
+				lhs, rhs := typeOf(&e.Exprs[0]), typeOf(&e.Exprs[1])
+				if lhs.IsMatrix() && !rhs.IsMatrix() && !isScalar(&rhs) {
+					return rhs
+				}
+				if rhs.IsMatrix() && !lhs.IsMatrix() && !isScalar(&lhs) {
+					return lhs
+				}
+				if lhs.IsMatrix() {
+					return lhs
+				}
+				return rhs
+			}
+			if lhs := typeOf(&e.Exprs[0]); !isScalar(&lhs) {
+				return lhs
+			}
+			return typeOf(&e.Exprs[1])
+		case shaderir.Selection:
+			return typeOf(&e.Exprs[1])
+		case shaderir.Call:
+			callee := e.Exprs[0]
+			if callee.Type == shaderir.FunctionExpr {
+				if f := funcByIndex(p, callee.Index); f != nil {
+					return f.Return
+				}
+				return shaderir.Type{}
+			}
+			switch callee.BuiltinFunc {
+			case shaderir.Len, shaderir.Cap, shaderir.IntF:
+				return shaderir.Type{Main: shaderir.Int}
+			case shaderir.BoolF:
+				return shaderir.Type{Main: shaderir.Bool}
+			case shaderir.FloatF, shaderir.Length, shaderir.Distance, shaderir.Dot:
+				return shaderir.Type{Main: shaderir.Float}
+			case shaderir.Vec2F:
+				return shaderir.Type{Main: shaderir.Vec2}
+			case shaderir.Vec3F, shaderir.Cross:
+				return shaderir.Type{Main: shaderir.Vec3}
+			case shaderir.Vec4F, shaderir.TexelAt:
+				return shaderir.Type{Main: shaderir.Vec4}
+			case shaderir.IVec2F:
+				return shaderir.Type{Main: shaderir.IVec2}
+			case shaderir.IVec3F:
+				return shaderir.Type{Main: shaderir.IVec3}
+			case shaderir.IVec4F:
+				return shaderir.Type{Main: shaderir.IVec4}
+			case shaderir.Mat2F:
+				return shaderir.Type{Main: shaderir.Mat2}
+			case shaderir.Mat3F:
+				return shaderir.Type{Main: shaderir.Mat3}
+			case shaderir.Mat4F:
+				return shaderir.Type{Main: shaderir.Mat4}
+			}
+			// Some built-in functions like clamp accept both scalars and vectors. The result type is the vector type.
+			for i := range e.Exprs[1:] {
+				if t := typeOf(&e.Exprs[1+i]); !isScalar(&t) {
+					return t
+				}
+			}
+			if len(e.Exprs) > 1 {
+				return typeOf(&e.Exprs[1])
+			}
+			return shaderir.Type{}
+		case shaderir.FieldSelector:
+			t := typeOf(&e.Exprs[0])
+			switch e.Exprs[1].Type {
+			case shaderir.SwizzlingExpr:
+				return vectorType(t.Main, len(e.Exprs[1].Swizzling))
+			case shaderir.StructMember:
+				if t.Main == shaderir.Struct && e.Exprs[1].Index < len(t.Sub) {
+					return t.Sub[e.Exprs[1].Index]
+				}
+			}
+			return shaderir.Type{}
+		case shaderir.Index:
+			t := typeOf(&e.Exprs[0])
+			switch t.Main {
+			case shaderir.Array:
+				return t.Sub[0]
+			case shaderir.Vec2, shaderir.Vec3, shaderir.Vec4, shaderir.IVec2, shaderir.IVec3, shaderir.IVec4:
+				return vectorType(t.Main, 1)
+			case shaderir.Mat2:
+				return shaderir.Type{Main: shaderir.Vec2}
+			case shaderir.Mat3:
+				return shaderir.Type{Main: shaderir.Vec3}
+			case shaderir.Mat4:
+				return shaderir.Type{Main: shaderir.Vec4}
+			}
+			return shaderir.Type{}
+		default:
+			return shaderir.Type{}
+		}
+	}
+
+	var expr func(e *shaderir.Expr) string
+	expr = func(e *shaderir.Expr) string {
+		switch e.Type {
+		case shaderir.NumberExpr:
+			return constantToNumberLiteral(e.Const)
+		case shaderir.UniformVariable:
+			return fmt.Sprintf("uniforms.U%d", e.Index)
+		case shaderir.TextureVariable:
+			return fmt.Sprintf("T%d", e.Index)
+		case shaderir.LocalVariable:
+			return localVariableName(p, topBlock, e.Index)
+		case shaderir.StructMember:
+			return fmt.Sprintf("M%d", e.Index)
+		case shaderir.BuiltinFuncExpr:
+			return builtinFuncString(e.BuiltinFunc)
+		case shaderir.SwizzlingExpr:
+			if !shaderir.IsValidSwizzling(e.Swizzling) {
+				return fmt.Sprintf("?(unexpected swizzling: %s)", e.Swizzling)
+			}
+			return swizzling(e.Swizzling)
+		case shaderir.FunctionExpr:
+			return fmt.Sprintf("F%d", e.Index)
+		case shaderir.Unary:
+			switch e.Op {
+			case shaderir.Add:
+				// WGSL doesn't have the unary plus operator.
+				return fmt.Sprintf("(%s)", expr(&e.Exprs[0]))
+			case shaderir.Sub, shaderir.NotOp:
+				return fmt.Sprintf("%s(%s)", opString(e.Op), expr(&e.Exprs[0]))
+			default:
+				return fmt.Sprintf("?(unexpected op: %d)(%s)", e.Op, expr(&e.Exprs[0]))
+			}
+		case shaderir.Binary:
+			switch e.Op {
+			case shaderir.VectorEqualOp:
+				return fmt.Sprintf("all((%s) == (%s))", expr(&e.Exprs[0]), expr(&e.Exprs[1]))
+			case shaderir.VectorNotEqualOp:
+				return fmt.Sprintf("!all((%s) == (%s))", expr(&e.Exprs[0]), expr(&e.Exprs[1]))
+			case shaderir.LeftShift, shaderir.RightShift:
+				// The right-hand side of a shift must be unsigned in WGSL.
+				lhs := typeOf(&e.Exprs[0])
+				u := "u32"
+				switch lhs.Main {
+				case shaderir.IVec2:
+					u = "vec2<u32>"
+				case shaderir.IVec3:
+					u = "vec3<u32>"
+				case shaderir.IVec4:
+					u = "vec4<u32>"
+				}
+				return fmt.Sprintf("(%s) %s %s(%s)", expr(&e.Exprs[0]), opString(e.Op), u, expr(&e.Exprs[1]))
+			}
+			return fmt.Sprintf("(%s) %s (%s)", expr(&e.Exprs[0]), opString(e.Op), expr(&e.Exprs[1]))
+		case shaderir.Selection:
+			return fmt.Sprintf("select(%s, %s, %s)", expr(&e.Exprs[2]), expr(&e.Exprs[1]), expr(&e.Exprs[0]))
+		case shaderir.Call:
+			callee := e.Exprs[0]
+			if callee.Type == shaderir.BuiltinFuncExpr {
+				return c.builtinCall(p, callee.BuiltinFunc, e.Exprs[1:], expr, typeOf)
+			}
+			var args []string
+			f := funcByIndex(p, callee.Index)
+			for i, exp := range e.Exprs[1:] {
+				if f != nil && i >= len(f.InParams) {
+					// An output parameter is passed as a pointer.
+					args = append(args, "&"+expr(&exp))
+					continue
+				}
+				args = append(args, expr(&exp))
+			}
+			return fmt.Sprintf("%s(%s)", expr(&callee), strings.Join(args, ", "))
+		case shaderir.FieldSelector:
+			return fmt.Sprintf("(%s).%s", expr(&e.Exprs[0]), expr(&e.Exprs[1]))
+		case shaderir.Index:
+			return fmt.Sprintf("(%s)[%s]", expr(&e.Exprs[0]), expr(&e.Exprs[1]))
+		default:
+			return fmt.Sprintf("?(unexpected expr: %d)", e.Type)
+		}
+	}
+
+	for _, s := range block.Stmts {
+		switch s.Type {
+		case shaderir.ExprStmt:
+			e := &s.Exprs[0]
+			if e.Type == shaderir.Call && e.Exprs[0].Type == shaderir.FunctionExpr {
+				lines = append(lines, fmt.Sprintf("%s%s;", idt, expr(e)))
+				break
+			}
+			// The result of a built-in function must be used explicitly.
+			lines = append(lines, fmt.Sprintf("%s_ = %s;", idt, expr(e)))
+		case shaderir.BlockStmt:
+			lines = append(lines, idt+"{")
+			lines = append(lines, c.block(p, topBlock, s.Blocks[0], level+1)...)
+			lines = append(lines, idt+"}")
+		case shaderir.Assign:
+			lhs := &s.Exprs[0]
+			if lhs.Type == shaderir.FieldSelector && lhs.Exprs[1].Type == shaderir.SwizzlingExpr && len(lhs.Exprs[1].Swizzling) > 1 {
+				// WGSL cannot assign a value to multiple components by swizzling. Assign them one by one.
+				base := expr(&lhs.Exprs[0])
+				sw := swizzling(lhs.Exprs[1].Swizzling)
+				lines = append(lines, idt+"{")
+				lines = append(lines, fmt.Sprintf("%s\tlet tmp = %s;", idt, expr(&s.Exprs[1])))
+				for i, ch := range sw {
+					lines = append(lines, fmt.Sprintf("%s\t(%s).%c = tmp.%c;", idt, base, ch, "xyzw"[i]))
+				}
+				lines = append(lines, idt+"}")
+				break
+			}
+			lines = append(lines, fmt.Sprintf("%s%s = %s;", idt, expr(lhs), expr(&s.Exprs[1])))
+		case shaderir.Init:
+			init := true
+			if topBlock == p.VertexFunc.Block {
+				// In the vertex function, varying values are the output parameters.
+				// These values are represented as a struct and not needed to be initialized.
+				na := len(p.Attributes)
+				nv := len(p.Varyings)
+				if s.InitIndex < na+nv+1 {
+					init = false
+				}
+			}
+			if init {
+				t := p.LocalVariableType(topBlock, block, s.InitIndex)
+				lines = append(lines, fmt.Sprintf("%s%s = %s();", idt, localVariableName(p, topBlock, s.InitIndex), c.typ(p, &t)))
+			}
+		case shaderir.If:
+			lines = append(lines, fmt.Sprintf("%sif (%s) {", idt, expr(&s.Exprs[0])))
+			lines = append(lines, c.block(p, topBlock, s.Blocks[0], level+1)...)
+			if len(s.Blocks) > 1 {
+				lines = append(lines, fmt.Sprintf("%s} else {", idt))
+				lines = append(lines, c.block(p, topBlock, s.Blocks[1], level+1)...)
+			}
+			lines = append(lines, fmt.Sprintf("%s}", idt))
+		case shaderir.For:
+			v := localVariableName(p, topBlock, s.ForVarIndex)
+			t := s.ForVarType
+			var delta string
+			switch val, _ := constant.Float64Val(s.ForDelta); {
+			case val == 0:
+				delta = fmt.Sprintf("?(unexpected delta: %v)", s.ForDelta)
+			case val == 1 && t.Main == shaderir.Int:
+				delta = fmt.Sprintf("%s++", v)
+			case val == -1 && t.Main == shaderir.Int:
+				delta = fmt.Sprintf("%s--", v)
+			default:
+				d := s.ForDelta
+				if t.Main == shaderir.Float {
+					d = constant.ToFloat(d)
+				}
+				if val > 0 {
+					delta = fmt.Sprintf("%s += %s", v, constantToNumberLiteral(d))
+				} else {
+					d = constant.UnaryOp(token.SUB, d, 0)
+					delta = fmt.Sprintf("%s -= %s", v, constantToNumberLiteral(d))
+				}
+			}
+			var op string
+			switch s.ForOp {
+			case shaderir.LessThanOp, shaderir.LessThanEqualOp, shaderir.GreaterThanOp, shaderir.GreaterThanEqualOp, shaderir.EqualOp, shaderir.NotEqualOp:
+				op = opString(s.ForOp)
+			default:
+				op = fmt.Sprintf("?(unexpected op: %d)", s.ForOp)
+			}
+
+			init, end := s.ForInit, s.ForEnd
+			if t.Main == shaderir.Float {
+				init, end = constant.ToFloat(init), constant.ToFloat(end)
+			}
+			ts := c.typ(p, &t)
+			lines = append(lines, fmt.Sprintf("%sfor (var %s: %s = %s; %s %s %s; %s) {", idt, v, ts, constantToNumberLiteral(init), v, op, constantToNumberLiteral(end), delta))
+
+			orig, ok := c.forVarTypes[s.ForVarIndex]
+			c.forVarTypes[s.ForVarIndex] = t
+			lines = append(lines, c.block(p, topBlock, s.Blocks[0], level+1)...)
+			if ok {
+				c.forVarTypes[s.ForVarIndex] = orig
+			} else {
+				delete(c.forVarTypes, s.ForVarIndex)
+			}
+
+			lines = append(lines, fmt.Sprintf("%s}", idt))
+		case shaderir.Continue:
+			lines = append(lines, idt+"continue;")
+		case shaderir.Break:
+			lines = append(lines, idt+"break;")
+		case shaderir.Return:
+			switch {
+			case topBlock == p.VertexFunc.Block:
+				lines = append(lines, fmt.Sprintf("%sreturn %s;", idt, vertexOut))
+			case len(s.Exprs) == 0:
+				lines = append(lines, idt+"return;")
+			default:
+				lines = append(lines, fmt.Sprintf("%sreturn %s;", idt, expr(&s.Exprs[0])))
+			}
+		case shaderir.Discard:
+			// 'discard' is invoked only in the fragment shader entry point.
+			lines = append(lines, idt+"discard;", idt+"return vec4<f32>(0.0);")
+		default:
+			lines = append(lines, fmt.Sprintf("%s?(unexpected stmt: %d)", idt, s.Type))
+		}
+	}
+
+	return lines
+}
+
+func (c *compileContext) builtinCall(p *shaderir.Program, f shaderir.BuiltinFunc, args []shaderir.Expr, expr func(e *shaderir.Expr) string, typeOf func(e *shaderir.Expr) shaderir.Type) string {
+	strs := make([]string, len(args))
+	ts := make([]shaderir.Type, len(args))
+	for i := range args {
+		strs[i] = expr(&args[i])
+		ts[i] = typeOf(&args[i])
+	}
+
+	// resultVectorType returns the vector type among the arguments, or the type of the first argument.
+	resultVectorType := func() shaderir.Type {
+		for _, t := range ts {
+			if !isScalar(&t) {
+				return t
+			}
+		}
+		return ts[0]
+	}
+
+	// splat converts scalar arguments to vectors.
+	splat := func(indices ...int) {
+		t := resultVectorType()
+		if isScalar(&t) {
+			return
+		}
+		for _, i := range indices {
+			if isScalar(&ts[i]) {
+				strs[i] = fmt.Sprintf("%s(%s)", typeString(&t), strs[i])
+			}
+		}
+	}
+
+	switch f {
+	case shaderir.Len, shaderir.Cap:
+		if ts[0].Main == shaderir.Array {
+			return fmt.Sprintf("%d", ts[0].Length)
+		}
+		return fmt.Sprintf("?(unexpected argument for %s)", f)
+	case shaderir.Vec2F, shaderir.Vec3F, shaderir.Vec4F:
+		// WGSL doesn't convert integers to floats implicitly.
+		for i, t := range ts {
+			switch t.Main {
+			case shaderir.Int:
+				strs[i] = fmt.Sprintf("f32(%s)", strs[i])
+			case shaderir.IVec2, shaderir.IVec3, shaderir.IVec4:
+				t := vectorType(shaderir.Float, t.VectorElementCount())
+				strs[i] = fmt.Sprintf("%s(%s)", typeString(&t), strs[i])
+			}
+		}
+	case shaderir.IVec2F, shaderir.IVec3F, shaderir.IVec4F:
+		for i, t := range ts {
+			switch t.Main {
+			case shaderir.Float:
+				strs[i] = fmt.Sprintf("i32(%s)", strs[i])
+			case shaderir.Vec2, shaderir.Vec3, shaderir.Vec4:
+				t := vectorType(shaderir.Int, t.VectorElementCount())
+				strs[i] = fmt.Sprintf("%s(%s)", typeString(&t), strs[i])
+			}
+		}
+	case shaderir.Mat2F, shaderir.Mat3F, shaderir.Mat4F:
+		n := map[shaderir.BuiltinFunc]int{
+			shaderir.Mat2F: 2,
+			shaderir.Mat3F: 3,
+			shaderir.Mat4F: 4,
+		}[f]
+		if len(args) == 1 {
+			if isScalar(&ts[0]) {
+				// A diagonal matrix.
+				cols := make([]string, n)
+				for i := range cols {
+					comps := make([]string, n)
+					for j := range comps {
+						if i == j {
+							comps[j] = strs[0]
+						} else {
+							comps[j] = "0.0"
+						}
+					}
+					cols[i] = fmt.Sprintf("vec%d<f32>(%s)", n, strings.Join(comps, ", "))
+				}
+				return fmt.Sprintf("%s(%s)", builtinFuncString(f), strings.Join(cols, ", "))
+			}
+			if ts[0].IsMatrix() && ts[0].MatrixSize() != n {
+				// A matrix from another size of matrix. Missing elements are filled with the identity matrix.
+				m := ts[0].MatrixSize()
+				cols := make([]string, n)
+				for i := range cols {
+					if i >= m {
+						comps := make([]string, n)
+						for j := range comps {
+							comps[j] = "0.0"
+							if i == j {
+								comps[j] = "1.0"
+							}
+						}
+						cols[i] = fmt.Sprintf("vec%d<f32>(%s)", n, strings.Join(comps, ", "))
+						continue
+					}
+					col := fmt.Sprintf("(%s)[%d]", strs[0], i)
+					if n < m {
+						cols[i] = col + "." + "xyzw"[:n]
+						continue
+					}
+					comps := []string{col}
+					for j := m; j < n; j++ {
+						if i == j {
+							comps = append(comps, "1.0")
+						} else {
+							comps = append(comps, "0.0")
+						}
+					}
+					cols[i] = fmt.Sprintf("vec%d<f32>(%s)", n, strings.Join(comps, ", "))
+				}
+				return fmt.Sprintf("%s(%s)", builtinFuncString(f), strings.Join(cols, ", "))
+			}
+		}
+	case shaderir.Mod:
+		// WGSL's % is a truncated remainder unlike GLSL's mod.
+		return fmt.Sprintf("((%[1]s) - (%[2]s) * floor((%[1]s) / (%[2]s)))", strs[0], strs[1])
+	case shaderir.Min, shaderir.Max:
+		splat(1)
+	case shaderir.Clamp:
+		splat(1, 2)
+	case shaderir.Step:
+		splat(0)
+	case shaderir.Smoothstep:
+		splat(0, 1)
+	case shaderir.TexelAt:
+		switch p.Unit {
+		case shaderir.Texels:
+			return fmt.Sprintf("textureSampleLevel(%s, texture_sampler, %s, 0.0)", strs[0], strs[1])
+		case shaderir.Pixels:
+			return fmt.Sprintf("textureLoad(%s, vec2<i32>(%s), 0)", strs[0], strs[1])
+		default:
+			panic(fmt.Sprintf("wgsl: unexpected unit: %d", p.Unit))
+		}
+	}
+
+	return fmt.Sprintf("%s(%s)", builtinFuncString(f), strings.Join(strs, ", "))
+}
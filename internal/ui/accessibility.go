@@ -0,0 +1,33 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+// AccessibilityPreferences represents the system's accessibility preferences.
+type AccessibilityPreferences struct {
+	// ReducedMotion reports whether the user prefers reduced motion.
+	ReducedMotion bool
+
+	// HighContrast reports whether the user prefers high contrast.
+	HighContrast bool
+
+	// TextScaleFactor is the scale factor of text sizes the user prefers. The default value is 1.
+	TextScaleFactor float64
+}
+
+func defaultAccessibilityPreferences() AccessibilityPreferences {
+	return AccessibilityPreferences{
+		TextScaleFactor: 1,
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"syscall/js"
+)
+
+func (u *UserInterface) AccessibilityPreferences() AccessibilityPreferences {
+	p := defaultAccessibilityPreferences()
+
+	// The DOM is not available in a worker.
+	if !window.Truthy() || !window.Get("matchMedia").Truthy() {
+		return p
+	}
+
+	p.ReducedMotion = matchesMedia("(prefers-reduced-motion: reduce)")
+	p.HighContrast = matchesMedia("(prefers-contrast: more)") || matchesMedia("(forced-colors: active)")
+
+	// The default font size of the root element is 16px. Users can change this in the browser settings.
+	if document.Truthy() && document.Get("documentElement").Truthy() {
+		style := window.Call("getComputedStyle", document.Get("documentElement"))
+		if size := js.Global().Call("parseFloat", style.Get("fontSize")).Float(); size > 0 {
+			p.TextScaleFactor = size / 16
+		}
+	}
+	return p
+}
+
+func matchesMedia(query string) bool {
+	return window.Call("matchMedia", query).Get("matches").Truthy()
+}
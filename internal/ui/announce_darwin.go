@@ -0,0 +1,100 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !ios
+
+package ui
+
+import (
+	"sync"
+
+	"github.com/ebitengine/purego"
+	"github.com/ebitengine/purego/objc"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/cocoa"
+)
+
+var (
+	class_NSMutableDictionary = objc.GetClass("NSMutableDictionary")
+	class_NSNumber            = objc.GetClass("NSNumber")
+)
+
+var (
+	sel_dictionary        = objc.RegisterName("dictionary")
+	sel_numberWithInteger = objc.RegisterName("numberWithInteger:")
+	sel_release           = objc.RegisterName("release")
+	sel_setObjectForKey   = objc.RegisterName("setObject:forKey:")
+)
+
+// The values of NSAccessibilityAnnouncementRequestedNotification, NSAccessibilityAnnouncementKey,
+// NSAccessibilityPriorityKey, and NSAccessibilityPriorityHigh.
+const (
+	nsAccessibilityAnnouncementRequestedNotification = "AXAnnouncementRequested"
+	nsAccessibilityAnnouncementKey                   = "AXAnnouncementKey"
+	nsAccessibilityPriorityKey                       = "AXPriorityKey"
+	nsAccessibilityPriorityHigh                      = 90
+)
+
+var (
+	_NSAccessibilityPostNotificationWithUserInfo func(element objc.ID, notification objc.ID, userInfo objc.ID)
+
+	appKitOnce sync.Once
+	appKitErr  error
+)
+
+func initializeAppKitAccessibility() error {
+	appKitOnce.Do(func() {
+		appKit, err := purego.Dlopen("/System/Library/Frameworks/AppKit.framework/AppKit", purego.RTLD_LAZY|purego.RTLD_GLOBAL)
+		if err != nil {
+			appKitErr = err
+			return
+		}
+		purego.RegisterLibFunc(&_NSAccessibilityPostNotificationWithUserInfo, appKit, "NSAccessibilityPostNotificationWithUserInfo")
+	})
+	return appKitErr
+}
+
+// announce must be called from the main thread.
+func (u *UserInterface) announce(text string) error {
+	if err := initializeAppKitAccessibility(); err != nil {
+		return err
+	}
+
+	w, err := u.window.GetCocoaWindow()
+	if err != nil {
+		return err
+	}
+
+	pool := cocoa.NSAutoreleasePool_new()
+	defer pool.Release()
+
+	newString := func(str string) objc.ID {
+		return cocoa.NSString_alloc().InitWithUTF8String(str).ID
+	}
+	announcement := newString(text)
+	defer announcement.Send(sel_release)
+	announcementKey := newString(nsAccessibilityAnnouncementKey)
+	defer announcementKey.Send(sel_release)
+	priorityKey := newString(nsAccessibilityPriorityKey)
+	defer priorityKey.Send(sel_release)
+	notification := newString(nsAccessibilityAnnouncementRequestedNotification)
+	defer notification.Send(sel_release)
+
+	userInfo := objc.ID(class_NSMutableDictionary).Send(sel_dictionary)
+	userInfo.Send(sel_setObjectForKey, announcement, announcementKey)
+	userInfo.Send(sel_setObjectForKey, objc.ID(class_NSNumber).Send(sel_numberWithInteger, nsAccessibilityPriorityHigh), priorityKey)
+
+	_NSAccessibilityPostNotificationWithUserInfo(objc.ID(w), notification, userInfo)
+	return nil
+}
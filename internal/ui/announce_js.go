@@ -0,0 +1,57 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"syscall/js"
+)
+
+// liveRegion is an ARIA live region to send texts to screen readers.
+var liveRegion js.Value
+
+func (u *UserInterface) Announce(text string) {
+	// The DOM is not available in a worker.
+	if !document.Truthy() || !document.Get("body").Truthy() {
+		return
+	}
+
+	if !liveRegion.Truthy() {
+		liveRegion = document.Call("createElement", "div")
+		liveRegion.Call("setAttribute", "aria-live", "assertive")
+		liveRegion.Call("setAttribute", "aria-atomic", "true")
+		// Hide the element visually but keep it in the accessibility tree.
+		style := liveRegion.Get("style")
+		style.Set("position", "absolute")
+		style.Set("width", "1px")
+		style.Set("height", "1px")
+		style.Set("margin", "-1px")
+		style.Set("padding", "0")
+		style.Set("overflow", "hidden")
+		style.Set("clip", "rect(0, 0, 0, 0)")
+		style.Set("whiteSpace", "nowrap")
+		style.Set("border", "0")
+		document.Get("body").Call("appendChild", liveRegion)
+	}
+
+	// Clear the content first so that the same text is announced again.
+	liveRegion.Set("textContent", "")
+	var f js.Func
+	f = js.FuncOf(func(this js.Value, args []js.Value) any {
+		f.Release()
+		liveRegion.Set("textContent", text)
+		return nil
+	})
+	setTimeout.Invoke(f, 100)
+}
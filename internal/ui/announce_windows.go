@@ -0,0 +1,200 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"fmt"
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/microsoftgdk"
+)
+
+// Announcing a text with UI Automation requires an IRawElementProviderSimple object.
+// uiaProvider is a minimal implementation of IRawElementProviderSimple whose host is the window.
+
+const (
+	_E_NOINTERFACE = 0x80004002
+	_E_INVALIDARG  = 0x80070057
+
+	_ProviderOptions_ServerSideProvider = 0x2
+	_ProviderOptions_UseComThreading    = 0x20
+
+	_NotificationKind_Other                     = 4
+	_NotificationProcessing_ImportantMostRecent = 1
+)
+
+var (
+	_IID_IUnknown = windows.GUID{
+		Data1: 0x00000000,
+		Data2: 0x0000,
+		Data3: 0x0000,
+		Data4: [...]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46},
+	}
+	_IID_IRawElementProviderSimple = windows.GUID{
+		Data1: 0xd6dd68d1,
+		Data2: 0x86fd,
+		Data3: 0x4332,
+		Data4: [...]byte{0x86, 0x66, 0x9a, 0xbe, 0xde, 0xa2, 0xd2, 0x4c},
+	}
+)
+
+var (
+	oleaut32         = windows.NewLazySystemDLL("oleaut32.dll")
+	uiautomationcore = windows.NewLazySystemDLL("uiautomationcore.dll")
+
+	procSysAllocString = oleaut32.NewProc("SysAllocString")
+	procSysFreeString  = oleaut32.NewProc("SysFreeString")
+
+	procUiaHostProviderFromHwnd   = uiautomationcore.NewProc("UiaHostProviderFromHwnd")
+	procUiaRaiseNotificationEvent = uiautomationcore.NewProc("UiaRaiseNotificationEvent")
+)
+
+func _SysAllocString(str string) (uintptr, error) {
+	s, err := windows.UTF16PtrFromString(str)
+	if err != nil {
+		return 0, err
+	}
+	r, _, _ := procSysAllocString.Call(uintptr(unsafe.Pointer(s)))
+	runtime.KeepAlive(s)
+	if r == 0 {
+		return 0, fmt.Errorf("ui: SysAllocString failed")
+	}
+	return r, nil
+}
+
+func _SysFreeString(bstrString uintptr) {
+	_, _, _ = procSysFreeString.Call(bstrString)
+}
+
+func _UiaRaiseNotificationEvent(provider *uiaProvider, notificationKind int32, notificationProcessing int32, displayString uintptr, activityId uintptr) error {
+	r, _, _ := procUiaRaiseNotificationEvent.Call(uintptr(unsafe.Pointer(provider)), uintptr(notificationKind), uintptr(notificationProcessing), displayString, activityId)
+	runtime.KeepAlive(provider)
+	if uint32(r) != uint32(windows.S_OK) {
+		return fmt.Errorf("ui: UiaRaiseNotificationEvent failed: HRESULT(%d)", uint32(r))
+	}
+	return nil
+}
+
+type uiaProvider struct {
+	vtbl *uiaProviderVtbl
+	hwnd windows.HWND
+}
+
+type uiaProviderVtbl struct {
+	QueryInterface uintptr
+	AddRef         uintptr
+	Release        uintptr
+
+	get_ProviderOptions        uintptr
+	GetPatternProvider         uintptr
+	GetPropertyValue           uintptr
+	get_HostRawElementProvider uintptr
+}
+
+// theUIAProvider is never freed, as UI Automation might refer to it anytime.
+var theUIAProvider *uiaProvider
+
+func newUIAProviderVtbl() *uiaProviderVtbl {
+	return &uiaProviderVtbl{
+		QueryInterface: syscall.NewCallback(func(this *uiaProvider, riid *windows.GUID, ppvObject *unsafe.Pointer) uintptr {
+			if ppvObject == nil {
+				return _E_INVALIDARG
+			}
+			if *riid != _IID_IUnknown && *riid != _IID_IRawElementProviderSimple {
+				*ppvObject = nil
+				return _E_NOINTERFACE
+			}
+			*ppvObject = unsafe.Pointer(this)
+			return uintptr(windows.S_OK)
+		}),
+		// The provider is a static object, so reference counting is not needed.
+		AddRef: syscall.NewCallback(func(this *uiaProvider) uintptr {
+			return 1
+		}),
+		Release: syscall.NewCallback(func(this *uiaProvider) uintptr {
+			return 1
+		}),
+		get_ProviderOptions: syscall.NewCallback(func(this *uiaProvider, pRetVal *int32) uintptr {
+			if pRetVal == nil {
+				return _E_INVALIDARG
+			}
+			*pRetVal = _ProviderOptions_ServerSideProvider | _ProviderOptions_UseComThreading
+			return uintptr(windows.S_OK)
+		}),
+		GetPatternProvider: syscall.NewCallback(func(this *uiaProvider, patternId int32, pRetVal *unsafe.Pointer) uintptr {
+			if pRetVal == nil {
+				return _E_INVALIDARG
+			}
+			*pRetVal = nil
+			return uintptr(windows.S_OK)
+		}),
+		GetPropertyValue: syscall.NewCallback(func(this *uiaProvider, propertyId int32, pRetVal *uint16) uintptr {
+			if pRetVal == nil {
+				return _E_INVALIDARG
+			}
+			// Set VT_EMPTY to VARIANT's vt so that the host provider's values are used.
+			*pRetVal = 0
+			return uintptr(windows.S_OK)
+		}),
+		get_HostRawElementProvider: syscall.NewCallback(func(this *uiaProvider, pRetVal *unsafe.Pointer) uintptr {
+			if pRetVal == nil {
+				return _E_INVALIDARG
+			}
+			r, _, _ := procUiaHostProviderFromHwnd.Call(uintptr(this.hwnd), uintptr(unsafe.Pointer(pRetVal)))
+			return r
+		}),
+	}
+}
+
+// announce must be called from the main thread.
+func (u *UserInterface) announce(text string) error {
+	if microsoftgdk.IsXbox() {
+		return nil
+	}
+	// UiaRaiseNotificationEvent is available as of Windows 10 version 1709.
+	if procUiaRaiseNotificationEvent.Find() != nil || procUiaHostProviderFromHwnd.Find() != nil {
+		return nil
+	}
+
+	w, err := u.window.GetWin32Window()
+	if err != nil {
+		return err
+	}
+
+	if theUIAProvider == nil {
+		theUIAProvider = &uiaProvider{
+			vtbl: newUIAProviderVtbl(),
+		}
+	}
+	theUIAProvider.hwnd = w
+
+	displayString, err := _SysAllocString(text)
+	if err != nil {
+		return err
+	}
+	defer _SysFreeString(displayString)
+
+	activityID, err := _SysAllocString("Ebitengine.Announce")
+	if err != nil {
+		return err
+	}
+	defer _SysFreeString(activityID)
+
+	return _UiaRaiseNotificationEvent(theUIAProvider, _NotificationKind_Other, _NotificationProcessing_ImportantMostRecent, displayString, activityID)
+}
@@ -25,12 +25,23 @@ import (
 )
 
 const (
-	_CLSCTX_INPROC_SERVER     = 0x1
-	_CLSCTX_LOCAL_SERVER      = 0x4
-	_CLSCTX_REMOTE_SERVER     = 0x10
-	_CLSCTX_SERVER            = _CLSCTX_INPROC_SERVER | _CLSCTX_LOCAL_SERVER | _CLSCTX_REMOTE_SERVER
-	_MONITOR_DEFAULTTONEAREST = 2
-	_SM_CYCAPTION             = 4
+	_TBPF_NOPROGRESS    = 0x0
+	_TBPF_INDETERMINATE = 0x1
+	_TBPF_NORMAL        = 0x2
+	_TBPF_ERROR         = 0x4
+	_TBPF_PAUSED        = 0x8
+)
+
+const (
+	_CLSCTX_INPROC_SERVER       = 0x1
+	_CLSCTX_LOCAL_SERVER        = 0x4
+	_CLSCTX_REMOTE_SERVER       = 0x10
+	_CLSCTX_SERVER              = _CLSCTX_INPROC_SERVER | _CLSCTX_LOCAL_SERVER | _CLSCTX_REMOTE_SERVER
+	_HCF_HIGHCONTRASTON         = 0x00000001
+	_MONITOR_DEFAULTTONEAREST   = 2
+	_SM_CYCAPTION               = 4
+	_SPI_GETCLIENTAREAANIMATION = 0x1042
+	_SPI_GETHIGHCONTRAST        = 0x0042
 )
 
 var (
@@ -46,6 +57,12 @@ var (
 		Data3: 0x11D0,
 		Data4: [...]byte{0x95, 0x8A, 0x00, 0x60, 0x97, 0xC9, 0xA0, 0x90},
 	}
+	_IID_ITaskbarList3 = windows.GUID{
+		Data1: 0xEA1AFB91,
+		Data2: 0x9E28,
+		Data3: 0x4B86,
+		Data4: [...]byte{0x90, 0xE9, 0x9E, 0x9F, 0x8A, 0x5E, 0xEF, 0xAF},
+	}
 )
 
 type _RECT struct {
@@ -67,6 +84,12 @@ type _POINT struct {
 	y int32
 }
 
+type _HIGHCONTRASTW struct {
+	cbSize            uint32
+	dwFlags           uint32
+	lpszDefaultScheme *uint16
+}
+
 var (
 	imm32  = windows.NewLazySystemDLL("imm32.dll")
 	ole32  = windows.NewLazySystemDLL("ole32.dll")
@@ -80,6 +103,8 @@ var (
 	procMonitorFromWindow = user32.NewProc("MonitorFromWindow")
 	procGetMonitorInfoW   = user32.NewProc("GetMonitorInfoW")
 	procGetCursorPos      = user32.NewProc("GetCursorPos")
+
+	procSystemParametersInfoW = user32.NewProc("SystemParametersInfoW")
 )
 
 func _ImmAssociateContext(hwnd windows.HWND, hIMC uintptr) (uintptr, error) {
@@ -142,6 +167,17 @@ func _GetCursorPos() (int32, int32, error) {
 	return pt.x, pt.y, nil
 }
 
+func _SystemParametersInfoW(uiAction uint32, uiParam uint32, pvParam unsafe.Pointer, fWinIni uint32) error {
+	r, _, e := procSystemParametersInfoW.Call(uintptr(uiAction), uintptr(uiParam), uintptr(pvParam), uintptr(fWinIni))
+	if int32(r) == 0 {
+		if e != nil && !errors.Is(e, windows.ERROR_SUCCESS) {
+			return fmt.Errorf("ui: SystemParametersInfoW failed: error code: %w", e)
+		}
+		return fmt.Errorf("ui: SystemParametersInfoW failed: returned 0")
+	}
+	return nil
+}
+
 type _ITaskbarList struct {
 	vtbl *_ITaskbarList_Vtbl
 }
@@ -169,3 +205,58 @@ func (i *_ITaskbarList) DeleteTab(hwnd windows.HWND) error {
 func (i *_ITaskbarList) Release() {
 	_, _, _ = syscall.Syscall(i.vtbl.Release, 1, uintptr(unsafe.Pointer(i)), 0, 0)
 }
+
+type _ITaskbarList3 struct {
+	vtbl *_ITaskbarList3_Vtbl
+}
+
+type _ITaskbarList3_Vtbl struct {
+	QueryInterface uintptr
+	AddRef         uintptr
+	Release        uintptr
+
+	HrInit       uintptr
+	AddTab       uintptr
+	DeleteTab    uintptr
+	ActivateTab  uintptr
+	SetActiveAlt uintptr
+
+	MarkFullscreenWindow uintptr
+
+	SetProgressValue uintptr
+	SetProgressState uintptr
+}
+
+func (i *_ITaskbarList3) HrInit() error {
+	r, _, _ := syscall.Syscall(i.vtbl.HrInit, 1, uintptr(unsafe.Pointer(i)), 0, 0)
+	if uint32(r) != uint32(windows.S_OK) {
+		return fmt.Errorf("ui: ITaskbarList3::HrInit failed: HRESULT(%d)", uint32(r))
+	}
+	return nil
+}
+
+func (i *_ITaskbarList3) SetProgressValue(hwnd windows.HWND, ullCompleted uint64, ullTotal uint64) error {
+	var r uintptr
+	if unsafe.Sizeof(uintptr(0)) == 4 {
+		// ULONGLONG values are passed as two 32-bit values on 32-bit machines.
+		r, _, _ = syscall.SyscallN(i.vtbl.SetProgressValue, uintptr(unsafe.Pointer(i)), uintptr(hwnd), uintptr(uint32(ullCompleted)), uintptr(ullCompleted>>32), uintptr(uint32(ullTotal)), uintptr(ullTotal>>32))
+	} else {
+		r, _, _ = syscall.SyscallN(i.vtbl.SetProgressValue, uintptr(unsafe.Pointer(i)), uintptr(hwnd), uintptr(ullCompleted), uintptr(ullTotal))
+	}
+	if uint32(r) != uint32(windows.S_OK) {
+		return fmt.Errorf("ui: ITaskbarList3::SetProgressValue failed: HRESULT(%d)", uint32(r))
+	}
+	return nil
+}
+
+func (i *_ITaskbarList3) SetProgressState(hwnd windows.HWND, tbpFlags uint32) error {
+	r, _, _ := syscall.Syscall(i.vtbl.SetProgressState, 3, uintptr(unsafe.Pointer(i)), uintptr(hwnd), uintptr(tbpFlags))
+	if uint32(r) != uint32(windows.S_OK) {
+		return fmt.Errorf("ui: ITaskbarList3::SetProgressState failed: HRESULT(%d)", uint32(r))
+	}
+	return nil
+}
+
+func (i *_ITaskbarList3) Release() {
+	_, _, _ = syscall.Syscall(i.vtbl.Release, 1, uintptr(unsafe.Pointer(i)), 0, 0)
+}
@@ -15,6 +15,7 @@
 package ui
 
 import (
+	"image"
 	"math"
 	"time"
 
@@ -119,6 +120,11 @@ func (c *context) updateFrameImpl(graphicsDriver graphicsdriver.Graphics, update
 		}
 	}()
 
+	// Notify the restoration outside of the atlas's lock so that the hooks can manipulate images.
+	if atlas.TakeContextRestored() {
+		hook.RunLifecycleEventHooks(hook.LifecycleEventGraphicsContextRestored)
+	}
+
 	// Flush deferred functions, like reading pixels from GPU.
 	if err := c.processFuncsInFrame(ui); err != nil {
 		return false, err
@@ -128,12 +134,15 @@ func (c *context) updateFrameImpl(graphicsDriver graphicsdriver.Graphics, update
 	if w, h := c.layoutGame(outsideWidth, outsideHeight, deviceScaleFactor); w == 0 || h == 0 {
 		return false, nil
 	}
+	ui.updateDeviceScaleFactor(deviceScaleFactor)
 
 	// Update the input state after the layout is updated as a cursor position is affected by the layout.
 	if err := ui.updateInputState(); err != nil {
 		return false, err
 	}
 
+	updateCount = ui.adjustUpdateCount(updateCount)
+
 	// Ensure that Update is called once before Draw so that Update can be used for initialization.
 	if !c.updateCalled && updateCount == 0 {
 		updateCount = 1
@@ -170,9 +179,55 @@ func (c *context) updateFrameImpl(graphicsDriver graphicsdriver.Graphics, update
 	}
 
 	// Draw the game.
+	defer hook.RunAfterFrameHooks()
 	return c.drawGame(graphicsDriver, ui, forceDraw)
 }
 
+// redrawFinalScreen re-presents the current offscreen scaled to the given outside size,
+// without calling the game's Update and Draw.
+//
+// redrawFinalScreen is used while the window is being resized interactively and the game loop cannot proceed.
+func (c *context) redrawFinalScreen(graphicsDriver graphicsdriver.Graphics, outsideWidth, outsideHeight float64, deviceScaleFactor float64) error {
+	if c.offscreen == nil || c.screen == nil {
+		return nil
+	}
+	if outsideWidth == 0 || outsideHeight == 0 {
+		return nil
+	}
+
+	if err := c.redrawFinalScreenInFrame(graphicsDriver, outsideWidth, outsideHeight, deviceScaleFactor); err != nil {
+		return err
+	}
+	return atlas.SwapBuffers(graphicsDriver)
+}
+
+func (c *context) redrawFinalScreenInFrame(graphicsDriver graphicsdriver.Graphics, outsideWidth, outsideHeight float64, deviceScaleFactor float64) (err error) {
+	if err := atlas.BeginFrame(graphicsDriver); err != nil {
+		return err
+	}
+	defer func() {
+		if err1 := atlas.EndFrame(); err1 != nil && err == nil {
+			err = err1
+		}
+	}()
+
+	// Update only the screen size. The offscreen is kept as it is, as the game's Layout is not called.
+	c.screenWidth = outsideWidth * deviceScaleFactor
+	c.screenHeight = outsideHeight * deviceScaleFactor
+	sw := int(math.Ceil(c.screenWidth))
+	sh := int(math.Ceil(c.screenHeight))
+	if c.screen.width != sw || c.screen.height != sh {
+		c.screen.Deallocate()
+		c.screen = c.game.NewScreenImage(sw, sh)
+	}
+
+	// The regions outside of the offscreen might have stale pixels. Clear the screen always.
+	c.screen.clear()
+	c.game.DrawFinalScreen(c.screenScaleAndOffsets())
+	c.screen.flushBufferIfNeeded()
+	return nil
+}
+
 func (c *context) swapBuffersOrWait(needsSwapBuffers bool, graphicsDriver graphicsdriver.Graphics, vsyncEnabled bool) error {
 	now := time.Now()
 	defer func() {
@@ -234,7 +289,14 @@ func (c *context) drawGame(graphicsDriver graphicsdriver.Graphics, ui *UserInter
 
 	const maxSkipCount = 4
 
-	if !forceDraw && !c.isOffscreenModified {
+	modified := c.isOffscreenModified
+	// In the damage tracking mode, only the declared damaged regions are regarded as changes.
+	// Take the damaged regions regardless of the mode so that stale regions don't remain.
+	if d := ui.takeScreenDamage(); ui.IsScreenDamageTrackingEnabled() {
+		modified = !d.Intersect(image.Rect(0, 0, c.offscreen.width, c.offscreen.height)).Empty()
+	}
+
+	if !forceDraw && !modified {
 		if c.skipCount < maxSkipCount {
 			c.skipCount++
 		}
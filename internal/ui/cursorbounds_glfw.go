@@ -0,0 +1,90 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !android && !ios && !js && !nintendosdk && !playstation5
+
+package ui
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/glfw"
+)
+
+func (u *UserInterface) CursorBounds() image.Rectangle {
+	u.m.RLock()
+	defer u.m.RUnlock()
+	return u.cursorBounds
+}
+
+func (u *UserInterface) SetCursorBounds(bounds image.Rectangle) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.cursorBounds = bounds.Canon()
+}
+
+// updateCursorBounds moves the cursor back into the cursor bounds if the cursor is out of them.
+//
+// updateCursorBounds must be called from the main thread.
+func (u *UserInterface) updateCursorBounds() error {
+	u.m.RLock()
+	bounds := u.cursorBounds
+	cx, cy := u.inputState.CursorX, u.inputState.CursorY
+	u.m.RUnlock()
+
+	if bounds.Empty() {
+		return nil
+	}
+
+	// A captured cursor is already confined.
+	mode, err := u.window.GetInputMode(glfw.CursorMode)
+	if err != nil {
+		return err
+	}
+	if mode == glfw.CursorDisabled {
+		return nil
+	}
+
+	// Do not take the cursor from other applications.
+	focused, err := u.window.GetAttrib(glfw.Focused)
+	if err != nil {
+		return err
+	}
+	if focused == glfw.False {
+		return nil
+	}
+
+	x := min(max(cx, float64(bounds.Min.X)), float64(bounds.Max.X-1))
+	y := min(max(cy, float64(bounds.Min.Y)), float64(bounds.Max.Y-1))
+	if x == cx && y == cy {
+		return nil
+	}
+
+	m, err := u.currentMonitor()
+	if err != nil {
+		return err
+	}
+	s := m.DeviceScaleFactor()
+	x2, y2 := u.context.logicalPositionToClientPosition(x, y, s)
+	x2 = dipToGLFWPixel(x2, s)
+	y2 = dipToGLFWPixel(y2, s)
+	if err := u.window.SetCursorPos(x2, y2); err != nil {
+		return err
+	}
+
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.inputState.CursorX, u.inputState.CursorY = x, y
+	return nil
+}
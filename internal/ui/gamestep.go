@@ -0,0 +1,80 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"math"
+	"sync"
+)
+
+// maxStepsPerFrame is the maximum number of the requested steps processed in one frame.
+const maxStepsPerFrame = 60
+
+// gameStepState is the state to pause the game and to step the paused game.
+//
+// The pause state and the pending steps are protected by one mutex so that
+// a step requested while the game is being resumed is never processed later.
+type gameStepState struct {
+	paused       bool
+	pendingSteps int
+
+	m sync.Mutex
+}
+
+func (g *gameStepState) isPaused() bool {
+	g.m.Lock()
+	defer g.m.Unlock()
+	return g.paused
+}
+
+// setPaused sets whether the game is paused.
+// When the game is resumed, the pending steps are discarded.
+func (g *gameStepState) setPaused(paused bool) {
+	g.m.Lock()
+	defer g.m.Unlock()
+	g.paused = paused
+	if !paused {
+		g.pendingSteps = 0
+	}
+}
+
+// step requests to advance the paused game by ticks.
+// If the game is not paused, step does nothing.
+func (g *gameStepState) step(ticks int) {
+	g.m.Lock()
+	defer g.m.Unlock()
+	if !g.paused {
+		return
+	}
+	if g.pendingSteps > math.MaxInt-ticks {
+		g.pendingSteps = math.MaxInt
+		return
+	}
+	g.pendingSteps += ticks
+}
+
+// adjustUpdateCount returns the number of Update calls at the current frame.
+// If the game is paused, adjustUpdateCount returns the number of the requested steps instead of updateCount.
+// The requested steps more than maxStepsPerFrame are carried over to the next frames.
+func (g *gameStepState) adjustUpdateCount(updateCount int) int {
+	g.m.Lock()
+	defer g.m.Unlock()
+	if !g.paused {
+		return updateCount
+	}
+	n := min(g.pendingSteps, maxStepsPerFrame)
+	g.pendingSteps -= n
+	return n
+}
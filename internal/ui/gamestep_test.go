@@ -0,0 +1,61 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"testing"
+)
+
+func TestGameStepStateAdjustUpdateCount(t *testing.T) {
+	var g gameStepState
+
+	// While the game is not paused, the update count is not changed.
+	if got, want := g.adjustUpdateCount(3), 3; got != want {
+		t.Errorf("adjustUpdateCount(3): got: %d, want: %d", got, want)
+	}
+
+	// Steps are ignored while the game is not paused.
+	g.step(5)
+	g.setPaused(true)
+	if got, want := g.adjustUpdateCount(3), 0; got != want {
+		t.Errorf("adjustUpdateCount(3): got: %d, want: %d", got, want)
+	}
+
+	// Steps are accumulated until the next frame.
+	g.step(2)
+	g.step(3)
+	if got, want := g.adjustUpdateCount(1), 5; got != want {
+		t.Errorf("adjustUpdateCount(1): got: %d, want: %d", got, want)
+	}
+	if got, want := g.adjustUpdateCount(1), 0; got != want {
+		t.Errorf("adjustUpdateCount(1): got: %d, want: %d", got, want)
+	}
+
+	// At most maxStepsPerFrame steps are processed in one frame, and the rest are carried over.
+	g.step(maxStepsPerFrame*2 + 10)
+	for i, want := range []int{maxStepsPerFrame, maxStepsPerFrame, 10, 0} {
+		if got := g.adjustUpdateCount(1); got != want {
+			t.Errorf("frame %d: adjustUpdateCount(1): got: %d, want: %d", i, got, want)
+		}
+	}
+
+	// Resuming the game discards the pending steps.
+	g.step(10)
+	g.setPaused(false)
+	g.setPaused(true)
+	if got, want := g.adjustUpdateCount(1), 0; got != want {
+		t.Errorf("adjustUpdateCount(1): got: %d, want: %d", got, want)
+	}
+}
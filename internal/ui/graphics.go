@@ -27,6 +27,7 @@ type graphicsDriverCreator interface {
 	newDirectX() (graphicsdriver.Graphics, error)
 	newMetal() (graphicsdriver.Graphics, error)
 	newPlayStation5() (graphicsdriver.Graphics, error)
+	newWebGPU() (graphicsdriver.Graphics, error)
 }
 
 func newGraphicsDriver(creator graphicsDriverCreator, graphicsLibrary GraphicsLibrary) (graphicsdriver.Graphics, GraphicsLibrary, error) {
@@ -50,6 +51,8 @@ func newGraphicsDriver(creator graphicsDriverCreator, graphicsLibrary GraphicsLi
 			graphicsLibrary = GraphicsLibraryMetal
 		case "playstation5":
 			graphicsLibrary = GraphicsLibraryPlayStation5
+		case "webgpu":
+			graphicsLibrary = GraphicsLibraryWebGPU
 		default:
 			return nil, 0, fmt.Errorf("ui: an unsupported graphics library is specified by the environment variable: %s", env)
 		}
@@ -89,6 +92,12 @@ func newGraphicsDriver(creator graphicsDriverCreator, graphicsLibrary GraphicsLi
 			return nil, 0, err
 		}
 		return g, GraphicsLibraryPlayStation5, nil
+	case GraphicsLibraryWebGPU:
+		g, err := creator.newWebGPU()
+		if err != nil {
+			return nil, 0, err
+		}
+		return g, GraphicsLibraryWebGPU, nil
 	default:
 		return nil, 0, fmt.Errorf("ui: an unsupported graphics library is specified: %d", graphicsLibrary)
 	}
@@ -107,6 +116,7 @@ const (
 	GraphicsLibraryDirectX
 	GraphicsLibraryMetal
 	GraphicsLibraryPlayStation5
+	GraphicsLibraryWebGPU
 )
 
 func (g GraphicsLibrary) String() string {
@@ -123,6 +133,8 @@ func (g GraphicsLibrary) String() string {
 		return "Metal"
 	case GraphicsLibraryPlayStation5:
 		return "PlayStation 5"
+	case GraphicsLibraryWebGPU:
+		return "WebGPU"
 	default:
 		return fmt.Sprintf("GraphicsLibrary(%d)", g)
 	}
@@ -0,0 +1,49 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"errors"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/clock"
+	"github.com/hajimehoshi/ebiten/v2/internal/hook"
+)
+
+// RunWithoutRendering calls update at the current TPS without any graphics context until update returns an error.
+//
+// If update returns RegularTermination, RunWithoutRendering returns nil.
+func (u *UserInterface) RunWithoutRendering(update func() error) error {
+	defer u.setTerminated()
+
+	for {
+		n := clock.UpdateFrameWithoutRendering()
+		for i := 0; i < n; i++ {
+			if err := hook.RunBeforeUpdateHooks(); err != nil {
+				return err
+			}
+			if err := update(); err != nil {
+				if errors.Is(err, RegularTermination) {
+					return nil
+				}
+				return err
+			}
+			u.tick.Add(1)
+		}
+
+		// Wait until the next tick.
+		time.Sleep(clock.DurationUntilNextTickWithoutRendering())
+	}
+}
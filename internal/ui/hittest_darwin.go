@@ -0,0 +1,78 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !ios
+
+package ui
+
+import (
+	"github.com/ebitengine/purego/objc"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/glfw"
+)
+
+var (
+	sel_currentEvent               = objc.RegisterName("currentEvent")
+	sel_performWindowDragWithEvent = objc.RegisterName("performWindowDragWithEvent:")
+)
+
+// isWindowDraggingNative reports whether dragging a window by a caption region is handled by the platform.
+// On macOS, performWindowDragWithEvent: handles a caption region.
+const isWindowDraggingNative = true
+
+// registerWindowHitTestCallbacks registers the callbacks to start the platform's window dragging on a caption region.
+//
+// registerWindowHitTestCallbacks must be called from the main thread.
+func (u *UserInterface) registerWindowHitTestCallbacks() error {
+	if _, err := u.window.SetMouseButtonCallback(func(w *glfw.Window, button glfw.MouseButton, action glfw.Action, mods glfw.ModifierKey) {
+		// As this function is called from GLFW callbacks, the current thread is main.
+		if button != glfw.MouseButtonLeft || action != glfw.Press {
+			return
+		}
+		u.hitTest.suppressLeftButton = false
+
+		x, y, err := w.GetCursorPos()
+		if err != nil {
+			u.setError(err)
+			return
+		}
+		r, ok, err := u.windowHitTestRegionAtGLFWPixel(x, y)
+		if err != nil {
+			u.setError(err)
+			return
+		}
+		if !ok || r.Kind != WindowHitTestKindCaption {
+			return
+		}
+
+		cocoaWindow, err := w.GetCocoaWindow()
+		if err != nil {
+			u.setError(err)
+			return
+		}
+		// The current event is the mouse-down event that invokes this callback.
+		e := objc.ID(class_NSApplication).Send(sel_sharedApplication).Send(sel_currentEvent)
+		if e == 0 {
+			return
+		}
+		objc.ID(cocoaWindow).Send(sel_performWindowDragWithEvent, e)
+
+		// The mouse-up event is consumed by the window dragging, and GLFW never knows the release.
+		// Treat the left button as released until the next press.
+		u.hitTest.suppressLeftButton = true
+	}); err != nil {
+		return err
+	}
+	return nil
+}
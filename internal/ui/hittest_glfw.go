@@ -0,0 +1,229 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !android && !ios && !js && !nintendosdk && !playstation5
+
+package ui
+
+import (
+	"image"
+	"math"
+)
+
+type windowHitTestState struct {
+	active  bool
+	kind    WindowHitTestKind
+	bounds  image.Rectangle
+	pressed bool
+
+	// suppressLeftButton indicates that the left mouse button should be treated as released.
+	// This is used when the platform's window dragging consumes the release event.
+	suppressLeftButton bool
+
+	// The cursor position in the screen coordinate and the window position when dragging started.
+	dragStartCursorX int
+	dragStartCursorY int
+	dragStartWindowX int
+	dragStartWindowY int
+}
+
+func (u *UserInterface) setWindowHitTestRegions(regions []WindowHitTestRegion) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.hitTestRegions = append(u.hitTestRegions[:0], regions...)
+}
+
+func (u *UserInterface) windowHitTestRegionAt(x, y float64) (WindowHitTestRegion, bool) {
+	u.m.RLock()
+	defer u.m.RUnlock()
+
+	p := image.Pt(int(math.Floor(x)), int(math.Floor(y)))
+	// Search from the last region so that a later region has a priority, e.g., a button on a caption.
+	for i := len(u.hitTestRegions) - 1; i >= 0; i-- {
+		r := u.hitTestRegions[i]
+		if p.In(r.Bounds) {
+			return r, true
+		}
+	}
+	return WindowHitTestRegion{}, false
+}
+
+// windowHitTestRegionAtGLFWPixel returns the hit-test region at the given position in GLFW pixels.
+//
+// windowHitTestRegionAtGLFWPixel must be called from the main thread.
+func (u *UserInterface) windowHitTestRegionAtGLFWPixel(x, y float64) (WindowHitTestRegion, bool, error) {
+	f, err := u.isFullscreen()
+	if err != nil {
+		return WindowHitTestRegion{}, false, err
+	}
+	if f {
+		return WindowHitTestRegion{}, false, nil
+	}
+
+	m, err := u.currentMonitor()
+	if err != nil {
+		return WindowHitTestRegion{}, false, err
+	}
+	s := m.DeviceScaleFactor()
+	cx, cy := u.context.clientPositionToLogicalPosition(dipFromGLFWPixel(x, s), dipFromGLFWPixel(y, s), s)
+	if math.IsNaN(cx) || math.IsNaN(cy) {
+		return WindowHitTestRegion{}, false, nil
+	}
+	r, ok := u.windowHitTestRegionAt(cx, cy)
+	return r, ok, nil
+}
+
+// cursorPositionInScreen returns the cursor position in the screen coordinate.
+//
+// cursorPositionInScreen must be called from the main thread.
+func (u *UserInterface) cursorPositionInScreen() (int, int, error) {
+	wx, wy, err := u.window.GetPos()
+	if err != nil {
+		return 0, 0, err
+	}
+	cx, cy, err := u.window.GetCursorPos()
+	if err != nil {
+		return 0, 0, err
+	}
+	return wx + int(math.Floor(cx)), wy + int(math.Floor(cy)), nil
+}
+
+// updateWindowHitTest emulates the native title bar behaviors for the registered hit-test regions
+// that are not handled by the platform's hit test.
+//
+// updateWindowHitTest must be called from the main thread.
+func (u *UserInterface) updateWindowHitTest() error {
+	u.m.RLock()
+	pressed := u.inputState.MouseButtonPressed[MouseButton0]
+	cx, cy := u.inputState.CursorX, u.inputState.CursorY
+	u.m.RUnlock()
+
+	s := &u.hitTest
+	justPressed := pressed && !s.pressed
+	justReleased := !pressed && s.pressed
+	s.pressed = pressed
+
+	if justPressed {
+		r, ok := u.windowHitTestRegionAt(cx, cy)
+		if !ok {
+			return nil
+		}
+		switch r.Kind {
+		case WindowHitTestKindCaption:
+			if isWindowDraggingNative {
+				return nil
+			}
+		case WindowHitTestKindMinimize, WindowHitTestKindMaximize, WindowHitTestKindClose:
+		default:
+			// The resize regions are handled only by the platform's hit test.
+			return nil
+		}
+		f, err := u.isFullscreen()
+		if err != nil {
+			return err
+		}
+		if f {
+			return nil
+		}
+		s.active = true
+		s.kind = r.Kind
+		s.bounds = r.Bounds
+		if r.Kind == WindowHitTestKindCaption {
+			x, y, err := u.cursorPositionInScreen()
+			if err != nil {
+				return err
+			}
+			wx, wy, err := u.window.GetPos()
+			if err != nil {
+				return err
+			}
+			s.dragStartCursorX, s.dragStartCursorY = x, y
+			s.dragStartWindowX, s.dragStartWindowY = wx, wy
+		}
+		return nil
+	}
+
+	if !s.active {
+		return nil
+	}
+
+	if pressed {
+		if s.kind != WindowHitTestKindCaption {
+			return nil
+		}
+		x, y, err := u.cursorPositionInScreen()
+		if err != nil {
+			return err
+		}
+		wx, wy, err := u.window.GetPos()
+		if err != nil {
+			return err
+		}
+		newX := s.dragStartWindowX + x - s.dragStartCursorX
+		newY := s.dragStartWindowY + y - s.dragStartCursorY
+		if newX == wx && newY == wy {
+			return nil
+		}
+		if err := u.window.SetPos(newX, newY); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	if !justReleased {
+		return nil
+	}
+	s.active = false
+
+	// A button is activated only when the cursor is released on the same region, as native buttons do.
+	if !image.Pt(int(math.Floor(cx)), int(math.Floor(cy))).In(s.bounds) {
+		return nil
+	}
+
+	switch s.kind {
+	case WindowHitTestKindMinimize:
+		if err := u.iconifyWindow(); err != nil {
+			return err
+		}
+	case WindowHitTestKindMaximize:
+		m, err := u.isWindowMaximized()
+		if err != nil {
+			return err
+		}
+		if m {
+			if err := u.restoreWindow(); err != nil {
+				return err
+			}
+			return nil
+		}
+		if u.windowResizingMode != WindowResizingModeEnabled {
+			return nil
+		}
+		if !u.isWindowMaximizable() {
+			return nil
+		}
+		if err := u.maximizeWindow(); err != nil {
+			return err
+		}
+	case WindowHitTestKindClose:
+		// Emulate the close button of the title bar. The close callback respects SetWindowClosingHandled.
+		if err := u.window.SetShouldClose(true); err != nil {
+			return err
+		}
+		if u.closeCallback != nil {
+			u.closeCallback(u.window)
+		}
+	}
+	return nil
+}
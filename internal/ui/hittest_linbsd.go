@@ -0,0 +1,26 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build (freebsd || (linux && !android) || netbsd || openbsd) && !nintendosdk && !playstation5
+
+package ui
+
+// isWindowDraggingNative reports whether dragging a window by a caption region is handled by the platform.
+// On Linux, dragging a window is emulated by moving the window.
+const isWindowDraggingNative = false
+
+// registerWindowHitTestCallbacks does nothing as there is no platform's hit test.
+func (u *UserInterface) registerWindowHitTestCallbacks() error {
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"github.com/hajimehoshi/ebiten/v2/internal/glfw"
+)
+
+// isWindowDraggingNative reports whether dragging a window by a caption region is handled by the platform.
+// On Windows, WM_NCHITTEST handles a caption region.
+const isWindowDraggingNative = true
+
+// registerWindowHitTestCallbacks registers the callbacks to handle the hit-test regions by WM_NCHITTEST.
+//
+// registerWindowHitTestCallbacks must be called from the main thread.
+func (u *UserInterface) registerWindowHitTestCallbacks() error {
+	if _, err := u.window.SetHitTestCallback(func(w *glfw.Window, x, y float64) glfw.HitTest {
+		// As this function is called from GLFW callbacks, the current thread is main.
+		r, ok, err := u.windowHitTestRegionAtGLFWPixel(x, y)
+		if err != nil {
+			u.setError(err)
+			return glfw.HitTestDefault
+		}
+		if !ok {
+			return glfw.HitTestDefault
+		}
+
+		if r.Kind == WindowHitTestKindCaption {
+			return glfw.HitTestCaption
+		}
+
+		// The resize regions work only when the window is resizable and not maximized.
+		if u.windowResizingMode != WindowResizingModeEnabled {
+			return glfw.HitTestDefault
+		}
+		maximized, err := u.isWindowMaximized()
+		if err != nil {
+			u.setError(err)
+			return glfw.HitTestDefault
+		}
+		if maximized {
+			return glfw.HitTestDefault
+		}
+		switch r.Kind {
+		case WindowHitTestKindResizeLeft:
+			return glfw.HitTestLeft
+		case WindowHitTestKindResizeRight:
+			return glfw.HitTestRight
+		case WindowHitTestKindResizeTop:
+			return glfw.HitTestTop
+		case WindowHitTestKindResizeBottom:
+			return glfw.HitTestBottom
+		case WindowHitTestKindResizeTopLeft:
+			return glfw.HitTestTopLeft
+		case WindowHitTestKindResizeTopRight:
+			return glfw.HitTestTopRight
+		case WindowHitTestKindResizeBottomLeft:
+			return glfw.HitTestBottomLeft
+		case WindowHitTestKindResizeBottomRight:
+			return glfw.HitTestBottomRight
+		}
+
+		// The button regions are handled as the client area so that the game can receive mouse events.
+		return glfw.HitTestDefault
+	}); err != nil {
+		return err
+	}
+	return nil
+}
@@ -30,6 +30,17 @@ const (
 	MouseButtonMax = MouseButton4
 )
 
+type WheelPhase int
+
+const (
+	WheelPhaseNone WheelPhase = iota
+	WheelPhaseScrolling
+	WheelPhaseMomentum
+)
+
+// wheelLineHeightInDIP is the height of a line in device-independent pixels, used to convert wheel offsets in lines to pixels.
+const wheelLineHeightInDIP = 40
+
 type TouchID int
 
 type Touch struct {
@@ -45,6 +56,9 @@ type InputState struct {
 	CursorY            float64
 	WheelX             float64
 	WheelY             float64
+	WheelPixelX        float64
+	WheelPixelY        float64
+	WheelPhase         WheelPhase
 	Touches            []Touch
 	Runes              []rune
 	WindowBeingClosed  bool
@@ -58,6 +72,9 @@ func (i *InputState) copyAndReset(dst *InputState) {
 	dst.CursorY = i.CursorY
 	dst.WheelX = i.WheelX
 	dst.WheelY = i.WheelY
+	dst.WheelPixelX = i.WheelPixelX
+	dst.WheelPixelY = i.WheelPixelY
+	dst.WheelPhase = i.WheelPhase
 	dst.Touches = append(dst.Touches[:0], i.Touches...)
 	dst.Runes = append(dst.Runes[:0], i.Runes...)
 	dst.WindowBeingClosed = i.WindowBeingClosed
@@ -66,6 +83,9 @@ func (i *InputState) copyAndReset(dst *InputState) {
 	// Reset the members that are updated by deltas, rather than absolute values.
 	i.WheelX = 0
 	i.WheelY = 0
+	i.WheelPixelX = 0
+	i.WheelPixelY = 0
+	i.WheelPhase = WheelPhaseNone
 	i.Runes = i.Runes[:0]
 
 	// Reset the members that are never reset until they are explicitly done.
@@ -51,13 +51,41 @@ func (u *UserInterface) registerInputCallbacks() error {
 		return err
 	}
 
+	if _, err := u.window.SetPreciseScrollCallback(func(w *glfw.Window, xoff float64, yoff float64, precise bool, phase glfw.ScrollPhase) {
+		// As this function is called from GLFW callbacks, the current thread is main.
+		u.m.Lock()
+		defer u.m.Unlock()
+		if !precise {
+			xoff *= wheelLineHeightInDIP
+			yoff *= wheelLineHeightInDIP
+		}
+		u.inputState.WheelPixelX += xoff
+		u.inputState.WheelPixelY += yoff
+		switch phase {
+		case glfw.ScrollPhaseScrolling:
+			u.inputState.WheelPhase = WheelPhaseScrolling
+		case glfw.ScrollPhaseMomentum:
+			u.inputState.WheelPhase = WheelPhaseMomentum
+		default:
+			u.inputState.WheelPhase = WheelPhaseNone
+		}
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func (u *UserInterface) updateInputState() error {
 	var err error
 	u.mainThread.Call(func() {
-		err = u.updateInputStateImpl()
+		if err = u.updateInputStateImpl(); err != nil {
+			return
+		}
+		if err = u.updateWindowHitTest(); err != nil {
+			return
+		}
+		err = u.updateCursorBounds()
 	})
 	return err
 }
@@ -81,6 +109,9 @@ func (u *UserInterface) updateInputStateImpl() error {
 		}
 		u.inputState.MouseButtonPressed[ub] = s == glfw.Press
 	}
+	if u.hitTest.suppressLeftButton {
+		u.inputState.MouseButtonPressed[MouseButton0] = false
+	}
 
 	m, err := u.currentMonitor()
 	if err != nil {
@@ -171,6 +171,7 @@ func (u *UserInterface) updateInputFromEvent(e js.Value) error {
 		// TODO: What if e.deltaMode is not DOM_DELTA_PIXEL?
 		u.inputState.WheelX = -e.Get("deltaX").Float()
 		u.inputState.WheelY = -e.Get("deltaY").Float()
+		u.setWheelPixelsFromEvent(e)
 	case t.Equal(stringTouchstart) || t.Equal(stringTouchend) || t.Equal(stringTouchmove):
 		u.updateTouchesFromEvent(e)
 	}
@@ -179,6 +180,39 @@ func (u *UserInterface) updateInputFromEvent(e js.Value) error {
 	return nil
 }
 
+func (u *UserInterface) setWheelPixelsFromEvent(e js.Value) {
+	dx := -jsNumberOr(e.Get("deltaX"), 0)
+	dy := -jsNumberOr(e.Get("deltaY"), 0)
+	// An event forwarded from a host page might not have deltaMode. Treat it as DOM_DELTA_PIXEL.
+	switch int(jsNumberOr(e.Get("deltaMode"), 0)) {
+	case 1: // DOM_DELTA_LINE
+		dx *= wheelLineHeightInDIP
+		dy *= wheelLineHeightInDIP
+	case 2: // DOM_DELTA_PAGE
+		// clientWidth and clientHeight are 0 when the canvas is not rendered. Use the canvas size in this case.
+		w := jsNumberOr(canvas.Get("clientWidth"), 0)
+		if w <= 0 {
+			w = jsNumberOr(canvas.Get("width"), 0)
+		}
+		h := jsNumberOr(canvas.Get("clientHeight"), 0)
+		if h <= 0 {
+			h = jsNumberOr(canvas.Get("height"), 0)
+		}
+		dx *= w
+		dy *= h
+	}
+	u.inputState.WheelPixelX += dx
+	u.inputState.WheelPixelY += dy
+}
+
+// jsNumberOr returns v as a float64 if v is a number, or defaultValue otherwise.
+func jsNumberOr(v js.Value, defaultValue float64) float64 {
+	if v.Type() != js.TypeNumber {
+		return defaultValue
+	}
+	return v.Float()
+}
+
 func (u *UserInterface) setMouseCursorFromEvent(e js.Value) {
 	if u.context == nil {
 		return
@@ -207,7 +241,8 @@ func (u *UserInterface) updateTouchesFromEvent(e js.Value) {
 
 	touches := e.Get("targetTouches")
 	for i := 0; i < touches.Length(); i++ {
-		t := touches.Call("item", i)
+		// Use an index access instead of TouchList.item, as touches might be a plain array forwarded to a worker.
+		t := touches.Index(i)
 		u.touchesInClient = append(u.touchesInClient, touchInClient{
 			id: TouchID(t.Get("identifier").Int()),
 			x:  t.Get("clientX").Float(),
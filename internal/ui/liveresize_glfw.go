@@ -0,0 +1,82 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !android && !ios && !js && !nintendosdk && !playstation5
+
+package ui
+
+import (
+	"github.com/hajimehoshi/ebiten/v2/internal/glfw"
+)
+
+func (u *UserInterface) isWindowBeingResized() bool {
+	u.m.RLock()
+	defer u.m.RUnlock()
+	return u.windowBeingResized
+}
+
+func (u *UserInterface) setWindowBeingResized(resizing bool) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.windowBeingResized = resizing
+}
+
+// registerWindowLiveResizeCallbacks must be called from the main thread.
+func (u *UserInterface) registerWindowLiveResizeCallbacks() error {
+	if u.liveResizeCallback == nil {
+		u.liveResizeCallback = func(_ *glfw.Window, resizing bool) {
+			u.setWindowBeingResized(resizing)
+		}
+	}
+	if _, err := u.window.SetLiveResizeCallback(u.liveResizeCallback); err != nil {
+		return err
+	}
+
+	if u.refreshCallback == nil {
+		// While the user is resizing the window interactively, the OS runs its own event loop inside PollEvents,
+		// and the game loop is blocked until the resizing ends.
+		// Re-present the last frame scaled to the new window size so that the window content doesn't look frozen.
+		u.refreshCallback = func(_ *glfw.Window) {
+			if !u.isWindowBeingResized() {
+				return
+			}
+			if !u.bufferOnceSwapped {
+				return
+			}
+			if err := u.redrawDuringLiveResize(); err != nil {
+				u.setError(err)
+				return
+			}
+		}
+	}
+	if _, err := u.window.SetRefreshCallback(u.refreshCallback); err != nil {
+		return err
+	}
+	return nil
+}
+
+// redrawDuringLiveResize re-presents the last frame with the current window size.
+//
+// redrawDuringLiveResize must be called from the main thread while the game loop is blocked.
+func (u *UserInterface) redrawDuringLiveResize() error {
+	w, h, err := u.outsideSize()
+	if err != nil {
+		return err
+	}
+	m, err := u.currentMonitor()
+	if err != nil {
+		return err
+	}
+	return u.context.redrawFinalScreen(u.graphicsDriver, w, h, m.DeviceScaleFactor())
+}
@@ -51,6 +51,14 @@ func (m *Monitor) Size() (int, int) {
 	return int(w), int(h)
 }
 
+// RefreshRate returns the refresh rate of the monitor in Hz.
+func (m *Monitor) RefreshRate() float64 {
+	if m.videoMode == nil {
+		return 0
+	}
+	return float64(m.videoMode.RefreshRate)
+}
+
 func (m *Monitor) sizeInDIP() (float64, float64) {
 	w, h := m.boundsInGLFWPixels.Dx(), m.boundsInGLFWPixels.Dy()
 	s := m.DeviceScaleFactor()
@@ -0,0 +1,68 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"math"
+	"slices"
+)
+
+// refreshRateEstimator estimates the display's refresh rate from the timestamps of requestAnimationFrame callbacks,
+// as browsers don't have an API to get the refresh rate.
+type refreshRateEstimator struct {
+	lastTimestamp float64
+	intervals     []float64
+	index         int
+	rate          float64
+
+	tmp []float64
+}
+
+const refreshRateSampleCount = 31
+
+// addTimestamp adds a timestamp of a requestAnimationFrame callback in milliseconds.
+func (r *refreshRateEstimator) addTimestamp(timestamp float64) {
+	last := r.lastTimestamp
+	r.lastTimestamp = timestamp
+	if last == 0 {
+		return
+	}
+
+	interval := timestamp - last
+	// Ignore too long intervals, e.g., when the tab was hidden or the game was suspended.
+	if interval <= 0 || interval > 100 {
+		return
+	}
+
+	if len(r.intervals) < refreshRateSampleCount {
+		r.intervals = append(r.intervals, interval)
+	} else {
+		r.intervals[r.index] = interval
+		r.index = (r.index + 1) % refreshRateSampleCount
+	}
+	if len(r.intervals) < refreshRateSampleCount {
+		return
+	}
+
+	// Use the median so that dropped frames don't affect the estimation.
+	r.tmp = append(r.tmp[:0], r.intervals...)
+	slices.Sort(r.tmp)
+	r.rate = math.Round(1000 / r.tmp[len(r.tmp)/2])
+}
+
+// refreshRate returns the estimated refresh rate in Hz, or 0 if the estimation is not available yet.
+func (r *refreshRateEstimator) refreshRate() float64 {
+	return r.rate
+}
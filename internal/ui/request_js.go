@@ -0,0 +1,185 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"syscall/js"
+)
+
+// Pointer lock and fullscreen are transient activation-gated APIs on browsers.
+// A request is performed immediately when the page has a transient activation. Otherwise, the request is deferred
+// until the next user gesture on the canvas.
+//
+// See https://html.spec.whatwg.org/multipage/interaction.html#activation-triggering-input-event
+
+type gestureRequestKind int
+
+const (
+	gestureRequestPointerLock gestureRequestKind = iota
+	gestureRequestFullscreen
+)
+
+type gestureRequest struct {
+	kind     gestureRequestKind
+	callback func(err error)
+}
+
+type requestResult struct {
+	callback func(err error)
+	err      error
+}
+
+type gestureRequests struct {
+	pending []gestureRequest
+
+	// pointerLockCallbacks are callbacks waiting for a pointerlockchange or pointerlockerror event.
+	pointerLockCallbacks []func(err error)
+
+	// results are the results to be notified before the next tick.
+	results []requestResult
+}
+
+var errPointerLockRejected = errors.New("ui: pointer lock was rejected by the browser. 'sandbox=\"allow-pointer-lock\"' might be required at an iframe")
+
+func hasTransientActivation() bool {
+	a := js.Global().Get("navigator").Get("userActivation")
+	if !a.Truthy() {
+		return false
+	}
+	return a.Get("isActive").Bool()
+}
+
+func (u *UserInterface) RequestPointerLock(callback func(err error)) {
+	u.requestWithGesture(gestureRequestPointerLock, callback)
+}
+
+func (u *UserInterface) RequestFullscreen(callback func(err error)) {
+	u.requestWithGesture(gestureRequestFullscreen, callback)
+}
+
+func (u *UserInterface) requestWithGesture(kind gestureRequestKind, callback func(err error)) {
+	if callback == nil {
+		callback = func(err error) {}
+	}
+	if !canvas.Truthy() {
+		u.addRequestResult(callback, errors.New("ui: the canvas is not available"))
+		return
+	}
+	if isWorker {
+		u.addRequestResult(callback, errors.New("ui: pointer lock and fullscreen cannot be requested in a worker"))
+		return
+	}
+
+	r := gestureRequest{
+		kind:     kind,
+		callback: callback,
+	}
+	if hasTransientActivation() {
+		u.performGestureRequest(r)
+		return
+	}
+	u.gestureRequests.pending = append(u.gestureRequests.pending, r)
+}
+
+// processGestureRequests performs the pending requests.
+// processGestureRequests must be called in an event handler of a user gesture.
+func (u *UserInterface) processGestureRequests() {
+	rs := u.gestureRequests.pending
+	u.gestureRequests.pending = nil
+	for _, r := range rs {
+		u.performGestureRequest(r)
+	}
+}
+
+func (u *UserInterface) performGestureRequest(r gestureRequest) {
+	switch r.kind {
+	case gestureRequestPointerLock:
+		if u.cursorMode == CursorModeCaptured && document.Get("pointerLockElement").Truthy() {
+			u.addRequestResult(r.callback, nil)
+			return
+		}
+		u.gestureRequests.pointerLockCallbacks = append(u.gestureRequests.pointerLockCallbacks, r.callback)
+		if u.cursorMode == CursorModeCaptured {
+			// The cursor mode is already captured but the pointer is not locked yet.
+			canvas.Call("requestPointerLock")
+			return
+		}
+		u.setCursorMode(CursorModeCaptured)
+	case gestureRequestFullscreen:
+		if u.IsFullscreen() {
+			u.addRequestResult(r.callback, nil)
+			return
+		}
+		if u.cursorMode == CursorModeCaptured {
+			u.saveCursorPosition()
+		}
+		p := requestFullscreen()
+		if p.Type() != js.TypeObject || !p.Get("then").Truthy() {
+			// Old WebKit's requestFullscreen doesn't return a Promise.
+			u.addRequestResult(r.callback, nil)
+			return
+		}
+		var then, catch js.Func
+		then = js.FuncOf(func(this js.Value, args []js.Value) any {
+			then.Release()
+			catch.Release()
+			u.addRequestResult(r.callback, nil)
+			return nil
+		})
+		catch = js.FuncOf(func(this js.Value, args []js.Value) any {
+			then.Release()
+			catch.Release()
+			u.addRequestResult(r.callback, fmt.Errorf("ui: fullscreen was rejected by the browser: %s", args[0].Call("toString").String()))
+			return nil
+		})
+		p.Call("then", then, catch)
+	}
+}
+
+// resolvePointerLockRequests notifies the result of a pointer lock to the waiting callbacks.
+func (u *UserInterface) resolvePointerLockRequests(err error) {
+	for _, f := range u.gestureRequests.pointerLockCallbacks {
+		u.addRequestResult(f, err)
+	}
+	u.gestureRequests.pointerLockCallbacks = nil
+}
+
+func (u *UserInterface) addRequestResult(callback func(err error), err error) {
+	u.gestureRequests.results = append(u.gestureRequests.results, requestResult{
+		callback: callback,
+		err:      err,
+	})
+}
+
+// flushRequestResults calls the callbacks of the finished requests.
+// flushRequestResults must be called from the game's goroutine.
+func (u *UserInterface) flushRequestResults() {
+	rs := u.gestureRequests.results
+	u.gestureRequests.results = nil
+	for _, r := range rs {
+		r.callback(r.err)
+	}
+}
+
+// requestFullscreen requests fullscreen for the canvas and returns the returned value, which is usually a Promise.
+func requestFullscreen() js.Value {
+	f := canvas.Get("requestFullscreen")
+	if !f.Truthy() {
+		f = canvas.Get("webkitRequestFullscreen")
+	}
+	return f.Call("bind", canvas).Invoke()
+}
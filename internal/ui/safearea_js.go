@@ -0,0 +1,57 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"math"
+	"syscall/js"
+)
+
+// safeAreaProbe is an invisible element to read the CSS safe area insets via its padding.
+var safeAreaProbe js.Value
+
+func (u *UserInterface) SafeAreaInsets() (left, top, right, bottom float64) {
+	if !document.Truthy() || !document.Get("body").Truthy() {
+		return 0, 0, 0, 0
+	}
+
+	if !safeAreaProbe.Truthy() {
+		safeAreaProbe = document.Call("createElement", "div")
+		style := safeAreaProbe.Get("style")
+		style.Set("position", "fixed")
+		style.Set("visibility", "hidden")
+		style.Set("pointerEvents", "none")
+		style.Set("paddingLeft", "env(safe-area-inset-left, 0px)")
+		style.Set("paddingTop", "env(safe-area-inset-top, 0px)")
+		style.Set("paddingRight", "env(safe-area-inset-right, 0px)")
+		style.Set("paddingBottom", "env(safe-area-inset-bottom, 0px)")
+		document.Get("body").Call("appendChild", safeAreaProbe)
+	}
+
+	s := window.Call("getComputedStyle", safeAreaProbe)
+	parse := func(v js.Value) float64 {
+		f := js.Global().Call("parseFloat", v).Float()
+		if math.IsNaN(f) {
+			return 0
+		}
+		return f
+	}
+	return parse(s.Get("paddingLeft")), parse(s.Get("paddingTop")), parse(s.Get("paddingRight")), parse(s.Get("paddingBottom"))
+}
+
+func (u *UserInterface) DisplayCornerRadius() float64 {
+	// There is no way to get the display corner radius on browsers.
+	return 0
+}
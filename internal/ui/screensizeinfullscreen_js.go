@@ -15,6 +15,10 @@
 package ui
 
 func (u *UserInterface) ScreenSizeInFullscreen() (int, int) {
+	if isWorker {
+		w, h := u.outsideSize()
+		return int(w), int(h)
+	}
 	// On browsers, ScreenSizeInFullscreen returns the 'window' (global object) size, not 'screen' size for backward compatibility (#2145).
 	return window.Get("innerWidth").Int(), window.Get("innerHeight").Int()
 }
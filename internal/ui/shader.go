@@ -40,6 +40,17 @@ func NewShader(ir *shaderir.Program, name string) *Shader {
 	}
 }
 
+// UniformNames returns the names of the user-defined uniform variables.
+func (s *Shader) UniformNames() []string {
+	return s.uniformNames
+}
+
+// UniformTypes returns the types of the user-defined uniform variables.
+// The order is the same as UniformNames.
+func (s *Shader) UniformTypes() []shaderir.Type {
+	return s.uniformTypes
+}
+
 func (s *Shader) Deallocate() {
 	s.shader.Deallocate()
 }
@@ -0,0 +1,55 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !ios
+
+package ui
+
+import (
+	"github.com/ebitengine/purego/objc"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/cocoa"
+)
+
+var (
+	class_NSApplication = objc.GetClass("NSApplication")
+)
+
+var (
+	sel_dockTile          = objc.RegisterName("dockTile")
+	sel_setBadgeLabel     = objc.RegisterName("setBadgeLabel:")
+	sel_sharedApplication = objc.RegisterName("sharedApplication")
+)
+
+// setWindowProgress must be called from the main thread.
+func (u *UserInterface) setWindowProgress(state WindowProgressState, progress float64) error {
+	// The Dock doesn't have a standard progress bar for an application.
+	return nil
+}
+
+// setWindowBadge must be called from the main thread.
+func (u *UserInterface) setWindowBadge(label string) error {
+	pool := cocoa.NSAutoreleasePool_new()
+	defer pool.Release()
+
+	dockTile := objc.ID(class_NSApplication).Send(sel_sharedApplication).Send(sel_dockTile)
+	if label == "" {
+		dockTile.Send(sel_setBadgeLabel, objc.ID(0))
+		return nil
+	}
+	str := cocoa.NSString_alloc().InitWithUTF8String(label).ID
+	defer str.Send(sel_release)
+	dockTile.Send(sel_setBadgeLabel, str)
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"errors"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/microsoftgdk"
+)
+
+// setWindowProgress must be called from the main thread.
+func (u *UserInterface) setWindowProgress(state WindowProgressState, progress float64) error {
+	if microsoftgdk.IsXbox() {
+		return nil
+	}
+
+	// S_FALSE is returned when CoInitializeEx is nested. This is a successful case.
+	if err := windows.CoInitializeEx(0, windows.COINIT_MULTITHREADED); err != nil && !errors.Is(err, syscall.Errno(windows.S_FALSE)) {
+		return err
+	}
+	// CoUninitialize should be called even when CoInitializeEx returns S_FALSE.
+	defer windows.CoUninitialize()
+
+	ptr, err := _CoCreateInstance(&_CLSID_TaskbarList, nil, _CLSCTX_SERVER, &_IID_ITaskbarList3)
+	if err != nil {
+		return err
+	}
+
+	t := (*_ITaskbarList3)(ptr)
+	defer t.Release()
+
+	if err := t.HrInit(); err != nil {
+		return err
+	}
+
+	w, err := u.window.GetWin32Window()
+	if err != nil {
+		return err
+	}
+
+	var flags uint32
+	switch state {
+	case WindowProgressStateNone:
+		flags = _TBPF_NOPROGRESS
+	case WindowProgressStateNormal:
+		flags = _TBPF_NORMAL
+	case WindowProgressStateIndeterminate:
+		flags = _TBPF_INDETERMINATE
+	case WindowProgressStatePaused:
+		flags = _TBPF_PAUSED
+	case WindowProgressStateError:
+		flags = _TBPF_ERROR
+	}
+	if err := t.SetProgressState(w, flags); err != nil {
+		return err
+	}
+
+	if state == WindowProgressStateNone || state == WindowProgressStateIndeterminate {
+		return nil
+	}
+
+	const total = 10000
+	if err := t.SetProgressValue(w, uint64(min(max(progress, 0), 1)*total), total); err != nil {
+		return err
+	}
+	return nil
+}
+
+// setWindowBadge must be called from the main thread.
+func (u *UserInterface) setWindowBadge(label string) error {
+	// A badge on a taskbar requires an overlay icon on Windows. This is not supported so far.
+	return nil
+}
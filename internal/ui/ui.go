@@ -17,12 +17,14 @@ package ui
 import (
 	"errors"
 	"image"
+	"math"
 	"sync"
 	"sync/atomic"
 
 	_ "github.com/ebitengine/hideconsole"
 
 	"github.com/hajimehoshi/ebiten/v2/internal/atlas"
+	"github.com/hajimehoshi/ebiten/v2/internal/graphicscommand"
 	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver"
 	"github.com/hajimehoshi/ebiten/v2/internal/mipmap"
 	"github.com/hajimehoshi/ebiten/v2/internal/thread"
@@ -76,11 +78,25 @@ type UserInterface struct {
 	err  error
 	errM sync.Mutex
 
-	isScreenClearedEveryFrame atomic.Bool
-	graphicsLibrary           atomic.Int32
-	running                   atomic.Bool
-	terminated                atomic.Bool
-	tick                      atomic.Int64
+	isScreenClearedEveryFrame     atomic.Bool
+	isScreenDamageTrackingEnabled atomic.Bool
+	graphicsLibrary               atomic.Int32
+	running                       atomic.Bool
+	terminated                    atomic.Bool
+	tick                          atomic.Int64
+
+	gameStep gameStepState
+
+	// deviceScaleFactorBits is the device scale factor at the last frame in bits of float64.
+	deviceScaleFactorBits atomic.Uint64
+
+	// deviceScaleFactorChangedTick is the tick when the device scale factor changed.
+	// The initial value is 0, but this is valid as the device scale factor is never 'changed' at the first tick.
+	deviceScaleFactorChangedTick atomic.Int64
+
+	// screenDamage is the union of the damaged regions declared since the last drawing.
+	screenDamage  image.Rectangle
+	screenDamageM sync.Mutex
 
 	whiteImage *Image
 
@@ -211,6 +227,31 @@ func (u *UserInterface) SetScreenClearedEveryFrame(cleared bool) {
 	u.isScreenClearedEveryFrame.Store(cleared)
 }
 
+func (u *UserInterface) IsScreenDamageTrackingEnabled() bool {
+	return u.isScreenDamageTrackingEnabled.Load()
+}
+
+func (u *UserInterface) SetScreenDamageTrackingEnabled(enabled bool) {
+	u.isScreenDamageTrackingEnabled.Store(enabled)
+}
+
+func (u *UserInterface) AddScreenDamage(region image.Rectangle) {
+	if region.Empty() {
+		return
+	}
+	u.screenDamageM.Lock()
+	defer u.screenDamageM.Unlock()
+	u.screenDamage = u.screenDamage.Union(region)
+}
+
+func (u *UserInterface) takeScreenDamage() image.Rectangle {
+	u.screenDamageM.Lock()
+	defer u.screenDamageM.Unlock()
+	r := u.screenDamage
+	u.screenDamage = image.Rectangle{}
+	return r
+}
+
 func (u *UserInterface) setGraphicsLibrary(library GraphicsLibrary) {
 	u.graphicsLibrary.Store(int32(library))
 }
@@ -238,3 +279,71 @@ func (u *UserInterface) setTerminated() {
 func (u *UserInterface) Tick() int64 {
 	return u.tick.Load()
 }
+
+func (u *UserInterface) IsGamePaused() bool {
+	return u.gameStep.isPaused()
+}
+
+func (u *UserInterface) SetGamePaused(paused bool) {
+	u.gameStep.setPaused(paused)
+}
+
+func (u *UserInterface) StepGame(ticks int) {
+	u.gameStep.step(ticks)
+}
+
+// adjustUpdateCount returns the number of Update calls at the current frame.
+// See gameStepState.adjustUpdateCount.
+func (u *UserInterface) adjustUpdateCount(updateCount int) int {
+	return u.gameStep.adjustUpdateCount(updateCount)
+}
+
+// updateDeviceScaleFactor records the device scale factor used at the current frame.
+func (u *UserInterface) updateDeviceScaleFactor(deviceScaleFactor float64) {
+	old := math.Float64frombits(u.deviceScaleFactorBits.Swap(math.Float64bits(deviceScaleFactor)))
+	if old == 0 || old == deviceScaleFactor {
+		return
+	}
+	u.deviceScaleFactorChangedTick.Store(u.tick.Load() + 1)
+}
+
+// IsDeviceScaleFactorJustChanged reports whether the device scale factor changed just before the current tick.
+func (u *UserInterface) IsDeviceScaleFactorJustChanged() bool {
+	t := u.deviceScaleFactorChangedTick.Load()
+	return t != 0 && t == u.tick.Load()+1
+}
+
+// IsGPUTimerAvailable reports whether the current graphics driver can measure GPU time.
+func (u *UserInterface) IsGPUTimerAvailable() bool {
+	if u.graphicsDriver == nil {
+		return false
+	}
+	return graphicscommand.IsGPUTimerAvailable(u.graphicsDriver)
+}
+
+// TriggerGPUCapture requests a graphics debugger to capture the next frame.
+func (u *UserInterface) TriggerGPUCapture() bool {
+	if u.graphicsDriver == nil {
+		return false
+	}
+	return graphicscommand.TriggerGPUCapture(u.graphicsDriver)
+}
+
+type GraphicsCapabilities struct {
+	MaxImageSize           int
+	FloatTextureRenderable bool
+	GPUTimer               bool
+}
+
+// GraphicsCapabilities returns the capabilities of the current graphics driver.
+// GraphicsCapabilities returns zero values before the graphics driver is initialized.
+func (u *UserInterface) GraphicsCapabilities() GraphicsCapabilities {
+	if u.graphicsDriver == nil {
+		return GraphicsCapabilities{}
+	}
+	return GraphicsCapabilities{
+		MaxImageSize:           graphicscommand.MaxImageSize(u.graphicsDriver),
+		FloatTextureRenderable: graphicscommand.IsFloatTextureRenderable(u.graphicsDriver),
+		GPUTimer:               graphicscommand.IsGPUTimerAvailable(u.graphicsDriver),
+	}
+}
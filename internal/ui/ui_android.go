@@ -128,6 +128,10 @@ func (*graphicsDriverCreatorImpl) newPlayStation5() (graphicsdriver.Graphics, er
 	return nil, errors.New("ui: PlayStation 5 is not supported in this environment")
 }
 
+func (*graphicsDriverCreatorImpl) newWebGPU() (graphicsdriver.Graphics, error) {
+	return nil, errors.New("ui: WebGPU is not supported in this environment")
+}
+
 func dipToNativePixels(x float64, scale float64) float64 {
 	return x * scale
 }
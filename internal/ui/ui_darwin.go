@@ -197,6 +197,10 @@ func (*graphicsDriverCreatorImpl) newPlayStation5() (graphicsdriver.Graphics, er
 	return nil, errors.New("ui: PlayStation 5 is not supported in this environment")
 }
 
+func (*graphicsDriverCreatorImpl) newWebGPU() (graphicsdriver.Graphics, error) {
+	return nil, errors.New("ui: WebGPU is not supported in this environment")
+}
+
 // glfwMonitorSizeInGLFWPixels must be called from the main thread.
 func glfwMonitorSizeInGLFWPixels(m *glfw.Monitor) (int, int, error) {
 	vm, err := m.GetVideoMode()
@@ -223,36 +227,40 @@ func (u *UserInterface) adjustWindowPosition(x, y int, monitor *Monitor) (int, i
 }
 
 var (
-	class_NSCursor = objc.GetClass("NSCursor")
-	class_NSEvent  = objc.GetClass("NSEvent")
+	class_NSCursor    = objc.GetClass("NSCursor")
+	class_NSEvent     = objc.GetClass("NSEvent")
+	class_NSWorkspace = objc.GetClass("NSWorkspace")
 )
 
 var (
-	sel_alloc                         = objc.RegisterName("alloc")
-	sel_collectionBehavior            = objc.RegisterName("collectionBehavior")
-	sel_delegate                      = objc.RegisterName("delegate")
-	sel_init                          = objc.RegisterName("init")
-	sel_initWithOrigDelegate          = objc.RegisterName("initWithOrigDelegate:")
-	sel_mouseLocation                 = objc.RegisterName("mouseLocation")
-	sel_origDelegate                  = objc.RegisterName("origDelegate")
-	sel_origResizable                 = objc.RegisterName("isOrigResizable")
-	sel_setCollectionBehavior         = objc.RegisterName("setCollectionBehavior:")
-	sel_setDelegate                   = objc.RegisterName("setDelegate:")
-	sel_setDocumentEdited             = objc.RegisterName("setDocumentEdited:")
-	sel_setOrigDelegate               = objc.RegisterName("setOrigDelegate:")
-	sel_setOrigResizable              = objc.RegisterName("setOrigResizable:")
-	sel_toggleFullScreen              = objc.RegisterName("toggleFullScreen:")
-	sel_windowDidBecomeKey            = objc.RegisterName("windowDidBecomeKey:")
-	sel_windowDidEnterFullScreen      = objc.RegisterName("windowDidEnterFullScreen:")
-	sel_windowDidExitFullScreen       = objc.RegisterName("windowDidExitFullScreen:")
-	sel_windowDidMiniaturize          = objc.RegisterName("windowDidMiniaturize:")
-	sel_windowDidMove                 = objc.RegisterName("windowDidMove:")
-	sel_windowDidResignKey            = objc.RegisterName("windowDidResignKey:")
-	sel_windowDidResize               = objc.RegisterName("windowDidResize:")
-	sel_windowDidChangeOcclusionState = objc.RegisterName("windowDidChangeOcclusionState:")
-	sel_windowShouldClose             = objc.RegisterName("windowShouldClose:")
-	sel_windowWillEnterFullScreen     = objc.RegisterName("windowWillEnterFullScreen:")
-	sel_windowWillExitFullScreen      = objc.RegisterName("windowWillExitFullScreen:")
+	sel_accessibilityDisplayShouldIncreaseContrast = objc.RegisterName("accessibilityDisplayShouldIncreaseContrast")
+	sel_accessibilityDisplayShouldReduceMotion     = objc.RegisterName("accessibilityDisplayShouldReduceMotion")
+	sel_alloc                                      = objc.RegisterName("alloc")
+	sel_collectionBehavior                         = objc.RegisterName("collectionBehavior")
+	sel_delegate                                   = objc.RegisterName("delegate")
+	sel_init                                       = objc.RegisterName("init")
+	sel_initWithOrigDelegate                       = objc.RegisterName("initWithOrigDelegate:")
+	sel_mouseLocation                              = objc.RegisterName("mouseLocation")
+	sel_origDelegate                               = objc.RegisterName("origDelegate")
+	sel_origResizable                              = objc.RegisterName("isOrigResizable")
+	sel_setCollectionBehavior                      = objc.RegisterName("setCollectionBehavior:")
+	sel_setDelegate                                = objc.RegisterName("setDelegate:")
+	sel_setDocumentEdited                          = objc.RegisterName("setDocumentEdited:")
+	sel_setOrigDelegate                            = objc.RegisterName("setOrigDelegate:")
+	sel_setOrigResizable                           = objc.RegisterName("setOrigResizable:")
+	sel_sharedWorkspace                            = objc.RegisterName("sharedWorkspace")
+	sel_toggleFullScreen                           = objc.RegisterName("toggleFullScreen:")
+	sel_windowDidBecomeKey                         = objc.RegisterName("windowDidBecomeKey:")
+	sel_windowDidEnterFullScreen                   = objc.RegisterName("windowDidEnterFullScreen:")
+	sel_windowDidExitFullScreen                    = objc.RegisterName("windowDidExitFullScreen:")
+	sel_windowDidMiniaturize                       = objc.RegisterName("windowDidMiniaturize:")
+	sel_windowDidMove                              = objc.RegisterName("windowDidMove:")
+	sel_windowDidResignKey                         = objc.RegisterName("windowDidResignKey:")
+	sel_windowDidResize                            = objc.RegisterName("windowDidResize:")
+	sel_windowDidChangeOcclusionState              = objc.RegisterName("windowDidChangeOcclusionState:")
+	sel_windowShouldClose                          = objc.RegisterName("windowShouldClose:")
+	sel_windowWillEnterFullScreen                  = objc.RegisterName("windowWillEnterFullScreen:")
+	sel_windowWillExitFullScreen                   = objc.RegisterName("windowWillExitFullScreen:")
 )
 
 func currentMouseLocation() (x, y int) {
@@ -439,6 +447,16 @@ func (u *UserInterface) setDocumentEdited(edited bool) error {
 	return nil
 }
 
+// accessibilityPreferences must be called from the main thread.
+func (u *UserInterface) accessibilityPreferences() AccessibilityPreferences {
+	p := defaultAccessibilityPreferences()
+	w := objc.ID(class_NSWorkspace).Send(sel_sharedWorkspace)
+	p.ReducedMotion = objc.Send[bool](w, sel_accessibilityDisplayShouldReduceMotion)
+	p.HighContrast = objc.Send[bool](w, sel_accessibilityDisplayShouldIncreaseContrast)
+	// macOS doesn't have a system-wide text size preference for applications.
+	return p
+}
+
 func (u *UserInterface) afterWindowCreation() error {
 	return nil
 }
@@ -26,6 +26,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hajimehoshi/ebiten/v2/internal/clock"
 	"github.com/hajimehoshi/ebiten/v2/internal/file"
 	"github.com/hajimehoshi/ebiten/v2/internal/gamepad"
 	"github.com/hajimehoshi/ebiten/v2/internal/glfw"
@@ -55,6 +56,10 @@ type userInterfaceImpl struct {
 	title   string
 	window  *glfw.Window
 
+	// glfwInitErr is the error at initializing GLFW, e.g., when there is no display.
+	// glfwInitErr is reported at Run so that RunWithoutRendering works without a display.
+	glfwInitErr error
+
 	minWindowWidthInDIP  int
 	minWindowHeightInDIP int
 	maxWindowWidthInDIP  int
@@ -98,10 +103,19 @@ type userInterfaceImpl struct {
 	savedCursorX float64
 	savedCursorY float64
 
+	hitTestRegions     []WindowHitTestRegion
+	cursorBounds       image.Rectangle
+	windowBeingResized bool
+
+	// hitTest must be accessed from the main thread.
+	hitTest windowHitTestState
+
 	closeCallback                  glfw.CloseCallback
 	framebufferSizeCallback        glfw.FramebufferSizeCallback
 	defaultFramebufferSizeCallback glfw.FramebufferSizeCallback
 	dropCallback                   glfw.DropCallback
+	liveResizeCallback             glfw.LiveResizeCallback
+	refreshCallback                glfw.RefreshCallback
 	framebufferSizeCallbackCh      chan struct{}
 
 	darwinInitOnce        sync.Once
@@ -149,7 +163,8 @@ func (u *UserInterface) init() error {
 		return err
 	}
 	if err := u.initializeGLFW(); err != nil {
-		return err
+		u.glfwInitErr = err
+		return nil
 	}
 	if _, err := glfw.SetMonitorCallback(func(monitor *glfw.Monitor, event glfw.PeripheralEvent) {
 		if err := theMonitors.update(); err != nil {
@@ -665,6 +680,78 @@ func (u *UserInterface) SetFullscreen(fullscreen bool) {
 	})
 }
 
+func (u *UserInterface) SafeAreaInsets() (left, top, right, bottom float64) {
+	// A desktop window doesn't have a display cutout.
+	return 0, 0, 0, 0
+}
+
+func (u *UserInterface) DisplayCornerRadius() float64 {
+	return 0
+}
+
+func (u *UserInterface) AccessibilityPreferences() AccessibilityPreferences {
+	// accessibilityPreferences must be called from the main thread, which is available only after the main loop starts.
+	if !u.isRunning() {
+		return defaultAccessibilityPreferences()
+	}
+	p := defaultAccessibilityPreferences()
+	u.mainThread.Call(func() {
+		if u.isTerminated() {
+			return
+		}
+		p = u.accessibilityPreferences()
+	})
+	return p
+}
+
+func (u *UserInterface) Announce(text string) {
+	if !u.isRunning() {
+		return
+	}
+	u.mainThread.Call(func() {
+		if u.isTerminated() {
+			return
+		}
+		if err := u.announce(text); err != nil {
+			u.setError(err)
+			return
+		}
+	})
+}
+
+func (u *UserInterface) ShowVirtualKeyboard() {
+	// A desktop doesn't have an on-screen virtual keyboard.
+}
+
+func (u *UserInterface) HideVirtualKeyboard() {
+}
+
+func (u *UserInterface) VirtualKeyboardHeight() float64 {
+	return 0
+}
+
+// RequestPointerLock captures the cursor immediately, as a user gesture is not required on desktops.
+func (u *UserInterface) RequestPointerLock(callback func(err error)) {
+	u.SetCursorMode(CursorModeCaptured)
+	if callback != nil {
+		callback(nil)
+	}
+}
+
+// RequestFullscreen enters fullscreen immediately, as a user gesture is not required on desktops.
+func (u *UserInterface) RequestFullscreen(callback func(err error)) {
+	if microsoftgdk.IsXbox() {
+		if callback != nil {
+			callback(errors.New("ui: fullscreen is not supported on Xbox"))
+		}
+		return
+	}
+	u.SetFullscreen(true)
+	if callback != nil {
+		callback(nil)
+	}
+}
+
 func (u *UserInterface) IsFocused() bool {
 	if !u.isRunning() {
 		return false
@@ -1053,6 +1140,10 @@ event:
 }
 
 func (u *UserInterface) initOnMainThread(options *RunOptions) error {
+	if u.glfwInitErr != nil {
+		return u.glfwInitErr
+	}
+
 	if err := glfw.WindowHint(glfw.AutoIconify, glfw.False); err != nil {
 		return err
 	}
@@ -1190,9 +1281,15 @@ func (u *UserInterface) initOnMainThread(options *RunOptions) error {
 	if err := u.registerWindowFramebufferSizeCallback(); err != nil {
 		return err
 	}
+	if err := u.registerWindowLiveResizeCallbacks(); err != nil {
+		return err
+	}
 	if err := u.registerInputCallbacks(); err != nil {
 		return err
 	}
+	if err := u.registerWindowHitTestCallbacks(); err != nil {
+		return err
+	}
 	if err := u.registerDropCallback(); err != nil {
 		return err
 	}
@@ -1484,6 +1581,7 @@ func (u *UserInterface) updateGame() error {
 			return
 		}
 		deviceScaleFactor = m.DeviceScaleFactor()
+		clock.SetRefreshRate(m.RefreshRate())
 	}); err != nil {
 		return err
 	}
@@ -100,6 +100,10 @@ func (*graphicsDriverCreatorImpl) newPlayStation5() (graphicsdriver.Graphics, er
 	return nil, errors.New("ui: PlayStation 5 is not supported in this environment")
 }
 
+func (*graphicsDriverCreatorImpl) newWebGPU() (graphicsdriver.Graphics, error) {
+	return nil, errors.New("ui: WebGPU is not supported in this environment")
+}
+
 func (u *UserInterface) SetUIView(uiview uintptr) error {
 	u.uiView.Store(uiview)
 	select {
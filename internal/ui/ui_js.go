@@ -16,16 +16,20 @@ package ui
 
 import (
 	"errors"
+	"image"
 	"math"
 	"sync"
 	"syscall/js"
 	"time"
 
+	"github.com/hajimehoshi/ebiten/v2/internal/clock"
 	"github.com/hajimehoshi/ebiten/v2/internal/file"
 	"github.com/hajimehoshi/ebiten/v2/internal/gamepad"
 	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver"
 	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver/opengl"
+	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver/webgpu"
 	"github.com/hajimehoshi/ebiten/v2/internal/hook"
+	"github.com/hajimehoshi/ebiten/v2/internal/restorable"
 )
 
 type graphicsDriverCreatorImpl struct {
@@ -54,6 +58,10 @@ func (*graphicsDriverCreatorImpl) newPlayStation5() (graphicsdriver.Graphics, er
 	return nil, errors.New("ui: PlayStation 5 is not supported in this environment")
 }
 
+func (g *graphicsDriverCreatorImpl) newWebGPU() (graphicsdriver.Graphics, error) {
+	return webgpu.NewGraphics(g.canvas, g.colorSpace)
+}
+
 var (
 	stringNone        = js.ValueOf("none")
 	stringTransparent = js.ValueOf("transparent")
@@ -99,6 +107,8 @@ type userInterfaceImpl struct {
 	lastCaptureExitTime time.Time
 	hiDPIEnabled        bool
 
+	strictContextRestoration bool
+
 	context                   *context
 	inputState                InputState
 	keyDurationsByKeyProperty map[Key]int
@@ -114,6 +124,9 @@ type userInterfaceImpl struct {
 	savedOutsideHeight        float64
 	outsideSizeUnchangedCount int
 
+	worker          workerState
+	gestureRequests gestureRequests
+
 	keyboardLayoutMap js.Value
 
 	m         sync.Mutex
@@ -130,15 +143,24 @@ var (
 )
 
 var (
-	documentHasFocus = document.Get("hasFocus").Call("bind", document)
-	documentHidden   = js.Global().Get("Object").Call("getOwnPropertyDescriptor", js.Global().Get("Document").Get("prototype"), "hidden").Get("get").Call("bind", document)
+	documentHasFocus js.Value
+	documentHidden   js.Value
 )
 
+func init() {
+	// document is undefined on node.js and Web Workers.
+	if !document.Truthy() {
+		return
+	}
+	documentHasFocus = document.Get("hasFocus").Call("bind", document)
+	documentHidden = js.Global().Get("Object").Call("getOwnPropertyDescriptor", js.Global().Get("Document").Get("prototype"), "hidden").Get("get").Call("bind", document)
+}
+
 func (u *UserInterface) SetFullscreen(fullscreen bool) {
 	if !canvas.Truthy() {
 		return
 	}
-	if !document.Truthy() {
+	if !document.Truthy() && !isWorker {
 		return
 	}
 	if fullscreen == u.IsFullscreen() {
@@ -149,12 +171,13 @@ func (u *UserInterface) SetFullscreen(fullscreen bool) {
 		u.saveCursorPosition()
 	}
 
+	if isWorker {
+		postMessageToHost("fullscreen", fullscreen)
+		return
+	}
+
 	if fullscreen {
-		f := canvas.Get("requestFullscreen")
-		if !f.Truthy() {
-			f = canvas.Get("webkitRequestFullscreen")
-		}
-		f.Call("bind", canvas).Invoke()
+		requestFullscreen()
 		return
 	}
 
@@ -166,6 +189,9 @@ func (u *UserInterface) SetFullscreen(fullscreen bool) {
 }
 
 func (u *UserInterface) IsFullscreen() bool {
+	if isWorker {
+		return u.worker.fullscreen
+	}
 	if !document.Truthy() {
 		return false
 	}
@@ -226,17 +252,25 @@ func (u *UserInterface) setCursorMode(mode CursorMode) {
 	// Remember the previous cursor mode in the case when the pointer lock exits by pressing ESC.
 	u.cursorPrevMode = u.cursorMode
 	if u.cursorMode == CursorModeCaptured {
-		document.Call("exitPointerLock")
+		if isWorker {
+			postMessageToHost("pointerlock", false)
+		} else {
+			document.Call("exitPointerLock")
+		}
 		u.lastCaptureExitTime = time.Now()
 	}
 	u.cursorMode = mode
 	switch mode {
 	case CursorModeVisible:
-		canvas.Get("style").Set("cursor", driverCursorShapeToCSSCursor(u.cursorShape))
+		setCanvasCursor(driverCursorShapeToCSSCursor(u.cursorShape))
 	case CursorModeHidden:
-		canvas.Get("style").Set("cursor", stringNone)
+		setCanvasCursor(stringNone)
 	case CursorModeCaptured:
-		canvas.Call("requestPointerLock")
+		if isWorker {
+			postMessageToHost("pointerlock", true)
+		} else {
+			canvas.Call("requestPointerLock")
+		}
 	}
 }
 
@@ -247,6 +281,14 @@ func (u *UserInterface) recoverCursorMode() {
 	u.SetCursorMode(u.cursorPrevMode)
 }
 
+func (u *UserInterface) CursorBounds() image.Rectangle {
+	return image.Rectangle{}
+}
+
+func (u *UserInterface) SetCursorBounds(bounds image.Rectangle) {
+	// Do nothing
+}
+
 func (u *UserInterface) CursorShape() CursorShape {
 	if !canvas.Truthy() {
 		return CursorShapeDefault
@@ -264,7 +306,7 @@ func (u *UserInterface) SetCursorShape(shape CursorShape) {
 
 	u.cursorShape = shape
 	if u.cursorMode == CursorModeVisible {
-		canvas.Get("style").Set("cursor", driverCursorShapeToCSSCursor(u.cursorShape))
+		setCanvasCursor(driverCursorShapeToCSSCursor(u.cursorShape))
 	}
 }
 
@@ -275,6 +317,9 @@ func (u *UserInterface) outsideSize() (float64, float64) {
 		bh := body.Get("clientHeight").Float()
 		return bw, bh
 	}
+	if isWorker {
+		return u.worker.outsideWidth, u.worker.outsideHeight
+	}
 
 	// Node.js
 	return 640, 480
@@ -288,6 +333,13 @@ func (u *UserInterface) suspended() bool {
 }
 
 func (u *UserInterface) isFocused() bool {
+	if isWorker {
+		return u.worker.focused
+	}
+	// Node.js
+	if !document.Truthy() {
+		return true
+	}
 	if !documentHasFocus.Invoke().Bool() {
 		return false
 	}
@@ -312,6 +364,8 @@ func (u *UserInterface) canCaptureCursor() bool {
 }
 
 func (u *UserInterface) update() error {
+	u.flushRequestResults()
+
 	if u.captureCursorLater && u.canCaptureCursor() {
 		u.setCursorMode(CursorModeCaptured)
 	}
@@ -343,6 +397,8 @@ func (u *UserInterface) updateImpl(force bool) error {
 	// Now there is not a good way to detect the change.
 	// See also https://crbug.com/123694.
 
+	clock.SetRefreshRate(theMonitor.RefreshRate())
+
 	w, h := u.outsideSize()
 	if force {
 		if err := u.context.forceUpdateFrame(u.graphicsDriver, w, h, theMonitor.DeviceScaleFactor(), u); err != nil {
@@ -411,6 +467,11 @@ func (u *UserInterface) loopGame() error {
 
 	// TODO: Should cf be released after the game ends?
 	cf = js.FuncOf(func(this js.Value, args []js.Value) any {
+		// A requestAnimationFrame callback has a timestamp argument, while a setTimeout callback doesn't.
+		if len(args) > 0 && args[0].Type() == js.TypeNumber {
+			theMonitor.refreshRate.addTimestamp(args[0].Float())
+		}
+
 		// f can be blocked but callbacks must not be blocked. Create a goroutine (#1161).
 		go f()
 		return nil
@@ -470,6 +531,10 @@ func (u *UserInterface) init() error {
 		hiDPIEnabled:        true,
 	}
 
+	if isWorker {
+		return u.initWorker()
+	}
+
 	// document is undefined on node.js
 	if !document.Truthy() {
 		return nil
@@ -526,6 +591,7 @@ func (u *UserInterface) init() error {
 	// Pointer Lock
 	document.Call("addEventListener", "pointerlockchange", js.FuncOf(func(this js.Value, args []js.Value) any {
 		if document.Get("pointerLockElement").Truthy() {
+			u.resolvePointerLockRequests(nil)
 			return nil
 		}
 		// Recover the state correctly when the pointer lock exits.
@@ -539,6 +605,7 @@ func (u *UserInterface) init() error {
 	}))
 	document.Call("addEventListener", "pointerlockerror", js.FuncOf(func(this js.Value, args []js.Value) any {
 		js.Global().Get("console").Call("error", "pointerlockerror event is fired. 'sandbox=\"allow-pointer-lock\"' might be required at an iframe. This function on browsers must be called as a result of a gestural interaction or orientation change.")
+		u.resolvePointerLockRequests(errPointerLockRejected)
 		if u.cursorMode == CursorModeCaptured {
 			u.recoverCursorMode()
 		}
@@ -581,6 +648,12 @@ func (u *UserInterface) setCanvasEventHandlers(v js.Value) {
 
 		e := args[0]
 		e.Call("preventDefault")
+
+		// The Escape key is not an activation-triggering input.
+		if !e.Get("code").Equal(uiKeyToJSCode[KeyEscape]) {
+			u.processGestureRequests()
+		}
+
 		if err := u.updateInputFromEvent(e); err != nil {
 			u.setError(err)
 			return nil
@@ -604,6 +677,8 @@ func (u *UserInterface) setCanvasEventHandlers(v js.Value) {
 
 		e := args[0]
 		e.Call("preventDefault")
+		u.processGestureRequests()
+
 		if err := u.updateInputFromEvent(e); err != nil {
 			u.setError(err)
 			return nil
@@ -654,6 +729,8 @@ func (u *UserInterface) setCanvasEventHandlers(v js.Value) {
 	v.Call("addEventListener", "touchend", js.FuncOf(func(this js.Value, args []js.Value) any {
 		e := args[0]
 		e.Call("preventDefault")
+		u.processGestureRequests()
+
 		if err := u.updateInputFromEvent(e); err != nil {
 			u.setError(err)
 			return nil
@@ -680,8 +757,19 @@ func (u *UserInterface) setCanvasEventHandlers(v js.Value) {
 	// Context
 	v.Call("addEventListener", "webglcontextlost", js.FuncOf(func(this js.Value, args []js.Value) any {
 		e := args[0]
+		// Prevent the default behavior so that the context can be restored.
 		e.Call("preventDefault")
-		window.Get("location").Call("reload")
+		// Give the application a chance to save its state before reloading.
+		hook.RunLifecycleEventHooks(hook.LifecycleEventGraphicsContextLost)
+		if !u.strictContextRestoration {
+			window.Get("location").Call("reload")
+		}
+		return nil
+	}))
+	v.Call("addEventListener", "webglcontextrestored", js.FuncOf(func(this js.Value, args []js.Value) any {
+		// The images and shaders are re-created at the next frame.
+		// LifecycleEventGraphicsContextRestored is notified after that.
+		restorable.OnContextLost()
 		return nil
 	}))
 
@@ -785,6 +873,16 @@ func (u *UserInterface) initOnMainThread(options *RunOptions) error {
 	u.graphicsDriver = g
 	u.setGraphicsLibrary(lib)
 
+	// Only WebGL contexts can be restored.
+	if options.StrictContextRestoration && lib == GraphicsLibraryOpenGL {
+		u.strictContextRestoration = true
+	} else {
+		restorable.Disable()
+	}
+
+	if !document.Truthy() {
+		return nil
+	}
 	if bodyStyle := document.Get("body").Get("style"); options.ScreenTransparent {
 		bodyStyle.Set("backgroundColor", "transparent")
 	} else {
@@ -802,6 +900,12 @@ func (u *UserInterface) updateScreenSize() {
 		bh := int(body.Get("clientHeight").Float() * f)
 		canvas.Set("width", bw)
 		canvas.Set("height", bh)
+		return
+	}
+	if isWorker {
+		f := theMonitor.DeviceScaleFactor()
+		canvas.Set("width", int(u.worker.outsideWidth*f))
+		canvas.Set("height", int(u.worker.outsideHeight*f))
 	}
 }
 
@@ -816,6 +920,7 @@ func (u *UserInterface) Window() Window {
 
 type Monitor struct {
 	deviceScaleFactor float64
+	refreshRate       refreshRateEstimator
 }
 
 var theMonitor = &Monitor{}
@@ -833,7 +938,12 @@ func (m *Monitor) DeviceScaleFactor() float64 {
 		return m.deviceScaleFactor
 	}
 
-	ratio := window.Get("devicePixelRatio").Float()
+	var ratio float64
+	if isWorker {
+		ratio = theUI.worker.deviceScaleFactor
+	} else {
+		ratio = window.Get("devicePixelRatio").Float()
+	}
 	if ratio == 0 {
 		ratio = 1
 	}
@@ -841,7 +951,14 @@ func (m *Monitor) DeviceScaleFactor() float64 {
 	return m.deviceScaleFactor
 }
 
+func (m *Monitor) RefreshRate() float64 {
+	return m.refreshRate.refreshRate()
+}
+
 func (m *Monitor) Size() (int, int) {
+	if !screen.Truthy() {
+		return 0, 0
+	}
 	return screen.Get("width").Int(), screen.Get("height").Int()
 }
 
@@ -60,6 +60,10 @@ func (*graphicsDriverCreatorImpl) newPlayStation5() (graphicsdriver.Graphics, er
 	return nil, errors.New("ui: PlayStation 5 is not supported in this environment")
 }
 
+func (*graphicsDriverCreatorImpl) newWebGPU() (graphicsdriver.Graphics, error) {
+	return nil, errors.New("ui: WebGPU is not supported in this environment")
+}
+
 // glfwMonitorSizeInGLFWPixels must be called from the main thread.
 func glfwMonitorSizeInGLFWPixels(m *glfw.Monitor) (int, int, error) {
 	vm, err := m.GetVideoMode()
@@ -206,6 +210,29 @@ func (u *UserInterface) setDocumentEdited(edited bool) error {
 	return nil
 }
 
+func (u *UserInterface) accessibilityPreferences() AccessibilityPreferences {
+	// TODO: Read the GNOME settings via D-Bus.
+	return defaultAccessibilityPreferences()
+}
+
+// announce must be called from the main thread.
+func (u *UserInterface) announce(text string) error {
+	// TODO: Implement this with AT-SPI.
+	return nil
+}
+
+// setWindowProgress must be called from the main thread.
+func (u *UserInterface) setWindowProgress(state WindowProgressState, progress float64) error {
+	// There is no standard way to show a progress on a taskbar on Linux.
+	return nil
+}
+
+// setWindowBadge must be called from the main thread.
+func (u *UserInterface) setWindowBadge(label string) error {
+	// There is no standard way to show a badge on a taskbar on Linux.
+	return nil
+}
+
 func (u *UserInterface) afterWindowCreation() error {
 	return nil
 }
@@ -18,12 +18,15 @@ package ui
 
 import (
 	stdcontext "context"
+	"errors"
 	"fmt"
+	"image"
 	"runtime"
 	"runtime/debug"
 	"sync"
 	"sync/atomic"
 
+	"github.com/hajimehoshi/ebiten/v2/internal/clock"
 	"github.com/hajimehoshi/ebiten/v2/internal/gamepad"
 	"github.com/hajimehoshi/ebiten/v2/internal/graphicscommand"
 	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver"
@@ -47,6 +50,8 @@ func (u *UserInterface) init() error {
 		// Give a default outside size so that the game can start without initializing them.
 		outsideWidth:  640,
 		outsideHeight: 480,
+
+		accessibilityPreferences: defaultAccessibilityPreferences(),
 	}
 	u.foreground.Store(true)
 	return nil
@@ -90,6 +95,18 @@ type userInterfaceImpl struct {
 	outsideWidth  float64
 	outsideHeight float64
 
+	safeAreaInsetLeft   float64
+	safeAreaInsetTop    float64
+	safeAreaInsetRight  float64
+	safeAreaInsetBottom float64
+	displayCornerRadius float64
+
+	virtualKeyboard       VirtualKeyboard
+	announcer             Announcer
+	virtualKeyboardHeight float64
+
+	accessibilityPreferences AccessibilityPreferences
+
 	foreground atomic.Bool
 	errCh      chan error
 
@@ -113,11 +130,17 @@ type userInterfaceImpl struct {
 func (u *UserInterface) SetForeground(foreground bool) error {
 	u.foreground.Store(foreground)
 
+	// Notify the lifecycle event before suspending audio and after resuming audio,
+	// so that the game can operate audio players in the hooks.
 	if foreground {
-		return hook.ResumeAudio()
-	} else {
-		return hook.SuspendAudio()
+		if err := hook.ResumeAudio(); err != nil {
+			return err
+		}
+		hook.RunLifecycleEventHooks(hook.LifecycleEventForeground)
+		return nil
 	}
+	hook.RunLifecycleEventHooks(hook.LifecycleEventBackground)
+	return hook.SuspendAudio()
 }
 
 func (u *UserInterface) Run(game Game, options *RunOptions) error {
@@ -186,6 +209,8 @@ func (u *UserInterface) update() error {
 		renderEndCh <- struct{}{}
 	}()
 
+	clock.SetRefreshRate(theMonitor.RefreshRate())
+
 	w, h := u.outsideSize()
 	if err := u.context.updateFrame(u.graphicsDriver, w, h, theMonitor.DeviceScaleFactor(), u); err != nil {
 		return err
@@ -193,6 +218,121 @@ func (u *UserInterface) update() error {
 	return nil
 }
 
+// SetSafeAreaInsets is called from mobile/ebitenmobileview.
+//
+// SetSafeAreaInsets sets the safe area insets and the display corner radius in device-independent pixels.
+func (u *UserInterface) SetSafeAreaInsets(left, top, right, bottom float64, cornerRadius float64) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.safeAreaInsetLeft = left
+	u.safeAreaInsetTop = top
+	u.safeAreaInsetRight = right
+	u.safeAreaInsetBottom = bottom
+	u.displayCornerRadius = cornerRadius
+}
+
+func (u *UserInterface) SafeAreaInsets() (left, top, right, bottom float64) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	return u.safeAreaInsetLeft, u.safeAreaInsetTop, u.safeAreaInsetRight, u.safeAreaInsetBottom
+}
+
+func (u *UserInterface) DisplayCornerRadius() float64 {
+	u.m.Lock()
+	defer u.m.Unlock()
+	return u.displayCornerRadius
+}
+
+// VirtualKeyboard shows or hides the on-screen virtual keyboard on the native side.
+type VirtualKeyboard interface {
+	SetVirtualKeyboardVisible(visible bool)
+}
+
+// SetVirtualKeyboard is called from mobile/ebitenmobileview.
+func (u *UserInterface) SetVirtualKeyboard(virtualKeyboard VirtualKeyboard) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.virtualKeyboard = virtualKeyboard
+}
+
+func (u *UserInterface) ShowVirtualKeyboard() {
+	u.setVirtualKeyboardVisible(true)
+}
+
+func (u *UserInterface) HideVirtualKeyboard() {
+	u.setVirtualKeyboardVisible(false)
+}
+
+func (u *UserInterface) setVirtualKeyboardVisible(visible bool) {
+	u.m.Lock()
+	k := u.virtualKeyboard
+	u.m.Unlock()
+
+	// Call the native side without the lock, as the native side might call back Go functions.
+	if k == nil {
+		return
+	}
+	k.SetVirtualKeyboardVisible(visible)
+}
+
+// Announcer sends a text to the screen reader on the native side.
+type Announcer interface {
+	Announce(text string)
+}
+
+// SetAnnouncer is called from mobile/ebitenmobileview.
+func (u *UserInterface) SetAnnouncer(announcer Announcer) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.announcer = announcer
+}
+
+func (u *UserInterface) Announce(text string) {
+	u.m.Lock()
+	a := u.announcer
+	u.m.Unlock()
+
+	if a == nil {
+		return
+	}
+	a.Announce(text)
+}
+
+// SetVirtualKeyboardHeight is called from mobile/ebitenmobileview.
+//
+// SetVirtualKeyboardHeight sets the height of the area covered by the virtual keyboard in device-independent pixels.
+func (u *UserInterface) SetVirtualKeyboardHeight(height float64) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.virtualKeyboardHeight = height
+}
+
+func (u *UserInterface) VirtualKeyboardHeight() float64 {
+	u.m.Lock()
+	defer u.m.Unlock()
+	return u.virtualKeyboardHeight
+}
+
+// SetAccessibilityPreferences is called from mobile/ebitenmobileview.
+func (u *UserInterface) SetAccessibilityPreferences(reducedMotion, highContrast bool, textScaleFactor float64) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	if textScaleFactor <= 0 {
+		textScaleFactor = 1
+	}
+	u.accessibilityPreferences = AccessibilityPreferences{
+		ReducedMotion:   reducedMotion,
+		HighContrast:    highContrast,
+		TextScaleFactor: textScaleFactor,
+	}
+}
+
+func (u *UserInterface) AccessibilityPreferences() AccessibilityPreferences {
+	u.m.Lock()
+	defer u.m.Unlock()
+	return u.accessibilityPreferences
+}
+
 // SetOutsideSize is called from mobile/ebitenmobileview.
 //
 // SetOutsideSize is concurrent safe.
@@ -213,6 +353,14 @@ func (u *UserInterface) SetCursorMode(mode CursorMode) {
 	// Do nothing
 }
 
+func (u *UserInterface) CursorBounds() image.Rectangle {
+	return image.Rectangle{}
+}
+
+func (u *UserInterface) SetCursorBounds(bounds image.Rectangle) {
+	// Do nothing
+}
+
 func (u *UserInterface) CursorShape() CursorShape {
 	return CursorShapeDefault
 }
@@ -229,6 +377,18 @@ func (u *UserInterface) SetFullscreen(fullscreen bool) {
 	// Do nothing
 }
 
+func (u *UserInterface) RequestPointerLock(callback func(err error)) {
+	if callback != nil {
+		callback(errors.New("ui: pointer lock is not supported in this environment"))
+	}
+}
+
+func (u *UserInterface) RequestFullscreen(callback func(err error)) {
+	if callback != nil {
+		callback(errors.New("ui: fullscreen is not supported in this environment"))
+	}
+}
+
 func (u *UserInterface) IsFocused() bool {
 	return u.foreground.Load()
 }
@@ -273,6 +433,9 @@ type Monitor struct {
 	deviceScaleFactor float64
 	inited            atomic.Bool
 
+	// refreshRate is updated from the native side and is not a part of the display info initialized at ensureInit.
+	refreshRate float64
+
 	m sync.Mutex
 }
 
@@ -313,6 +476,21 @@ func (m *Monitor) Size() (int, int) {
 	return m.width, m.height
 }
 
+func (m *Monitor) RefreshRate() float64 {
+	m.m.Lock()
+	defer m.m.Unlock()
+	return m.refreshRate
+}
+
+// SetRefreshRate is called from mobile/ebitenmobileview.
+//
+// SetRefreshRate sets the display's refresh rate in Hz.
+func (u *UserInterface) SetRefreshRate(rate float64) {
+	theMonitor.m.Lock()
+	defer theMonitor.m.Unlock()
+	theMonitor.refreshRate = rate
+}
+
 func (u *UserInterface) AppendMonitors(mons []*Monitor) []*Monitor {
 	return append(mons, theMonitor)
 }
@@ -48,6 +48,10 @@ func (*graphicsDriverCreatorImpl) newPlayStation5() (graphicsdriver.Graphics, er
 	return playstation5.NewGraphics()
 }
 
+func (*graphicsDriverCreatorImpl) newWebGPU() (graphicsdriver.Graphics, error) {
+	return nil, errors.New("ui: WebGPU is not supported in this environment")
+}
+
 const (
 	// TODO: Get this value from the SDK.
 	screenWidth  = 3840
@@ -105,6 +109,14 @@ func (*UserInterface) CursorMode() CursorMode {
 func (*UserInterface) SetCursorMode(mode CursorMode) {
 }
 
+func (*UserInterface) CursorBounds() image.Rectangle {
+	return image.Rectangle{}
+}
+
+func (*UserInterface) SetCursorBounds(bounds image.Rectangle) {
+	// Do nothing
+}
+
 func (*UserInterface) CursorShape() CursorShape {
 	return CursorShapeDefault
 }
@@ -119,6 +131,43 @@ func (*UserInterface) IsFullscreen() bool {
 func (*UserInterface) SetFullscreen(fullscreen bool) {
 }
 
+func (*UserInterface) SafeAreaInsets() (left, top, right, bottom float64) {
+	return 0, 0, 0, 0
+}
+
+func (*UserInterface) DisplayCornerRadius() float64 {
+	return 0
+}
+
+func (*UserInterface) AccessibilityPreferences() AccessibilityPreferences {
+	return defaultAccessibilityPreferences()
+}
+
+func (*UserInterface) Announce(text string) {
+}
+
+func (*UserInterface) ShowVirtualKeyboard() {
+}
+
+func (*UserInterface) HideVirtualKeyboard() {
+}
+
+func (*UserInterface) VirtualKeyboardHeight() float64 {
+	return 0
+}
+
+func (*UserInterface) RequestPointerLock(callback func(err error)) {
+	if callback != nil {
+		callback(errors.New("ui: pointer lock is not supported in this environment"))
+	}
+}
+
+func (*UserInterface) RequestFullscreen(callback func(err error)) {
+	if callback != nil {
+		callback(errors.New("ui: fullscreen is not supported in this environment"))
+	}
+}
+
 func (*UserInterface) IsRunnableOnUnfocused() bool {
 	return false
 }
@@ -161,6 +210,11 @@ func (m *Monitor) DeviceScaleFactor() float64 {
 	return 1
 }
 
+func (m *Monitor) RefreshRate() float64 {
+	// TODO: Get the refresh rate from the platform.
+	return 0
+}
+
 func (m *Monitor) Size() (int, int) {
 	return screenWidth, screenHeight
 }
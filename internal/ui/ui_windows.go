@@ -19,8 +19,10 @@ import (
 	"fmt"
 	"runtime"
 	"syscall"
+	"unsafe"
 
 	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
 
 	"github.com/hajimehoshi/ebiten/v2/internal/glfw"
 	"github.com/hajimehoshi/ebiten/v2/internal/graphicsdriver"
@@ -93,6 +95,10 @@ func (*graphicsDriverCreatorImpl) newPlayStation5() (graphicsdriver.Graphics, er
 	return nil, errors.New("ui: PlayStation 5 is not supported in this environment")
 }
 
+func (*graphicsDriverCreatorImpl) newWebGPU() (graphicsdriver.Graphics, error) {
+	return nil, errors.New("ui: WebGPU is not supported in this environment")
+}
+
 // glfwMonitorSizeInGLFWPixels must be called from the main thread.
 func glfwMonitorSizeInGLFWPixels(m *glfw.Monitor) (int, int, error) {
 	vm, err := m.GetVideoMode()
@@ -256,6 +262,35 @@ func (u *UserInterface) setDocumentEdited(edited bool) error {
 	return nil
 }
 
+func (u *UserInterface) accessibilityPreferences() AccessibilityPreferences {
+	p := defaultAccessibilityPreferences()
+	if microsoftgdk.IsXbox() {
+		return p
+	}
+
+	// Errors are ignored and the default values are used, as the preferences are not critical.
+	var animation int32
+	if err := _SystemParametersInfoW(_SPI_GETCLIENTAREAANIMATION, 0, unsafe.Pointer(&animation), 0); err == nil {
+		p.ReducedMotion = animation == 0
+	}
+
+	var hc _HIGHCONTRASTW
+	hc.cbSize = uint32(unsafe.Sizeof(hc))
+	if err := _SystemParametersInfoW(_SPI_GETHIGHCONTRAST, hc.cbSize, unsafe.Pointer(&hc), 0); err == nil {
+		p.HighContrast = hc.dwFlags&_HCF_HIGHCONTRASTON != 0
+	}
+
+	// The text size in the Ease of Access settings is stored in the registry as a percentage (100-225).
+	if k, err := registry.OpenKey(registry.CURRENT_USER, `Software\Microsoft\Accessibility`, registry.QUERY_VALUE); err == nil {
+		if v, _, err := k.GetIntegerValue("TextScaleFactor"); err == nil && v > 0 {
+			p.TextScaleFactor = float64(v) / 100
+		}
+		_ = k.Close()
+	}
+
+	return p
+}
+
 func (u *UserInterface) afterWindowCreation() error {
 	if microsoftgdk.IsXbox() {
 		return nil
@@ -0,0 +1,41 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"syscall/js"
+)
+
+// On browsers, the virtual keyboard is shown and hidden by the browser when an editable element is focused,
+// e.g., by exp/textinput. ShowVirtualKeyboard and HideVirtualKeyboard do nothing.
+
+func (u *UserInterface) ShowVirtualKeyboard() {
+}
+
+func (u *UserInterface) HideVirtualKeyboard() {
+}
+
+// VirtualKeyboardHeight returns the height of the virtual keyboard with the VirtualKeyboard API.
+// The height is non-zero only when navigator.virtualKeyboard.overlaysContent is true. Otherwise, the browser resizes
+// the viewport instead of overlaying the keyboard.
+//
+// See https://developer.mozilla.org/en-US/docs/Web/API/VirtualKeyboard_API
+func (u *UserInterface) VirtualKeyboardHeight() float64 {
+	k := js.Global().Get("navigator").Get("virtualKeyboard")
+	if !k.Truthy() {
+		return 0
+	}
+	return k.Get("boundingRect").Get("height").Float()
+}
@@ -44,6 +44,42 @@ type Window interface {
 	SetMousePassthrough(enabled bool)
 	IsMousePassthrough() bool
 	RequestAttention()
+	SetProgress(state WindowProgressState, progress float64)
+	SetBadge(label string)
+	SetHitTestRegions(regions []WindowHitTestRegion)
+	IsBeingResized() bool
+}
+
+type WindowProgressState int
+
+const (
+	WindowProgressStateNone WindowProgressState = iota
+	WindowProgressStateNormal
+	WindowProgressStateIndeterminate
+	WindowProgressStatePaused
+	WindowProgressStateError
+)
+
+type WindowHitTestKind int
+
+const (
+	WindowHitTestKindCaption WindowHitTestKind = iota
+	WindowHitTestKindMinimize
+	WindowHitTestKindMaximize
+	WindowHitTestKindClose
+	WindowHitTestKindResizeLeft
+	WindowHitTestKindResizeRight
+	WindowHitTestKindResizeTop
+	WindowHitTestKindResizeBottom
+	WindowHitTestKindResizeTopLeft
+	WindowHitTestKindResizeTopRight
+	WindowHitTestKindResizeBottomLeft
+	WindowHitTestKindResizeBottomRight
+)
+
+type WindowHitTestRegion struct {
+	Bounds image.Rectangle
+	Kind   WindowHitTestKind
 }
 
 type nullWindow struct{}
@@ -132,3 +168,16 @@ func (*nullWindow) IsMousePassthrough() bool {
 
 func (*nullWindow) RequestAttention() {
 }
+
+func (*nullWindow) SetProgress(state WindowProgressState, progress float64) {
+}
+
+func (*nullWindow) SetBadge(label string) {
+}
+
+func (*nullWindow) SetHitTestRegions(regions []WindowHitTestRegion) {
+}
+
+func (*nullWindow) IsBeingResized() bool {
+	return false
+}
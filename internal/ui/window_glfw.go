@@ -525,3 +525,56 @@ func (w *glfwWindow) RequestAttention() {
 		}
 	})
 }
+
+func (w *glfwWindow) SetProgress(state WindowProgressState, progress float64) {
+	if w.ui.isTerminated() {
+		return
+	}
+	if !w.ui.isRunning() {
+		// Do nothing
+		return
+	}
+	w.ui.mainThread.Call(func() {
+		if w.ui.isTerminated() {
+			return
+		}
+		if err := w.ui.setWindowProgress(state, progress); err != nil {
+			w.ui.setError(err)
+			return
+		}
+	})
+}
+
+func (w *glfwWindow) SetBadge(label string) {
+	if w.ui.isTerminated() {
+		return
+	}
+	if !w.ui.isRunning() {
+		// Do nothing
+		return
+	}
+	w.ui.mainThread.Call(func() {
+		if w.ui.isTerminated() {
+			return
+		}
+		if err := w.ui.setWindowBadge(label); err != nil {
+			w.ui.setError(err)
+			return
+		}
+	})
+}
+
+func (w *glfwWindow) SetHitTestRegions(regions []WindowHitTestRegion) {
+	if w.ui.isTerminated() {
+		return
+	}
+	w.ui.setWindowHitTestRegions(regions)
+}
+
+func (w *glfwWindow) IsBeingResized() bool {
+	if w.ui.isTerminated() {
+		return false
+	}
+	// Do not use the main thread, as the main thread is blocked during the resizing.
+	return w.ui.isWindowBeingResized()
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"syscall/js"
+)
+
+// In the worker mode, Ebitengine runs in a dedicated Web Worker and renders to an OffscreenCanvas transferred from
+// the main thread. As a worker cannot access DOM, the main thread (the host) forwards the states and the events.
+//
+// All the messages are JavaScript objects with an 'ebitengine' property that represents the message kind.
+//
+// From the worker to the host:
+//
+//   - "ready": The worker is ready to receive messages.
+//   - "cursor": Sets the canvas's CSS cursor to 'value'.
+//   - "pointerlock": Requests ('value' is true) or exits ('value' is false) a pointer lock.
+//   - "fullscreen": Requests ('value' is true) or exits ('value' is false) fullscreen.
+//
+// From the host to the worker:
+//
+//   - "init": 'canvas' is an OffscreenCanvas. This must be the first message.
+//     This also has the same properties as "resize".
+//   - "resize": 'width' and 'height' are the canvas's client size in CSS pixels, and 'devicePixelRatio' is the device
+//     pixel ratio.
+//   - "focus": 'focused' reports whether the canvas is focused and the document is visible.
+//   - "event": 'event' is a plain object that has the same properties as a DOM event used by Ebitengine, such as
+//     'type', 'key', 'code', 'button', 'clientX', 'clientY', 'movementX', 'movementY', 'deltaX', 'deltaY', and
+//     'targetTouches' (an array of objects with 'identifier', 'clientX', and 'clientY').
+//   - "pointerlockchange": 'locked' reports whether the pointer is locked.
+//   - "fullscreenchange": 'fullscreen' reports whether the canvas is fullscreen.
+
+// isWorker reports whether the current context is a Web Worker.
+var isWorker = !document.Truthy() && js.Global().Get("WorkerGlobalScope").Truthy()
+
+var (
+	stringInit              = js.ValueOf("init")
+	stringResize            = js.ValueOf("resize")
+	stringFocus             = js.ValueOf("focus")
+	stringEvent             = js.ValueOf("event")
+	stringPointerlockchange = js.ValueOf("pointerlockchange")
+	stringFullscreenchange  = js.ValueOf("fullscreenchange")
+)
+
+type workerState struct {
+	outsideWidth      float64
+	outsideHeight     float64
+	deviceScaleFactor float64
+	focused           bool
+	pointerLocked     bool
+	fullscreen        bool
+}
+
+func postMessageToHost(kind string, value any) {
+	msg := js.Global().Get("Object").New()
+	msg.Set("ebitengine", kind)
+	if value != nil {
+		msg.Set("value", value)
+	}
+	js.Global().Call("postMessage", msg)
+}
+
+func (u *UserInterface) initWorker() error {
+	u.worker.focused = true
+
+	initCh := make(chan struct{})
+	js.Global().Call("addEventListener", "message", js.FuncOf(func(this js.Value, args []js.Value) any {
+		data := args[0].Get("data")
+		if data.Type() != js.TypeObject {
+			return nil
+		}
+		kind := data.Get("ebitengine")
+		if kind.Type() != js.TypeString {
+			return nil
+		}
+
+		switch {
+		case kind.Equal(stringInit):
+			if canvas.Truthy() {
+				return nil
+			}
+			canvas = data.Get("canvas")
+			u.updateWorkerSize(data)
+			close(initCh)
+		case kind.Equal(stringResize):
+			u.updateWorkerSize(data)
+			u.updateScreenSize()
+
+			// updateImpl can block. Use goroutine.
+			// See https://pkg.go.dev/syscall/js#FuncOf.
+			go func() {
+				if err := u.updateImpl(true); err != nil {
+					u.setError(err)
+					return
+				}
+			}()
+		case kind.Equal(stringFocus):
+			u.worker.focused = data.Get("focused").Bool()
+			if !u.worker.focused {
+				u.inputState.resetForBlur()
+			}
+		case kind.Equal(stringEvent):
+			if err := u.updateInputFromEvent(data.Get("event")); err != nil {
+				u.setError(err)
+				return nil
+			}
+		case kind.Equal(stringPointerlockchange):
+			u.worker.pointerLocked = data.Get("locked").Bool()
+			if u.worker.pointerLocked {
+				return nil
+			}
+			// Recover the state correctly when the pointer lock exits.
+			if u.cursorMode == CursorModeCaptured {
+				u.recoverCursorMode()
+			}
+			u.recoverCursorPosition()
+		case kind.Equal(stringFullscreenchange):
+			u.worker.fullscreen = data.Get("fullscreen").Bool()
+		}
+		return nil
+	}))
+
+	postMessageToHost("ready", nil)
+	<-initCh
+	return nil
+}
+
+func (u *UserInterface) updateWorkerSize(data js.Value) {
+	u.worker.outsideWidth = data.Get("width").Float()
+	u.worker.outsideHeight = data.Get("height").Float()
+	if r := data.Get("devicePixelRatio"); r.Truthy() {
+		u.worker.deviceScaleFactor = r.Float()
+	} else {
+		u.worker.deviceScaleFactor = 1
+	}
+	// Invalidate the cached value.
+	theMonitor.deviceScaleFactor = 0
+}
+
+// setCanvasCursor sets the canvas's CSS cursor.
+func setCanvasCursor(cursor any) {
+	if isWorker {
+		postMessageToHost("cursor", cursor)
+		return
+	}
+	canvas.Get("style").Set("cursor", cursor)
+}
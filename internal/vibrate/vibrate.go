@@ -0,0 +1,38 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vibrate
+
+import (
+	"time"
+)
+
+// onOffDurations converts the given steps into alternating durations of pauses and vibrations.
+// The first duration is always for a pause, which might be 0.
+// A step whose magnitude is 0 or less is treated as a pause. Adjacent steps of the same kind are merged.
+func onOffDurations(durations []time.Duration, magnitudes []float64) []time.Duration {
+	ds := []time.Duration{0}
+	on := false
+	for i, d := range durations {
+		if d <= 0 {
+			continue
+		}
+		if stepOn := magnitudes[i] > 0; stepOn != on {
+			ds = append(ds, 0)
+			on = stepOn
+		}
+		ds[len(ds)-1] += d
+	}
+	return ds
+}
@@ -101,6 +101,90 @@ static void vibrateOneShot(uintptr_t java_vm, uintptr_t jni_env, uintptr_t ctx,
   (*env)->DeleteLocalRef(env, vibrator);
 }
 
+// Basically same as:
+//
+//     Vibrator v = (Vibrator)getSystemService(Context.VIBRATOR_SERVICE);
+//     if (Build.VERSION.SDK_INT >= 26) {
+//       v.vibrate(VibrationEffect.createWaveform(timings, amplitudes, -1))
+//     } else {
+//       v.vibrate(onOffTimings, -1)
+//     }
+//
+static void vibrateWaveform(uintptr_t java_vm, uintptr_t jni_env, uintptr_t ctx,
+                            int64_t* timings, int32_t* amplitudes, int n,
+                            int64_t* on_off_timings, int on_off_n) {
+  JavaVM* vm = (JavaVM*)java_vm;
+  JNIEnv* env = (JNIEnv*)jni_env;
+  jobject context = (jobject)ctx;
+
+  static int apiLevel = 0;
+  if (!apiLevel) {
+    const jclass android_os_Build_VERSION = (*env)->FindClass(env, "android/os/Build$VERSION");
+
+    apiLevel = (*env)->GetStaticIntField(
+        env, android_os_Build_VERSION,
+        (*env)->GetStaticFieldID(env, android_os_Build_VERSION, "SDK_INT", "I"));
+
+    (*env)->DeleteLocalRef(env, android_os_Build_VERSION);
+  }
+
+  const jclass android_content_Context = (*env)->FindClass(env, "android/content/Context");
+  const jclass android_os_Vibrator = (*env)->FindClass(env, "android/os/Vibrator");
+
+  const jobject android_context_Context_VIBRATOR_SERVICE =
+      (*env)->GetStaticObjectField(
+          env, android_content_Context,
+          (*env)->GetStaticFieldID(env, android_content_Context, "VIBRATOR_SERVICE", "Ljava/lang/String;"));
+
+  const jobject vibrator =
+      (*env)->CallObjectMethod(
+          env, context,
+          (*env)->GetMethodID(env, android_content_Context, "getSystemService", "(Ljava/lang/String;)Ljava/lang/Object;"),
+          android_context_Context_VIBRATOR_SERVICE);
+
+  if (apiLevel >= 26) {
+    const jclass android_os_VibrationEffect = (*env)->FindClass(env, "android/os/VibrationEffect");
+
+    jlongArray jtimings = (*env)->NewLongArray(env, n);
+    (*env)->SetLongArrayRegion(env, jtimings, 0, n, (const jlong*)timings);
+    jintArray jamplitudes = (*env)->NewIntArray(env, n);
+    (*env)->SetIntArrayRegion(env, jamplitudes, 0, n, (const jint*)amplitudes);
+
+    const jobject vibrationEffect =
+        (*env)->CallStaticObjectMethod(
+            env, android_os_VibrationEffect,
+            (*env)->GetStaticMethodID(env, android_os_VibrationEffect, "createWaveform", "([J[II)Landroid/os/VibrationEffect;"),
+            jtimings, jamplitudes, -1);
+
+    (*env)->CallVoidMethod(
+        env, vibrator,
+        (*env)->GetMethodID(env, android_os_Vibrator, "vibrate", "(Landroid/os/VibrationEffect;)V"),
+        vibrationEffect);
+
+    (*env)->DeleteLocalRef(env, android_os_VibrationEffect);
+    (*env)->DeleteLocalRef(env, jtimings);
+    (*env)->DeleteLocalRef(env, jamplitudes);
+
+    (*env)->DeleteLocalRef(env, vibrationEffect);
+  } else {
+    jlongArray jpattern = (*env)->NewLongArray(env, on_off_n);
+    (*env)->SetLongArrayRegion(env, jpattern, 0, on_off_n, (const jlong*)on_off_timings);
+
+    (*env)->CallVoidMethod(
+        env, vibrator,
+        (*env)->GetMethodID(env, android_os_Vibrator, "vibrate", "([JI)V"),
+        jpattern, -1);
+
+    (*env)->DeleteLocalRef(env, jpattern);
+  }
+
+  (*env)->DeleteLocalRef(env, android_content_Context);
+  (*env)->DeleteLocalRef(env, android_os_Vibrator);
+
+  (*env)->DeleteLocalRef(env, android_context_Context_VIBRATOR_SERVICE);
+  (*env)->DeleteLocalRef(env, vibrator);
+}
+
 */
 import "C"
 
@@ -113,3 +197,33 @@ func Vibrate(duration time.Duration, magnitude float64) {
 		})
 	}()
 }
+
+func VibratePattern(durations []time.Duration, magnitudes []float64) {
+	var timings []C.int64_t
+	var amplitudes []C.int32_t
+	for i, d := range durations {
+		if d <= 0 {
+			continue
+		}
+		timings = append(timings, C.int64_t(d/time.Millisecond))
+		amplitudes = append(amplitudes, C.int32_t(min(max(magnitudes[i], 0), 1)*255))
+	}
+	if len(timings) == 0 {
+		return
+	}
+
+	// Android's legacy pattern is alternating durations of pauses and vibrations, starting with a pause.
+	var onOffTimings []C.int64_t
+	for _, d := range onOffDurations(durations, magnitudes) {
+		onOffTimings = append(onOffTimings, C.int64_t(d/time.Millisecond))
+	}
+
+	go func() {
+		_ = app.RunOnJVM(func(vm, env, ctx uintptr) error {
+			C.vibrateWaveform(C.uintptr_t(vm), C.uintptr_t(env), C.uintptr_t(ctx),
+				&timings[0], &amplitudes[0], C.int(len(timings)),
+				&onOffTimings[0], C.int(len(onOffTimings)))
+			return nil
+		})
+	}()
+}
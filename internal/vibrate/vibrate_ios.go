@@ -48,7 +48,24 @@ package vibrate
 //   return nil;
 // }
 //
-// static void vibrateOnMainThread(double duration, double intensity) {
+// API_AVAILABLE(ios(13.0))
+// static NSDictionary* hapticEvent(double time, double duration, double intensity) {
+//   return @{
+//     (id<NSCopying>)(CHHapticPatternKeyEvent): @{
+//       (id<NSCopying>)(CHHapticPatternKeyEventType):CHHapticEventTypeHapticContinuous,
+//       (id<NSCopying>)(CHHapticPatternKeyTime):[NSNumber numberWithDouble:time],
+//       (id<NSCopying>)(CHHapticPatternKeyEventDuration):[NSNumber numberWithDouble:duration],
+//       (id<NSCopying>)(CHHapticPatternKeyEventParameters):@[
+//         @{
+//           (id<NSCopying>)(CHHapticPatternKeyParameterID): CHHapticEventParameterIDHapticIntensity,
+//           (id<NSCopying>)(CHHapticPatternKeyParameterValue): [NSNumber numberWithDouble:intensity],
+//         },
+//       ],
+//     },
+//   };
+// }
+//
+// static void playHapticEventsOnMainThread(NSArray* events) {
 //   if (@available(iOS 13.0, *)) {
 //     static BOOL initializeHapticEngineCalled = NO;
 //     static CHHapticEngine* engine = nil;
@@ -61,21 +78,7 @@ package vibrate
 //     }
 //     @autoreleasepool {
 //       NSDictionary* hapticDict = @{
-//         (id<NSCopying>)(CHHapticPatternKeyPattern): @[
-//           @{
-//             (id<NSCopying>)(CHHapticPatternKeyEvent): @{
-//               (id<NSCopying>)(CHHapticPatternKeyEventType):CHHapticEventTypeHapticContinuous,
-//               (id<NSCopying>)(CHHapticPatternKeyTime):@0.0,
-//               (id<NSCopying>)(CHHapticPatternKeyEventDuration):[NSNumber numberWithDouble:duration],
-//               (id<NSCopying>)(CHHapticPatternKeyEventParameters):@[
-//                 @{
-//                   (id<NSCopying>)(CHHapticPatternKeyParameterID): CHHapticEventParameterIDHapticIntensity,
-//                   (id<NSCopying>)(CHHapticPatternKeyParameterValue): [NSNumber numberWithDouble:intensity],
-//                 },
-//               ],
-//             },
-//           },
-//         ],
+//         (id<NSCopying>)(CHHapticPatternKeyPattern): events,
 //       };
 //
 //       NSError* error = nil;
@@ -101,9 +104,32 @@ package vibrate
 // }
 //
 // static void vibrate(double duration, double intensity) {
-//   dispatch_async(dispatch_get_main_queue(), ^{
-//     vibrateOnMainThread(duration, intensity);
-//   });
+//   if (@available(iOS 13.0, *)) {
+//     NSArray* events = @[hapticEvent(0, duration, intensity)];
+//     dispatch_async(dispatch_get_main_queue(), ^{
+//       playHapticEventsOnMainThread(events);
+//     });
+//   }
+// }
+//
+// static void vibratePattern(double* durations, double* intensities, int n) {
+//   if (@available(iOS 13.0, *)) {
+//     NSMutableArray* events = [NSMutableArray arrayWithCapacity:n];
+//     double time = 0;
+//     for (int i = 0; i < n; i++) {
+//       // A step with zero intensity is a pause.
+//       if (intensities[i] > 0) {
+//         [events addObject:hapticEvent(time, durations[i], intensities[i])];
+//       }
+//       time += durations[i];
+//     }
+//     if (events.count == 0) {
+//       return;
+//     }
+//     dispatch_async(dispatch_get_main_queue(), ^{
+//       playHapticEventsOnMainThread(events);
+//     });
+//   }
 // }
 import "C"
 
@@ -116,3 +142,18 @@ func Vibrate(duration time.Duration, magnitude float64) {
 		C.vibrate(C.double(float64(duration)/float64(time.Second)), C.double(magnitude))
 	}()
 }
+
+func VibratePattern(durations []time.Duration, magnitudes []float64) {
+	if len(durations) == 0 {
+		return
+	}
+	ds := make([]C.double, len(durations))
+	ms := make([]C.double, len(durations))
+	for i, d := range durations {
+		ds[i] = C.double(float64(max(d, 0)) / float64(time.Second))
+		ms[i] = C.double(min(max(magnitudes[i], 0), 1))
+	}
+	go func() {
+		C.vibratePattern(&ds[0], &ms[0], C.int(len(ds)))
+	}()
+}
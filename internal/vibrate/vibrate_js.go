@@ -26,3 +26,22 @@ func Vibrate(duration time.Duration, magnitude float64) {
 		js.Global().Get("navigator").Call("vibrate", float64(duration/time.Millisecond))
 	}
 }
+
+func VibratePattern(durations []time.Duration, magnitudes []float64) {
+	// magnitudes are ignored except for distinguishing vibrations and pauses.
+
+	if !js.Global().Get("navigator").Get("vibrate").Truthy() {
+		return
+	}
+
+	// navigator.vibrate takes alternating durations of vibrations and pauses, starting with a vibration.
+	ds := onOffDurations(durations, magnitudes)
+	if ds[0] == 0 {
+		ds = ds[1:]
+	}
+	pattern := make([]any, len(ds))
+	for i, d := range ds {
+		pattern[i] = float64(d / time.Millisecond)
+	}
+	js.Global().Get("navigator").Call("vibrate", pattern)
+}
@@ -23,3 +23,7 @@ import (
 func Vibrate(duration time.Duration, magnitude float64) {
 	// Do nothing.
 }
+
+func VibratePattern(durations []time.Duration, magnitudes []float64) {
+	// Do nothing.
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vibrate
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestOnOffDurations(t *testing.T) {
+	const ms = time.Millisecond
+	testCases := []struct {
+		Durations  []time.Duration
+		Magnitudes []float64
+		Want       []time.Duration
+	}{
+		{
+			Durations:  nil,
+			Magnitudes: nil,
+			Want:       []time.Duration{0},
+		},
+		{
+			Durations:  []time.Duration{100 * ms},
+			Magnitudes: []float64{1},
+			Want:       []time.Duration{0, 100 * ms},
+		},
+		{
+			Durations:  []time.Duration{50 * ms, 100 * ms, 30 * ms, 100 * ms},
+			Magnitudes: []float64{0, 1, 0, 0.5},
+			Want:       []time.Duration{50 * ms, 100 * ms, 30 * ms, 100 * ms},
+		},
+		{
+			// Adjacent steps of the same kind are merged, and empty steps are ignored.
+			Durations:  []time.Duration{100 * ms, 0, 100 * ms, 20 * ms, 30 * ms, 40 * ms},
+			Magnitudes: []float64{1, 0, 0.5, 0, 0, 1},
+			Want:       []time.Duration{0, 200 * ms, 50 * ms, 40 * ms},
+		},
+	}
+	for _, tc := range testCases {
+		got := onOffDurations(tc.Durations, tc.Magnitudes)
+		if !slices.Equal(got, tc.Want) {
+			t.Errorf("onOffDurations(%v, %v): got: %v, want: %v", tc.Durations, tc.Magnitudes, got, tc.Want)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten
+
+import (
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/hook"
+)
+
+// LifecycleEvent represents an application lifecycle event.
+type LifecycleEvent int
+
+const (
+	// LifecycleEventBackground is notified when the application goes to the background.
+	// This is notified before the audio is suspended.
+	LifecycleEventBackground = LifecycleEvent(hook.LifecycleEventBackground)
+
+	// LifecycleEventForeground is notified when the application comes back to the foreground.
+	// This is notified after the audio is resumed.
+	LifecycleEventForeground = LifecycleEvent(hook.LifecycleEventForeground)
+
+	// LifecycleEventLowMemory is notified when the system is running low on memory.
+	// Ebitengine drops its internal caches like text glyph caches at this event.
+	// You should also release your own caches that can be recreated.
+	LifecycleEventLowMemory = LifecycleEvent(hook.LifecycleEventLowMemory)
+
+	// LifecycleEventGraphicsContextLost is notified when the graphics context is lost.
+	// After this event, the contents of the images on GPU are no longer reliable.
+	//
+	// Without RunGameOptions.StrictContextRestoration, the application is terminated on Android,
+	// and the page is reloaded on browsers after this event.
+	// You should save your application's state at this event if needed.
+	LifecycleEventGraphicsContextLost = LifecycleEvent(hook.LifecycleEventGraphicsContextLost)
+
+	// LifecycleEventGraphicsContextRestored is notified when the graphics context is restored after the context lost.
+	// This is notified only on Android and browsers with RunGameOptions.StrictContextRestoration.
+	//
+	// At this event, all the images and shaders are already re-created by Ebitengine:
+	// the pixels given by WritePixels and NewImageFromImage are re-uploaded, and the drawing history is replayed.
+	// You need to recreate only the resources Ebitengine doesn't know, e.g., external GPU resources.
+	//
+	// Unlike the other events, this is notified on the same goroutine as Update and Draw, before Update is called.
+	LifecycleEventGraphicsContextRestored = LifecycleEvent(hook.LifecycleEventGraphicsContextRestored)
+)
+
+var (
+	theLifecycleCallback  func(event LifecycleEvent)
+	theLifecycleCallbackM sync.Mutex
+)
+
+func init() {
+	hook.AppendHookOnLifecycleEvent(func(event hook.LifecycleEvent) {
+		theLifecycleCallbackM.Lock()
+		f := theLifecycleCallback
+		theLifecycleCallbackM.Unlock()
+		if f != nil {
+			f(LifecycleEvent(event))
+		}
+	})
+}
+
+// SetLifecycleCallback sets a callback function called when an application lifecycle event happens.
+// A nil callback removes the current callback.
+//
+// callback is called synchronously from a different goroutine than Update and Draw, e.g., the platform's UI thread.
+// callback must be concurrent-safe with Update and Draw, and should return quickly.
+// While the application is in the background, Update and Draw are not called.
+//
+// Lifecycle events are notified only on Android and iOS so far,
+// except for LifecycleEventGraphicsContextLost, which is also notified on browsers.
+// On Android, LifecycleEventLowMemory is notified at onLowMemory and onTrimMemory with a level of
+// TRIM_MEMORY_RUNNING_LOW or higher except for TRIM_MEMORY_UI_HIDDEN.
+// On iOS, LifecycleEventLowMemory is notified at didReceiveMemoryWarning.
+//
+// SetLifecycleCallback is concurrent-safe.
+func SetLifecycleCallback(callback func(event LifecycleEvent)) {
+	theLifecycleCallbackM.Lock()
+	defer theLifecycleCallbackM.Unlock()
+	theLifecycleCallback = callback
+}
@@ -0,0 +1,232 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/graphics"
+)
+
+// Material is a set of a shader and its parameters, i.e., uniform variables, source images and samplers.
+//
+// A Material can be reused across draw calls.
+// The uniform values are converted into the internal representation only when they are changed,
+// while DrawTrianglesShader and DrawRectShader convert a uniform map at every call.
+// Material is useful e.g. when many objects share the same parameters, like in a scene graph sorted by materials.
+//
+// Material is not concurrent-safe.
+type Material struct {
+	shader   *Shader
+	uniforms map[string]any
+	images   [graphics.ShaderSrcImageCount]*Image
+	samplers [graphics.ShaderSrcImageCount]ShaderImageSampler
+
+	uniformDwords      []uint32
+	uniformDwordsDirty bool
+}
+
+// NewMaterial creates a new material with the given shader.
+//
+// If shader is nil, NewMaterial panics.
+func NewMaterial(shader *Shader) *Material {
+	if shader == nil {
+		panic("ebiten: shader must not be nil at NewMaterial")
+	}
+	return &Material{
+		shader:             shader,
+		uniforms:           map[string]any{},
+		uniformDwordsDirty: true,
+	}
+}
+
+// Shader returns the material's shader.
+func (m *Material) Shader() *Shader {
+	return m.shader
+}
+
+// Uniform returns the value of the uniform variable specified by name.
+// If the value is not set, Uniform returns nil.
+func (m *Material) Uniform(name string) any {
+	return m.uniforms[name]
+}
+
+// SetUniform sets the value of the uniform variable specified by name.
+//
+// The value must be a numeric type, or a slice or an array of a numeric type.
+// See DrawTrianglesShaderOptions.Uniforms for the details.
+// If the value is nil, the uniform variable is reset and is treated as zero values.
+//
+// If a slice given as a value is modified after SetUniform, call SetUniform again to reflect the change.
+//
+// If the value's length or type doesn't match with the uniform variable, the drawing function with the material panics.
+func (m *Material) SetUniform(name string, value any) {
+	if value == nil {
+		delete(m.uniforms, name)
+	} else {
+		m.uniforms[name] = value
+	}
+	m.uniformDwordsDirty = true
+}
+
+// Image returns the source image at the given index.
+//
+// If index is out of range, Image panics.
+func (m *Material) Image(index int) *Image {
+	if index < 0 || index >= len(m.images) {
+		panic(fmt.Sprintf("ebiten: index out of range: %d", index))
+	}
+	return m.images[index]
+}
+
+// SetImage sets the source image at the given index.
+// This corresponds to DrawTrianglesShaderOptions.Images[index].
+//
+// If index is out of range, SetImage panics.
+func (m *Material) SetImage(index int, image *Image) {
+	if index < 0 || index >= len(m.images) {
+		panic(fmt.Sprintf("ebiten: index out of range: %d", index))
+	}
+	m.images[index] = image
+}
+
+// Sampler returns the sampler for the source image at the given index.
+//
+// If index is out of range, Sampler panics.
+func (m *Material) Sampler(index int) ShaderImageSampler {
+	if index < 0 || index >= len(m.samplers) {
+		panic(fmt.Sprintf("ebiten: index out of range: %d", index))
+	}
+	return m.samplers[index]
+}
+
+// SetSampler sets the sampler for the source image at the given index.
+// This corresponds to DrawTrianglesShaderOptions.Samplers[index].
+//
+// If index is out of range, SetSampler panics.
+func (m *Material) SetSampler(index int, sampler ShaderImageSampler) {
+	if index < 0 || index >= len(m.samplers) {
+		panic(fmt.Sprintf("ebiten: index out of range: %d", index))
+	}
+	m.samplers[index] = sampler
+}
+
+func (m *Material) uniformValues() []uint32 {
+	if !m.uniformDwordsDirty {
+		return m.uniformDwords
+	}
+	m.uniformDwords = m.shader.appendUniforms(m.uniformDwords[:0], m.uniforms)
+	m.uniformDwordsDirty = false
+	return m.uniformDwords
+}
+
+// DrawTrianglesMaterialOptions represents options for DrawTrianglesMaterial.
+type DrawTrianglesMaterialOptions struct {
+	// Blend is a blending way of the source color and the destination color.
+	// The default (zero) value is the regular alpha blending.
+	Blend Blend
+
+	// FillRule indicates the rule how an overlapped region is rendered.
+	// See DrawTrianglesShaderOptions.FillRule for the details.
+	//
+	// The default (zero) value is FillRuleFillAll.
+	FillRule FillRule
+
+	// AntiAlias indicates whether the rendering uses anti-alias or not.
+	// See DrawTrianglesShaderOptions.AntiAlias for the details.
+	//
+	// The default (zero) value is false.
+	AntiAlias bool
+}
+
+// DrawTrianglesMaterial draws triangles with the specified vertices and their indices with the specified material.
+//
+// DrawTrianglesMaterial works like DrawTrianglesShader32 with the material's shader, uniform variables, images and samplers.
+// The conditions to panic are the same as DrawTrianglesShader32.
+//
+// When the image i is disposed, DrawTrianglesMaterial does nothing.
+func (i *Image) DrawTrianglesMaterial(vertices []Vertex, indices []uint32, material *Material, options *DrawTrianglesMaterialOptions) {
+	i.copyCheck()
+
+	if i.isDisposed() {
+		return
+	}
+
+	if material.shader.isDisposed() {
+		theValidator.report("ebiten: the given material's shader to DrawTrianglesMaterial must not be disposed")
+		return
+	}
+
+	if theValidator.isStrict() && material.uniformDwordsDirty {
+		if err := material.shader.ValidateUniforms(material.uniforms); err != nil {
+			theValidator.report(fmt.Sprintf("ebiten: invalid uniforms at DrawTrianglesMaterial: %v", err))
+			return
+		}
+	}
+
+	if options == nil {
+		options = &DrawTrianglesMaterialOptions{}
+	}
+
+	i.drawTrianglesShader(vertices, indices, material.shader, &material.images, &material.samplers, material.uniformValues(), options.Blend.internalBlend(), options.FillRule, options.AntiAlias, "DrawTrianglesMaterial")
+}
+
+// DrawRectMaterialOptions represents options for DrawRectMaterial.
+type DrawRectMaterialOptions struct {
+	// GeoM is a geometry matrix to draw.
+	// The default (zero) value is identity, which draws the rectangle at (0, 0).
+	GeoM GeoM
+
+	// ColorScale is a scale of color.
+	// This scaling values are passed to the `color vec4` argument of the Fragment function in a Kage program.
+	// The default (zero) value is identity, which is (1, 1, 1, 1).
+	ColorScale ColorScale
+
+	// Blend is a blending way of the source color and the destination color.
+	// The default (zero) value is the regular alpha blending.
+	Blend Blend
+}
+
+// DrawRectMaterial draws a rectangle with the specified width and height with the specified material.
+//
+// DrawRectMaterial works like DrawRectShader with the material's shader, uniform variables, images and samplers.
+// The conditions to panic are the same as DrawRectShader.
+//
+// When the image i is disposed, DrawRectMaterial does nothing.
+func (i *Image) DrawRectMaterial(width, height int, material *Material, options *DrawRectMaterialOptions) {
+	i.copyCheck()
+
+	if i.isDisposed() {
+		return
+	}
+
+	if material.shader.isDisposed() {
+		theValidator.report("ebiten: the given material's shader to DrawRectMaterial must not be disposed")
+		return
+	}
+
+	if theValidator.isStrict() && material.uniformDwordsDirty {
+		if err := material.shader.ValidateUniforms(material.uniforms); err != nil {
+			theValidator.report(fmt.Sprintf("ebiten: invalid uniforms at DrawRectMaterial: %v", err))
+			return
+		}
+	}
+
+	if options == nil {
+		options = &DrawRectMaterialOptions{}
+	}
+
+	i.drawRectShader(width, height, material.shader, &material.images, &material.samplers, material.uniformValues(), options.GeoM, options.ColorScale, options.Blend, options.Blend.internalBlend(), "DrawRectMaterial")
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build android || ios
+
+package ebitenmobileview
+
+import (
+	"github.com/hajimehoshi/ebiten/v2/internal/ui"
+)
+
+// Announcer sends a text to the screen reader on the native side.
+type Announcer interface {
+	ui.Announcer
+}
+
+func SetAnnouncer(announcer Announcer) {
+	ui.Get().SetAnnouncer(announcer)
+}
+
+// SetAccessibilityPreferences sets the system's accessibility preferences.
+//
+// textScaleFactor is the scale factor of text sizes the user prefers. 1 is the default size.
+func SetAccessibilityPreferences(reducedMotion, highContrast bool, textScaleFactor float64) {
+	ui.Get().SetAccessibilityPreferences(reducedMotion, highContrast, textScaleFactor)
+}
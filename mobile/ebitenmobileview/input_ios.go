@@ -16,8 +16,10 @@ package ebitenmobileview
 
 import (
 	"fmt"
+	"sync"
 	"unicode"
 
+	"github.com/hajimehoshi/ebiten/v2/internal/hook"
 	"github.com/hajimehoshi/ebiten/v2/internal/ui"
 )
 
@@ -29,6 +31,15 @@ import "C"
 
 var ptrToID = map[int64]int{}
 
+var (
+	// inputM protects the input states, which are updated on the main thread and on the game thread.
+	inputM sync.Mutex
+
+	// tappedKeys is the ticks when the keys were tapped, keyed by the key codes.
+	tappedKeys     = map[int]int64{}
+	tappedKeysOnce sync.Once
+)
+
 func getIDFromPtr(ptr int64) int {
 	if id, ok := ptrToID[ptr]; ok {
 		return id
@@ -45,6 +56,9 @@ func getIDFromPtr(ptr int64) int {
 }
 
 func UpdateTouchesOnIOS(phase int, ptr int64, x, y int) {
+	inputM.Lock()
+	defer inputM.Unlock()
+
 	switch phase {
 	case C.UITouchPhaseBegan, C.UITouchPhaseMoved, C.UITouchPhaseStationary:
 		id := getIDFromPtr(ptr)
@@ -61,6 +75,13 @@ func UpdateTouchesOnIOS(phase int, ptr int64, x, y int) {
 }
 
 func UpdatePressesOnIOS(phase int, keyCode int, keyString string) {
+	inputM.Lock()
+	defer inputM.Unlock()
+
+	updatePresses(phase, keyCode, keyString)
+}
+
+func updatePresses(phase int, keyCode int, keyString string) {
 	switch phase {
 	case C.UITouchPhaseBegan, C.UITouchPhaseMoved, C.UITouchPhaseStationary:
 		if key, ok := iosKeyToUIKey[keyCode]; ok {
@@ -85,3 +106,38 @@ func UpdatePressesOnIOS(phase int, keyCode int, keyString string) {
 		panic(fmt.Sprintf("ebitenmobileview: invalid phase: %d", phase))
 	}
 }
+
+// TapKeyOnIOS presses the key, and releases it on the next tick.
+//
+// TapKeyOnIOS is for keys from the virtual keyboard, which doesn't notify the press and the release separately.
+// If the key were released at the same time, a game polling the key state once per tick might miss it.
+func TapKeyOnIOS(keyCode int) {
+	tappedKeysOnce.Do(func() {
+		hook.AppendHookOnBeforeUpdate(func() error {
+			releaseTappedKeys()
+			return nil
+		})
+	})
+
+	inputM.Lock()
+	defer inputM.Unlock()
+
+	updatePresses(C.UITouchPhaseBegan, keyCode, "")
+	tappedKeys[keyCode] = ui.Get().Tick()
+}
+
+func releaseTappedKeys() {
+	inputM.Lock()
+	defer inputM.Unlock()
+
+	// The input state is read before the hooks are run. If the tick has advanced since the tap,
+	// at least one tick has already read the pressed state.
+	tick := ui.Get().Tick()
+	for keyCode, t := range tappedKeys {
+		if tick <= t {
+			continue
+		}
+		delete(tappedKeys, keyCode)
+		updatePresses(C.UITouchPhaseEnded, keyCode, "")
+	}
+}
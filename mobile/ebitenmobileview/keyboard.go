@@ -0,0 +1,53 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build android || ios
+
+package ebitenmobileview
+
+import (
+	"unicode"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/ui"
+)
+
+// VirtualKeyboard shows or hides the on-screen virtual keyboard on the native side.
+type VirtualKeyboard interface {
+	ui.VirtualKeyboard
+}
+
+func SetVirtualKeyboard(virtualKeyboard VirtualKeyboard) {
+	ui.Get().SetVirtualKeyboard(virtualKeyboard)
+}
+
+// SetVirtualKeyboardHeight sets the height of the area covered by the virtual keyboard at the bottom of the view
+// in device-independent pixels.
+func SetVirtualKeyboardHeight(height float64) {
+	ui.Get().SetVirtualKeyboardHeight(height)
+}
+
+// InsertText inserts a text from the virtual keyboard.
+func InsertText(text string) {
+	var runes []rune
+	for _, r := range text {
+		if !unicode.IsPrint(r) {
+			continue
+		}
+		runes = append(runes, r)
+	}
+	if len(runes) == 0 {
+		return
+	}
+	updateInput(runes)
+}
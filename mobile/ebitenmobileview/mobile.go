@@ -30,6 +30,7 @@ import (
 	"sync"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/internal/hook"
 	"github.com/hajimehoshi/ebiten/v2/internal/restorable"
 	"github.com/hajimehoshi/ebiten/v2/internal/ui"
 )
@@ -115,6 +116,17 @@ func Resume() error {
 
 func OnContextLost() {
 	restorable.OnContextLost()
+	hook.RunLifecycleEventHooks(hook.LifecycleEventGraphicsContextLost)
+}
+
+// OnLowMemory is called when the system is running low on memory.
+func OnLowMemory() {
+	hook.RunLifecycleEventHooks(hook.LifecycleEventLowMemory)
+}
+
+// SetRefreshRate sets the display's refresh rate in Hz.
+func SetRefreshRate(rate float64) {
+	ui.Get().SetRefreshRate(rate)
 }
 
 func DeviceScale() float64 {
@@ -136,3 +148,8 @@ func SetSetGameNotifier(setGameNotifier SetGameNotifier) {
 func UsesStrictContextRestoration() bool {
 	return ui.Get().UsesStrictContextRestoration()
 }
+
+// SetSafeAreaInsets sets the safe area insets and the display corner radius in device-independent pixels.
+func SetSafeAreaInsets(left, top, right, bottom float64, cornerRadius float64) {
+	ui.Get().SetSafeAreaInsets(left, top, right, bottom, cornerRadius)
+}
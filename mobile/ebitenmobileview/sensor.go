@@ -0,0 +1,58 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build android || ios
+
+package ebitenmobileview
+
+import (
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/sensor"
+)
+
+// SensorManager enables or disables a device motion sensor on the native side.
+//
+// sensorType is one of the sensor types in internal/sensor.
+type SensorManager interface {
+	SetSensorEnabled(sensorType int, enabled bool, intervalInMicroseconds int)
+}
+
+type sensorDriver struct {
+	manager SensorManager
+}
+
+func (s *sensorDriver) SetSensorEnabled(sensorType sensor.Type, enabled bool, interval time.Duration) {
+	s.manager.SetSensorEnabled(int(sensorType), enabled, int(interval/time.Microsecond))
+}
+
+func SetSensorManager(manager SensorManager) {
+	if manager == nil {
+		sensor.SetDriver(nil)
+		return
+	}
+	sensor.SetDriver(&sensorDriver{
+		manager: manager,
+	})
+}
+
+// UpdateSensor updates a sensor value.
+// The value must be in the unit and the coordinate system of internal/sensor.
+func UpdateSensor(sensorType int, x, y, z float64) {
+	sensor.Update(sensor.Type(sensorType), sensor.Value{
+		X: x,
+		Y: y,
+		Z: z,
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mobile
+
+import (
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/sensor"
+)
+
+// SensorType represents a type of a device motion sensor.
+type SensorType int
+
+const (
+	// SensorTypeAccelerometer measures the acceleration including the gravity in m/s^2.
+	SensorTypeAccelerometer SensorType = SensorType(sensor.Accelerometer)
+
+	// SensorTypeGyroscope measures the rotation rate in rad/s.
+	SensorTypeGyroscope SensorType = SensorType(sensor.Gyroscope)
+
+	// SensorTypeMagnetometer measures the ambient magnetic field in μT.
+	// This can be used as a compass.
+	SensorTypeMagnetometer SensorType = SensorType(sensor.Magnetometer)
+)
+
+// EnableSensor starts the given sensor with the given sampling interval.
+//
+// If interval is not positive, the default interval (1/60 seconds) is used.
+// interval is a hint, and the actual interval might be different.
+//
+// The values are in the device's coordinate system in its natural orientation:
+// X points to the right, Y points up, and Z points toward the outside of the screen's front face.
+// For example, the accelerometer reports about (0, 0, 9.8) when the device lies flat on a table.
+// Note that the coordinate system doesn't follow the screen orientation.
+//
+// EnableSensor works on Android, iOS, and browsers.
+// On browsers, the Generic Sensor API is used if available, and DeviceMotionEvent is used otherwise.
+// With DeviceMotionEvent, interval is ignored and SensorTypeMagnetometer is not available.
+// On iOS Safari, DeviceMotionEvent.requestPermission must be called in a user gesture before enabling a sensor.
+//
+// EnableSensor does nothing on the other environments.
+//
+// EnableSensor is concurrent-safe.
+func EnableSensor(sensorType SensorType, interval time.Duration) {
+	sensor.Enable(sensor.Type(sensorType), interval)
+}
+
+// DisableSensor stops the given sensor.
+//
+// It is recommended to disable sensors when they are not used to save the battery.
+//
+// DisableSensor is concurrent-safe.
+func DisableSensor(sensorType SensorType) {
+	sensor.Disable(sensor.Type(sensorType))
+}
+
+// SensorValue returns the latest value of the given sensor.
+//
+// SensorValue returns false when the sensor is disabled, the sensor is not available, or no value is reported yet.
+//
+// SensorValue is concurrent-safe.
+func SensorValue(sensorType SensorType) (x, y, z float64, ok bool) {
+	v, ok := sensor.Read(sensor.Type(sensorType))
+	if !ok {
+		return 0, 0, 0, false
+	}
+	return v.X, v.Y, v.Z, true
+}
@@ -50,6 +50,38 @@ func (m *MonitorType) Size() (int, int) {
 	return (*ui.Monitor)(m).Size()
 }
 
+// RefreshRate returns the refresh rate of the monitor in Hz.
+// RefreshRate returns 0 if the refresh rate is unknown.
+//
+// The refresh rate can change while the game is running, e.g., when the window moves to another monitor, or when
+// the system changes the refresh rate for power saving. Check RefreshRate regularly if your game depends on it.
+//
+// On browsers, the refresh rate is estimated from the intervals of the frames, and RefreshRate returns 0 until
+// the estimation is available.
+//
+// On mobiles, RefreshRate returns 0 before the game starts e.g. in init functions.
+//
+// See also SyncWithRefreshRate.
+func (m *MonitorType) RefreshRate() float64 {
+	return (*ui.Monitor)(m).RefreshRate()
+}
+
+// IsDeviceScaleFactorJustChanged reports whether the device scale factor used for the rendering changed
+// just before the current tick, e.g., when the window moves to a monitor with a different device scale factor,
+// or when the system's scale setting changes.
+//
+// IsDeviceScaleFactorJustChanged returns true only in the first Update after the change.
+// The new device scale factor is available by Monitor().DeviceScaleFactor().
+// This is useful to rebuild caches depending on the device scale factor, like text glyph caches for
+// pixel-perfect rendering, at the right moment.
+//
+// Layout is also called with the new outside size in device-independent pixels before Update.
+//
+// IsDeviceScaleFactorJustChanged is concurrent-safe, but is meaningful only in Update.
+func IsDeviceScaleFactorJustChanged() bool {
+	return ui.Get().IsDeviceScaleFactorJustChanged()
+}
+
 // Monitor returns the current monitor.
 func Monitor() *MonitorType {
 	m := ui.Get().Monitor()
@@ -16,6 +16,7 @@ package ebiten
 
 import (
 	"errors"
+	"fmt"
 	"image"
 	"image/color"
 	"io/fs"
@@ -176,6 +177,44 @@ func IsScreenClearedEveryFrame() bool {
 	return ui.Get().IsScreenClearedEveryFrame()
 }
 
+// SetScreenDamageTrackingEnabled enables or disables the damage tracking mode.
+//
+// In the damage tracking mode, Ebitengine regards the screen as changed only when a damaged region is declared
+// by AddScreenDamage, instead of detecting modifications of the screen image.
+// If no region is declared, presenting the frame is skipped, which reduces CPU/GPU usages and battery consumption
+// for mostly-static applications like tools and board games.
+// Draw is still called every frame.
+//
+// The damage tracking mode is useful especially with SetScreenClearedEveryFrame(false),
+// where the game can redraw only the damaged regions of the screen.
+//
+// The default value is false.
+//
+// SetScreenDamageTrackingEnabled is concurrent-safe.
+func SetScreenDamageTrackingEnabled(enabled bool) {
+	ui.Get().SetScreenDamageTrackingEnabled(enabled)
+}
+
+// IsScreenDamageTrackingEnabled returns true if the damage tracking mode is enabled.
+//
+// IsScreenDamageTrackingEnabled is concurrent-safe.
+func IsScreenDamageTrackingEnabled() bool {
+	return ui.Get().IsScreenDamageTrackingEnabled()
+}
+
+// AddScreenDamage declares that the given region of the screen is changed.
+//
+// region is in the screen coordinate given at Draw, and a region out of the screen is ignored.
+// The declared regions are accumulated until the next frame is drawn.
+//
+// AddScreenDamage is meaningful only when the damage tracking mode is enabled. See SetScreenDamageTrackingEnabled.
+// Note that the whole screen is presented even if only a part of the screen is damaged.
+//
+// AddScreenDamage is concurrent-safe.
+func AddScreenDamage(region image.Rectangle) {
+	ui.Get().AddScreenDamage(region)
+}
+
 // SetScreenFilterEnabled enables/disables the use of the "screen" filter Ebitengine uses.
 //
 // The "screen" filter is a box filter from game to display resolution.
@@ -299,6 +338,56 @@ type RunGameOptions struct {
 
 	// X11InstanceName is an instance name in the ICCCM WM_CLASS window property.
 	X11InstanceName string
+
+	// StrictContextRestoration indicates whether the graphics context lost should be restored strictly by Ebitengine or not.
+	//
+	// StrictContextRestoration is available only on Android and browsers with WebGL. Otherwise, StrictContextRestoration is ignored.
+	// On Android, StrictContextRestoration should be used with mobile.SetGameWithOptions, rather than RunGameWithOptions.
+	//
+	// In Android, Ebitengine uses `GLSurfaceView`'s `setPreserveEGLContextOnPause(true)`.
+	// This works in most cases, but it is still possible that the context is lost in some minor cases.
+	// In browsers, a WebGL context can be lost e.g. when the GPU is reset or too many contexts are created.
+	//
+	// When StrictContextRestoration is true, Ebitengine keeps the pixels given by WritePixels and NewImageFromImage,
+	// and the drawing history of all the images, and re-creates all the images and shaders automatically
+	// when the context is lost.
+	// LifecycleEventGraphicsContextRestored is notified after the restoration.
+	// However, this might cause a performance issue since Ebitengine tries to keep all the information
+	// to restore the context.
+	//
+	// When StrictContextRestoration is false, Ebitengine does nothing special to restore the context and
+	// the application is terminated on Android, or the page is reloaded on browsers, when the context is lost.
+	// LifecycleEventGraphicsContextLost is notified before the termination.
+	//
+	// The default (zero) value is false.
+	StrictContextRestoration bool
+
+	// ValidationErrorHandler is called with the errors of invalid drawing operations.
+	//
+	// If ValidationErrorHandler is non-nil, the strict validation mode is enabled.
+	// In the strict validation mode, invalid drawing operations like
+	// drawing a disposed image, drawing an image to itself, specifying indices out of range of vertices,
+	// and specifying uniform variables that don't match with the shader,
+	// don't panic but are just skipped.
+	// Instead, the errors with the callers' locations are joined and passed to ValidationErrorHandler once a frame,
+	// after the game's Draw is called.
+	// Unknown uniform variable names are also treated as errors in the strict validation mode.
+	//
+	// The strict validation mode is useful for debugging, but might affect performance.
+	//
+	// ValidationErrorHandler is called on the same goroutine as the game's Draw.
+	//
+	// The default (zero) value is nil, which means that invalid drawing operations panic.
+	ValidationErrorHandler func(err error)
+
+	// TicksDroppedHandler is called with the number of the dropped ticks when the game's Update falls behind
+	// and some ticks are dropped.
+	// See SetCatchUpPolicy for when ticks are dropped.
+	//
+	// TicksDroppedHandler is called on the same goroutine as the game's Update, just before the next Update.
+	//
+	// The default (zero) value is nil.
+	TicksDroppedHandler func(ticks int)
 }
 
 // RunGameWithOptions starts the main loop and runs the game with the specified options.
@@ -337,12 +426,21 @@ type RunGameOptions struct {
 func RunGameWithOptions(game Game, options *RunGameOptions) error {
 	defer isRunGameEnded_.Store(true)
 
+	setGameLoopRunning(true)
+	defer setGameLoopRunning(false)
+
 	initializeWindowPositionIfNeeded(WindowSize())
 
 	op := toUIRunOptions(options)
+	if options != nil {
+		theValidator.setHandler(options.ValidationErrorHandler)
+	}
 	// This is necessary to change the result of IsScreenTransparent.
 	screenTransparent.Store(op.ScreenTransparent)
 	g := newGameForUI(game, op.ScreenTransparent)
+	if options != nil {
+		g.ticksDroppedHandler = options.TicksDroppedHandler
+	}
 
 	if err := ui.Get().Run(g, op); err != nil {
 		if errors.Is(err, Termination) {
@@ -354,6 +452,38 @@ func RunGameWithOptions(game Game, options *RunGameOptions) error {
 	return nil
 }
 
+// RunGameWithoutRendering runs the game's Update at TPS without any window or graphics context.
+// game's Draw and Layout functions are never called.
+//
+// RunGameWithoutRendering is useful for dedicated servers and replays, which want to reuse the game's Update code
+// without rendering.
+// RunGameWithoutRendering works even without a display, e.g., on a Linux server without an X server.
+// Hooks before Update, e.g. ones to update the states of inpututil and audio, work as RunGame does.
+// Functions scheduled by ScheduleBeforeUpdate and RunOnMainThread are also called.
+// As there is no window, all the input states are empty, e.g. no keys are pressed.
+//
+// TPS works as RunGame does, except that SyncWithFPS is treated as the default TPS, as there are no frames.
+// Tick and ActualTPS also work.
+//
+// game's Update must not use any graphics functions like NewImage and DrawImage.
+// Functions related to windows, monitors, and inputs are not available either.
+//
+// RunGameWithoutRendering returns error when Update returns an error.
+// If Update returns Termination, RunGameWithoutRendering returns nil.
+//
+// Don't call RunGameWithoutRendering with RunGame or RunGameWithOptions, or twice or more in one process.
+func RunGameWithoutRendering(game Game) error {
+	defer isRunGameEnded_.Store(true)
+
+	setGameLoopRunning(true)
+	defer setGameLoopRunning(false)
+
+	return ui.Get().RunWithoutRendering(func() error {
+		runScheduledFuncs()
+		return game.Update()
+	})
+}
+
 func isRunGameEnded() bool {
 	return isRunGameEnded_.Load()
 }
@@ -411,6 +541,95 @@ func SetCursorMode(mode CursorModeType) {
 	ui.Get().SetCursorMode(ui.CursorMode(mode))
 }
 
+// CursorBounds returns the bounds to confine the mouse cursor set by SetCursorBounds.
+//
+// CursorBounds is concurrent-safe.
+func CursorBounds() image.Rectangle {
+	return ui.Get().CursorBounds()
+}
+
+// SetCursorBounds confines the mouse cursor to the given bounds.
+//
+// bounds is in the same coordinate as CursorPosition.
+// To confine the cursor to the whole game screen, specify the rectangle from (0, 0) to the screen size returned by Layout.
+// This is useful e.g. for a strategy game scrolling its view at the screen edges in the windowed mode,
+// so that the cursor doesn't move to another monitor.
+//
+// If bounds is empty, the cursor is not confined. The default value is an empty rectangle.
+//
+// Unlike CursorModeCaptured, the cursor is still visible and moves as usual within the bounds.
+// The cursor is confined only while the window is focused, and a cursor going out of the bounds is moved back in the next tick.
+// When the cursor mode is CursorModeCaptured, SetCursorBounds doesn't take any effect.
+//
+// SetCursorBounds does nothing on browsers and mobiles.
+//
+// SetCursorBounds is concurrent-safe.
+func SetCursorBounds(bounds image.Rectangle) {
+	ui.Get().SetCursorBounds(bounds)
+}
+
+// SafeAreaInsets returns the insets of the safe area in device-independent pixels.
+//
+// The safe area is the area of the game screen that is not covered by a display cutout (e.g. a camera notch),
+// rounded corners, or system UIs (e.g. a status bar or a home indicator).
+// The insets are in the same unit as the outside size given to Layout, and are relative to the edges of the outside size.
+// You can use the insets to place HUD elements in the safe area.
+//
+// On Android, the insets include the display cutout and the system bars.
+// On iOS, the insets are the view's safeAreaInsets.
+// On browsers, the insets are the CSS safe-area-inset-* environment variables. These are non-zero only when
+// the page's viewport meta tag has 'viewport-fit=cover'.
+// On the other environments, SafeAreaInsets returns zeros.
+//
+// SafeAreaInsets is concurrent-safe.
+func SafeAreaInsets() (left, top, right, bottom float64) {
+	return ui.Get().SafeAreaInsets()
+}
+
+// DisplayCornerRadius returns the largest radius of the display's rounded corners in device-independent pixels.
+//
+// DisplayCornerRadius works only on Android 12 (API level 31) or newer so far.
+// On the other environments, DisplayCornerRadius returns 0.
+//
+// DisplayCornerRadius is concurrent-safe.
+func DisplayCornerRadius() float64 {
+	return ui.Get().DisplayCornerRadius()
+}
+
+// RequestPointerLock requests to capture the cursor, i.e., to set CursorModeCaptured, and calls callback with the result.
+// callback can be nil.
+//
+// On browsers, capturing a cursor requires a user gesture. If there is no user gesture just before the call,
+// the request is deferred until the next user gesture on the game screen, e.g., a mouse click, a key press, or a touch.
+// callback is called with a non-nil error when the browser rejects the request.
+// callback is called in the game's goroutine before a later Update.
+//
+// On desktops, the cursor is captured immediately, and callback is called before RequestPointerLock returns.
+//
+// On other environments, callback is called with a non-nil error before RequestPointerLock returns.
+//
+// RequestPointerLock should be called from Update.
+func RequestPointerLock(callback func(err error)) {
+	ui.Get().RequestPointerLock(callback)
+}
+
+// RequestFullscreen requests to enter fullscreen, i.e., SetFullscreen(true), and calls callback with the result.
+// callback can be nil.
+//
+// On browsers, entering fullscreen requires a user gesture. If there is no user gesture just before the call,
+// the request is deferred until the next user gesture on the game screen, e.g., a mouse click, a key press, or a touch.
+// callback is called with a non-nil error when the browser rejects the request.
+// callback is called in the game's goroutine before a later Update.
+//
+// On desktops, the window enters fullscreen immediately, and callback is called before RequestFullscreen returns.
+//
+// On other environments, callback is called with a non-nil error before RequestFullscreen returns.
+//
+// RequestFullscreen should be called from Update.
+func RequestFullscreen(callback func(err error)) {
+	ui.Get().RequestFullscreen(callback)
+}
+
 // IsFullscreen reports whether the current mode is fullscreen or not.
 //
 // IsFullscreen always returns false on mobiles.
@@ -577,6 +796,8 @@ func ScheduleFrame() {
 
 // TPS returns the current maximum TPS.
 //
+// If SyncWithFPS or SyncWithRefreshRate is set at SetTPS, TPS returns the value as it is.
+//
 // TPS is concurrent-safe.
 func TPS() int {
 	return clock.TPS()
@@ -599,6 +820,21 @@ func ActualTPS() float64 {
 	return clock.ActualTPS()
 }
 
+// InterpolationAlpha returns how far the current frame is between the last tick and the next tick, in [0, 1).
+//
+// InterpolationAlpha is the elapsed time since the last Update divided by the tick length (1 / TPS).
+// When the render FPS is higher than TPS, you can use InterpolationAlpha in Draw to interpolate positions
+// between the previous and the current states updated in Update, without measuring the wall time by yourself:
+//
+//	x := prevX + (currX-prevX)*ebiten.InterpolationAlpha()
+//
+// If TPS is SyncWithFPS, InterpolationAlpha always returns 0.
+//
+// InterpolationAlpha is concurrent-safe.
+func InterpolationAlpha() float64 {
+	return clock.InterpolationAlpha()
+}
+
 // CurrentTPS returns the current TPS (ticks per second),
 // that represents how many times Update function is called in a second.
 //
@@ -610,6 +846,15 @@ func CurrentTPS() float64 {
 // SyncWithFPS is a special TPS value that means TPS syncs with FPS.
 const SyncWithFPS = clock.SyncWithFPS
 
+// SyncWithRefreshRate is a special TPS value that means TPS follows the current monitor's refresh rate.
+//
+// Unlike SyncWithFPS, the game is updated at a fixed rate that is the refresh rate, e.g. 120 times a second on
+// a 120Hz display. If rendering is delayed, Update might be called multiple times in a frame to catch up.
+// Use the monitor's RefreshRate to know the duration of a tick.
+//
+// If the refresh rate is unknown, 60 is used as TPS.
+const SyncWithRefreshRate = clock.SyncWithRefreshRate
+
 // UncappedTPS is a special TPS value that means TPS syncs with FPS.
 //
 // Deprecated: as of v2.2. Use SyncWithFPS instead.
@@ -620,13 +865,81 @@ const UncappedTPS = SyncWithFPS
 // The initial value is 60.
 //
 // If tps is SyncWithFPS, TPS is uncapped and the game is updated per frame.
-// If tps is negative but not SyncWithFPS, SetTPS panics.
+// If tps is SyncWithRefreshRate, TPS follows the current monitor's refresh rate.
+// If tps is negative but not SyncWithFPS or SyncWithRefreshRate, SetTPS panics.
 //
 // SetTPS is concurrent-safe.
 func SetTPS(tps int) {
 	clock.SetTPS(tps)
 }
 
+// CatchUpPolicyType represents what happens when the game's Update falls behind.
+type CatchUpPolicyType int
+
+const (
+	// CatchUpPolicySlowDown indicates that the ticks exceeding the maximum catch-up ticks in a frame are dropped.
+	// The game slows down relative to the real time, but each frame is drawn after a limited number of Update calls.
+	// This prefers smoothness.
+	//
+	// CatchUpPolicySlowDown is the default policy.
+	CatchUpPolicySlowDown CatchUpPolicyType = CatchUpPolicyType(clock.CatchUpPolicySlowDown)
+
+	// CatchUpPolicySkipFrames indicates that the ticks exceeding the maximum catch-up ticks in a frame are
+	// carried over to the next frames.
+	// Update is called more times per frame, i.e., frames are skipped, until the game catches up with the real time.
+	// The number of Update calls is kept consistent with the elapsed time, which prefers determinism.
+	//
+	// Even with CatchUpPolicySkipFrames, the game time is synchronized with the real time without calling Update
+	// if the game falls behind by more than one second, e.g., when the application is suspended.
+	// These skipped ticks are not notified as dropped ticks.
+	CatchUpPolicySkipFrames CatchUpPolicyType = CatchUpPolicyType(clock.CatchUpPolicySkipFrames)
+)
+
+// CatchUpPolicy returns the current catch-up policy.
+//
+// CatchUpPolicy is concurrent-safe.
+func CatchUpPolicy() CatchUpPolicyType {
+	return CatchUpPolicyType(clock.CatchUpPolicy())
+}
+
+// SetCatchUpPolicy sets the policy for when the game's Update falls behind.
+// The default policy is CatchUpPolicySlowDown.
+//
+// The dropped ticks are notified by RunGameOptions.TicksDroppedHandler.
+// With CatchUpPolicySlowDown, ticks are dropped when the game falls behind by more than MaxCatchUpTicks,
+// or by more than 5 ticks if MaxCatchUpTicks is 0.
+// A delay of more than one second, e.g., by suspending the application, is not regarded as dropped ticks.
+// If TPS is SyncWithFPS, no ticks are dropped.
+//
+// SetCatchUpPolicy is concurrent-safe.
+func SetCatchUpPolicy(policy CatchUpPolicyType) {
+	clock.SetCatchUpPolicy(clock.CatchUpPolicyType(policy))
+}
+
+// MaxCatchUpTicks returns the maximum number of Update calls in one frame to catch up.
+//
+// MaxCatchUpTicks is concurrent-safe.
+func MaxCatchUpTicks() int {
+	return clock.MaxCatchUpTicks()
+}
+
+// SetMaxCatchUpTicks sets the maximum number of Update calls in one frame to catch up.
+// The exceeding ticks are handled based on the catch-up policy. See SetCatchUpPolicy.
+//
+// If ticks is 0, the number is not limited explicitly, and the game is regarded as falling behind
+// when the delay is more than 5 ticks with CatchUpPolicySlowDown.
+// The default value is 0.
+//
+// SetMaxCatchUpTicks panics if ticks is negative.
+//
+// SetMaxCatchUpTicks is concurrent-safe.
+func SetMaxCatchUpTicks(ticks int) {
+	if ticks < 0 {
+		panic(fmt.Sprintf("ebiten: ticks must be non-negative at SetMaxCatchUpTicks but %d", ticks))
+	}
+	clock.SetMaxCatchUpTicks(ticks)
+}
+
 // SetMaxTPS sets the maximum TPS (ticks per second),
 // that represents how many times updating function is called per second.
 //
@@ -700,38 +1013,17 @@ func toUIRunOptions(options *RunGameOptions) *ui.RunOptions {
 		options.X11InstanceName = defaultX11InstanceName
 	}
 
-	// ui.RunOptions.StrictContextRestoration is not used so far (#3098).
-	// This might be reused in the future.
-	// The original comment for StrictContextRestration is as follows:
-	//
-	// StrictContextRestration indicates whether the context lost should be restored strictly by Ebitengine or not.
-	//
-	// StrictContextRestration is available only on Android. Otherwise, StrictContextRestration is ignored.
-	// Thus, StrictContextRestration should be used with mobile.SetGameWithOptions, rather than RunGameWithOptions.
-	//
-	// In Android, Ebitengien uses `GLSurfaceView`'s `setPreserveEGLContextOnPause(true)`.
-	// This works in most cases, but it is still possible that the context is lost in some minor cases.
-	//
-	// When StrictContextRestration is true, Ebitengine tries to restore the context more strictly
-	// for such minor cases.
-	// However, this might cause a performance issue since Ebitengine tries to keep all the information
-	// to restore the context.
-	//
-	// When StrictContextRestration is false, Ebitengine does nothing special to restore the context and
-	// relies on the OS's behavior.
-	//
-	// The default (zero) value is false.
-
 	return &ui.RunOptions{
-		GraphicsLibrary:   ui.GraphicsLibrary(options.GraphicsLibrary),
-		InitUnfocused:     options.InitUnfocused,
-		ScreenTransparent: options.ScreenTransparent,
-		SkipTaskbar:       options.SkipTaskbar,
-		SingleThread:      options.SingleThread,
-		DisableHiDPI:      options.DisableHiDPI,
-		ColorSpace:        graphicsdriver.ColorSpace(options.ColorSpace),
-		X11ClassName:      options.X11ClassName,
-		X11InstanceName:   options.X11InstanceName,
+		GraphicsLibrary:          ui.GraphicsLibrary(options.GraphicsLibrary),
+		InitUnfocused:            options.InitUnfocused,
+		ScreenTransparent:        options.ScreenTransparent,
+		SkipTaskbar:              options.SkipTaskbar,
+		SingleThread:             options.SingleThread,
+		DisableHiDPI:             options.DisableHiDPI,
+		ColorSpace:               graphicsdriver.ColorSpace(options.ColorSpace),
+		X11ClassName:             options.X11ClassName,
+		X11InstanceName:          options.X11InstanceName,
+		StrictContextRestoration: options.StrictContextRestoration,
 	}
 }
 
@@ -745,6 +1037,50 @@ func DroppedFiles() fs.FS {
 	return theInputState.droppedFiles()
 }
 
+// SetGamePaused sets whether the game is paused or not.
+//
+// While the game is paused, the game's Update is not called except for the ticks requested by StepGame,
+// but the game's Draw is still called every frame.
+// This is useful e.g. for frame-by-frame debugging tools and test harnesses that control the game's progress.
+//
+// When the game is resumed, the pending steps requested by StepGame are discarded.
+//
+// The game is not paused by default.
+//
+// SetGamePaused is concurrent-safe.
+func SetGamePaused(paused bool) {
+	ui.Get().SetGamePaused(paused)
+}
+
+// IsGamePaused reports whether the game is paused by SetGamePaused.
+//
+// IsGamePaused is concurrent-safe.
+func IsGamePaused() bool {
+	return ui.Get().IsGamePaused()
+}
+
+// StepGame requests to advance the paused game by the given number of ticks.
+//
+// The requested ticks are processed at the next frame, i.e., the game's Update is called ticks times before the next Draw.
+// At most 60 ticks are processed in one frame, and the rest are carried over to the following frames
+// so that a large number of ticks doesn't block the game loop.
+// Calling StepGame multiple times before the next frame accumulates the ticks.
+// The tick count returned by Tick is incremented by the number of the processed ticks.
+//
+// StepGame doesn't run the game loop by itself. The ticks are processed by the game loop of RunGame,
+// so StepGame cannot be used to drive the game from another event loop.
+//
+// StepGame works only while the game is paused by SetGamePaused. Otherwise, StepGame does nothing.
+// If ticks is not positive, StepGame panics.
+//
+// StepGame is concurrent-safe.
+func StepGame(ticks int) {
+	if ticks <= 0 {
+		panic(fmt.Sprintf("ebiten: ticks must be positive at StepGame but %d", ticks))
+	}
+	ui.Get().StepGame(ticks)
+}
+
 // Tick returns the current tick count.
 // The tick count starts with 0 and is incremented by one on every Update call.
 //
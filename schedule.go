@@ -0,0 +1,101 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten
+
+import (
+	"sync"
+)
+
+var (
+	theScheduledFuncs  []func()
+	theScheduledFuncsM sync.Mutex
+
+	// theGameLoopRunning reports whether the game loop is running and the scheduled functions will be called.
+	theGameLoopRunning bool
+)
+
+// ScheduleBeforeUpdate schedules f to be called before the next game's Update, on the same goroutine as Update.
+//
+// ScheduleBeforeUpdate is useful to pass data from other goroutines, e.g. network callbacks, to the game
+// without hand-rolled channels and mutexes. f can access the game's states safely as Update does.
+//
+// The scheduled functions are called in the order they are scheduled, before Update of the next tick.
+// If a function is scheduled while the scheduled functions are being called, the function is called
+// before Update of the tick after the next tick.
+// While Update is not called, e.g. when the application is in the background, the scheduled functions are not called either.
+//
+// ScheduleBeforeUpdate doesn't wait for f to be called.
+//
+// ScheduleBeforeUpdate is concurrent-safe.
+func ScheduleBeforeUpdate(f func()) {
+	theScheduledFuncsM.Lock()
+	defer theScheduledFuncsM.Unlock()
+	theScheduledFuncs = append(theScheduledFuncs, f)
+}
+
+// RunOnMainThread calls f on the same goroutine as the game's Update, and waits for f to finish.
+// In this function, the main thread means the goroutine where Update and Draw are called,
+// which is not necessarily the OS's main thread.
+//
+// f is called before the next Update in the same order as ScheduleBeforeUpdate.
+//
+// If the game loop is not running, i.e., before RunGame is called or after RunGame returns,
+// RunOnMainThread calls f directly on the current goroutine.
+// If RunGame returns while RunOnMainThread is waiting, f is called on the goroutine where RunGame was called.
+//
+// RunOnMainThread must not be called from Update, Draw, or the functions scheduled by ScheduleBeforeUpdate.
+// Otherwise, RunOnMainThread never returns.
+// RunOnMainThread blocks while Update is not called, e.g. when the application is in the background.
+//
+// RunOnMainThread is concurrent-safe.
+func RunOnMainThread(f func()) {
+	theScheduledFuncsM.Lock()
+	if !theGameLoopRunning {
+		theScheduledFuncsM.Unlock()
+		f()
+		return
+	}
+	ch := make(chan struct{})
+	theScheduledFuncs = append(theScheduledFuncs, func() {
+		defer close(ch)
+		f()
+	})
+	theScheduledFuncsM.Unlock()
+	<-ch
+}
+
+// setGameLoopRunning sets whether the game loop is running.
+// When the game loop ends, the remaining scheduled functions are called so that RunOnMainThread doesn't block forever.
+func setGameLoopRunning(running bool) {
+	theScheduledFuncsM.Lock()
+	theGameLoopRunning = running
+	theScheduledFuncsM.Unlock()
+
+	if !running {
+		runScheduledFuncs()
+	}
+}
+
+// runScheduledFuncs calls the functions scheduled by ScheduleBeforeUpdate.
+func runScheduledFuncs() {
+	theScheduledFuncsM.Lock()
+	fs := theScheduledFuncs
+	theScheduledFuncs = nil
+	theScheduledFuncsM.Unlock()
+
+	for _, f := range fs {
+		f()
+	}
+}
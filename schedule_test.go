@@ -0,0 +1,122 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type scheduleTestGame struct {
+	update func() error
+}
+
+func (g *scheduleTestGame) Update() error {
+	return g.update()
+}
+
+func (g *scheduleTestGame) Draw(screen *ebiten.Image) {
+}
+
+func (g *scheduleTestGame) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return outsideWidth, outsideHeight
+}
+
+// Tests run in Update of the test game loop. Simulate a new game loop by toggling the running state.
+func restoreGameLoopRunning(t *testing.T) {
+	running := ebiten.IsGameLoopRunning()
+	t.Cleanup(func() {
+		ebiten.SetGameLoopRunning(running)
+	})
+}
+
+func TestScheduleBeforeUpdateBeforeRunGame(t *testing.T) {
+	restoreGameLoopRunning(t)
+	ebiten.SetGameLoopRunning(false)
+
+	var events []string
+	ebiten.ScheduleBeforeUpdate(func() {
+		events = append(events, "1")
+	})
+	ebiten.ScheduleBeforeUpdate(func() {
+		events = append(events, "2")
+	})
+	ebiten.ScheduleBeforeUpdate(func() {
+		events = append(events, "3")
+	})
+	if len(events) != 0 {
+		t.Errorf("the scheduled functions must not be called before the game loop starts: %v", events)
+	}
+
+	// The game loop starts.
+	ebiten.SetGameLoopRunning(true)
+	g := &scheduleTestGame{
+		update: func() error {
+			events = append(events, "update")
+			return nil
+		},
+	}
+	if err := ebiten.UpdateForTesting(g); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := events, []string{"1", "2", "3", "update"}; !slices.Equal(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+
+	// The functions are called only once.
+	events = nil
+	if err := ebiten.UpdateForTesting(g); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := events, []string{"update"}; !slices.Equal(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
+
+func TestScheduleWhenGameLoopStops(t *testing.T) {
+	restoreGameLoopRunning(t)
+	ebiten.SetGameLoopRunning(true)
+
+	// The remaining scheduled functions are called when the game loop stops.
+	var called bool
+	ebiten.ScheduleBeforeUpdate(func() {
+		called = true
+	})
+	ebiten.SetGameLoopRunning(false)
+	if !called {
+		t.Errorf("the scheduled function must be called when the game loop stops")
+	}
+}
+
+func TestRunOnMainThreadAfterGameLoopStops(t *testing.T) {
+	restoreGameLoopRunning(t)
+	ebiten.SetGameLoopRunning(false)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 3 {
+			ebiten.RunOnMainThread(func() {})
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("RunOnMainThread must not block after the game loop stops")
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"sync"
+)
+
+type screenColorAdjustment struct {
+	gamma      float64
+	brightness float64
+	contrast   float64
+
+	lutDirty bool
+	lut      *Image
+	adjusted *Image
+
+	m sync.Mutex
+}
+
+var theScreenColorAdjustment = screenColorAdjustment{
+	gamma:    1,
+	contrast: 1,
+}
+
+// SetScreenGamma sets the gamma value applied to the final screen.
+//
+// A value greater than 1 brightens mid-tones, and a value less than 1 darkens them.
+// The default value is 1, which means no adjustment.
+//
+// SetScreenGamma panics if gamma is not positive or not finite.
+//
+// The adjustment is applied by Ebitengine's final rendering pass, and the hardware gamma ramp is not used.
+// The adjustment is applied even if the game implements FinalScreenDrawer.
+//
+// SetScreenGamma is concurrent-safe, but takes effect only at the next Draw call.
+func SetScreenGamma(gamma float64) {
+	if gamma <= 0 || math.IsInf(gamma, 0) || math.IsNaN(gamma) {
+		panic(fmt.Sprintf("ebiten: gamma must be positive and finite but %f", gamma))
+	}
+	theScreenColorAdjustment.set(func(a *screenColorAdjustment) {
+		a.gamma = gamma
+	})
+}
+
+// ScreenGamma returns the current gamma value applied to the final screen.
+//
+// ScreenGamma is concurrent-safe.
+func ScreenGamma() float64 {
+	a := &theScreenColorAdjustment
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.gamma
+}
+
+// SetScreenBrightness sets the brightness offset applied to the final screen.
+//
+// brightness is added to each color component in [0, 1] after the gamma and the contrast are applied.
+// The range of brightness is [-1, 1], and a value out of the range is clamped.
+// The default value is 0, which means no adjustment.
+//
+// SetScreenBrightness is concurrent-safe, but takes effect only at the next Draw call.
+func SetScreenBrightness(brightness float64) {
+	if math.IsNaN(brightness) {
+		panic("ebiten: brightness must not be NaN")
+	}
+	brightness = min(max(brightness, -1), 1)
+	theScreenColorAdjustment.set(func(a *screenColorAdjustment) {
+		a.brightness = brightness
+	})
+}
+
+// ScreenBrightness returns the current brightness offset applied to the final screen.
+//
+// ScreenBrightness is concurrent-safe.
+func ScreenBrightness() float64 {
+	a := &theScreenColorAdjustment
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.brightness
+}
+
+// SetScreenContrast sets the contrast factor applied to the final screen.
+//
+// Each color component is scaled around the middle gray 0.5 by contrast.
+// The default value is 1, which means no adjustment.
+//
+// SetScreenContrast panics if contrast is negative or not finite.
+//
+// SetScreenContrast is concurrent-safe, but takes effect only at the next Draw call.
+func SetScreenContrast(contrast float64) {
+	if contrast < 0 || math.IsInf(contrast, 0) || math.IsNaN(contrast) {
+		panic(fmt.Sprintf("ebiten: contrast must be non-negative and finite but %f", contrast))
+	}
+	theScreenColorAdjustment.set(func(a *screenColorAdjustment) {
+		a.contrast = contrast
+	})
+}
+
+// ScreenContrast returns the current contrast factor applied to the final screen.
+//
+// ScreenContrast is concurrent-safe.
+func ScreenContrast() float64 {
+	a := &theScreenColorAdjustment
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.contrast
+}
+
+func (a *screenColorAdjustment) set(f func(a *screenColorAdjustment)) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	f(a)
+	a.lutDirty = true
+}
+
+func (a *screenColorAdjustment) isIdentity() bool {
+	return a.gamma == 1 && a.brightness == 0 && a.contrast == 1
+}
+
+// screenColorLUT returns the lookup table mapping an 8-bit color component to an adjusted one.
+func screenColorLUT(gamma, brightness, contrast float64) [256]byte {
+	var lut [256]byte
+	for i := range lut {
+		v := math.Pow(float64(i)/255, 1/gamma)
+		v = (v-0.5)*contrast + 0.5 + brightness
+		v = min(max(v, 0), 1)
+		lut[i] = byte(math.Round(v * 255))
+	}
+	return lut
+}
+
+// apply returns the offscreen image with the color adjustment applied.
+// If no adjustment is needed, apply returns offscreen as it is.
+//
+// apply must be called from the game's goroutine.
+func (a *screenColorAdjustment) apply(offscreen *Image) *Image {
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	if a.isIdentity() {
+		if a.adjusted != nil {
+			a.adjusted.Deallocate()
+			a.adjusted = nil
+		}
+		return offscreen
+	}
+
+	if a.lut == nil {
+		a.lut = NewImage(256, 1)
+		a.lutDirty = true
+	}
+	if a.lutDirty {
+		lut := screenColorLUT(a.gamma, a.brightness, a.contrast)
+		pix := make([]byte, 4*len(lut))
+		for i, v := range lut {
+			pix[4*i] = v
+			pix[4*i+1] = v
+			pix[4*i+2] = v
+			pix[4*i+3] = 0xff
+		}
+		a.lut.WritePixels(pix)
+		a.lutDirty = false
+	}
+
+	b := offscreen.Bounds()
+	if a.adjusted == nil || a.adjusted.Bounds().Size() != b.Size() {
+		if a.adjusted != nil {
+			a.adjusted.Deallocate()
+		}
+		// Use an unmanaged image for the same reason as the offscreen (#1938).
+		a.adjusted = NewImageWithOptions(image.Rect(0, 0, b.Dx(), b.Dy()), &NewImageOptions{
+			Unmanaged: true,
+		})
+	}
+
+	op := &DrawRectShaderOptions{}
+	op.Images[0] = offscreen
+	op.Images[1] = a.lut
+	op.Blend = BlendCopy
+	a.adjusted.DrawRectShader(b.Dx(), b.Dy(), ensureScreenColorShader(), op)
+	return a.adjusted
+}
+
+var (
+	screenColorShader     *Shader
+	screenColorShaderOnce sync.Once
+)
+
+func ensureScreenColorShader() *Shader {
+	screenColorShaderOnce.Do(func() {
+		s, err := NewShader([]byte(`//kage:unit pixels
+
+package main
+
+func lookUp(v float) float {
+	x := clamp(v, 0, 1) * 255
+	i := floor(x)
+	o := imageSrc1Origin()
+	c0 := imageSrc1UnsafeAt(o + vec2(i+0.5, 0.5)).r
+	c1 := imageSrc1UnsafeAt(o + vec2(min(i+1, 255)+0.5, 0.5)).r
+	return mix(c0, c1, x-i)
+}
+
+func Fragment(dstPos vec4, srcPos vec2, color vec4) vec4 {
+	c := imageSrc0UnsafeAt(srcPos)
+	if c.a == 0 {
+		return vec4(0)
+	}
+	rgb := c.rgb / c.a
+	return vec4(lookUp(rgb.r), lookUp(rgb.g), lookUp(rgb.b), 1) * c.a
+}
+`))
+		if err != nil {
+			panic("ebiten: compiling the screen color shader failed: " + err.Error())
+		}
+		screenColorShader = s
+	})
+	return screenColorShader
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten_test
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestScreenColorLUT(t *testing.T) {
+	lut := ebiten.ScreenColorLUT(1, 0, 1)
+	for i, v := range lut {
+		if got, want := int(v), i; got != want {
+			t.Errorf("identity: lut[%d]: got: %d, want: %d", i, got, want)
+		}
+	}
+
+	lut = ebiten.ScreenColorLUT(2.2, 0, 1)
+	if got, want := lut[0], byte(0); got != want {
+		t.Errorf("gamma: lut[0]: got: %d, want: %d", got, want)
+	}
+	if got, want := lut[255], byte(255); got != want {
+		t.Errorf("gamma: lut[255]: got: %d, want: %d", got, want)
+	}
+	if lut[128] <= 128 {
+		t.Errorf("gamma: lut[128]: got: %d, want: > 128", lut[128])
+	}
+
+	lut = ebiten.ScreenColorLUT(1, 0.5, 1)
+	if got, want := lut[0], byte(128); got != want {
+		t.Errorf("brightness: lut[0]: got: %d, want: %d", got, want)
+	}
+	if got, want := lut[255], byte(255); got != want {
+		t.Errorf("brightness: lut[255]: got: %d, want: %d", got, want)
+	}
+
+	lut = ebiten.ScreenColorLUT(1, 0, 0)
+	for i, v := range lut {
+		if got, want := v, byte(128); got != want {
+			t.Errorf("contrast: lut[%d]: got: %d, want: %d", i, got, want)
+		}
+	}
+}
@@ -15,6 +15,7 @@
 package ebiten
 
 import (
+	"bytes"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -31,6 +32,13 @@ import (
 type Shader struct {
 	shader *ui.Shader
 	unit   shaderir.Unit
+
+	src  []byte
+	name string
+
+	// variants is the shaders compiled with non-default samplers.
+	variants  map[[graphics.ShaderSrcImageCount]graphics.ShaderSampler]*ui.Shader
+	variantsM sync.Mutex
 }
 
 // NewShader compiles a shader program in the shading language Kage, and returns the result.
@@ -50,6 +58,8 @@ func newShader(src []byte, name string) (*Shader, error) {
 	return &Shader{
 		shader: ui.NewShader(ir, name),
 		unit:   ir.Unit,
+		src:    bytes.Clone(src),
+		name:   name,
 	}, nil
 }
 
@@ -60,6 +70,13 @@ func newShader(src []byte, name string) (*Shader, error) {
 func (s *Shader) Dispose() {
 	s.shader.Deallocate()
 	s.shader = nil
+
+	s.variantsM.Lock()
+	defer s.variantsM.Unlock()
+	for _, v := range s.variants {
+		v.Deallocate()
+	}
+	s.variants = nil
 }
 
 func (s *Shader) isDisposed() bool {
@@ -80,12 +97,89 @@ func (s *Shader) Deallocate() {
 		return
 	}
 	s.shader.Deallocate()
+
+	s.variantsM.Lock()
+	defer s.variantsM.Unlock()
+	for _, v := range s.variants {
+		v.Deallocate()
+	}
 }
 
 func (s *Shader) appendUniforms(dst []uint32, uniforms map[string]any) []uint32 {
 	return s.shader.AppendUniforms(dst, uniforms)
 }
 
+// shaderForSamplers returns the internal shader for the given samplers.
+//
+// The sampling path of imageSrcNAt is generated only for non-default samplers.
+// A shader variant is compiled for each set of samplers at the first use, and the default samplers use the original shader.
+// The uniform variables are the same among the variants.
+func (s *Shader) shaderForSamplers(samplers *[graphics.ShaderSrcImageCount]ShaderImageSampler) *ui.Shader {
+	var key [graphics.ShaderSrcImageCount]graphics.ShaderSampler
+	for i, sampler := range samplers {
+		key[i] = sampler.internalSampler()
+	}
+	if key == ([graphics.ShaderSrcImageCount]graphics.ShaderSampler{}) {
+		return s.shader
+	}
+
+	s.variantsM.Lock()
+	defer s.variantsM.Unlock()
+
+	if v, ok := s.variants[key]; ok {
+		return v
+	}
+	// The source was already compiled successfully at NewShader, so an error is unexpected here.
+	ir, err := graphics.CompileShaderWithSamplers(s.src, &key)
+	if err != nil {
+		panic(fmt.Sprintf("ebiten: compiling a shader with samplers failed: %v", err))
+	}
+	v := ui.NewShader(ir, s.name)
+	if s.variants == nil {
+		s.variants = map[[graphics.ShaderSrcImageCount]graphics.ShaderSampler]*ui.Shader{}
+	}
+	s.variants[key] = v
+	return v
+}
+
+// ShaderImageSampler represents how a source image is sampled by imageSrcNAt in a Kage program.
+//
+// A sampler doesn't affect imageSrcNUnsafeAt.
+type ShaderImageSampler struct {
+	// Filter is a filter to sample the image.
+	// Filter must be FilterNearest or FilterLinear. Otherwise, the drawing function panics.
+	//
+	// The default (zero) value is FilterNearest.
+	Filter Filter
+
+	// Address is an address mode for a position out of the image.
+	// AddressUnsafe is treated as AddressClampToZero, i.e., imageSrcNAt returns 0 for a position out of the image.
+	//
+	// The default (zero) value is AddressUnsafe.
+	Address Address
+}
+
+func (s ShaderImageSampler) internalSampler() graphics.ShaderSampler {
+	var sampler graphics.ShaderSampler
+	switch s.Filter {
+	case FilterNearest:
+		sampler.Filter = graphics.ShaderSamplerFilterNearest
+	case FilterLinear:
+		sampler.Filter = graphics.ShaderSamplerFilterLinear
+	default:
+		panic(fmt.Sprintf("ebiten: invalid filter for a shader image sampler: %d", s.Filter))
+	}
+	switch s.Address {
+	case AddressUnsafe, AddressClampToZero:
+		sampler.Address = graphics.ShaderSamplerAddressClampToZero
+	case AddressRepeat:
+		sampler.Address = graphics.ShaderSamplerAddressRepeat
+	default:
+		panic(fmt.Sprintf("ebiten: invalid address for a shader image sampler: %d", s.Address))
+	}
+	return sampler
+}
+
 var (
 	builtinShadersForRead atomic.Pointer[[builtinshader.FilterCount][builtinshader.AddressCount][2]*Shader]
 	builtinShadersM       sync.Mutex
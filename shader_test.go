@@ -19,6 +19,7 @@ import (
 	"image"
 	"image/color"
 	"math"
+	"slices"
 	"testing"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -2860,6 +2861,175 @@ func Fragment(dstPos vec4, srcPos vec2, color vec4) vec4 {
 	}
 }
 
+func TestShaderSwitch(t *testing.T) {
+	const w, h = 4, 1
+
+	dst := ebiten.NewImage(w, h)
+	s, err := ebiten.NewShader([]byte(`//kage:unit pixels
+
+package main
+
+func Fragment(dstPos vec4, srcPos vec2, color vec4) vec4 {
+	switch int(dstPos.x) {
+	case 0:
+		return vec4(1, 0, 0, 1)
+	case 1, 2:
+		return vec4(0, 1, 0, 1)
+	default:
+		return vec4(0, 0, 1, 1)
+	}
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dst.DrawRectShader(w, h, s, nil)
+
+	wants := [w]color.RGBA{
+		{R: 0xff, A: 0xff},
+		{G: 0xff, A: 0xff},
+		{G: 0xff, A: 0xff},
+		{B: 0xff, A: 0xff},
+	}
+	for i := 0; i < w; i++ {
+		got := dst.At(i, 0).(color.RGBA)
+		want := wants[i]
+		if !sameColors(got, want, 2) {
+			t.Errorf("dst.At(%d, 0): got: %v, want: %v", i, got, want)
+		}
+	}
+}
+
+func TestShaderImageSamplers(t *testing.T) {
+	const w, h = 4, 1
+
+	src := ebiten.NewImage(w, h)
+	src.WritePixels([]byte{
+		0xff, 0, 0, 0xff,
+		0xff, 0, 0, 0xff,
+		0, 0xff, 0, 0xff,
+		0, 0xff, 0, 0xff,
+	})
+
+	s, err := ebiten.NewShader([]byte(`//kage:unit pixels
+
+package main
+
+var Offset float
+
+func Fragment(dstPos vec4, srcPos vec2, color vec4) vec4 {
+	return imageSrc0At(srcPos + vec2(Offset, 0))
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	red := color.RGBA{R: 0xff, A: 0xff}
+	green := color.RGBA{G: 0xff, A: 0xff}
+	yellow := color.RGBA{R: 0x80, G: 0x80, A: 0xff}
+
+	testCases := []struct {
+		Name    string
+		Offset  float32
+		Sampler ebiten.ShaderImageSampler
+		Want    [w]color.RGBA
+	}{
+		{
+			Name:   "default",
+			Offset: 2,
+			Want:   [w]color.RGBA{green, green, {}, {}},
+		},
+		{
+			Name:   "repeat",
+			Offset: 2,
+			Sampler: ebiten.ShaderImageSampler{
+				Address: ebiten.AddressRepeat,
+			},
+			Want: [w]color.RGBA{green, green, red, red},
+		},
+		{
+			Name:   "linear",
+			Offset: 0.5,
+			Sampler: ebiten.ShaderImageSampler{
+				Filter: ebiten.FilterLinear,
+			},
+			Want: [w]color.RGBA{red, yellow, green, {R: 0, G: 0x80, B: 0, A: 0x80}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dst := ebiten.NewImage(w, h)
+			op := &ebiten.DrawRectShaderOptions{}
+			op.Images[0] = src
+			op.Samplers[0] = tc.Sampler
+			op.Uniforms = map[string]any{
+				"Offset": tc.Offset,
+			}
+			dst.DrawRectShader(w, h, s, op)
+			for i := 0; i < w; i++ {
+				got := dst.At(i, 0).(color.RGBA)
+				want := tc.Want[i]
+				if !sameColors(got, want, 2) {
+					t.Errorf("dst.At(%d, 0): got: %v, want: %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkShaderImageSamplers(b *testing.B) {
+	const w, h = 256, 256
+
+	src := ebiten.NewImage(w, h)
+	dst := ebiten.NewImage(w, h)
+	s, err := ebiten.NewShader([]byte(`//kage:unit pixels
+
+package main
+
+func Fragment(dstPos vec4, srcPos vec2, color vec4) vec4 {
+	return imageSrc0At(srcPos)
+}
+`))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name    string
+		Sampler ebiten.ShaderImageSampler
+	}{
+		{
+			Name: "default",
+		},
+		{
+			Name: "repeat",
+			Sampler: ebiten.ShaderImageSampler{
+				Address: ebiten.AddressRepeat,
+			},
+		},
+		{
+			Name: "linear",
+			Sampler: ebiten.ShaderImageSampler{
+				Filter: ebiten.FilterLinear,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		b.Run(tc.Name, func(b *testing.B) {
+			op := &ebiten.DrawRectShaderOptions{}
+			op.Images[0] = src
+			op.Samplers[0] = tc.Sampler
+			for i := 0; i < b.N; i++ {
+				dst.DrawRectShader(w, h, s, op)
+				// Flush the commands and wait for the GPU so that the cost of the sampling is included.
+				_ = dst.At(0, 0)
+			}
+		})
+	}
+}
+
 func BenchmarkBuiltinShader(b *testing.B) {
 	// Create a shader to cache the shader compilation result.
 	_ = ebiten.BuiltinShader(builtinshader.FilterNearest, builtinshader.AddressUnsafe, false)
@@ -2867,3 +3037,103 @@ func BenchmarkBuiltinShader(b *testing.B) {
 		_ = ebiten.BuiltinShader(builtinshader.FilterNearest, builtinshader.AddressUnsafe, false)
 	}
 }
+
+func TestShaderUniforms(t *testing.T) {
+	s, err := ebiten.NewShader([]byte(`//kage:unit pixels
+
+package main
+
+var Time float
+var Cursor vec2
+var Colors [2]vec4
+var Count int
+
+func Fragment(dstPos vec4, srcPos vec2, color vec4) vec4 {
+	return vec4(Time, Cursor.x, Colors[0].x, float(Count))
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := s.Uniforms()
+	want := []ebiten.ShaderUniform{
+		{Name: "Time", Type: ebiten.ShaderUniformTypeFloat},
+		{Name: "Cursor", Type: ebiten.ShaderUniformTypeVec2},
+		{Name: "Colors", Type: ebiten.ShaderUniformTypeVec4, ArrayLength: 2},
+		{Name: "Count", Type: ebiten.ShaderUniformTypeInt},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+
+	if err := s.ValidateUniforms(map[string]any{
+		"Time":   float32(1),
+		"Cursor": []float32{1, 2},
+		"Colors": [8]float32{},
+		"Count":  3,
+	}); err != nil {
+		t.Errorf("ValidateUniforms must succeed but failed: %v", err)
+	}
+
+	for _, uniforms := range []map[string]any{
+		{"time": float32(1)},
+		{"Cursor": float32(1)},
+		{"Colors": []float32{1, 2, 3, 4}},
+		{"Count": float32(1.5)},
+		{"Time": "1"},
+		{"Time": nil},
+	} {
+		if err := s.ValidateUniforms(uniforms); err == nil {
+			t.Errorf("ValidateUniforms(%v) must fail but succeeded", uniforms)
+		}
+	}
+}
+
+func TestMaterial(t *testing.T) {
+	const w, h = 16, 16
+
+	s, err := ebiten.NewShader([]byte(`//kage:unit pixels
+
+package main
+
+var Color vec4
+
+func Fragment(dstPos vec4, srcPos vec2, color vec4) vec4 {
+	return Color + imageSrc0At(srcPos)
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := ebiten.NewImage(w, h)
+	src.Fill(color.RGBA{B: 0xff, A: 0xff})
+
+	m := ebiten.NewMaterial(s)
+	m.SetUniform("Color", []float32{1, 0, 0, 0})
+	m.SetImage(0, src)
+
+	dst := ebiten.NewImage(w, h)
+	dst.DrawRectMaterial(w, h, m, &ebiten.DrawRectMaterialOptions{
+		Blend: ebiten.BlendCopy,
+	})
+	if got, want := dst.At(0, 0).(color.RGBA), (color.RGBA{R: 0xff, B: 0xff, A: 0xff}); !sameColors(got, want, 2) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+
+	// Changing a uniform value must be reflected.
+	m.SetUniform("Color", []float32{0, 1, 0, 0})
+	vs := []ebiten.Vertex{
+		{DstX: 0, DstY: 0, SrcX: 0, SrcY: 0},
+		{DstX: w, DstY: 0, SrcX: w, SrcY: 0},
+		{DstX: 0, DstY: h, SrcX: 0, SrcY: h},
+		{DstX: w, DstY: h, SrcX: w, SrcY: h},
+	}
+	dst.DrawTrianglesMaterial(vs, []uint32{0, 1, 2, 1, 2, 3}, m, &ebiten.DrawTrianglesMaterialOptions{
+		Blend: ebiten.BlendCopy,
+	})
+	if got, want := dst.At(0, 0).(color.RGBA), (color.RGBA{G: 0xff, B: 0xff, A: 0xff}); !sameColors(got, want, 2) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
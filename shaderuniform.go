@@ -0,0 +1,261 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/shaderir"
+)
+
+// ShaderUniformType represents a type of a uniform variable in a shader.
+type ShaderUniformType int
+
+const (
+	ShaderUniformTypeFloat ShaderUniformType = iota
+	ShaderUniformTypeInt
+	ShaderUniformTypeVec2
+	ShaderUniformTypeVec3
+	ShaderUniformTypeVec4
+	ShaderUniformTypeIVec2
+	ShaderUniformTypeIVec3
+	ShaderUniformTypeIVec4
+	ShaderUniformTypeMat2
+	ShaderUniformTypeMat3
+	ShaderUniformTypeMat4
+)
+
+// String returns the type name in Kage.
+func (t ShaderUniformType) String() string {
+	switch t {
+	case ShaderUniformTypeFloat:
+		return "float"
+	case ShaderUniformTypeInt:
+		return "int"
+	case ShaderUniformTypeVec2:
+		return "vec2"
+	case ShaderUniformTypeVec3:
+		return "vec3"
+	case ShaderUniformTypeVec4:
+		return "vec4"
+	case ShaderUniformTypeIVec2:
+		return "ivec2"
+	case ShaderUniformTypeIVec3:
+		return "ivec3"
+	case ShaderUniformTypeIVec4:
+		return "ivec4"
+	case ShaderUniformTypeMat2:
+		return "mat2"
+	case ShaderUniformTypeMat3:
+		return "mat3"
+	case ShaderUniformTypeMat4:
+		return "mat4"
+	}
+	return fmt.Sprintf("ShaderUniformType(%d)", int(t))
+}
+
+// componentCount returns the number of the numeric values of the type.
+func (t ShaderUniformType) componentCount() int {
+	switch t {
+	case ShaderUniformTypeFloat, ShaderUniformTypeInt:
+		return 1
+	case ShaderUniformTypeVec2, ShaderUniformTypeIVec2:
+		return 2
+	case ShaderUniformTypeVec3, ShaderUniformTypeIVec3:
+		return 3
+	case ShaderUniformTypeVec4, ShaderUniformTypeIVec4, ShaderUniformTypeMat2:
+		return 4
+	case ShaderUniformTypeMat3:
+		return 9
+	case ShaderUniformTypeMat4:
+		return 16
+	}
+	return 0
+}
+
+func (t ShaderUniformType) isInt() bool {
+	return t == ShaderUniformTypeInt || t == ShaderUniformTypeIVec2 || t == ShaderUniformTypeIVec3 || t == ShaderUniformTypeIVec4
+}
+
+func shaderUniformTypeFromBasicType(t shaderir.BasicType) (ShaderUniformType, bool) {
+	switch t {
+	case shaderir.Float:
+		return ShaderUniformTypeFloat, true
+	case shaderir.Int:
+		return ShaderUniformTypeInt, true
+	case shaderir.Vec2:
+		return ShaderUniformTypeVec2, true
+	case shaderir.Vec3:
+		return ShaderUniformTypeVec3, true
+	case shaderir.Vec4:
+		return ShaderUniformTypeVec4, true
+	case shaderir.IVec2:
+		return ShaderUniformTypeIVec2, true
+	case shaderir.IVec3:
+		return ShaderUniformTypeIVec3, true
+	case shaderir.IVec4:
+		return ShaderUniformTypeIVec4, true
+	case shaderir.Mat2:
+		return ShaderUniformTypeMat2, true
+	case shaderir.Mat3:
+		return ShaderUniformTypeMat3, true
+	case shaderir.Mat4:
+		return ShaderUniformTypeMat4, true
+	}
+	return 0, false
+}
+
+// ShaderUniform represents a uniform variable declared in a shader.
+type ShaderUniform struct {
+	// Name is the name of the uniform variable.
+	Name string
+
+	// Type is the type of the uniform variable.
+	// If the uniform variable is an array, Type is the type of the elements.
+	Type ShaderUniformType
+
+	// ArrayLength is the length of the array if the uniform variable is an array.
+	// Otherwise, ArrayLength is 0.
+	ArrayLength int
+}
+
+// ValueCount returns the number of the numeric values to specify the uniform variable.
+//
+// If ValueCount is 1, the value in a uniform map must be a numeric value.
+// Otherwise, the value in a uniform map must be a slice or an array of a numeric type with ValueCount elements.
+func (u ShaderUniform) ValueCount() int {
+	if u.ArrayLength > 0 {
+		return u.ArrayLength * u.Type.componentCount()
+	}
+	return u.Type.componentCount()
+}
+
+// Uniforms returns the uniform variables declared in the shader, in the order of the declarations.
+//
+// Uniforms is useful e.g. for tools to bind values to a shader generically.
+//
+// If the shader is disposed, Uniforms returns nil.
+func (s *Shader) Uniforms() []ShaderUniform {
+	if s.isDisposed() {
+		return nil
+	}
+
+	names := s.shader.UniformNames()
+	types := s.shader.UniformTypes()
+	uniforms := make([]ShaderUniform, 0, len(names))
+	for i, name := range names {
+		u := ShaderUniform{
+			Name: name,
+		}
+		t := types[i]
+		if t.Main == shaderir.Array {
+			u.ArrayLength = t.Length
+			t = t.Sub[0]
+		}
+		typ, ok := shaderUniformTypeFromBasicType(t.Main)
+		if !ok {
+			panic(fmt.Sprintf("ebiten: unexpected uniform variable type: %s", types[i].String()))
+		}
+		u.Type = typ
+		uniforms = append(uniforms, u)
+	}
+	return uniforms
+}
+
+// ValidateUniforms validates the given uniform map against the uniform variables declared in the shader.
+//
+// ValidateUniforms returns an error if a name in the map is not declared in the shader,
+// or if a value doesn't match with the uniform variable's type.
+// The error includes all the problems found.
+// A uniform variable missing in the map is not an error, as it is treated as zero values.
+//
+// The drawing functions with a shader panic on an invalid value, but ignore an unknown name.
+// ValidateUniforms is useful to find such mistakes like typos.
+//
+// If the shader is disposed, ValidateUniforms returns an error.
+func (s *Shader) ValidateUniforms(uniforms map[string]any) error {
+	if s.isDisposed() {
+		return errors.New("ebiten: the shader is disposed")
+	}
+
+	declared := s.Uniforms()
+
+	names := make([]string, 0, len(uniforms))
+	for name := range uniforms {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var errs []error
+	for _, name := range names {
+		idx := slices.IndexFunc(declared, func(u ShaderUniform) bool {
+			return u.Name == name
+		})
+		if idx < 0 {
+			msg := fmt.Sprintf("ebiten: uniform variable %s is not declared", name)
+			for _, u := range declared {
+				if strings.EqualFold(u.Name, name) {
+					msg += fmt.Sprintf("; did you mean %s?", u.Name)
+					break
+				}
+			}
+			errs = append(errs, errors.New(msg))
+			continue
+		}
+		if err := validateUniformValue(uniforms[name], declared[idx]); err != nil {
+			errs = append(errs, fmt.Errorf("ebiten: uniform variable %s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validateUniformValue(value any, uniform ShaderUniform) error {
+	typeName := uniform.Type.String()
+	if uniform.ArrayLength > 0 {
+		typeName = fmt.Sprintf("[%d]%s", uniform.ArrayLength, typeName)
+	}
+
+	v := reflect.ValueOf(value)
+	if !v.IsValid() {
+		return fmt.Errorf("nil is given for %s", typeName)
+	}
+
+	kind := v.Kind()
+	count := 1
+	if kind == reflect.Slice || kind == reflect.Array {
+		kind = v.Type().Elem().Kind()
+		count = v.Len()
+	}
+
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+	case reflect.Float32, reflect.Float64:
+		if uniform.Type.isInt() {
+			return fmt.Errorf("a floating-point value is given for %s", typeName)
+		}
+	default:
+		return fmt.Errorf("a value of %s is given for %s", v.Type(), typeName)
+	}
+
+	if n := uniform.ValueCount(); count != n {
+		return fmt.Errorf("%d values are given for %s, which requires %d values", count, typeName, n)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"image"
+	"image/color"
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// Background represents a box drawn behind a text at Draw.
+//
+// Background is used at DrawOptions.Background.
+// The box is calculated from the measured extents of the laid-out text, the same region as Fill uses,
+// so the box always agrees with the text metrics.
+// The box is transformed together with the glyphs by DrawImageOptions.GeoM.
+type Background struct {
+	// Color is the color of the box.
+	// The color is multiplied by DrawImageOptions.ColorScale, and DrawImageOptions.ColorM is ignored.
+	// If Color is nil, nothing is rendered.
+	Color color.Color
+
+	// Padding is the space between the text extents and the box edges in pixels.
+	Padding float64
+
+	// CornerRadius is the radius of the rounded corners of the box in pixels.
+	// CornerRadius is clamped to the half of the shorter side of the box.
+	CornerRadius float64
+}
+
+var (
+	backgroundWhiteImage     *ebiten.Image
+	backgroundWhiteImageOnce sync.Once
+)
+
+func ensureBackgroundWhiteImage() *ebiten.Image {
+	backgroundWhiteImageOnce.Do(func() {
+		img := ebiten.NewImage(3, 3)
+		pix := make([]byte, 4*3*3)
+		for i := range pix {
+			pix[i] = 0xff
+		}
+		img.WritePixels(pix)
+		backgroundWhiteImage = img.SubImage(image.Rect(1, 1, 2, 2)).(*ebiten.Image)
+	})
+	return backgroundWhiteImage
+}
+
+func (b *Background) draw(dst *ebiten.Image, region fillRegion, options *ebiten.DrawImageOptions) {
+	if b.Color == nil {
+		return
+	}
+
+	x0 := float32(region.minX - b.Padding)
+	y0 := float32(region.minY - b.Padding)
+	x1 := float32(region.maxX + b.Padding)
+	y1 := float32(region.maxY + b.Padding)
+	if x0 >= x1 || y0 >= y1 {
+		return
+	}
+
+	r := float32(b.CornerRadius)
+	r = min(r, (x1-x0)/2, (y1-y0)/2)
+
+	var path vector.Path
+	if r > 0 {
+		path.MoveTo(x0+r, y0)
+		path.ArcTo(x1, y0, x1, y1, r)
+		path.ArcTo(x1, y1, x0, y1, r)
+		path.ArcTo(x0, y1, x0, y0, r)
+		path.ArcTo(x0, y0, x1, y0, r)
+	} else {
+		path.MoveTo(x0, y0)
+		path.LineTo(x1, y0)
+		path.LineTo(x1, y1)
+		path.LineTo(x0, y1)
+	}
+	path.Close()
+
+	vs, is := path.ApplyGeoM(options.GeoM).AppendVerticesAndIndicesForFilling(nil, nil)
+	clr := premultipliedColor(b.Color)
+	for i := range vs {
+		vs[i].SrcX = 1
+		vs[i].SrcY = 1
+		vs[i].ColorR = clr[0] * options.ColorScale.R()
+		vs[i].ColorG = clr[1] * options.ColorScale.G()
+		vs[i].ColorB = clr[2] * options.ColorScale.B()
+		vs[i].ColorA = clr[3] * options.ColorScale.A()
+	}
+
+	op := &ebiten.DrawTrianglesOptions{}
+	op.ColorScaleMode = ebiten.ColorScaleModePremultipliedAlpha
+	op.Blend = options.Blend
+	op.AntiAlias = r > 0
+	dst.DrawTriangles(vs, is, ensureBackgroundWhiteImage(), op)
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"golang.org/x/text/unicode/bidi"
+)
+
+// resolveDirection returns the direction for the given paragraph.
+//
+// If d is DirectionAuto, resolveDirection detects the base direction from the first strong character
+// in the paragraph, skipping characters between an isolate initiator and its matching PDI (UAX #9 P2).
+// If there is no strong character, the direction is left-to-right (UAX #9 P3).
+// Otherwise, resolveDirection returns d as it is.
+func resolveDirection(d Direction, paragraph string) Direction {
+	if d != DirectionAuto {
+		return d
+	}
+
+	var isolateDepth int
+	for _, r := range paragraph {
+		p, _ := bidi.LookupRune(r)
+		switch p.Class() {
+		case bidi.LRI, bidi.RLI, bidi.FSI:
+			isolateDepth++
+		case bidi.PDI:
+			if isolateDepth > 0 {
+				isolateDepth--
+			}
+		case bidi.L:
+			if isolateDepth == 0 {
+				return DirectionLeftToRight
+			}
+		case bidi.R, bidi.AL:
+			if isolateDepth == 0 {
+				return DirectionRightToLeft
+			}
+		}
+	}
+	return DirectionLeftToRight
+}
@@ -15,82 +15,78 @@
 package text
 
 import (
-	"math"
-	"sync"
-
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/internal/hook"
+	"github.com/hajimehoshi/ebiten/v2/x/cache"
 )
 
-const infTick = math.MaxInt64
+// theCacheBudget is the budget shared by the caches in this package except for GoTextFace's glyph images.
+// The budget is unlimited, and is used to purge all the caches at once.
+var theCacheBudget = cache.NewBudget(0)
 
-type cacheValue[Value any] struct {
-	value Value
+// glyphImageCacheBudgetLimit is the limit of the total bytes of GoTextFace's glyph images.
+//
+// 64 MiB is an arbitrary number.
+const glyphImageCacheBudgetLimit = 64 * 1024 * 1024
 
-	// atime is the last time when the value was accessed.
-	atime int64
+// theGlyphImageCacheBudget is the budget shared by GoTextFace's glyph image caches of all the sources and sizes.
+//
+// When the total bytes of the glyph images exceed the limit, the least recently used glyph images among all the caches are evicted.
+// Thus, the glyph images for a size in steady use are kept,
+// while the ones for sizes no longer used, e.g., after the device scale factor changes, are evicted eventually.
+var theGlyphImageCacheBudget = cache.NewBudget(glyphImageCacheBudgetLimit)
+
+func init() {
+	hook.AppendHookOnLifecycleEvent(func(event hook.LifecycleEvent) {
+		if event != hook.LifecycleEventLowMemory {
+			return
+		}
+		// Release the caches immediately. The memory might not be available at the next tick.
+		PurgeCaches()
+	})
 }
 
-type cache[Key comparable, Value any] struct {
-	// softLimit indicates the soft limit of the number of values in the cache.
-	softLimit int
-
-	values map[Key]*cacheValue[Value]
-
-	// atime is the last time when the cache was accessed.
-	atime int64
-
-	m sync.Mutex
+// PurgeCaches purges all the caches in this package, including shaping results and glyph images.
+//
+// Glyph images rasterized with GoTextFace.RasterizeWithDeviceScaleFactor are cached for each device scale factor.
+// When the device scale factor changes, e.g., when the window moves to another monitor,
+// the glyph images for the old scale are no longer used but remain until they are evicted by newer glyph images.
+// Call PurgeCaches to release them immediately.
+//
+// PurgeCaches is concurrent-safe.
+func PurgeCaches() {
+	theCacheBudget.Clear()
+	theGlyphImageCacheBudget.Clear()
 }
 
-func newCache[Key comparable, Value any](softLimit int) *cache[Key, Value] {
-	return &cache[Key, Value]{
-		softLimit: softLimit,
-	}
+// newCache creates a new cache with the soft limit of the number of values.
+//
+// Even if the number of values exceeds the soft limit, the values used in the last 60 ticks are not removed.
+func newCache[Key comparable, Value any](softLimit int) *cache.Cache[Key, Value] {
+	return cache.New(&cache.Options[Key, Value]{
+		Budget:     theCacheBudget,
+		MaxEntries: softLimit,
+		// 60 is an arbitrary number.
+		RetainTicks: 60,
+		Tick:        ebiten.Tick,
+	})
 }
 
-func (c *cache[Key, Value]) getOrCreate(key Key, create func() (Value, bool)) Value {
-	n := ebiten.Tick()
-
-	c.m.Lock()
-	defer c.m.Unlock()
-
-	e, ok := c.values[key]
-	if ok {
-		e.atime = n
-		return e.value
-	}
-
-	if c.values == nil {
-		c.values = map[Key]*cacheValue[Value]{}
-	}
-
-	ent, canExpire := create()
-	e = &cacheValue[Value]{
-		value: ent,
-		atime: infTick,
-	}
-	if canExpire {
-		e.atime = n
-	}
-	c.values[key] = e
-
-	// Clean up old entries.
-	if c.atime < n {
-		// If the number of values exceeds the soft limits, old values are removed.
-		// Even after cleaning up the cache, the number of values might still exceed the soft limit,
-		// but this is fine.
-		if len(c.values) > c.softLimit {
-			for key, e := range c.values {
-				// 60 is an arbitrary number.
-				if e.atime >= n-60 {
-					continue
-				}
-				delete(c.values, key)
+// newGlyphImageCache creates a new cache for glyph images with the soft limit of the number of images.
+//
+// The glyph images are also evicted when the total bytes of the glyph images in all the caches exceed theGlyphImageCacheBudget's limit.
+func newGlyphImageCache[Key comparable](softLimit int) *cache.Cache[Key, *ebiten.Image] {
+	return cache.New(&cache.Options[Key, *ebiten.Image]{
+		Budget:     theGlyphImageCacheBudget,
+		MaxEntries: softLimit,
+		// 60 is an arbitrary number.
+		RetainTicks: 60,
+		Tick:        ebiten.Tick,
+		Size: func(key Key, img *ebiten.Image) int64 {
+			if img == nil {
+				return 0
 			}
-		}
-	}
-
-	c.atime = n
-
-	return e.value
+			return 4 * int64(img.Bounds().Dx()) * int64(img.Bounds().Dy())
+		},
+	})
 }
@@ -0,0 +1,181 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"unicode/utf8"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+var _ Face = (*CustomFace)(nil)
+
+// CustomFaceSource is an interface to provide glyphs and metrics for a CustomFace.
+//
+// CustomFaceSource is useful to use your own font technology, e.g., custom bitmap fonts or procedurally generated glyphs,
+// with the functions in this package like Draw and Measure.
+//
+// The unit of all the values is pixels.
+//
+// The functions might be called from multiple goroutines concurrently, since the functions in this package like Draw are concurrent-safe.
+type CustomFaceSource interface {
+	// Metrics returns the metrics of the face.
+	//
+	// At least HAscent and HDescent must be specified, as they are used to determine the rendering region of a text.
+	// HLineGap is a recommendation of the space between lines.
+	// XHeight and CapHeight are optional.
+	// The metrics for the vertical direction are not used, as CustomFace supports only the left-to-right direction.
+	Metrics() Metrics
+
+	// HasGlyph reports whether the source has a glyph for the given rune.
+	//
+	// When a CustomFace is used in a MultiFace, a glyph for a rune is taken from a face that has the glyph.
+	HasGlyph(r rune) bool
+
+	// GlyphAdvance returns the advance of the glyph for the given rune.
+	GlyphAdvance(r rune) float64
+
+	// GlyphImage returns the image of the glyph for the given rune,
+	// and the offset from the glyph's origin on the baseline to the image's upper-left position.
+	// The offset Y is typically negative, as the image is above the baseline.
+	//
+	// The returned image can be nil, e.g., for a space.
+	//
+	// GlyphImage is called every time a glyph is rendered.
+	// The returned image should be cached and reused by the source.
+	GlyphImage(r rune) (img *ebiten.Image, offsetX, offsetY float64)
+
+	// Kern returns the kerning adjustment between the two adjacent runes r0 and r1.
+	// The adjustment is added to the advance after r0.
+	// Kern should return 0 if there is no kerning.
+	Kern(r0, r1 rune) float64
+}
+
+// CustomFace is a Face implementation for a custom font technology.
+//
+// CustomFace supports only the left-to-right direction.
+type CustomFace struct {
+	source CustomFaceSource
+}
+
+// NewCustomFace creates a new CustomFace from the given source.
+func NewCustomFace(source CustomFaceSource) *CustomFace {
+	return &CustomFace{
+		source: source,
+	}
+}
+
+// Metrics implements Face.
+func (c *CustomFace) Metrics() Metrics {
+	return c.source.Metrics()
+}
+
+// advance implements Face.
+func (c *CustomFace) advance(text string) float64 {
+	var a float64
+	prev := rune(-1)
+	for _, r := range text {
+		if prev >= 0 {
+			a += c.source.Kern(prev, r)
+		}
+		a += c.source.GlyphAdvance(r)
+		prev = r
+	}
+	return a
+}
+
+// hasGlyph implements Face.
+func (c *CustomFace) hasGlyph(r rune) bool {
+	return c.source.HasGlyph(r)
+}
+
+// appendGlyphsForLine implements Face.
+func (c *CustomFace) appendGlyphsForLine(glyphs []Glyph, line string, indexOffset int, originX, originY float64) []Glyph {
+	prev := rune(-1)
+	for i, r := range line {
+		if prev >= 0 {
+			originX += c.source.Kern(prev, r)
+		}
+
+		img, offsetX, offsetY := c.source.GlyphImage(r)
+		_, size := utf8.DecodeRuneInString(line[i:])
+
+		// Append a glyph even if img is nil.
+		// This is necessary to return index information for control characters.
+		glyphs = append(glyphs, Glyph{
+			StartIndexInBytes: indexOffset + i,
+			EndIndexInBytes:   indexOffset + i + size,
+			Image:             img,
+			X:                 originX + offsetX,
+			Y:                 originY + offsetY,
+			OriginX:           originX,
+			OriginY:           originY,
+		})
+
+		originX += c.source.GlyphAdvance(r)
+		prev = r
+	}
+	return glyphs
+}
+
+// appendRunsForLine implements Face.
+func (c *CustomFace) appendRunsForLine(runs []Run, line string, indexOffset int) []Run {
+	if line == "" {
+		return runs
+	}
+	m := c.Metrics()
+	return append(runs, Run{
+		StartIndexInBytes: indexOffset,
+		EndIndexInBytes:   indexOffset + len(line),
+		Face:              c,
+		Ascent:            m.HAscent,
+		Descent:           m.HDescent,
+		LineGap:           m.HLineGap,
+		Advance:           c.advance(line),
+	})
+}
+
+// appendShapedGlyphsForLine implements Face.
+func (c *CustomFace) appendShapedGlyphsForLine(glyphs []ShapedGlyph, line string, indexOffset int) []ShapedGlyph {
+	for i, r := range line {
+		_, size := utf8.DecodeRuneInString(line[i:])
+		a := c.source.GlyphAdvance(r)
+		// The kerning is added to the advance after the current rune.
+		if r1, _ := utf8.DecodeRuneInString(line[i+size:]); i+size < len(line) {
+			a += c.source.Kern(r, r1)
+		}
+		glyphs = append(glyphs, ShapedGlyph{
+			Face:              c,
+			StartIndexInBytes: indexOffset + i,
+			EndIndexInBytes:   indexOffset + i + size,
+			XAdvance:          a,
+		})
+	}
+	return glyphs
+}
+
+// appendVectorPathForLine implements Face.
+func (c *CustomFace) appendVectorPathForLine(path *vector.Path, line string, originX, originY float64) {
+}
+
+// direction implements Face.
+func (c *CustomFace) direction() Direction {
+	return DirectionLeftToRight
+}
+
+// private implements Face.
+func (c *CustomFace) private() {
+}
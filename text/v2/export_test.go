@@ -33,3 +33,19 @@ func Float32ToFixed26_6(x float32) fixed.Int26_6 {
 func Float64ToFixed26_6(x float64) fixed.Int26_6 {
 	return float64ToFixed26_6(x)
 }
+
+func ResolveDirection(d Direction, paragraph string) Direction {
+	return resolveDirection(d, paragraph)
+}
+
+func SetGlyphImageCacheBudgetLimitForTesting(limit int64) (restore func()) {
+	orig := theGlyphImageCacheBudget.Limit()
+	theGlyphImageCacheBudget.SetLimit(limit)
+	return func() {
+		theGlyphImageCacheBudget.SetLimit(orig)
+	}
+}
+
+func GlyphImageCacheBudgetSizeForTesting() int64 {
+	return theGlyphImageCacheBudget.Size()
+}
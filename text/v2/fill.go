@@ -0,0 +1,209 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"image/color"
+	"strings"
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Fill represents how to fill glyphs at Draw.
+//
+// Fill is used at DrawOptions.Fill.
+// The available fills are GradientFill and TextureFill.
+type Fill interface {
+	draw(dst *ebiten.Image, glyphs []Glyph, region fillRegion, options *ebiten.DrawImageOptions)
+}
+
+// GradientFill is a Fill with a linear gradient along the horizontal or the vertical axis.
+//
+// The gradient spans the whole rendering region, not each glyph.
+// The color is calculated for each glyph vertex, and interpolated in the glyph.
+type GradientFill struct {
+	// Vertical indicates whether the gradient goes from the top to the bottom.
+	// If Vertical is false, the gradient goes from the left to the right.
+	Vertical bool
+
+	// StartColor is the color at the left or the top edge of the rendering region.
+	// If StartColor is nil, the color is treated as transparent.
+	StartColor color.Color
+
+	// EndColor is the color at the right or the bottom edge of the rendering region.
+	// If EndColor is nil, the color is treated as transparent.
+	EndColor color.Color
+}
+
+// TextureFill is a Fill with a texture image.
+//
+// The upper-left corner of the texture image is put at the upper-left corner of the rendering region,
+// and the texture image is repeated in both directions.
+// The texture is transformed together with the glyphs by DrawImageOptions.GeoM.
+type TextureFill struct {
+	// Image is the texture image.
+	// If Image is nil, nothing is rendered.
+	Image *ebiten.Image
+}
+
+type fillRegion struct {
+	minX, minY float64
+	maxX, maxY float64
+}
+
+func premultipliedColor(clr color.Color) [4]float32 {
+	if clr == nil {
+		return [4]float32{}
+	}
+	r, g, b, a := clr.RGBA()
+	return [4]float32{float32(r) / 0xffff, float32(g) / 0xffff, float32(b) / 0xffff, float32(a) / 0xffff}
+}
+
+func appendGlyphVertices(vertices []ebiten.Vertex, g Glyph, geoM *ebiten.GeoM) []ebiten.Vertex {
+	b := g.Image.Bounds()
+	w, h := float64(b.Dx()), float64(b.Dy())
+	s := g.imageScale()
+	for _, p := range [...][2]float64{{0, 0}, {w, 0}, {0, h}, {w, h}} {
+		dx, dy := geoM.Apply(g.X+p[0]*s, g.Y+p[1]*s)
+		vertices = append(vertices, ebiten.Vertex{
+			DstX: float32(dx),
+			DstY: float32(dy),
+			SrcX: float32(float64(b.Min.X) + p[0]),
+			SrcY: float32(float64(b.Min.Y) + p[1]),
+		})
+	}
+	return vertices
+}
+
+var glyphQuadIndices = []uint16{0, 1, 2, 1, 2, 3}
+
+func (f *GradientFill) draw(dst *ebiten.Image, glyphs []Glyph, region fillRegion, options *ebiten.DrawImageOptions) {
+	start := premultipliedColor(f.StartColor)
+	end := premultipliedColor(f.EndColor)
+	scale := [4]float32{options.ColorScale.R(), options.ColorScale.G(), options.ColorScale.B(), options.ColorScale.A()}
+
+	op := &ebiten.DrawTrianglesOptions{}
+	op.ColorScaleMode = ebiten.ColorScaleModePremultipliedAlpha
+	op.Blend = options.Blend
+	op.Filter = options.Filter
+
+	var vs []ebiten.Vertex
+	for _, g := range glyphs {
+		if g.Image == nil {
+			continue
+		}
+		vs = appendGlyphVertices(vs[:0], g, &options.GeoM)
+		b := g.Image.Bounds()
+		for i := range vs {
+			var t float64
+			if f.Vertical {
+				if h := region.maxY - region.minY; h > 0 {
+					t = (g.Y + (float64(vs[i].SrcY)-float64(b.Min.Y))*g.imageScale() - region.minY) / h
+				}
+			} else {
+				if w := region.maxX - region.minX; w > 0 {
+					t = (g.X + (float64(vs[i].SrcX)-float64(b.Min.X))*g.imageScale() - region.minX) / w
+				}
+			}
+			t = min(max(t, 0), 1)
+			vs[i].ColorR = (start[0] + (end[0]-start[0])*float32(t)) * scale[0]
+			vs[i].ColorG = (start[1] + (end[1]-start[1])*float32(t)) * scale[1]
+			vs[i].ColorB = (start[2] + (end[2]-start[2])*float32(t)) * scale[2]
+			vs[i].ColorA = (start[3] + (end[3]-start[3])*float32(t)) * scale[3]
+		}
+		dst.DrawTriangles(vs, glyphQuadIndices, g.Image, op)
+	}
+}
+
+var (
+	textureFillShader     *ebiten.Shader
+	textureFillShaderOnce sync.Once
+)
+
+func ensureTextureFillShader() *ebiten.Shader {
+	textureFillShaderOnce.Do(func() {
+		s, err := ebiten.NewShader([]byte(`//kage:unit pixels
+
+package main
+
+func Fragment(dstPos vec4, srcPos vec2, color vec4, custom vec4) vec4 {
+	alpha := imageSrc0At(srcPos).a
+	pos := mod(custom.xy, imageSrc1Size()) + imageSrc1Origin()
+	return imageSrc1UnsafeAt(pos) * alpha * color
+}
+`))
+		if err != nil {
+			panic("text: compiling the texture fill shader failed: " + err.Error())
+		}
+		textureFillShader = s
+	})
+	return textureFillShader
+}
+
+func (f *TextureFill) draw(dst *ebiten.Image, glyphs []Glyph, region fillRegion, options *ebiten.DrawImageOptions) {
+	if f.Image == nil {
+		return
+	}
+
+	op := &ebiten.DrawTrianglesShaderOptions{}
+	op.Blend = options.Blend
+	op.Images[1] = f.Image
+	s := ensureTextureFillShader()
+
+	var vs []ebiten.Vertex
+	for _, g := range glyphs {
+		if g.Image == nil {
+			continue
+		}
+		vs = appendGlyphVertices(vs[:0], g, &options.GeoM)
+		b := g.Image.Bounds()
+		for i := range vs {
+			vs[i].ColorR = options.ColorScale.R()
+			vs[i].ColorG = options.ColorScale.G()
+			vs[i].ColorB = options.ColorScale.B()
+			vs[i].ColorA = options.ColorScale.A()
+			vs[i].Custom0 = float32(g.X + (float64(vs[i].SrcX)-float64(b.Min.X))*g.imageScale() - region.minX)
+			vs[i].Custom1 = float32(g.Y + (float64(vs[i].SrcY)-float64(b.Min.Y))*g.imageScale() - region.minY)
+		}
+		op.Images[0] = g.Image
+		dst.DrawTrianglesShader(vs, glyphQuadIndices, s, op)
+	}
+}
+
+func renderingRegion(text string, face Face, options *LayoutOptions) fillRegion {
+	w, h := Measure(text, face, options.LineSpacing)
+	var r fillRegion
+	// With DirectionAuto, the first paragraph determines the region.
+	firstLine, _, _ := strings.Cut(text, "\n")
+	ha, va := calcAligns(resolveDirection(face.direction(), firstLine), options.PrimaryAlign, options.SecondaryAlign)
+	switch ha {
+	case horizontalAlignLeft:
+	case horizontalAlignCenter:
+		r.minX = -w / 2
+	case horizontalAlignRight:
+		r.minX = -w
+	}
+	switch va {
+	case verticalAlignTop:
+	case verticalAlignCenter:
+		r.minY = -h / 2
+	case verticalAlignBottom:
+		r.minY = -h
+	}
+	r.maxX = r.minX + w
+	r.maxY = r.minY + h
+	return r
+}
@@ -18,9 +18,11 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"slices"
 
 	"github.com/go-text/typesetting/di"
 	"github.com/go-text/typesetting/font"
+	"github.com/go-text/typesetting/font/opentype"
 	glanguage "github.com/go-text/typesetting/language"
 	"github.com/go-text/typesetting/shaping"
 	"golang.org/x/image/math/fixed"
@@ -33,7 +35,8 @@ import (
 var _ Face = (*GoTextFace)(nil)
 
 // GoTextFace is a Face implementation for go-text's font.Face (github.com/go-text/typesetting).
-// With a GoTextFace, shaping.HarfBuzzShaper is always used as a shaper internally.
+// With a GoTextFace, shaping.HarfBuzzShaper is used as a shaper internally by default.
+// The shaper can be replaced by GoTextFaceSource.UnsafeSetShaper.
 // GoTextFace includes the source and various options.
 //
 // Unlike GoXFace, one GoTextFace instance doesn't have its own glyph image cache.
@@ -62,6 +65,76 @@ type GoTextFace struct {
 	// Deprecated: as of v2.9. Use Language instead.
 	Script language.Script
 
+	// LetterSpacing is the additional space between characters (grapheme clusters) in pixels.
+	// LetterSpacing is also known as tracking.
+	// LetterSpacing can be negative.
+	//
+	// LetterSpacing is applied after shaping.
+	// LetterSpacing is not applied to texts in cursive scripts like Arabic, where the letters must be connected.
+	//
+	// To disable kerning, use SetFeature with the 'kern' tag and 0.
+	LetterSpacing float64
+
+	// WordSpacing is the additional space for word separators like spaces in pixels.
+	// WordSpacing can be negative.
+	//
+	// WordSpacing is applied after shaping, in addition to LetterSpacing.
+	WordSpacing float64
+
+	// SyntheticBold is the width in pixels by which the glyph outlines are dilated.
+	// SyntheticBold is useful for a font family without a bold style.
+	// The default (zero) value means no synthetic emboldening.
+	//
+	// SyntheticBold is applied to the scaled outlines before rasterization.
+	// The advances of glyphs are not changed. To make spaces for emboldened glyphs, use LetterSpacing.
+	SyntheticBold float64
+
+	// SyntheticOblique is the horizontal shear factor to slant the glyph outlines.
+	// A positive value leans glyphs to the right. For example, 0.2 slants glyphs by about 11 degrees.
+	// SyntheticOblique is useful for a font family without an italic style.
+	// The default (zero) value means no synthetic slanting.
+	//
+	// SyntheticOblique is applied to the scaled outlines before rasterization.
+	SyntheticOblique float64
+
+	// RasterizeWithDeviceScaleFactor indicates whether glyphs are rasterized at Size multiplied by the device scale factor.
+	//
+	// If RasterizeWithDeviceScaleFactor is true, glyph images are rasterized at the higher resolution
+	// and Glyph.ImageScale is set so that the glyphs are rendered in the same size as Size.
+	// This keeps texts sharp on high-DPI displays, when the texts are rendered onto an image scaled up by the device scale factor,
+	// e.g., when Layout returns the outside size multiplied by the device scale factor and GeoM scales the texts accordingly.
+	//
+	// The device scale factor is the one of the monitor the window is on at the time of rendering,
+	// and the glyph images are cached for each scale separately.
+	// Glyphs are rasterized again at the new scale when the window moves to a monitor with a different scale.
+	// See also PurgeCaches.
+	//
+	// The layout and the metrics are not affected by RasterizeWithDeviceScaleFactor.
+	// The default (false) rasterizes glyphs at Size.
+	RasterizeWithDeviceScaleFactor bool
+
+	// Normalization is the Unicode normalization applied to texts before shaping.
+	// The default (zero) value doesn't normalize texts.
+	//
+	// The indices in bytes of glyphs and runs are for the original text, not the normalized text.
+	Normalization Normalization
+
+	// SmallCaps indicates whether lowercase letters are rendered as small capitals with the 'smcp' feature.
+	// If the font doesn't have the 'smcp' feature, lowercase letters are rendered as they are.
+	SmallCaps bool
+
+	// FigureStyle is the style of figures (digits).
+	// The default (zero) value uses the font's default style.
+	FigureStyle FigureStyle
+
+	// FigureWidth is the width of figures (digits).
+	// The default (zero) value uses the font's default width.
+	FigureWidth FigureWidth
+
+	// SlashedZero indicates whether zero is rendered with a slash with the 'zero' feature.
+	// If the font doesn't have the 'zero' feature, zero is rendered as it is.
+	SlashedZero bool
+
 	variations []font.Variation
 	features   []shaping.FontFeature
 
@@ -163,6 +236,79 @@ func (g *GoTextFace) RemoveFeature(tag Tag) {
 	}
 }
 
+// FigureStyle represents a style of figures (digits).
+type FigureStyle int
+
+const (
+	// FigureStyleDefault uses the font's default figure style.
+	FigureStyleDefault FigureStyle = iota
+
+	// FigureStyleLining uses lining figures, which have the height of capital letters, with the 'lnum' feature.
+	FigureStyleLining
+
+	// FigureStyleOldstyle uses oldstyle figures, which have ascenders and descenders like lowercase letters, with the 'onum' feature.
+	FigureStyleOldstyle
+)
+
+// FigureWidth represents a width of figures (digits).
+type FigureWidth int
+
+const (
+	// FigureWidthDefault uses the font's default figure width.
+	FigureWidthDefault FigureWidth = iota
+
+	// FigureWidthProportional uses proportional figures, which have their own widths, with the 'pnum' feature.
+	FigureWidthProportional
+
+	// FigureWidthTabular uses tabular figures, which have the same width, with the 'tnum' feature.
+	// Tabular figures are useful for numbers that change frequently like scores and timers.
+	//
+	// If the font doesn't have the 'tnum' feature, the advances of the figures are adjusted to the widest figure's advance
+	// and the figures are centered, for horizontal texts.
+	FigureWidthTabular
+)
+
+// shapingFeatures returns the font features for shaping, including the features for the typographic options like SmallCaps.
+// The features set by SetFeature take precedence.
+func (g *GoTextFace) shapingFeatures() []shaping.FontFeature {
+	var tags []Tag
+	if g.SmallCaps {
+		tags = append(tags, MustParseTag("smcp"))
+	}
+	switch g.FigureStyle {
+	case FigureStyleLining:
+		tags = append(tags, MustParseTag("lnum"))
+	case FigureStyleOldstyle:
+		tags = append(tags, MustParseTag("onum"))
+	}
+	switch g.FigureWidth {
+	case FigureWidthProportional:
+		tags = append(tags, MustParseTag("pnum"))
+	case FigureWidthTabular:
+		tags = append(tags, MustParseTag("tnum"))
+	}
+	if g.SlashedZero {
+		tags = append(tags, MustParseTag("zero"))
+	}
+	if len(tags) == 0 {
+		return g.features
+	}
+
+	features := slices.Clone(g.features)
+	for _, tag := range tags {
+		if slices.ContainsFunc(g.features, func(f shaping.FontFeature) bool {
+			return f.Tag == font.Tag(tag)
+		}) {
+			continue
+		}
+		features = append(features, shaping.FontFeature{
+			Tag:   font.Tag(tag),
+			Value: 1,
+		})
+	}
+	return features
+}
+
 // Tag is a tag for font variations and features.
 // Tag is a 4-byte value like 'cmap'.
 type Tag uint32
@@ -193,21 +339,22 @@ func MustParseTag(str string) Tag {
 // Metrics implements Face.
 func (g *GoTextFace) Metrics() Metrics {
 	scale := g.Source.scale(g.Size)
+	f := g.Source.faceWithVariations(g)
 
 	var m Metrics
-	if h, ok := g.Source.f.FontHExtents(); ok {
+	if h, ok := f.FontHExtents(); ok {
 		m.HLineGap = float64(h.LineGap) * scale
 		m.HAscent = float64(h.Ascender) * scale
 		m.HDescent = float64(-h.Descender) * scale
 	}
-	if v, ok := g.Source.f.FontVExtents(); ok {
+	if v, ok := f.FontVExtents(); ok {
 		m.VLineGap = float64(v.LineGap) * scale
 		m.VAscent = float64(v.Ascender) * scale
 		m.VDescent = float64(-v.Descender) * scale
 	}
 
-	m.XHeight = float64(g.Source.f.LineMetric(font.XHeight)) * scale
-	m.CapHeight = float64(g.Source.f.LineMetric(font.CapHeight)) * scale
+	m.XHeight = float64(f.LineMetric(font.XHeight)) * scale
+	m.CapHeight = float64(f.LineMetric(font.CapHeight)) * scale
 
 	// XHeight and CapHeight might not be correct for some old fonts (go-text/typesetting#169).
 	if m.XHeight <= 0 {
@@ -265,11 +412,22 @@ func (g *GoTextFace) outputCacheKey(text string) goTextOutputCacheKey {
 		script:     g.Script.String(),
 		variations: g.ensureVariationsString(),
 		features:   g.ensureFeaturesString(),
+
+		letterSpacing: g.LetterSpacing,
+		wordSpacing:   g.WordSpacing,
+
+		syntheticBold:    g.SyntheticBold,
+		syntheticOblique: g.SyntheticOblique,
+
+		smallCaps:   g.SmallCaps,
+		figureStyle: g.FigureStyle,
+		figureWidth: g.FigureWidth,
+		slashedZero: g.SlashedZero,
 	}
 }
 
-func (g *GoTextFace) diDirection() di.Direction {
-	switch g.Direction {
+func (g *GoTextFace) diDirection(text string) di.Direction {
+	switch resolveDirection(g.Direction, text) {
 	case DirectionLeftToRight:
 		return di.DirectionLTR
 	case DirectionRightToLeft:
@@ -321,6 +479,12 @@ func (g *GoTextFace) appendGlyphsForLine(glyphs []Glyph, line string, indexOffse
 		X: float64ToFixed26_6(originX),
 		Y: float64ToFixed26_6(originY),
 	}
+	rasterScale := g.rasterScale()
+	var imageScale float64
+	if rasterScale != 1 {
+		imageScale = 1 / rasterScale
+	}
+
 	_, gs := g.Source.shape(line, g)
 	for _, glyph := range gs {
 		o := origin.Add(fixed.Point26_6{
@@ -328,8 +492,8 @@ func (g *GoTextFace) appendGlyphsForLine(glyphs []Glyph, line string, indexOffse
 			Y: -glyph.shapingGlyph.YOffset,
 		})
 
-		// imgX and imgY are integers so that the nearest filter can be used.
-		img, imgX, imgY := g.glyphImage(glyph, o)
+		// imgX and imgY are integers in the rasterized scale so that the nearest filter can be used.
+		img, imgX, imgY, padding := g.glyphImage(glyph, o, rasterScale)
 
 		// Append a glyph even if img is nil.
 		// This is necessary to return index information for control characters.
@@ -338,12 +502,14 @@ func (g *GoTextFace) appendGlyphsForLine(glyphs []Glyph, line string, indexOffse
 			EndIndexInBytes:   indexOffset + glyph.endIndex,
 			GID:               uint32(glyph.shapingGlyph.GlyphID),
 			Image:             img,
-			X:                 float64(imgX),
-			Y:                 float64(imgY),
+			X:                 float64(imgX) / rasterScale,
+			Y:                 float64(imgY) / rasterScale,
 			OriginX:           fixed26_6ToFloat64(origin.X),
 			OriginY:           fixed26_6ToFloat64(origin.Y),
 			OriginOffsetX:     fixed26_6ToFloat64(glyph.shapingGlyph.XOffset),
 			OriginOffsetY:     fixed26_6ToFloat64(-glyph.shapingGlyph.YOffset),
+			Padding:           padding,
+			ImageScale:        imageScale,
 		})
 		origin = origin.Add(fixed.Point26_6{
 			X: glyph.shapingGlyph.XAdvance,
@@ -354,7 +520,84 @@ func (g *GoTextFace) appendGlyphsForLine(glyphs []Glyph, line string, indexOffse
 	return glyphs
 }
 
-func (g *GoTextFace) glyphImage(glyph glyph, origin fixed.Point26_6) (*ebiten.Image, int, int) {
+// appendRunsForLine implements Face.
+func (g *GoTextFace) appendRunsForLine(runs []Run, line string, indexOffset int) []Run {
+	outputs, _ := g.Source.shape(line, g)
+
+	// The outputs' rune indices are for the normalized text.
+	shaped := line
+	n, normalized := normalizeText(line, g.Normalization)
+	if normalized {
+		shaped = n.text
+	}
+
+	// indices maps rune indices to byte indices.
+	var indices []int
+	for i := range shaped {
+		indices = append(indices, i)
+	}
+	indices = append(indices, len(shaped))
+
+	for _, out := range outputs {
+		a := fixed26_6ToFloat64(out.Advance)
+		if !g.direction().isHorizontal() {
+			a = -a
+		}
+		start := indices[out.Runes.Offset]
+		end := indices[out.Runes.Offset+out.Runes.Count]
+		if normalized {
+			start = n.origStartIndex(start)
+			end = n.origEndIndex(end)
+		}
+		runs = append(runs, Run{
+			StartIndexInBytes: indexOffset + start,
+			EndIndexInBytes:   indexOffset + end,
+			Face:              g,
+			Ascent:            fixed26_6ToFloat64(out.LineBounds.Ascent),
+			Descent:           fixed26_6ToFloat64(-out.LineBounds.Descent),
+			LineGap:           fixed26_6ToFloat64(out.LineBounds.Gap),
+			Advance:           a,
+		})
+	}
+	return runs
+}
+
+// rasterScale returns the scale to rasterize glyphs.
+func (g *GoTextFace) rasterScale() float64 {
+	if !g.RasterizeWithDeviceScaleFactor {
+		return 1
+	}
+	return ebiten.Monitor().DeviceScaleFactor()
+}
+
+// appendShapedGlyphsForLine implements Face.
+func (g *GoTextFace) appendShapedGlyphsForLine(glyphs []ShapedGlyph, line string, indexOffset int) []ShapedGlyph {
+	_, gs := g.Source.shape(line, g)
+	for _, glyph := range gs {
+		glyphs = append(glyphs, ShapedGlyph{
+			Face:              g,
+			GID:               uint32(glyph.shapingGlyph.GlyphID),
+			StartIndexInBytes: indexOffset + glyph.startIndex,
+			EndIndexInBytes:   indexOffset + glyph.endIndex,
+			XOffset:           fixed26_6ToFloat64(glyph.shapingGlyph.XOffset),
+			YOffset:           fixed26_6ToFloat64(-glyph.shapingGlyph.YOffset),
+			XAdvance:          fixed26_6ToFloat64(glyph.shapingGlyph.XAdvance),
+			YAdvance:          fixed26_6ToFloat64(-glyph.shapingGlyph.YAdvance),
+		})
+	}
+	return glyphs
+}
+
+func (g *GoTextFace) glyphImage(glyph glyph, origin fixed.Point26_6, rasterScale float64) (*ebiten.Image, int, int, GlyphPadding) {
+	b := glyph.bounds
+	if rasterScale != 1 {
+		origin = scaleFixedPoint(origin, rasterScale)
+		b = fixed.Rectangle26_6{
+			Min: scaleFixedPoint(b.Min, rasterScale),
+			Max: scaleFixedPoint(b.Max, rasterScale),
+		}
+	}
+
 	if g.direction().isHorizontal() {
 		origin.X = adjustGranularity(origin.X, g)
 		origin.Y &^= ((1 << 6) - 1)
@@ -363,7 +606,6 @@ func (g *GoTextFace) glyphImage(glyph glyph, origin fixed.Point26_6) (*ebiten.Im
 		origin.Y = adjustGranularity(origin.Y, g)
 	}
 
-	b := glyph.bounds
 	subpixelOffset := fixed.Point26_6{
 		X: (origin.X + b.Min.X) & ((1 << 6) - 1),
 		Y: (origin.Y + b.Min.Y) & ((1 << 6) - 1),
@@ -373,15 +615,29 @@ func (g *GoTextFace) glyphImage(glyph glyph, origin fixed.Point26_6) (*ebiten.Im
 		xoffset:    subpixelOffset.X,
 		yoffset:    subpixelOffset.Y,
 		variations: g.ensureVariationsString(),
+
+		syntheticBold:    g.SyntheticBold,
+		syntheticOblique: g.SyntheticOblique,
+		rasterScale:      rasterScale,
 	}
-	img := g.Source.getOrCreateGlyphImage(g, key, func() (*ebiten.Image, bool) {
-		img := segmentsToImage(glyph.scaledSegments, subpixelOffset, b)
-		return img, img != nil
+	img := g.Source.getOrCreateGlyphImage(g, key, func() *ebiten.Image {
+		segs := glyph.scaledSegments
+		if rasterScale != 1 {
+			segs = make([]opentype.Segment, len(glyph.scaledSegments))
+			for i, seg := range glyph.scaledSegments {
+				segs[i] = seg
+				for j := range seg.Args {
+					segs[i].Args[j].X *= float32(rasterScale)
+					segs[i].Args[j].Y *= float32(rasterScale)
+				}
+			}
+		}
+		return segmentsToImage(segs, subpixelOffset, b)
 	})
 
 	imgX := (origin.X + b.Min.X).Floor()
 	imgY := (origin.Y + b.Min.Y).Floor()
-	return img, imgX, imgY
+	return img, imgX, imgY, glyphPadding(img, subpixelOffset, b)
 }
 
 // appendVectorPathForLine implements Face.
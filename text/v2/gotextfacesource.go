@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"io"
 	"slices"
+	"sync"
 
 	"github.com/go-text/typesetting/font"
 	"github.com/go-text/typesetting/font/opentype"
@@ -26,6 +27,7 @@ import (
 	"golang.org/x/image/math/fixed"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/x/cache"
 )
 
 type goTextOutputCacheKey struct {
@@ -36,6 +38,17 @@ type goTextOutputCacheKey struct {
 	script     string
 	variations string
 	features   string
+
+	letterSpacing float64
+	wordSpacing   float64
+
+	syntheticBold    float64
+	syntheticOblique float64
+
+	smallCaps   bool
+	figureStyle FigureStyle
+	figureWidth FigureWidth
+	slashedZero bool
 }
 
 type glyph struct {
@@ -56,19 +69,47 @@ type goTextGlyphImageCacheKey struct {
 	xoffset    fixed.Int26_6
 	yoffset    fixed.Int26_6
 	variations string
+
+	syntheticBold    float64
+	syntheticOblique float64
+	rasterScale      float64
 }
 
 // GoTextFaceSource is a source of a GoTextFace. This can be shared by multiple GoTextFace objects.
 type GoTextFaceSource struct {
+	// f is the font face without variations.
 	f        *font.Face
 	metadata Metadata
 
-	outputCache     *cache[goTextOutputCacheKey, goTextOutputCacheValue]
-	glyphImageCache map[float64]*cache[goTextGlyphImageCacheKey, *ebiten.Image]
+	// variationFaceCache caches font faces for each variations.
+	// The font faces are never modified after creation, so they can be used concurrently.
+	variationFaceCache *cache.Cache[string, *font.Face]
+
+	outputCache *cache.Cache[goTextOutputCacheKey, goTextOutputCacheValue]
+
+	// glyphImageCache caches glyph images for each effective size, that is the size multiplied by the rasterization scale.
+	// The glyph images are evicted by theGlyphImageCacheBudget, and the caches become empty when all the images are evicted.
+	glyphImageCache          map[float64]*cache.Cache[goTextGlyphImageCacheKey, *ebiten.Image]
+	glyphImageCachePruneTick int64
+	glyphImageCacheM         sync.Mutex
 
 	addr *GoTextFaceSource
 
-	shaper shaping.HarfbuzzShaper
+	// shaper is the shaper to shape texts.
+	// shaper is accessed only while shaperM is locked.
+	shaper  shaping.Shaper
+	shaperM sync.Mutex
+
+	// coverage is the sorted rune ranges that the font has glyphs for.
+	coverage     []RuneRange
+	coverageOnce sync.Once
+}
+
+// RuneRange represents a range of runes.
+// Both Start and End are inclusive.
+type RuneRange struct {
+	Start rune
+	End   rune
 }
 
 func toFontResource(source io.Reader) (font.Resource, error) {
@@ -91,11 +132,13 @@ func toFontResource(source io.Reader) (font.Resource, error) {
 
 func newGoTextFaceSource(face *font.Face) *GoTextFaceSource {
 	s := &GoTextFaceSource{
-		f: face,
+		f:      face,
+		shaper: &shaping.HarfbuzzShaper{},
 	}
 	s.addr = s
 	s.metadata = metadataFromFace(face)
 	s.outputCache = newCache[goTextOutputCacheKey, goTextOutputCacheValue](512)
+	s.variationFaceCache = newCache[string, *font.Face](16)
 	return s
 }
 
@@ -155,7 +198,56 @@ func (g *GoTextFaceSource) Metadata() Metadata {
 	return g.metadata
 }
 
+// HasGlyph reports whether the font has a glyph for the rune r.
+//
+// HasGlyph doesn't consider glyph substitutions by shaping, so this is useful to check
+// whether the font covers the characters in a text before rendering it, e.g., to choose a fallback font.
+//
+// HasGlyph is concurrent-safe.
+func (g *GoTextFaceSource) HasGlyph(r rune) bool {
+	g.copyCheck()
+	gid, ok := g.f.Cmap.Lookup(r)
+	return ok && gid != 0
+}
+
+// AppendCoverage appends the rune ranges that the font has glyphs for to ranges and returns the extended slice.
+//
+// The appended ranges are sorted in ascending order and never overlap or adjoin each other.
+//
+// AppendCoverage is concurrent-safe.
+func (g *GoTextFaceSource) AppendCoverage(ranges []RuneRange) []RuneRange {
+	g.copyCheck()
+	g.coverageOnce.Do(func() {
+		g.coverage = g.calcCoverage()
+	})
+	return append(ranges, g.coverage...)
+}
+
+func (g *GoTextFaceSource) calcCoverage() []RuneRange {
+	var runes []rune
+	iter := g.f.Cmap.Iter()
+	for iter.Next() {
+		r, gid := iter.Char()
+		if gid == 0 {
+			continue
+		}
+		runes = append(runes, r)
+	}
+	slices.Sort(runes)
+
+	var ranges []RuneRange
+	for _, r := range runes {
+		if n := len(ranges); n > 0 && r <= ranges[n-1].End+1 {
+			ranges[n-1].End = max(ranges[n-1].End, r)
+			continue
+		}
+		ranges = append(ranges, RuneRange{Start: r, End: r})
+	}
+	return ranges
+}
+
 // UnsafeInternal returns its font.Face.
+// The font.Face doesn't have variations. GoTextFace's variations are applied to other font.Face objects internally.
 // The return value type is any since github.com/go-text/typesettings's API is now unstable.
 //
 // UnsafeInternal is unsafe since this might make internal cache states out of sync.
@@ -165,32 +257,88 @@ func (g *GoTextFaceSource) UnsafeInternal() any {
 	return g.f
 }
 
+// UnsafeSetShaper sets a shaper to shape texts with this source, and purges the cached shaping results.
+//
+// shaper must implement github.com/go-text/typesetting/shaping.Shaper, or be nil.
+// If shaper is nil, the default shaper shaping.HarfbuzzShaper is used.
+// The parameter type is any since github.com/go-text/typesettings's API is now unstable.
+// UnsafeSetShaper panics if shaper doesn't implement shaping.Shaper.
+//
+// The shaping results are cached for each text and face options, and the shaper is not called again for the same text and the same options.
+// If the shaper's behavior changes, call UnsafeSetShaper again with the same shaper to purge the cache.
+// The cached glyph images are not purged, as they depend only on the font and the glyph IDs.
+//
+// The shaper is never called concurrently with the same source.
+//
+// UnsafeSetShaper might have breaking changes even in the same major version.
+func (g *GoTextFaceSource) UnsafeSetShaper(shaper any) {
+	g.copyCheck()
+
+	var s shaping.Shaper = &shaping.HarfbuzzShaper{}
+	if shaper != nil {
+		sh, ok := shaper.(shaping.Shaper)
+		if !ok {
+			panic("text: shaper must implement github.com/go-text/typesetting/shaping.Shaper")
+		}
+		s = sh
+	}
+
+	g.shaperM.Lock()
+	defer g.shaperM.Unlock()
+	g.shaper = s
+	g.outputCache.Clear()
+}
+
+// shape shapes the text with the face.
+//
+// If the text is normalized by the face's Normalization, the normalized text is shaped.
+// The glyphs' indices are converted to the indices in the original text,
+// while the outputs' rune indices are still for the normalized text.
 func (g *GoTextFaceSource) shape(text string, face *GoTextFace) ([]shaping.Output, []glyph) {
 	g.copyCheck()
 
+	n, ok := normalizeText(text, face.Normalization)
+	if !ok {
+		return g.shapeWithCache(text, face)
+	}
+
+	outputs, gs := g.shapeWithCache(n.text, face)
+	// Copy the glyphs not to modify the cached glyphs.
+	gs = slices.Clone(gs)
+	for i := range gs {
+		gs[i].startIndex = n.origStartIndex(gs[i].startIndex)
+		gs[i].endIndex = n.origEndIndex(gs[i].endIndex)
+	}
+	return outputs, gs
+}
+
+func (g *GoTextFaceSource) shapeWithCache(text string, face *GoTextFace) ([]shaping.Output, []glyph) {
+	// Lock the shaper while accessing the cache so that a result by an old shaper is never added to the cache.
+	g.shaperM.Lock()
+	defer g.shaperM.Unlock()
+
 	key := face.outputCacheKey(text)
-	e := g.outputCache.getOrCreate(key, func() (goTextOutputCacheValue, bool) {
+	e := g.outputCache.GetOrAdd(key, func() goTextOutputCacheValue {
 		outputs, gs := g.shapeImpl(text, face)
 		return goTextOutputCacheValue{
 			outputs: outputs,
 			glyphs:  gs,
-		}, true
+		}
 	})
 	return e.outputs, e.glyphs
 }
 
 func (g *GoTextFaceSource) shapeImpl(text string, face *GoTextFace) ([]shaping.Output, []glyph) {
-	f := face.Source.f
-	f.SetVariations(face.variations)
+	f := g.faceWithVariations(face)
 
 	runes := []rune(text)
 	input := shaping.Input{
 		Text:         runes,
 		RunStart:     0,
 		RunEnd:       len(runes),
-		Direction:    face.diDirection(),
+		Direction:    face.diDirection(text),
 		Face:         f,
-		FontFeatures: face.features,
+		FontFeatures: face.shapingFeatures(),
 		Size:         float64ToFixed26_6(face.Size),
 		Script:       face.gScript(),
 		Language:     language.Language(face.Language.String()),
@@ -200,7 +348,7 @@ func (g *GoTextFaceSource) shapeImpl(text string, face *GoTextFace) ([]shaping.O
 	inputs := seg.Split(input, &singleFontmap{face: f})
 
 	// Reverse the input for RTL texts.
-	if face.Direction == DirectionRightToLeft {
+	if resolveDirection(face.Direction, text) == DirectionRightToLeft {
 		slices.Reverse(inputs)
 	}
 
@@ -208,6 +356,10 @@ func (g *GoTextFaceSource) shapeImpl(text string, face *GoTextFace) ([]shaping.O
 	var gs []glyph
 	for i, input := range inputs {
 		out := g.shaper.Shape(input)
+		if face.FigureWidth == FigureWidthTabular && !g.hasGSUBFeature(MustParseTag("tnum")) {
+			g.applyTabularFigures(&out, runes, face)
+		}
+		applySpacing(&out, runes, input.Script, face)
 		outputs[i] = out
 
 		(shaping.Line{out}).AdjustBaselines()
@@ -221,7 +373,7 @@ func (g *GoTextFaceSource) shapeImpl(text string, face *GoTextFace) ([]shaping.O
 		for _, gl := range out.Glyphs {
 			gl := gl
 			var segs []opentype.Segment
-			switch data := g.f.GlyphData(gl.GlyphID).(type) {
+			switch data := f.GlyphData(gl.GlyphID).(type) {
 			case font.GlyphOutline:
 				if out.Direction.IsSideways() {
 					data.Sideways(fixed26_6ToFloat32(-gl.YOffset) / fixed26_6ToFloat32(out.Size) * float32(f.Upem()))
@@ -244,6 +396,12 @@ func (g *GoTextFaceSource) shapeImpl(text string, face *GoTextFace) ([]shaping.O
 					scaledSegs[i].Args[j].Y *= -scale
 				}
 			}
+			if face.SyntheticOblique != 0 {
+				obliqueSegments(scaledSegs, float32(face.SyntheticOblique))
+			}
+			if face.SyntheticBold != 0 {
+				emboldenSegments(scaledSegs, float32(face.SyntheticBold))
+			}
 
 			gs = append(gs, glyph{
 				shapingGlyph:   &gl,
@@ -257,18 +415,166 @@ func (g *GoTextFaceSource) shapeImpl(text string, face *GoTextFace) ([]shaping.O
 	return outputs, gs
 }
 
+// faceWithVariations returns a font face with the variations of the given GoTextFace.
+//
+// The font face without variations is shared by all the GoTextFace objects, and must not be modified.
+// Instead of setting variations to the shared font face, a font face is created for each variations
+// so that GoTextFace objects with different variations can be used concurrently.
+func (g *GoTextFaceSource) faceWithVariations(face *GoTextFace) *font.Face {
+	if len(face.variations) == 0 {
+		return g.f
+	}
+	return g.variationFaceCache.GetOrAdd(face.ensureVariationsString(), func() *font.Face {
+		f := font.NewFace(g.f.Font)
+		f.SetVariations(face.variations)
+		return f
+	})
+}
+
+func (g *GoTextFaceSource) hasGSUBFeature(tag Tag) bool {
+	_, ok := g.f.GSUB.FindFeatureIndex(font.Tag(tag))
+	return ok
+}
+
+// applyTabularFigures adjusts the advances of the figures to the widest figure's advance.
+// applyTabularFigures is used as a fallback when the font doesn't have the 'tnum' feature.
+func (g *GoTextFaceSource) applyTabularFigures(out *shaping.Output, runes []rune, face *GoTextFace) {
+	if out.Direction.IsVertical() {
+		return
+	}
+
+	f := g.faceWithVariations(face)
+	var maxAdvance float32
+	for r := '0'; r <= '9'; r++ {
+		gid, ok := f.NominalGlyph(r)
+		if !ok {
+			continue
+		}
+		maxAdvance = max(maxAdvance, f.HorizontalAdvance(gid))
+	}
+	a := float64ToFixed26_6(float64(maxAdvance) * g.scale(face.Size))
+
+	for i := range out.Glyphs {
+		gl := &out.Glyphs[i]
+		if r := runes[gl.ClusterIndex]; r < '0' || r > '9' {
+			continue
+		}
+		// Skip clusters with multiple glyphs, e.g., a digit with a combining mark.
+		if i > 0 && out.Glyphs[i-1].ClusterIndex == gl.ClusterIndex {
+			continue
+		}
+		if i < len(out.Glyphs)-1 && out.Glyphs[i+1].ClusterIndex == gl.ClusterIndex {
+			continue
+		}
+		d := a - gl.XAdvance
+		gl.XOffset += d / 2
+		gl.XAdvance = a
+		out.Advance += d
+	}
+}
+
+// applySpacing applies the letter spacing and the word spacing of the face to the shaping output.
+func applySpacing(out *shaping.Output, runes []rune, script language.Script, face *GoTextFace) {
+	if face.LetterSpacing == 0 && face.WordSpacing == 0 {
+		return
+	}
+
+	letterSpacing := face.LetterSpacing
+	if isCursiveScript(script) {
+		letterSpacing = 0
+	}
+
+	for i := range out.Glyphs {
+		gl := &out.Glyphs[i]
+
+		// Add the spacing only to the last glyph of each cluster.
+		if i < len(out.Glyphs)-1 && out.Glyphs[i+1].ClusterIndex == gl.ClusterIndex {
+			continue
+		}
+
+		s := letterSpacing
+		if isWordSeparator(runes[gl.ClusterIndex]) {
+			s += face.WordSpacing
+		}
+		if s == 0 {
+			continue
+		}
+
+		d := float64ToFixed26_6(s)
+		if out.Direction.IsVertical() {
+			gl.YAdvance -= d
+			out.Advance -= d
+		} else {
+			gl.XAdvance += d
+			out.Advance += d
+		}
+	}
+}
+
+// isCursiveScript reports whether the script is cursive, where the letters are connected and letter spacing must not be applied.
+func isCursiveScript(script language.Script) bool {
+	switch script {
+	case language.Adlam,
+		language.Arabic,
+		language.Hanifi_Rohingya,
+		language.Mandaic,
+		language.Manichaean,
+		language.Mongolian,
+		language.Nko,
+		language.Old_Uyghur,
+		language.Phags_Pa,
+		language.Psalter_Pahlavi,
+		language.Sogdian,
+		language.Syriac:
+		return true
+	}
+	return false
+}
+
+// isWordSeparator reports whether the rune is a word-separator character.
+// See https://www.w3.org/TR/css-text-3/#word-separator.
+func isWordSeparator(r rune) bool {
+	switch r {
+	case 0x0020, 0x00a0, 0x1361, 0x10100, 0x10101, 0x1039f, 0x1091f:
+		return true
+	}
+	return false
+}
+
 func (g *GoTextFaceSource) scale(size float64) float64 {
 	return size / float64(g.f.Upem())
 }
 
-func (g *GoTextFaceSource) getOrCreateGlyphImage(goTextFace *GoTextFace, key goTextGlyphImageCacheKey, create func() (*ebiten.Image, bool)) *ebiten.Image {
+func (g *GoTextFaceSource) getOrCreateGlyphImage(goTextFace *GoTextFace, key goTextGlyphImageCacheKey, create func() *ebiten.Image) *ebiten.Image {
+	// Glyph images rasterized at different scales must not be shared even if the sizes are the same.
+	effectiveSize := goTextFace.Size * key.rasterScale
+	n := ebiten.Tick()
+
+	g.glyphImageCacheM.Lock()
 	if g.glyphImageCache == nil {
-		g.glyphImageCache = map[float64]*cache[goTextGlyphImageCacheKey, *ebiten.Image]{}
+		g.glyphImageCache = map[float64]*cache.Cache[goTextGlyphImageCacheKey, *ebiten.Image]{}
 	}
-	if _, ok := g.glyphImageCache[goTextFace.Size]; !ok {
-		g.glyphImageCache[goTextFace.Size] = newCache[goTextGlyphImageCacheKey, *ebiten.Image](128 * glyphVariationCount(goTextFace))
+
+	// Remove the caches whose glyph images are all evicted or purged,
+	// e.g., the caches for the sizes that are no longer used after the device scale factor changes.
+	if g.glyphImageCachePruneTick != n {
+		for size, c := range g.glyphImageCache {
+			if c.Len() > 0 {
+				continue
+			}
+			delete(g.glyphImageCache, size)
+		}
+		g.glyphImageCachePruneTick = n
 	}
-	return g.glyphImageCache[goTextFace.Size].getOrCreate(key, create)
+
+	c, ok := g.glyphImageCache[effectiveSize]
+	if !ok {
+		c = newGlyphImageCache[goTextGlyphImageCacheKey](128 * glyphVariationCount(goTextFace))
+		g.glyphImageCache[effectiveSize] = c
+	}
+	g.glyphImageCacheM.Unlock()
+
+	return c.GetOrAdd(key, create)
 }
 
 type singleFontmap struct {
@@ -147,3 +147,117 @@ func appendVectorPathFromSegments(path *vector.Path, segs []opentype.Segment, x,
 	}
 	path.Close()
 }
+
+// obliqueSegments slants the segments by shearing them horizontally in place.
+// A positive slant leans the glyphs to the right.
+func obliqueSegments(segs []opentype.Segment, slant float32) {
+	for i := range segs {
+		for j := range segs[i].Args {
+			// Y is downward in the scaled segments.
+			segs[i].Args[j].X -= segs[i].Args[j].Y * slant
+		}
+	}
+}
+
+type segmentPointIndex struct {
+	seg int
+	arg int
+}
+
+// emboldenSegments dilates the outlines of the segments in place by strength in total.
+// Each point is moved along the bisector of its adjacent edges' normals by strength/2,
+// in the same way as FreeType's FT_Outline_Embolden.
+func emboldenSegments(segs []opentype.Segment, strength float32) {
+	if strength == 0 {
+		return
+	}
+
+	// Split the points into contours. Control points are treated as polygon vertices.
+	var contours [][]segmentPointIndex
+	for i, seg := range segs {
+		if seg.Op == opentype.SegmentOpMoveTo || len(contours) == 0 {
+			contours = append(contours, nil)
+		}
+		c := &contours[len(contours)-1]
+		for j := range seg.ArgsSlice() {
+			*c = append(*c, segmentPointIndex{seg: i, arg: j})
+		}
+	}
+
+	point := func(idx segmentPointIndex) opentype.SegmentPoint {
+		return segs[idx.seg].Args[idx.arg]
+	}
+
+	// Determine the orientation of the whole outline so that outer contours grow and holes shrink.
+	var area float32
+	for _, c := range contours {
+		for i := range c {
+			p, q := point(c[i]), point(c[(i+1)%len(c)])
+			area += p.X*q.Y - q.X*p.Y
+		}
+	}
+	if area == 0 {
+		return
+	}
+	orientation := float32(1)
+	if area < 0 {
+		orientation = -1
+	}
+
+	shift := strength / 2
+	shifted := make([]opentype.SegmentPoint, 0, len(segs)*3)
+	for _, c := range contours {
+		shifted = shifted[:0]
+		n := len(c)
+		for i := range c {
+			p := point(c[i])
+
+			// Find the previous and next distinct points to avoid zero-length edges.
+			var prev, next opentype.SegmentPoint
+			var okPrev, okNext bool
+			for k := 1; k < n; k++ {
+				if q := point(c[(i-k+n)%n]); q != p {
+					prev, okPrev = q, true
+					break
+				}
+			}
+			for k := 1; k < n; k++ {
+				if q := point(c[(i+k)%n]); q != p {
+					next, okNext = q, true
+					break
+				}
+			}
+			if !okPrev || !okNext {
+				shifted = append(shifted, p)
+				continue
+			}
+
+			inX, inY := normalize(p.X-prev.X, p.Y-prev.Y)
+			outX, outY := normalize(next.X-p.X, next.Y-p.Y)
+
+			// Rotate the edge directions to get the outward normals.
+			inNX, inNY := inY*orientation, -inX*orientation
+			outNX, outNY := outY*orientation, -outX*orientation
+
+			// Use the miter join, but limit the length at sharp corners.
+			d := 1 + inNX*outNX + inNY*outNY
+			if d < 1.0/16 {
+				d = 1.0 / 16
+			}
+			p.X += (inNX + outNX) * shift / d
+			p.Y += (inNY + outNY) * shift / d
+			shifted = append(shifted, p)
+		}
+		for i, idx := range c {
+			segs[idx.seg].Args[idx.arg] = shifted[i]
+		}
+	}
+}
+
+func normalize(x, y float32) (float32, float32) {
+	l := float32(math.Hypot(float64(x), float64(y)))
+	if l == 0 {
+		return 0, 0
+	}
+	return x / l, y / l
+}
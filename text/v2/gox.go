@@ -23,6 +23,7 @@ import (
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/vector"
+	"github.com/hajimehoshi/ebiten/v2/x/cache"
 )
 
 var _ Face = (*GoXFace)(nil)
@@ -43,11 +44,11 @@ type goXFaceGlyphImageCacheKey struct {
 type GoXFace struct {
 	f *faceWithCache
 
-	glyphImageCache *cache[goXFaceGlyphImageCacheKey, *ebiten.Image]
+	glyphImageCache *cache.Cache[goXFaceGlyphImageCacheKey, *ebiten.Image]
 
 	cachedMetrics Metrics
 
-	originXCache *cache[string, []fixed.Int26_6]
+	originXCache *cache.Cache[string, []fixed.Int26_6]
 
 	addr *GoXFace
 }
@@ -120,11 +121,10 @@ func (g *GoXFace) advance(text string) float64 {
 }
 
 func (g *GoXFace) originXs(text string) []fixed.Int26_6 {
-	return g.originXCache.getOrCreate(text, func() ([]fixed.Int26_6, bool) {
-		if len(text) == 0 {
-			return nil, false
-		}
-
+	if len(text) == 0 {
+		return nil
+	}
+	return g.originXCache.GetOrAdd(text, func() []fixed.Int26_6 {
 		var originXs []fixed.Int26_6
 		prevR := rune(-1)
 		var originX fixed.Int26_6
@@ -138,7 +138,7 @@ func (g *GoXFace) originXs(text string) []fixed.Int26_6 {
 			prevR = r
 		}
 		originXs = append(originXs, originX)
-		return originXs, true
+		return originXs
 	})
 }
 
@@ -167,7 +167,7 @@ func (g *GoXFace) appendGlyphsForLine(glyphs []Glyph, line string, indexOffset i
 		}
 
 		// imgX and imgY are integers so that the nearest filter can be used.
-		img, imgX, imgY := g.glyphImage(r, origin)
+		img, imgX, imgY, padding := g.glyphImage(r, origin)
 
 		// Adjust the position to the integers.
 		// The current glyph images assume that they are rendered on integer positions so far.
@@ -185,13 +185,52 @@ func (g *GoXFace) appendGlyphsForLine(glyphs []Glyph, line string, indexOffset i
 			OriginY:           fixed26_6ToFloat64(origin.Y),
 			OriginOffsetX:     0,
 			OriginOffsetY:     0,
+			Padding:           padding,
 		})
 	}
 
 	return glyphs
 }
 
-func (g *GoXFace) glyphImage(r rune, origin fixed.Point26_6) (*ebiten.Image, int, int) {
+// appendRunsForLine implements Face.
+func (g *GoXFace) appendRunsForLine(runs []Run, line string, indexOffset int) []Run {
+	if line == "" {
+		return runs
+	}
+	m := g.Metrics()
+	return append(runs, Run{
+		StartIndexInBytes: indexOffset,
+		EndIndexInBytes:   indexOffset + len(line),
+		Face:              g,
+		Ascent:            m.HAscent,
+		Descent:           m.HDescent,
+		LineGap:           m.HLineGap,
+		Advance:           g.advance(line),
+	})
+}
+
+// appendShapedGlyphsForLine implements Face.
+func (g *GoXFace) appendShapedGlyphsForLine(glyphs []ShapedGlyph, line string, indexOffset int) []ShapedGlyph {
+	g.copyCheck()
+
+	xs := g.originXs(line)
+	var prevX fixed.Int26_6
+	var idx int
+	for i := range line {
+		_, size := utf8.DecodeRuneInString(line[i:])
+		glyphs = append(glyphs, ShapedGlyph{
+			Face:              g,
+			StartIndexInBytes: indexOffset + i,
+			EndIndexInBytes:   indexOffset + i + size,
+			XAdvance:          fixed26_6ToFloat64(xs[idx] - prevX),
+		})
+		prevX = xs[idx]
+		idx++
+	}
+	return glyphs
+}
+
+func (g *GoXFace) glyphImage(r rune, origin fixed.Point26_6) (*ebiten.Image, int, int, GlyphPadding) {
 	// Assume that GoXFace's direction is always horizontal.
 	origin.X = adjustGranularity(origin.X, g)
 	origin.Y &^= ((1 << 6) - 1)
@@ -205,13 +244,12 @@ func (g *GoXFace) glyphImage(r rune, origin fixed.Point26_6) (*ebiten.Image, int
 		rune:    r,
 		xoffset: subpixelOffset.X,
 	}
-	img := g.glyphImageCache.getOrCreate(key, func() (*ebiten.Image, bool) {
-		img := g.glyphImageImpl(r, subpixelOffset, b)
-		return img, img != nil
+	img := g.glyphImageCache.GetOrAdd(key, func() *ebiten.Image {
+		return g.glyphImageImpl(r, subpixelOffset, b)
 	})
 	imgX := (origin.X + b.Min.X).Floor()
 	imgY := (origin.Y + b.Min.Y).Floor()
-	return img, imgX, imgY
+	return img, imgX, imgY, glyphPadding(img, subpixelOffset, b)
 }
 
 func (g *GoXFace) glyphImageImpl(r rune, subpixelOffset fixed.Point26_6, glyphBounds fixed.Rectangle26_6) *ebiten.Image {
@@ -0,0 +1,203 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Hyphenator finds hyphenation points in words by Liang's algorithm, which is used in TeX.
+//
+// A Hyphenator is for one language. The patterns and the exceptions for many languages are available
+// as TeX hyphenation pattern files, e.g., hyph-de-1996.pat.txt and hyph-fi.pat.txt of the hyph-utf8 project.
+//
+// A Hyphenator is concurrent-safe.
+type Hyphenator struct {
+	// LeftMin is the minimum number of characters before a hyphenation point.
+	// If LeftMin is 0, 2 is used.
+	LeftMin int
+
+	// RightMin is the minimum number of characters after a hyphenation point.
+	// If RightMin is 0, 3 is used.
+	RightMin int
+
+	// patterns maps a letter sequence to the values between the letters.
+	// The length of the values is the number of the letters + 1.
+	patterns map[string][]byte
+
+	// maxPatternLen is the maximum number of letters of the patterns.
+	maxPatternLen int
+
+	// exceptions maps a word to the hyphenation points in runes.
+	exceptions map[string][]int
+}
+
+// NewHyphenator creates a new Hyphenator from patterns and exceptions.
+//
+// patterns is a whitespace-separated list of Liang patterns like "hy3ph he2n 1na n2at".
+// A digit represents the value between two letters, and a dot represents a word boundary.
+//
+// exceptions is a whitespace-separated list of words with explicit hyphenation points like "ta-ble as-so-ciate".
+// exceptions can be empty.
+//
+// Lines starting with '%' are treated as comments.
+func NewHyphenator(patterns string, exceptions string) (*Hyphenator, error) {
+	h := &Hyphenator{
+		patterns:   map[string][]byte{},
+		exceptions: map[string][]int{},
+	}
+
+	for _, p := range hyphenationFields(patterns) {
+		var letters []rune
+		values := []byte{0}
+		for _, r := range strings.ToLower(p) {
+			if '0' <= r && r <= '9' {
+				values[len(values)-1] = byte(r - '0')
+				continue
+			}
+			if r != '.' && !unicode.IsLetter(r) && !unicode.Is(unicode.Mn, r) && r != '\'' && r != '’' {
+				return nil, fmt.Errorf("text: invalid hyphenation pattern: %q", p)
+			}
+			letters = append(letters, r)
+			values = append(values, 0)
+		}
+		if len(letters) == 0 {
+			return nil, fmt.Errorf("text: invalid hyphenation pattern: %q", p)
+		}
+		h.patterns[string(letters)] = values
+		h.maxPatternLen = max(h.maxPatternLen, len(letters))
+	}
+
+	for _, e := range hyphenationFields(exceptions) {
+		var word []rune
+		var points []int
+		for _, r := range strings.ToLower(e) {
+			if r == '-' {
+				points = append(points, len(word))
+				continue
+			}
+			word = append(word, r)
+		}
+		h.exceptions[string(word)] = points
+	}
+
+	return h, nil
+}
+
+func hyphenationFields(str string) []string {
+	var fields []string
+	for _, line := range strings.Split(str, "\n") {
+		if i := strings.IndexByte(line, '%'); i >= 0 {
+			line = line[:i]
+		}
+		fields = append(fields, strings.Fields(line)...)
+	}
+	return fields
+}
+
+// AppendHyphenationPoints appends the hyphenation points of text to points and returns the extended buffer.
+//
+// A hyphenation point is an index in bytes of text where a word can be broken with a hyphen.
+// text can include multiple words. A sequence of letters is treated as a word, and the other characters are ignored.
+// The words are matched with the patterns case-insensitively.
+func (h *Hyphenator) AppendHyphenationPoints(points []int, text string) []int {
+	var start int
+	var inWord bool
+	for i, r := range text {
+		isLetter := unicode.IsLetter(r) || unicode.Is(unicode.Mn, r)
+		if isLetter && !inWord {
+			start = i
+			inWord = true
+		} else if !isLetter && inWord {
+			points = h.appendHyphenationPointsForWord(points, text[start:i], start)
+			inWord = false
+		}
+	}
+	if inWord {
+		points = h.appendHyphenationPointsForWord(points, text[start:], start)
+	}
+	return points
+}
+
+func (h *Hyphenator) appendHyphenationPointsForWord(points []int, word string, indexOffset int) []int {
+	leftMin := h.LeftMin
+	if leftMin == 0 {
+		leftMin = 2
+	}
+	rightMin := h.RightMin
+	if rightMin == 0 {
+		rightMin = 3
+	}
+
+	// offsets[i] is the byte offset of the i-th rune in word.
+	offsets := make([]int, 0, len(word)+1)
+	for i := range word {
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(word))
+	n := len(offsets) - 1
+	if n < leftMin+rightMin {
+		return points
+	}
+
+	lower := []rune(strings.ToLower(word))
+	if len(lower) != n {
+		// The lower case has a different number of runes. Give up hyphenation.
+		return points
+	}
+
+	if ps, ok := h.exceptions[string(lower)]; ok {
+		for _, p := range ps {
+			if p >= leftMin && p <= n-rightMin {
+				points = append(points, indexOffset+offsets[p])
+			}
+		}
+		return points
+	}
+
+	// Apply the patterns to ".word.".
+	w := make([]rune, 0, n+2)
+	w = append(w, '.')
+	w = append(w, lower...)
+	w = append(w, '.')
+	values := make([]byte, len(w)+1)
+	var buf []byte
+	for i := range w {
+		for j := i + 1; j <= min(len(w), i+h.maxPatternLen); j++ {
+			buf = buf[:0]
+			for _, r := range w[i:j] {
+				buf = utf8.AppendRune(buf, r)
+			}
+			vs, ok := h.patterns[string(buf)]
+			if !ok {
+				continue
+			}
+			for k, v := range vs {
+				values[i+k] = max(values[i+k], v)
+			}
+		}
+	}
+
+	// values[k+1] is the value before the k-th rune of the word, as w has the leading dot.
+	for k := leftMin; k <= n-rightMin; k++ {
+		if values[k+1]%2 == 1 {
+			points = append(points, indexOffset+offsets[k])
+		}
+	}
+	return points
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+func TestHyphenator(t *testing.T) {
+	// The patterns from Liang's thesis.
+	h, err := text.NewHyphenator(`% A comment
+hy3ph he2n hena4 hen5at 1na n2at 1tio 2io o2n`, "ta-ble")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		In   string
+		Want []int
+	}{
+		{
+			In:   "hyphenation",
+			Want: []int{2, 6},
+		},
+		{
+			In:   "Hyphenation",
+			Want: []int{2, 6},
+		},
+		{
+			In:   "(hyphenation, table)",
+			Want: []int{3, 7, 16},
+		},
+		{
+			In:   "hyp",
+			Want: nil,
+		},
+	}
+	for _, tc := range testCases {
+		if got := h.AppendHyphenationPoints(nil, tc.In); !slices.Equal(got, tc.Want) {
+			t.Errorf("AppendHyphenationPoints(%q): got: %v, want: %v", tc.In, got, tc.Want)
+		}
+	}
+
+	if _, err := text.NewHyphenator("a1b 1-", ""); err == nil {
+		t.Errorf("NewHyphenator with an invalid pattern: got: nil, want: an error")
+	}
+}
@@ -39,6 +39,18 @@ const (
 type DrawOptions struct {
 	ebiten.DrawImageOptions
 	LayoutOptions
+
+	// Fill specifies how to fill glyphs.
+	// If Fill is specified, the fill color is multiplied by DrawImageOptions.ColorScale,
+	// and DrawImageOptions.ColorM is ignored.
+	//
+	// The default (nil) value fills glyphs with the color specified by DrawImageOptions.ColorScale.
+	Fill Fill
+
+	// Background specifies a box drawn behind the text.
+	//
+	// The default (nil) value draws no box.
+	Background *Background
 }
 
 // LayoutOptions represents options for layouting texts.
@@ -111,14 +123,24 @@ func Draw(dst *ebiten.Image, text string, face Face, options *DrawOptions) {
 		drawOp = options.DrawImageOptions
 	}
 
+	if options != nil && options.Background != nil {
+		region := renderingRegion(text, face, &layoutOp)
+		options.Background.draw(dst, region, &drawOp)
+	}
+
+	if options != nil && options.Fill != nil {
+		region := renderingRegion(text, face, &layoutOp)
+		options.Fill.draw(dst, AppendGlyphs(nil, text, face, &layoutOp), region, &drawOp)
+		return
+	}
+
 	geoM := drawOp.GeoM
 
 	for _, g := range AppendGlyphs(nil, text, face, &layoutOp) {
 		if g.Image == nil {
 			continue
 		}
-		drawOp.GeoM.Reset()
-		drawOp.GeoM.Translate(g.X, g.Y)
+		drawOp.GeoM = g.imageGeoM()
 		drawOp.GeoM.Concat(geoM)
 		dst.DrawImage(g.Image, &drawOp)
 	}
@@ -203,7 +225,7 @@ func forEachLine(text string, face Face, options *LayoutOptions, f func(text str
 	// Adjust the offset based on the secondary alignments.
 	h, v := calcAligns(d, options.PrimaryAlign, options.SecondaryAlign)
 	switch d {
-	case DirectionLeftToRight, DirectionRightToLeft:
+	case DirectionLeftToRight, DirectionRightToLeft, DirectionAuto:
 		offsetY += m.HAscent
 		switch v {
 		case verticalAlignTop:
@@ -242,7 +264,9 @@ func forEachLine(text string, face Face, options *LayoutOptions, f func(text str
 
 		// Adjust the origin position based on the primary alignments.
 		switch d {
-		case DirectionLeftToRight, DirectionRightToLeft:
+		case DirectionLeftToRight, DirectionRightToLeft, DirectionAuto:
+			// With DirectionAuto, the alignments depend on the direction of each line.
+			h, _ := calcAligns(resolveDirection(d, line), options.PrimaryAlign, options.SecondaryAlign)
 			switch h {
 			case horizontalAlignLeft:
 				originX = 0
@@ -272,10 +296,8 @@ func forEachLine(text string, face Face, options *LayoutOptions, f func(text str
 		i++
 
 		// Advance the origin position in the secondary direction.
-		switch face.direction() {
-		case DirectionLeftToRight:
-			originY += options.LineSpacing
-		case DirectionRightToLeft:
+		switch d {
+		case DirectionLeftToRight, DirectionRightToLeft, DirectionAuto:
 			originY += options.LineSpacing
 		case DirectionTopToBottomAndLeftToRight:
 			originX += options.LineSpacing
@@ -306,7 +328,7 @@ func calcAligns(direction Direction, primaryAlign, secondaryAlign Align) (horizo
 	var v verticalAlign
 
 	switch direction {
-	case DirectionLeftToRight:
+	case DirectionLeftToRight, DirectionAuto:
 		switch primaryAlign {
 		case AlignStart:
 			h = horizontalAlignLeft
@@ -61,6 +61,16 @@ func (l *LimitedFace) appendGlyphsForLine(glyphs []Glyph, line string, indexOffs
 	return l.face.appendGlyphsForLine(glyphs, l.unicodeRanges.filter(line), indexOffset, originX, originY)
 }
 
+// appendRunsForLine implements Face.
+func (l *LimitedFace) appendRunsForLine(runs []Run, line string, indexOffset int) []Run {
+	return l.face.appendRunsForLine(runs, l.unicodeRanges.filter(line), indexOffset)
+}
+
+// appendShapedGlyphsForLine implements Face.
+func (l *LimitedFace) appendShapedGlyphsForLine(glyphs []ShapedGlyph, line string, indexOffset int) []ShapedGlyph {
+	return l.face.appendShapedGlyphsForLine(glyphs, l.unicodeRanges.filter(line), indexOffset)
+}
+
 // appendVectorPathForLine implements Face.
 func (l *LimitedFace) appendVectorPathForLine(path *vector.Path, line string, originX, originY float64) {
 	l.face.appendVectorPathForLine(path, l.unicodeRanges.filter(line), originX, originY)
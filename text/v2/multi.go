@@ -127,6 +127,34 @@ func (m *MultiFace) appendGlyphsForLine(glyphs []Glyph, line string, indexOffset
 	return glyphs
 }
 
+// appendRunsForLine implements Face.
+func (m *MultiFace) appendRunsForLine(runs []Run, line string, indexOffset int) []Run {
+	for _, c := range m.splitText(line) {
+		if c.faceIndex == -1 {
+			continue
+		}
+		f := m.faces[c.faceIndex]
+		t := line[c.textStartIndex:c.textEndIndex]
+		runs = f.appendRunsForLine(runs, t, indexOffset)
+		indexOffset += len(t)
+	}
+	return runs
+}
+
+// appendShapedGlyphsForLine implements Face.
+func (m *MultiFace) appendShapedGlyphsForLine(glyphs []ShapedGlyph, line string, indexOffset int) []ShapedGlyph {
+	for _, c := range m.splitText(line) {
+		if c.faceIndex == -1 {
+			continue
+		}
+		f := m.faces[c.faceIndex]
+		t := line[c.textStartIndex:c.textEndIndex]
+		glyphs = f.appendShapedGlyphsForLine(glyphs, t, indexOffset)
+		indexOffset += len(t)
+	}
+	return glyphs
+}
+
 // appendVectorPathForLine implements Face.
 func (m *MultiFace) appendVectorPathForLine(path *vector.Path, line string, originX, originY float64) {
 	for _, c := range m.splitText(line) {
@@ -16,6 +16,7 @@ package text_test
 
 import (
 	"bytes"
+	"math"
 	"testing"
 
 	"github.com/hajimehoshi/bitmapfont/v3"
@@ -61,3 +62,57 @@ func TestMultiFaceFallback(t *testing.T) {
 		t.Errorf("got: %d, want: %d", len(got), len(want))
 	}
 }
+
+func TestMultiFaceAppendRuns(t *testing.T) {
+	goTextFaceSource, err := text.NewGoTextFaceSource(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatal(err)
+	}
+	goTextFace := &text.GoTextFace{
+		Source: goTextFaceSource,
+		Size:   24,
+	}
+	goXFace := text.NewGoXFace(bitmapfont.Face)
+	f, err := text.NewMultiFace(goTextFace, goXFace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// goregular doesn't have glyphs for Japanese.
+	const str = "Hello, 世界"
+	runs := text.AppendRuns(nil, str, f)
+	if got, want := len(runs), 2; got != want {
+		t.Fatalf("len(runs): got: %d, want: %d", got, want)
+	}
+
+	if got, want := runs[0].Face, text.Face(goTextFace); got != want {
+		t.Errorf("runs[0].Face: got: %v, want: %v", got, want)
+	}
+	if got, want := runs[0].StartIndexInBytes, 0; got != want {
+		t.Errorf("runs[0].StartIndexInBytes: got: %d, want: %d", got, want)
+	}
+	if got, want := runs[0].EndIndexInBytes, len("Hello, "); got != want {
+		t.Errorf("runs[0].EndIndexInBytes: got: %d, want: %d", got, want)
+	}
+	// The run's metrics are calculated in 26.6 fixed-point numbers.
+	if got, want := runs[0].Ascent, goTextFace.Metrics().HAscent; math.Abs(got-want) > 1.0/64 {
+		t.Errorf("runs[0].Ascent: got: %f, want: %f", got, want)
+	}
+
+	if got, want := runs[1].Face, text.Face(goXFace); got != want {
+		t.Errorf("runs[1].Face: got: %v, want: %v", got, want)
+	}
+	if got, want := runs[1].StartIndexInBytes, len("Hello, "); got != want {
+		t.Errorf("runs[1].StartIndexInBytes: got: %d, want: %d", got, want)
+	}
+	if got, want := runs[1].EndIndexInBytes, len(str); got != want {
+		t.Errorf("runs[1].EndIndexInBytes: got: %d, want: %d", got, want)
+	}
+	if got, want := runs[1].Ascent, goXFace.Metrics().HAscent; got != want {
+		t.Errorf("runs[1].Ascent: got: %f, want: %f", got, want)
+	}
+
+	if got, want := runs[0].Advance+runs[1].Advance, text.Advance(str, f); got != want {
+		t.Errorf("the total advance: got: %f, want: %f", got, want)
+	}
+}
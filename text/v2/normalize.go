@@ -0,0 +1,167 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalization represents a Unicode normalization applied to texts before shaping.
+type Normalization int
+
+const (
+	// NormalizationNone doesn't normalize texts.
+	NormalizationNone Normalization = iota
+
+	// NormalizationNFC normalizes texts to NFC (Normalization Form C).
+	//
+	// NFC unifies logically identical strings, e.g., 'é' as one rune and 'e' followed by a combining acute accent,
+	// so that they are rendered in the same way and share the same shaping cache.
+	//
+	// NFC converts a CJK compatibility ideograph to its unified ideograph, which might have a different glyph.
+	// In order to keep the glyph, a CJK compatibility ideograph is converted to the corresponding standardized variation sequence,
+	// i.e., the unified ideograph followed by a variation selector, which is rendered with the original glyph if the font supports it.
+	NormalizationNFC
+)
+
+// normalizedText is a normalized text with the mapping from the normalized text's indices to the original text's indices.
+type normalizedText struct {
+	text     string
+	origLen  int
+	segments []normalizedSegment
+}
+
+// normalizedSegment is a segment of a normalized text.
+// A segment boundary is a boundary in both the normalized text and the original text.
+type normalizedSegment struct {
+	origStart int
+	start     int
+}
+
+// normalizeText normalizes the text.
+// normalizeText returns false if the text doesn't have to be normalized.
+func normalizeText(text string, normalization Normalization) (normalizedText, bool) {
+	if normalization != NormalizationNFC {
+		return normalizedText{}, false
+	}
+	// A CJK compatibility ideograph is not NFC, so IsNormalString returns false for a text with it.
+	if norm.NFC.IsNormalString(text) {
+		return normalizedText{}, false
+	}
+
+	var buf strings.Builder
+	var segs []normalizedSegment
+	appendNFC := func(str string, origOffset int) {
+		var it norm.Iter
+		it.InitString(norm.NFC, str)
+		for !it.Done() {
+			segs = append(segs, normalizedSegment{
+				origStart: origOffset + it.Pos(),
+				start:     buf.Len(),
+			})
+			buf.Write(it.Next())
+		}
+	}
+
+	var start int
+	for i, r := range text {
+		vs, ok := cjkCompatibilityVariationSequences()[r]
+		if !ok {
+			continue
+		}
+		appendNFC(text[start:i], start)
+		segs = append(segs, normalizedSegment{
+			origStart: i,
+			start:     buf.Len(),
+		})
+		buf.WriteString(vs)
+		start = i + utf8.RuneLen(r)
+	}
+	appendNFC(text[start:], start)
+
+	return normalizedText{
+		text:     buf.String(),
+		origLen:  len(text),
+		segments: segs,
+	}, true
+}
+
+// segmentIndex returns the index of the segment including the index in the normalized text.
+func (n *normalizedText) segmentIndex(index int) int {
+	lo, hi := 0, len(n.segments)
+	for lo < hi {
+		m := (lo + hi) / 2
+		if n.segments[m].start <= index {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	return lo - 1
+}
+
+// origStartIndex converts a start index in the normalized text to the index in the original text.
+func (n *normalizedText) origStartIndex(index int) int {
+	if index >= len(n.text) {
+		return n.origLen
+	}
+	i := n.segmentIndex(index)
+	if i < 0 {
+		return 0
+	}
+	return n.segments[i].origStart
+}
+
+// origEndIndex converts an end index in the normalized text to the index in the original text.
+func (n *normalizedText) origEndIndex(index int) int {
+	if index >= len(n.text) {
+		return n.origLen
+	}
+	i := n.segmentIndex(index)
+	if i < 0 {
+		return 0
+	}
+	if n.segments[i].start == index {
+		return n.segments[i].origStart
+	}
+	if i+1 < len(n.segments) {
+		return n.segments[i+1].origStart
+	}
+	return n.origLen
+}
+
+var cjkCompatibilityVariationSequences = sync.OnceValue(func() map[rune]string {
+	// Each CJK compatibility ideograph with a canonical decomposition has a standardized variation sequence.
+	// The variation selectors are assigned in the code point order of the compatibility ideographs for each unified ideograph,
+	// e.g., U+F914, U+F95C, and U+F9BF correspond to U+6A02 with U+FE00, U+FE01, and U+FE02 respectively.
+	m := map[rune]string{}
+	counts := map[rune]int{}
+	for _, rng := range [][2]rune{{0xf900, 0xfaff}, {0x2f800, 0x2fa1f}} {
+		for r := rng[0]; r <= rng[1]; r++ {
+			d := norm.NFD.String(string(r))
+			base, size := utf8.DecodeRuneInString(d)
+			if d == string(r) || size != len(d) {
+				continue
+			}
+			m[r] = string([]rune{base, 0xfe00 + rune(counts[base])})
+			counts[base]++
+		}
+	}
+	return m
+})
@@ -0,0 +1,341 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// RubyRun represents a run of a base text and its ruby annotation text.
+//
+// Ruby (e.g. furigana in Japanese) is a small annotation text put along with its base text to show e.g. the reading of the base text.
+type RubyRun struct {
+	// Base is the base text.
+	//
+	// Base can include '\n' to put the following text on the next line only when Ruby is empty.
+	Base string
+
+	// Ruby is the annotation text for Base.
+	// If Ruby is empty, Base is rendered without annotations.
+	Ruby string
+}
+
+// RubyLayoutOptions represents options for layouting texts with ruby annotations.
+type RubyLayoutOptions struct {
+	LayoutOptions
+
+	// RubyGap is the distance between the base text and the ruby text.
+	// The unit is in pixels.
+	RubyGap float64
+}
+
+// RubyDrawOptions represents options for the DrawRuby function.
+//
+// RubyDrawOptions embeds ebiten.DrawImageOptions.
+// For the details, see DrawOptions.
+type RubyDrawOptions struct {
+	ebiten.DrawImageOptions
+	RubyLayoutOptions
+}
+
+// DrawRuby draws the given runs with ruby annotations on a given destination image dst.
+// face is the font for base texts, and rubyFace is the font for ruby texts.
+// rubyFace is usually a smaller face with the same direction as face.
+//
+// For a horizontal-direction face, a ruby text is put above its base text.
+// For a vertical-direction face, a ruby text is put on the right side of its base text.
+//
+// A ruby text is centered on its base text.
+// If a ruby text is longer than its base text, the base text is centered in the ruby text's length,
+// and the following texts are shifted so that ruby texts don't overlap with each other.
+//
+// The rendering region includes the area for ruby texts if at least one run has a ruby text.
+// LayoutOptions.LineSpacing should be large enough to include ruby texts between two adjacent lines.
+//
+// For the other details, see Draw.
+//
+// DrawRuby is concurrent-safe.
+func DrawRuby(dst *ebiten.Image, runs []RubyRun, face, rubyFace Face, options *RubyDrawOptions) {
+	var layoutOp RubyLayoutOptions
+	var drawOp ebiten.DrawImageOptions
+
+	if options != nil {
+		layoutOp = options.RubyLayoutOptions
+		drawOp = options.DrawImageOptions
+	}
+
+	geoM := drawOp.GeoM
+
+	for _, g := range AppendRubyGlyphs(nil, runs, face, rubyFace, &layoutOp) {
+		if g.Image == nil {
+			continue
+		}
+		drawOp.GeoM = g.imageGeoM()
+		drawOp.GeoM.Concat(geoM)
+		dst.DrawImage(g.Image, &drawOp)
+	}
+}
+
+// AppendRubyGlyphs appends glyphs for the given runs with ruby annotations to the given slice and returns a slice.
+//
+// StartIndexInBytes and EndIndexInBytes of a base text glyph are the indices in the concatenated string of all the base texts.
+// StartIndexInBytes and EndIndexInBytes of a ruby text glyph are the indices of its whole base text in the concatenated string.
+// This is useful e.g. to show a ruby text along with its base text in a typewriter effect.
+//
+// For the details of options, see DrawRuby function.
+//
+// AppendRubyGlyphs is concurrent-safe.
+func AppendRubyGlyphs(glyphs []Glyph, runs []RubyRun, face, rubyFace Face, options *RubyLayoutOptions) []Glyph {
+	if options == nil {
+		options = &RubyLayoutOptions{}
+	}
+
+	lines, hasRuby := splitRubyRunsIntoLines(runs)
+	if len(lines) == 0 {
+		return glyphs
+	}
+
+	// Calculate the advances for each segment and each line.
+	var longestAdvance float64
+	for i := range lines {
+		l := &lines[i]
+		for j := range l.segments {
+			s := &l.segments[j]
+			s.baseAdvance = face.advance(s.base)
+			s.advance = s.baseAdvance
+			if s.ruby != "" {
+				s.rubyAdvance = rubyFace.advance(s.ruby)
+				s.advance = max(s.advance, s.rubyAdvance)
+			}
+			l.advance += s.advance
+		}
+		longestAdvance = max(longestAdvance, l.advance)
+	}
+
+	d := face.direction()
+	m := face.Metrics()
+	rm := rubyFace.Metrics()
+
+	// rubyExtent is the size of the ruby texts in the secondary direction.
+	var rubyExtent float64
+	if hasRuby {
+		if d.isHorizontal() {
+			rubyExtent = options.RubyGap + rm.HAscent + rm.HDescent
+		} else {
+			rubyExtent = options.RubyGap + rm.VAscent + rm.VDescent
+		}
+	}
+
+	var boundaryWidth, boundaryHeight float64
+	if d.isHorizontal() {
+		boundaryWidth = longestAdvance
+		boundaryHeight = float64(len(lines)-1)*options.LineSpacing + m.HAscent + m.HDescent + rubyExtent
+	} else {
+		boundaryWidth = float64(len(lines)-1)*options.LineSpacing + m.VAscent + m.VDescent + rubyExtent
+		boundaryHeight = longestAdvance
+	}
+
+	var offsetX, offsetY float64
+
+	// Adjust the offset based on the secondary alignments.
+	// Ruby texts are put on the top side for horizontal texts, and on the right side for vertical texts.
+	h, v := calcAligns(d, options.PrimaryAlign, options.SecondaryAlign)
+	switch d {
+	case DirectionLeftToRight, DirectionRightToLeft, DirectionAuto:
+		offsetY += m.HAscent + rubyExtent
+		switch v {
+		case verticalAlignTop:
+		case verticalAlignCenter:
+			offsetY -= boundaryHeight / 2
+		case verticalAlignBottom:
+			offsetY -= boundaryHeight
+		}
+	case DirectionTopToBottomAndLeftToRight:
+		offsetX += m.VDescent
+		switch h {
+		case horizontalAlignLeft:
+		case horizontalAlignCenter:
+			offsetX -= boundaryWidth / 2
+		case horizontalAlignRight:
+			offsetX -= boundaryWidth
+		}
+	case DirectionTopToBottomAndRightToLeft:
+		offsetX -= m.VAscent + rubyExtent
+		switch h {
+		case horizontalAlignLeft:
+			offsetX += boundaryWidth
+		case horizontalAlignCenter:
+			offsetX += boundaryWidth / 2
+		case horizontalAlignRight:
+		}
+	}
+
+	var originX, originY float64
+	for i, l := range lines {
+		// With DirectionAuto, the direction is resolved for each line.
+		ld := d
+		if d == DirectionAuto {
+			ld = resolveDirection(d, l.text())
+		}
+
+		// Adjust the origin position based on the primary alignments.
+		switch d {
+		case DirectionLeftToRight, DirectionRightToLeft, DirectionAuto:
+			h, _ := calcAligns(ld, options.PrimaryAlign, options.SecondaryAlign)
+			switch h {
+			case horizontalAlignLeft:
+				originX = 0
+			case horizontalAlignCenter:
+				originX = -l.advance / 2
+			case horizontalAlignRight:
+				originX = -l.advance
+			}
+		case DirectionTopToBottomAndLeftToRight, DirectionTopToBottomAndRightToLeft:
+			switch v {
+			case verticalAlignTop:
+				originY = 0
+			case verticalAlignCenter:
+				originY = -l.advance / 2
+			case verticalAlignBottom:
+				originY = -l.advance
+			}
+		}
+
+		var pos float64
+		for _, s := range l.segments {
+			// start is the start position of the segment in the primary direction.
+			start := pos
+			if ld == DirectionRightToLeft {
+				start = l.advance - pos - s.advance
+			}
+			pos += s.advance
+
+			baseOffset := start + (s.advance-s.baseAdvance)/2
+			rubyOffset := start + (s.advance-s.rubyAdvance)/2
+
+			x, y := originX+offsetX, originY+offsetY
+			if d.isHorizontal() {
+				glyphs = face.appendGlyphsForLine(glyphs, s.base, s.indexOffset, x+baseOffset, y)
+			} else {
+				glyphs = face.appendGlyphsForLine(glyphs, s.base, s.indexOffset, x, y+baseOffset)
+			}
+
+			if s.ruby == "" {
+				continue
+			}
+
+			// Let all the ruby glyphs have the indices of the base text.
+			n := len(glyphs)
+			if d.isHorizontal() {
+				glyphs = rubyFace.appendGlyphsForLine(glyphs, s.ruby, 0, x+rubyOffset, y-m.HAscent-options.RubyGap-rm.HDescent)
+			} else {
+				glyphs = rubyFace.appendGlyphsForLine(glyphs, s.ruby, 0, x+m.VAscent+options.RubyGap+rm.VDescent, y+rubyOffset)
+			}
+			for j := n; j < len(glyphs); j++ {
+				glyphs[j].StartIndexInBytes = s.indexOffset
+				glyphs[j].EndIndexInBytes = s.indexOffset + len(s.base)
+			}
+		}
+
+		if i == len(lines)-1 {
+			break
+		}
+
+		// Advance the origin position in the secondary direction.
+		switch d {
+		case DirectionLeftToRight, DirectionRightToLeft, DirectionAuto:
+			originY += options.LineSpacing
+		case DirectionTopToBottomAndLeftToRight:
+			originX += options.LineSpacing
+		case DirectionTopToBottomAndRightToLeft:
+			originX -= options.LineSpacing
+		}
+	}
+
+	return glyphs
+}
+
+type rubyLine struct {
+	segments []rubySegment
+	advance  float64
+}
+
+// text returns the concatenated base texts of the line.
+func (r *rubyLine) text() string {
+	var b strings.Builder
+	for _, s := range r.segments {
+		b.WriteString(s.base)
+	}
+	return b.String()
+}
+
+type rubySegment struct {
+	base        string
+	ruby        string
+	indexOffset int
+
+	advance     float64
+	baseAdvance float64
+	rubyAdvance float64
+}
+
+// splitRubyRunsIntoLines splits the runs into lines at '\n'.
+// splitRubyRunsIntoLines also reports whether at least one run has a ruby text.
+func splitRubyRunsIntoLines(runs []RubyRun) ([]rubyLine, bool) {
+	if len(runs) == 0 {
+		return nil, false
+	}
+
+	var hasRuby bool
+	lines := []rubyLine{{}}
+	var indexOffset int
+	for _, r := range runs {
+		if r.Ruby != "" {
+			if strings.Contains(r.Base, "\n") {
+				panic("text: RubyRun.Base must not include '\\n' when RubyRun.Ruby is not empty")
+			}
+			hasRuby = true
+			l := &lines[len(lines)-1]
+			l.segments = append(l.segments, rubySegment{
+				base:        r.Base,
+				ruby:        r.Ruby,
+				indexOffset: indexOffset,
+			})
+			indexOffset += len(r.Base)
+			continue
+		}
+
+		for t := r.Base; ; {
+			line, rest, found := strings.Cut(t, "\n")
+			if line != "" {
+				l := &lines[len(lines)-1]
+				l.segments = append(l.segments, rubySegment{
+					base:        line,
+					indexOffset: indexOffset,
+				})
+			}
+			indexOffset += len(line)
+			if !found {
+				break
+			}
+			indexOffset++
+			lines = append(lines, rubyLine{})
+			t = rest
+		}
+	}
+	return lines, hasRuby
+}
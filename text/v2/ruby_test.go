@@ -0,0 +1,75 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text_test
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/bitmapfont/v3"
+
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+func TestAppendRubyGlyphs(t *testing.T) {
+	f := text.NewGoXFace(bitmapfont.Face)
+	runs := []text.RubyRun{
+		{
+			Base: "a",
+			Ruby: "xyz",
+		},
+		{
+			Base: "b",
+		},
+	}
+	gs := text.AppendRubyGlyphs(nil, runs, f, f, nil)
+	if got, want := len(gs), 5; got != want {
+		t.Fatalf("len(gs): got: %d, want: %d", got, want)
+	}
+
+	base0, ruby0, ruby2, base1 := gs[0], gs[1], gs[3], gs[4]
+
+	// The ruby glyphs have the indices of the base text.
+	for _, g := range gs[:4] {
+		if got, want := g.StartIndexInBytes, 0; got != want {
+			t.Errorf("g.StartIndexInBytes: got: %d, want: %d", got, want)
+		}
+		if got, want := g.EndIndexInBytes, 1; got != want {
+			t.Errorf("g.EndIndexInBytes: got: %d, want: %d", got, want)
+		}
+	}
+	if got, want := base1.StartIndexInBytes, 1; got != want {
+		t.Errorf("base1.StartIndexInBytes: got: %d, want: %d", got, want)
+	}
+
+	// The ruby text is above the base text.
+	if ruby0.OriginY >= base0.OriginY {
+		t.Errorf("ruby0.OriginY (%f) must be less than base0.OriginY (%f)", ruby0.OriginY, base0.OriginY)
+	}
+
+	// The base text is centered in the longer ruby text.
+	a := text.Advance("a", f)
+	rubyAdvance := text.Advance("xyz", f)
+	if got, want := base0.OriginX-ruby0.OriginX, (rubyAdvance-a)/2; got != want {
+		t.Errorf("base0.OriginX - ruby0.OriginX: got: %f, want: %f", got, want)
+	}
+	if ruby2.OriginX >= base1.OriginX {
+		t.Errorf("ruby2.OriginX (%f) must be less than base1.OriginX (%f)", ruby2.OriginX, base1.OriginX)
+	}
+
+	// The following text is shifted so that the ruby texts don't overlap.
+	if got, want := base1.OriginX-ruby0.OriginX, rubyAdvance; got != want {
+		t.Errorf("base1.OriginX - ruby0.OriginX: got: %f, want: %f", got, want)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"unicode/utf8"
+
+	"github.com/go-text/typesetting/segmenter"
+)
+
+// AppendGraphemeClusters appends the grapheme clusters of text to clusters and returns the extended buffer.
+//
+// A grapheme cluster is what a user perceives as a single character, e.g., an emoji with modifiers,
+// a regional indicator pair representing a flag, or a Hangul syllable composed of conjoining jamo.
+// Grapheme clusters are determined by the extended grapheme cluster boundaries of Unicode Standard Annex #29.
+//
+// Each appended string is a substring of text. Concatenating all the appended strings results in text.
+//
+// AppendGraphemeClusters is useful for e.g. a typewriter effect that shows a text character by character.
+func AppendGraphemeClusters(clusters []string, text string) []string {
+	runes, offsets := runesAndByteOffsets(text)
+	var seg segmenter.Segmenter
+	seg.Init(runes)
+	iter := seg.GraphemeIterator()
+	for iter.Next() {
+		g := iter.Grapheme()
+		clusters = append(clusters, text[offsets[g.Offset]:offsets[g.Offset+len(g.Text)]])
+	}
+	return clusters
+}
+
+// AppendWords appends the words of text to words and returns the extended buffer.
+//
+// Words are determined by the word boundaries of Unicode Standard Annex #29.
+// Segments between words, like spaces and punctuation marks, are not appended.
+// Note that the word boundaries are not tailored for specific languages.
+// For example, a sequence of Japanese Kanji is treated as one word.
+//
+// Each appended string is a substring of text.
+func AppendWords(words []string, text string) []string {
+	runes, offsets := runesAndByteOffsets(text)
+	var seg segmenter.Segmenter
+	seg.Init(runes)
+	iter := seg.WordIterator()
+	for iter.Next() {
+		w := iter.Word()
+		words = append(words, text[offsets[w.Offset]:offsets[w.Offset+len(w.Text)]])
+	}
+	return words
+}
+
+// AppendLineBreakSegments appends the segments of text delimited by line break opportunities to segments
+// and returns the extended buffer.
+//
+// Line break opportunities are determined by the line breaking algorithm of Unicode Standard Annex #14.
+// A line can be broken at the end of each segment.
+// A segment ending with a mandatory line break like '\n' includes the line break.
+//
+// Each appended string is a substring of text. Concatenating all the appended strings results in text.
+func AppendLineBreakSegments(segments []string, text string) []string {
+	runes, offsets := runesAndByteOffsets(text)
+	var seg segmenter.Segmenter
+	seg.Init(runes)
+	iter := seg.LineIterator()
+	for iter.Next() {
+		l := iter.Line()
+		segments = append(segments, text[offsets[l.Offset]:offsets[l.Offset+len(l.Text)]])
+	}
+	return segments
+}
+
+// runesAndByteOffsets returns the runes of text and the byte offsets of the runes in text.
+// The length of the offsets is the length of the runes + 1, and the last offset is the length of text.
+func runesAndByteOffsets(text string) ([]rune, []int) {
+	n := utf8.RuneCountInString(text)
+	runes := make([]rune, 0, n)
+	offsets := make([]int, 0, n+1)
+	for i, r := range text {
+		runes = append(runes, r)
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(text))
+	return runes, offsets
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+func TestAppendGraphemeClusters(t *testing.T) {
+	testCases := []struct {
+		In   string
+		Want []string
+	}{
+		{
+			In:   "",
+			Want: nil,
+		},
+		{
+			In:   "abc",
+			Want: []string{"a", "b", "c"},
+		},
+		{
+			In:   "é!",
+			Want: []string{"é", "!"},
+		},
+		{
+			// A family emoji with ZWJs.
+			In:   "\U0001F468‍\U0001F469‍\U0001F467a",
+			Want: []string{"\U0001F468‍\U0001F469‍\U0001F467", "a"},
+		},
+		{
+			// Flags with regional indicators.
+			In:   "\U0001F1EF\U0001F1F5\U0001F1FA\U0001F1F8",
+			Want: []string{"\U0001F1EF\U0001F1F5", "\U0001F1FA\U0001F1F8"},
+		},
+		{
+			// Hangul syllables with conjoining jamo.
+			In:   "각가",
+			Want: []string{"각", "가"},
+		},
+		{
+			In:   "a\r\nb",
+			Want: []string{"a", "\r\n", "b"},
+		},
+		{
+			// An invalid UTF-8 sequence.
+			In:   "a\xffb",
+			Want: []string{"a", "\xff", "b"},
+		},
+	}
+	for _, tc := range testCases {
+		got := text.AppendGraphemeClusters(nil, tc.In)
+		if !slices.Equal(got, tc.Want) {
+			t.Errorf("text.AppendGraphemeClusters(nil, %q): got: %q, want: %q", tc.In, got, tc.Want)
+		}
+	}
+}
+
+func TestAppendWords(t *testing.T) {
+	testCases := []struct {
+		In   string
+		Want []string
+	}{
+		{
+			In:   "",
+			Want: nil,
+		},
+		{
+			In:   "Hello, world!",
+			Want: []string{"Hello", "world"},
+		},
+		{
+			In:   "  can't stop 3.14  ",
+			Want: []string{"can't", "stop", "3.14"},
+		},
+	}
+	for _, tc := range testCases {
+		got := text.AppendWords(nil, tc.In)
+		if !slices.Equal(got, tc.Want) {
+			t.Errorf("text.AppendWords(nil, %q): got: %q, want: %q", tc.In, got, tc.Want)
+		}
+	}
+}
+
+func TestAppendLineBreakSegments(t *testing.T) {
+	testCases := []struct {
+		In   string
+		Want []string
+	}{
+		{
+			In:   "",
+			Want: nil,
+		},
+		{
+			In:   "Hello, world!",
+			Want: []string{"Hello, ", "world!"},
+		},
+		{
+			In:   "a b\nc",
+			Want: []string{"a ", "b\n", "c"},
+		},
+	}
+	for _, tc := range testCases {
+		got := text.AppendLineBreakSegments(nil, tc.In)
+		if !slices.Equal(got, tc.Want) {
+			t.Errorf("text.AppendLineBreakSegments(nil, %q): got: %q, want: %q", tc.In, got, tc.Want)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// DrawWithShaderOptions represents options for DrawWithShader.
+type DrawWithShaderOptions struct {
+	// GeoM is a geometry matrix to draw the text.
+	// The default (zero) value is identity, which draws the text at (0, 0).
+	GeoM ebiten.GeoM
+
+	// ColorScale is a scale of color, which is passed to the shader as the vertex color.
+	// ColorScale is applied to premultiplied-alpha colors.
+	// The default (zero) value is identity, which is (1, 1, 1, 1).
+	ColorScale ebiten.ColorScale
+
+	// Blend is a blending way of the source color and the destination color.
+	// The default (zero) value is the regular alpha blending.
+	Blend ebiten.Blend
+
+	// Uniforms is a set of uniform variables for the shader.
+	// See ebiten.DrawTrianglesShaderOptions.Uniforms for details.
+	Uniforms map[string]any
+
+	// Images is a set of the source images.
+	// Images[0] is always replaced with each glyph image, and the other images are passed to the shader as they are.
+	Images [4]*ebiten.Image
+
+	LayoutOptions
+}
+
+// DrawWithShader draws a given text on a given destination image dst with a given shader.
+//
+// Each glyph is rendered as a quad with the glyph image as the source image 0.
+// The glyph images are the ones in the glyph cache, the same as Draw uses.
+// As the glyph image is a grayscale image, the coverage of the glyph can be read from any channel of the source image 0.
+//
+// The shader receives the following values in addition to the regular arguments:
+//
+//   - custom.xy (Vertex.Custom0 and Custom1): the UV in the glyph quad, (0, 0) at the upper-left and (1, 1) at the lower-right corner.
+//   - custom.z (Vertex.Custom2): the index of the glyph in the text.
+//   - custom.w (Vertex.Custom3): the start index in bytes of the glyph's cluster in the text.
+//
+// DrawWithShader is useful for effects like animated gradients, dissolves and per-glyph distortions.
+//
+// For the layout options, see Draw.
+//
+// If the shader is in the texel unit mode, all the images in Images must have the same size as the glyph image,
+// so the pixel unit mode (//kage:unit pixels) is recommended.
+func DrawWithShader(dst *ebiten.Image, text string, face Face, shader *ebiten.Shader, options *DrawWithShaderOptions) {
+	var op DrawWithShaderOptions
+	if options != nil {
+		op = *options
+	}
+
+	top := &ebiten.DrawTrianglesShaderOptions{}
+	top.Blend = op.Blend
+	top.Uniforms = op.Uniforms
+	top.Images = op.Images
+
+	var vs []ebiten.Vertex
+	for i, g := range AppendGlyphs(nil, text, face, &op.LayoutOptions) {
+		if g.Image == nil {
+			continue
+		}
+		vs = appendGlyphVertices(vs[:0], g, &op.GeoM)
+		for j := range vs {
+			vs[j].ColorR = op.ColorScale.R()
+			vs[j].ColorG = op.ColorScale.G()
+			vs[j].ColorB = op.ColorScale.B()
+			vs[j].ColorA = op.ColorScale.A()
+			vs[j].Custom0 = float32(j % 2)
+			vs[j].Custom1 = float32(j / 2)
+			vs[j].Custom2 = float32(i)
+			vs[j].Custom3 = float32(g.StartIndexInBytes)
+		}
+		top.Images[0] = g.Image
+		dst.DrawTrianglesShader(vs, glyphQuadIndices, shader, top)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+// ShapedGlyph represents a glyph positioned by shaping, without rasterization.
+type ShapedGlyph struct {
+	// Face is the face to render this glyph.
+	// For a MultiFace, Face is one of the faces of the MultiFace.
+	Face Face
+
+	// GID is an ID for a glyph of TrueType or OpenType font. GID is valid when the face is GoTextFace.
+	GID uint32
+
+	// StartIndexInBytes is the start index in bytes of the cluster of this glyph for the given string at AppendShapedGlyphs.
+	StartIndexInBytes int
+
+	// EndIndexInBytes is the end index in bytes of the cluster of this glyph for the given string at AppendShapedGlyphs.
+	EndIndexInBytes int
+
+	// XOffset and YOffset are the offset in pixels from the current pen position to the glyph's origin.
+	XOffset float64
+	YOffset float64
+
+	// XAdvance and YAdvance are the distance in pixels to move the pen position after this glyph.
+	// YAdvance is positive downward, e.g., for a vertical-direction face.
+	XAdvance float64
+	YAdvance float64
+}
+
+// AppendShapedGlyphs appends shaped glyphs for the given text to the given slice and returns a slice.
+//
+// The glyphs are appended in the order in which they are rendered from the origin.
+// The glyph's origin is the sum of the previous glyphs' advances and the glyph's offset.
+//
+// AppendShapedGlyphs doesn't rasterize glyphs, so AppendShapedGlyphs is useful for external layout engines
+// to use the same shaping results as the renderer, which are cached internally.
+// Use AppendRuns to get the metrics of each run.
+//
+// AppendShapedGlyphs doesn't treat multiple lines.
+//
+// AppendShapedGlyphs is concurrent-safe.
+func AppendShapedGlyphs(glyphs []ShapedGlyph, text string, face Face) []ShapedGlyph {
+	return face.appendShapedGlyphsForLine(glyphs, text, 0)
+}
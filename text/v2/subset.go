@@ -0,0 +1,50 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"io"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/fontsubset"
+)
+
+// SubsetFont creates a subset of the given TrueType font that includes only the glyphs for the runes in text,
+// and returns the font binary of the subset.
+//
+// SubsetFont is useful to reduce the size of a font to embed, especially for a CJK font in browsers.
+// It is fine to pass all the texts used in a game concatenated as text.
+// The result can be passed to NewGoTextFaceSource.
+//
+// The glyphs which the given runes can be substituted with by OpenType features, like ligatures and vertical forms, are also included.
+//
+// The glyph IDs are kept in the subset, and the glyphs not included become empty.
+// Thus, multiple subsets created from the same font can be merged by MergeFontSubsets.
+//
+// SubsetFont supports only fonts with TrueType outlines so far.
+// SubsetFont returns an error for fonts with CFF outlines.
+func SubsetFont(source io.Reader, text string) ([]byte, error) {
+	return fontsubset.Subset(source, text)
+}
+
+// MergeFontSubsets merges the given font subsets created from the same font by SubsetFont,
+// and returns the font binary of the merged subset.
+//
+// MergeFontSubsets is useful to add glyphs at runtime, e.g., when a text that is unknown at build time is given.
+// The original font can also be passed as one of the subsets.
+//
+// MergeFontSubsets returns an error if the subsets are not created from the same font.
+func MergeFontSubsets(subsets ...io.Reader) ([]byte, error) {
+	return fontsubset.Merge(subsets...)
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+func gids(t *testing.T, str string, source *text.GoTextFaceSource) []uint32 {
+	t.Helper()
+
+	f := &text.GoTextFace{
+		Source: source,
+		Size:   16,
+	}
+	var gids []uint32
+	for _, g := range text.AppendGlyphs(nil, str, f, nil) {
+		gids = append(gids, g.GID)
+	}
+	return gids
+}
+
+func TestSubsetFont(t *testing.T) {
+	fontdata, err := os.ReadFile(filepath.Join("testdata", "MPLUS1p-Regular.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := text.NewGoTextFaceSource(bytes.NewReader(fontdata))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subset0, err := text.SubsetFont(bytes.NewReader(fontdata), "Hello, 世界")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subset0) >= len(fontdata) {
+		t.Errorf("len(subset0) (%d) must be less than len(fontdata) (%d)", len(subset0), len(fontdata))
+	}
+	subset1, err := text.SubsetFont(bytes.NewReader(fontdata), "World")
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged, err := text.MergeFontSubsets(bytes.NewReader(subset0), bytes.NewReader(subset1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subsetSource0, err := text.NewGoTextFaceSource(bytes.NewReader(subset0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mergedSource, err := text.NewGoTextFaceSource(bytes.NewReader(merged))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name   string
+		Source *text.GoTextFaceSource
+		In     string
+		Want   []uint32
+	}{
+		{
+			Name:   "subset",
+			Source: subsetSource0,
+			In:     "Hello, 世界",
+			Want:   gids(t, "Hello, 世界", source),
+		},
+		{
+			// 'W' and 'r' are not included in the subset. Then .notdef (0) is used.
+			Name:   "subset without glyphs",
+			Source: subsetSource0,
+			In:     "Wr",
+			Want:   []uint32{0, 0},
+		},
+		{
+			Name:   "merged",
+			Source: mergedSource,
+			In:     "Hello, World 世界",
+			Want:   gids(t, "Hello, World 世界", source),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got := gids(t, tc.In, tc.Source)
+			if len(got) != len(tc.Want) {
+				t.Fatalf("got: %v, want: %v", got, tc.Want)
+			}
+			for i := range got {
+				if got[i] != tc.Want[i] {
+					t.Errorf("got: %v, want: %v", got, tc.Want)
+					break
+				}
+			}
+		})
+	}
+
+	f := &text.GoTextFace{
+		Source: source,
+		Size:   16,
+	}
+	subsetF := &text.GoTextFace{
+		Source: mergedSource,
+		Size:   16,
+	}
+	if got, want := text.Advance("Hello, World", subsetF), text.Advance("Hello, World", f); got != want {
+		t.Errorf("text.Advance: got: %f, want: %f", got, want)
+	}
+}
+
+func TestMergeFontSubsetsWithDifferentFonts(t *testing.T) {
+	var subsets [][]byte
+	for _, name := range []string{"MPLUS1p-Regular.ttf", "Roboto-Regular.ttf"} {
+		fontdata, err := os.ReadFile(filepath.Join("testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		subset, err := text.SubsetFont(bytes.NewReader(fontdata), "Hello")
+		if err != nil {
+			t.Fatal(err)
+		}
+		subsets = append(subsets, subset)
+	}
+	if _, err := text.MergeFontSubsets(bytes.NewReader(subsets[0]), bytes.NewReader(subsets[1])); err == nil {
+		t.Errorf("text.MergeFontSubsets must return an error for subsets from different fonts")
+	}
+}
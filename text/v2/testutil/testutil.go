@@ -0,0 +1,257 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testutil provides utilities to test text rendering with golden files.
+//
+// Render rasterizes a text on CPU without GPU, so the result is deterministic regardless of the graphics driver.
+// CompareGolden compares a rendering result with a golden PNG file with a perceptual tolerance,
+// so that games can detect regressions of their UI texts across engine upgrades.
+package testutil
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gvector "golang.org/x/image/vector"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// UpdateGoldenEnv is the environment variable name to update golden files.
+// If the environment variable is set to "1", CompareGolden writes golden files instead of comparing them.
+const UpdateGoldenEnv = "EBITEN_UPDATE_GOLDEN"
+
+// RenderOptions represents options for Render.
+type RenderOptions struct {
+	// GeoM is a geometry matrix applied to the text.
+	GeoM ebiten.GeoM
+
+	text.LayoutOptions
+}
+
+// Render renders the text with the face onto a new image with the given size, and returns the image.
+// Each pixel of the returned image represents the coverage of the glyphs.
+//
+// The text is rendered from the glyphs' vector paths by the same rules as text.AppendVectorPath.
+// Render doesn't use GPU, so the result is deterministic and doesn't depend on the graphics driver.
+//
+// Render works only with faces that support vector paths, e.g., GoTextFace, or MultiFace and LimitedFace with GoTextFace.
+// For the other faces, Render returns an empty image.
+func Render(width, height int, str string, face text.Face, options *RenderOptions) *image.Alpha {
+	if options == nil {
+		options = &RenderOptions{}
+	}
+
+	var path vector.Path
+	text.AppendVectorPath(&path, str, face, &options.LayoutOptions)
+	vs, is := path.ApplyGeoM(options.GeoM).AppendVerticesAndIndicesForFilling(nil, nil)
+
+	// Rasterize each triangle as a contour. The rasterizer accumulates the signed areas of the contours,
+	// which results in the non-zero fill rule.
+	rast := gvector.NewRasterizer(width, height)
+	for i := 0; i+2 < len(is); i += 3 {
+		v0, v1, v2 := vs[is[i]], vs[is[i+1]], vs[is[i+2]]
+		rast.MoveTo(v0.DstX, v0.DstY)
+		rast.LineTo(v1.DstX, v1.DstY)
+		rast.LineTo(v2.DstX, v2.DstY)
+		rast.ClosePath()
+	}
+
+	dst := image.NewAlpha(image.Rect(0, 0, width, height))
+	rast.Draw(dst, dst.Bounds(), image.Opaque, image.Point{})
+	return dst
+}
+
+// CompareOptions represents options for Compare and CompareGolden.
+type CompareOptions struct {
+	// Threshold is the maximum difference of a color channel in [0, 255] regarded as the same.
+	// The images are blurred slightly before the comparison, so that a tiny shift of antialiased edges is tolerated.
+	// If Threshold is 0, 16 is used. To compare images exactly, specify a negative value.
+	Threshold int
+
+	// MaxDiffRatio is the maximum ratio of the different pixels to all the pixels.
+	// The default (zero) value doesn't allow any different pixels.
+	MaxDiffRatio float64
+}
+
+// Compare compares two images with a perceptual tolerance.
+// Compare returns an error describing the difference if the images are regarded as different.
+func Compare(got, want image.Image, options *CompareOptions) error {
+	if options == nil {
+		options = &CompareOptions{}
+	}
+
+	if got.Bounds().Size() != want.Bounds().Size() {
+		return fmt.Errorf("testutil: image sizes differ: got: %v, want: %v", got.Bounds().Size(), want.Bounds().Size())
+	}
+
+	threshold := options.Threshold
+	blur := true
+	if threshold == 0 {
+		threshold = 16
+	}
+	if threshold < 0 {
+		threshold = 0
+		blur = false
+	}
+
+	g := toRGBA(got)
+	w := toRGBA(want)
+	if blur {
+		g = boxBlur(g)
+		w = boxBlur(w)
+	}
+
+	var diff int
+	maxDiff := 0
+	for i := 0; i < len(g.Pix); i += 4 {
+		var d int
+		for j := 0; j < 4; j++ {
+			d = max(d, abs(int(g.Pix[i+j])-int(w.Pix[i+j])))
+		}
+		if d > threshold {
+			diff++
+		}
+		maxDiff = max(maxDiff, d)
+	}
+
+	size := got.Bounds().Dx() * got.Bounds().Dy()
+	if size == 0 {
+		return nil
+	}
+	if ratio := float64(diff) / float64(size); ratio > options.MaxDiffRatio {
+		return fmt.Errorf("testutil: %d of %d pixels differ (ratio: %f, max difference: %d)", diff, size, ratio, maxDiff)
+	}
+	return nil
+}
+
+// CompareGolden compares the image with the golden PNG file at path, and reports an error to t if they are different.
+//
+// If the environment variable UpdateGoldenEnv is "1", or the golden file doesn't exist,
+// CompareGolden writes the image to path as a new golden file instead.
+// When the comparison fails, the image is written to path with the suffix ".actual.png" for investigation.
+func CompareGolden(t testing.TB, img image.Image, path string, options *CompareOptions) {
+	t.Helper()
+
+	if os.Getenv(UpdateGoldenEnv) == "1" {
+		if err := writePNG(path, img); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := readPNG(path)
+	if errors.Is(err, os.ErrNotExist) {
+		if err := writePNG(path, img); err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("testutil: golden file %s was created", path)
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Compare(img, want, options); err != nil {
+		actual := path + ".actual.png"
+		if err := writePNG(actual, img); err != nil {
+			t.Log(err)
+		}
+		t.Errorf("golden file %s: %v (the actual image is written to %s)", path, err, actual)
+	}
+}
+
+func readPNG(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return png.Decode(f)
+}
+
+func writePNG(path string, img image.Image) (err error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err1 := f.Close(); err1 != nil && err == nil {
+			err = err1
+		}
+	}()
+	return png.Encode(f, img)
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	for j := 0; j < b.Dy(); j++ {
+		for i := 0; i < b.Dx(); i++ {
+			dst.Set(i, j, color.RGBAModel.Convert(img.At(b.Min.X+i, b.Min.Y+j)))
+		}
+	}
+	return dst
+}
+
+// boxBlur applies a 3x3 box blur to img.
+func boxBlur(img *image.RGBA) *image.RGBA {
+	b := img.Bounds()
+	dst := image.NewRGBA(b)
+	for j := 0; j < b.Dy(); j++ {
+		for i := 0; i < b.Dx(); i++ {
+			var sum [4]int
+			var n int
+			for dj := -1; dj <= 1; dj++ {
+				for di := -1; di <= 1; di++ {
+					x, y := i+di, j+dj
+					if x < 0 || y < 0 || x >= b.Dx() || y >= b.Dy() {
+						continue
+					}
+					p := img.PixOffset(x, y)
+					for k := 0; k < 4; k++ {
+						sum[k] += int(img.Pix[p+k])
+					}
+					n++
+				}
+			}
+			p := dst.PixOffset(i, j)
+			for k := 0; k < 4; k++ {
+				dst.Pix[p+k] = uint8(math.Round(float64(sum[k]) / float64(n)))
+			}
+		}
+	}
+	return dst
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
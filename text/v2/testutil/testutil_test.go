@@ -0,0 +1,91 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2/testutil"
+)
+
+func TestRender(t *testing.T) {
+	fontdata, err := os.ReadFile(filepath.Join("..", "testdata", "Roboto-Regular.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := text.NewGoTextFaceSource(bytes.NewReader(fontdata))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &text.GoTextFace{
+		Source: source,
+		Size:   32,
+	}
+
+	img0 := testutil.Render(64, 48, "Ag", f, nil)
+	img1 := testutil.Render(64, 48, "Ag", f, nil)
+	if !bytes.Equal(img0.Pix, img1.Pix) {
+		t.Errorf("Render is not deterministic")
+	}
+
+	var covered bool
+	for _, a := range img0.Pix {
+		if a > 0 {
+			covered = true
+			break
+		}
+	}
+	if !covered {
+		t.Errorf("Render: got: an empty image, want: a non-empty image")
+	}
+
+	path := filepath.Join(t.TempDir(), "golden.png")
+	// The first call creates the golden file.
+	testutil.CompareGolden(t, img0, path, nil)
+	testutil.CompareGolden(t, img1, path, nil)
+}
+
+func TestCompare(t *testing.T) {
+	a := image.NewAlpha(image.Rect(0, 0, 16, 16))
+	b := image.NewAlpha(image.Rect(0, 0, 16, 16))
+	a.SetAlpha(8, 8, color.Alpha{A: 0xff})
+	b.SetAlpha(8, 8, color.Alpha{A: 0xf0})
+
+	if err := testutil.Compare(a, b, nil); err != nil {
+		t.Errorf("Compare: got: %v, want: nil", err)
+	}
+	if err := testutil.Compare(a, b, &testutil.CompareOptions{Threshold: -1}); err == nil {
+		t.Errorf("Compare with an exact comparison: got: nil, want: an error")
+	}
+
+	b.SetAlpha(8, 8, color.Alpha{})
+	if err := testutil.Compare(a, b, nil); err == nil {
+		t.Errorf("Compare: got: nil, want: an error")
+	}
+	if err := testutil.Compare(a, b, &testutil.CompareOptions{MaxDiffRatio: 0.1}); err != nil {
+		t.Errorf("Compare with MaxDiffRatio: got: %v, want: nil", err)
+	}
+
+	c := image.NewAlpha(image.Rect(0, 0, 8, 8))
+	if err := testutil.Compare(a, c, nil); err == nil {
+		t.Errorf("Compare with different sizes: got: nil, want: an error")
+	}
+}
@@ -28,6 +28,7 @@ import (
 
 // Face is an interface representing a font face.
 // The implementations are only faces defined in this package, like GoTextFace and GoXFace.
+// To use your own font technology, use CustomFace.
 type Face interface {
 	// Metrics returns the metrics for this Face.
 	Metrics() Metrics
@@ -37,6 +38,8 @@ type Face interface {
 	hasGlyph(r rune) bool
 
 	appendGlyphsForLine(glyphs []Glyph, line string, indexOffset int, originX, originY float64) []Glyph
+	appendRunsForLine(runs []Run, line string, indexOffset int) []Run
+	appendShapedGlyphsForLine(glyphs []ShapedGlyph, line string, indexOffset int) []ShapedGlyph
 	appendVectorPathForLine(path *vector.Path, line string, originX, originY float64)
 
 	direction() Direction
@@ -93,6 +96,13 @@ func float64ToFixed26_6(x float64) fixed.Int26_6 {
 	return fixed.Int26_6(x * (1 << 6))
 }
 
+func scaleFixedPoint(p fixed.Point26_6, scale float64) fixed.Point26_6 {
+	return fixed.Point26_6{
+		X: float64ToFixed26_6(fixed26_6ToFloat64(p.X) * scale),
+		Y: float64ToFixed26_6(fixed26_6ToFloat64(p.Y) * scale),
+	}
+}
+
 func glyphVariationCount(face Face) int {
 	var s float64
 	if m := face.Metrics(); face.direction().isHorizontal() {
@@ -161,6 +171,84 @@ type Glyph struct {
 	// OriginOffsetY is the adjustment value to the Y position of the origin of this glyph.
 	// OriginOffsetY is usually 0, but can be non-zero for some special glyphs or glyphs in the vertical text layout.
 	OriginOffsetY float64
+
+	// Padding is the transparent margin between the edges of Image and the bounding box of the glyph's outline.
+	//
+	// Glyph images are rasterized with subpixel offsets and extra margins to avoid cutting antialiased edges.
+	// Padding is useful for custom renderers, e.g., to pack glyph images into a texture atlas tightly,
+	// or to compute texture coordinates of the outline in a shader.
+	//
+	// Padding is zero for glyphs from a CustomFace, or when Image is nil.
+	Padding GlyphPadding
+
+	// ImageScale is the scale to render Image.
+	// Image is rasterized at a higher resolution when e.g. GoTextFace.RasterizeWithDeviceScaleFactor is true,
+	// and then Image must be rendered scaled by ImageScale.
+	// X and Y are not affected by ImageScale.
+	//
+	// If ImageScale is 0, Image is rendered without scaling.
+	ImageScale float64
+}
+
+// GlyphPadding represents paddings of a glyph image in pixels.
+type GlyphPadding struct {
+	Left   float64
+	Top    float64
+	Right  float64
+	Bottom float64
+}
+
+func (g *Glyph) imageScale() float64 {
+	if g.ImageScale == 0 {
+		return 1
+	}
+	return g.ImageScale
+}
+
+// imageGeoM returns the geometry matrix to render the glyph's image at its position.
+func (g *Glyph) imageGeoM() ebiten.GeoM {
+	var geoM ebiten.GeoM
+	if g.ImageScale != 0 {
+		geoM.Scale(g.ImageScale, g.ImageScale)
+	}
+	geoM.Translate(g.X, g.Y)
+	return geoM
+}
+
+func glyphPadding(img *ebiten.Image, subpixelOffset fixed.Point26_6, glyphBounds fixed.Rectangle26_6) GlyphPadding {
+	if img == nil {
+		return GlyphPadding{}
+	}
+	b := img.Bounds()
+	l := fixed26_6ToFloat64(subpixelOffset.X)
+	t := fixed26_6ToFloat64(subpixelOffset.Y)
+	return GlyphPadding{
+		Left:   l,
+		Top:    t,
+		Right:  float64(b.Dx()) - l - fixed26_6ToFloat64(glyphBounds.Max.X-glyphBounds.Min.X),
+		Bottom: float64(b.Dy()) - t - fixed26_6ToFloat64(glyphBounds.Max.Y-glyphBounds.Min.Y),
+	}
+}
+
+// LookupGlyph returns the glyph for the given rune rendered at the origin (0, 0) with the given face.
+// The returned glyph's image is the one in the face's glyph cache, so LookupGlyph is useful for custom renderers
+// to reuse the glyph cache, e.g., to draw a text into a light map or with a custom shader per glyph.
+//
+// The position of the glyph's image relative to the origin, i.e. the bearing, is (X, Y) of the returned glyph.
+//
+// LookupGlyph returns false if the face doesn't have a glyph for the rune.
+// Note that the returned glyph's image can be nil even if LookupGlyph returns true, e.g., for a space.
+//
+// LookupGlyph is concurrent-safe.
+func LookupGlyph(face Face, r rune) (Glyph, bool) {
+	if !face.hasGlyph(r) {
+		return Glyph{}, false
+	}
+	gs := face.appendGlyphsForLine(nil, string(r), 0, 0, 0)
+	if len(gs) == 0 {
+		return Glyph{}, false
+	}
+	return gs[0], true
 }
 
 // Advance returns the advanced distance from the origin position when rendering the given text with the given face.
@@ -172,6 +260,49 @@ func Advance(text string, face Face) float64 {
 	return face.advance(text)
 }
 
+// Run represents a run of a text, which is a part of a text rendered with one face.
+// A run has its own metrics.
+//
+// Ascent, Descent, and LineGap correspond to Metrics's HAscent, HDescent, and HLineGap for a horizontal-direction face,
+// and VAscent, VDescent, and VLineGap for a vertical-direction face.
+type Run struct {
+	// StartIndexInBytes is the start index in bytes for the given string at AppendRuns.
+	StartIndexInBytes int
+
+	// EndIndexInBytes is the end index in bytes for the given string at AppendRuns.
+	EndIndexInBytes int
+
+	// Face is the face to render this run.
+	// For a MultiFace, Face is one of the faces of the MultiFace.
+	Face Face
+
+	// Ascent is the distance in pixels from the top of a line to its baseline for this run.
+	Ascent float64
+
+	// Descent is the distance in pixels from the bottom of a line to its baseline for this run.
+	// The value is typically positive.
+	Descent float64
+
+	// LineGap is the recommended amount of space between two lines of text in pixels for this run.
+	LineGap float64
+
+	// Advance is the advanced distance in pixels of this run.
+	Advance float64
+}
+
+// AppendRuns appends runs for the given text to the given slice and returns a slice.
+//
+// The runs are appended in the order in which they are rendered from the origin.
+//
+// AppendRuns is useful to calculate baselines in the same way as the renderer does, e.g., when mixing multiple faces in one line.
+//
+// AppendRuns doesn't treat multiple lines.
+//
+// AppendRuns is concurrent-safe.
+func AppendRuns(runs []Run, text string, face Face) []Run {
+	return face.appendRunsForLine(runs, text, 0)
+}
+
 // Direction represents a direction of text rendering.
 // Direction indicates both the primary direction, in which a text in one line is rendered,
 // and the secondary direction, in which multiple lines are rendered.
@@ -195,11 +326,20 @@ const (
 	// and the secondary direction is from right to left.
 	// This is used e.g. for Japanese.
 	DirectionTopToBottomAndRightToLeft
+
+	// DirectionAuto indicates that the primary direction is horizontal and is detected for each paragraph,
+	// and the secondary direction is from top to bottom.
+	// The direction of a paragraph is determined by its first strong character,
+	// ignoring characters in isolates, as UAX #9 rules P2 and P3 specify.
+	// A paragraph without strong characters is rendered from left to right.
+	//
+	// Paragraphs are separated by '\n'.
+	DirectionAuto
 )
 
 func (d Direction) isHorizontal() bool {
 	switch d {
-	case DirectionLeftToRight, DirectionRightToLeft:
+	case DirectionLeftToRight, DirectionRightToLeft, DirectionAuto:
 		return true
 	}
 	return false
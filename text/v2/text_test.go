@@ -22,9 +22,11 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/go-text/typesetting/shaping"
 	"github.com/hajimehoshi/bitmapfont/v3"
 	"golang.org/x/image/font"
 	"golang.org/x/image/font/opentype"
@@ -475,3 +477,876 @@ func TestCollection(t *testing.T) {
 		})
 	}
 }
+
+type countingShaper struct {
+	shaping.HarfbuzzShaper
+
+	count int
+}
+
+func (c *countingShaper) Shape(input shaping.Input) shaping.Output {
+	c.count++
+	return c.HarfbuzzShaper.Shape(input)
+}
+
+func TestUnsafeSetShaper(t *testing.T) {
+	fontdata, err := os.ReadFile(filepath.Join("testdata", "Roboto-Regular.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := text.NewGoTextFaceSource(bytes.NewReader(fontdata))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &text.GoTextFace{
+		Source: source,
+		Size:   16,
+	}
+	want := text.Advance("Hello", f)
+
+	s := &countingShaper{}
+	source.UnsafeSetShaper(s)
+	if got := text.Advance("Hello", f); got != want {
+		t.Errorf("got: %f, want: %f", got, want)
+	}
+	if got, want := s.count, 1; got != want {
+		t.Errorf("s.count: got: %d, want: %d", got, want)
+	}
+
+	// The shaping result is cached.
+	text.Advance("Hello", f)
+	if got, want := s.count, 1; got != want {
+		t.Errorf("s.count: got: %d, want: %d", got, want)
+	}
+
+	// Setting the shaper again purges the cache.
+	source.UnsafeSetShaper(s)
+	text.Advance("Hello", f)
+	if got, want := s.count, 2; got != want {
+		t.Errorf("s.count: got: %d, want: %d", got, want)
+	}
+
+	// Reset the shaper.
+	source.UnsafeSetShaper(nil)
+	if got := text.Advance("Hello", f); got != want {
+		t.Errorf("got: %f, want: %f", got, want)
+	}
+	if got, want := s.count, 2; got != want {
+		t.Errorf("s.count: got: %d, want: %d", got, want)
+	}
+}
+
+func TestLetterSpacingAndWordSpacing(t *testing.T) {
+	fontdata, err := os.ReadFile(filepath.Join("testdata", "Roboto-Regular.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := text.NewGoTextFaceSource(bytes.NewReader(fontdata))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const str = "Hello, World"
+	f := &text.GoTextFace{
+		Source: source,
+		Size:   16,
+	}
+	a := text.Advance(str, f)
+
+	testCases := []struct {
+		Name          string
+		LetterSpacing float64
+		WordSpacing   float64
+		Want          float64
+	}{
+		{
+			Name:          "letter spacing",
+			LetterSpacing: 2,
+			Want:          a + 2*12,
+		},
+		{
+			Name:          "negative letter spacing",
+			LetterSpacing: -1,
+			Want:          a - 1*12,
+		},
+		{
+			Name:        "word spacing",
+			WordSpacing: 5,
+			Want:        a + 5,
+		},
+		{
+			Name:          "letter and word spacing",
+			LetterSpacing: 2,
+			WordSpacing:   5,
+			Want:          a + 2*12 + 5,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			f := &text.GoTextFace{
+				Source:        source,
+				Size:          16,
+				LetterSpacing: tc.LetterSpacing,
+				WordSpacing:   tc.WordSpacing,
+			}
+			if got := text.Advance(str, f); got != tc.Want {
+				t.Errorf("got: %f, want: %f", got, tc.Want)
+			}
+
+			// The glyph positions must agree with the advance.
+			gs := text.AppendGlyphs(nil, str, f, nil)
+			last := gs[len(gs)-1]
+			if got, want := last.OriginX-gs[0].OriginX+text.Advance("d", f), tc.Want; math.Abs(got-want) > 1.0/64 {
+				t.Errorf("got: %f, want: %f", got, want)
+			}
+		})
+	}
+}
+
+func TestSyntheticBoldAndOblique(t *testing.T) {
+	fontdata, err := os.ReadFile(filepath.Join("testdata", "Roboto-Regular.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := text.NewGoTextFaceSource(bytes.NewReader(fontdata))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const str = "l"
+	glyphWidth := func(f *text.GoTextFace) int {
+		gs := text.AppendGlyphs(nil, str, f, nil)
+		if len(gs) != 1 || gs[0].Image == nil {
+			t.Fatalf("AppendGlyphs(%q): unexpected glyphs: %v", str, gs)
+		}
+		return gs[0].Image.Bounds().Dx()
+	}
+
+	regular := &text.GoTextFace{
+		Source: source,
+		Size:   32,
+	}
+	bold := &text.GoTextFace{
+		Source:        source,
+		Size:          32,
+		SyntheticBold: 4,
+	}
+	oblique := &text.GoTextFace{
+		Source:           source,
+		Size:             32,
+		SyntheticOblique: 0.25,
+	}
+
+	w := glyphWidth(regular)
+	if got, want := glyphWidth(bold), w+4; got < want-1 || got > want+1 {
+		t.Errorf("bold width: got: %d, want: %d", got, want)
+	}
+	if got := glyphWidth(oblique); got <= w {
+		t.Errorf("oblique width: got: %d, want: > %d", got, w)
+	}
+
+	// The advances are not changed.
+	if got, want := text.Advance(str, bold), text.Advance(str, regular); got != want {
+		t.Errorf("bold advance: got: %f, want: %f", got, want)
+	}
+	if got, want := text.Advance(str, oblique), text.Advance(str, regular); got != want {
+		t.Errorf("oblique advance: got: %f, want: %f", got, want)
+	}
+}
+
+type testCustomFaceSource struct {
+	img *ebiten.Image
+}
+
+func (t *testCustomFaceSource) Metrics() text.Metrics {
+	return text.Metrics{
+		HAscent:  8,
+		HDescent: 2,
+	}
+}
+
+func (t *testCustomFaceSource) HasGlyph(r rune) bool {
+	return 'A' <= r && r <= 'Z'
+}
+
+func (t *testCustomFaceSource) GlyphAdvance(r rune) float64 {
+	return 6
+}
+
+func (t *testCustomFaceSource) GlyphImage(r rune) (*ebiten.Image, float64, float64) {
+	return t.img, 1, -8
+}
+
+func (t *testCustomFaceSource) Kern(r0, r1 rune) float64 {
+	if r0 == 'A' && r1 == 'V' {
+		return -2
+	}
+	return 0
+}
+
+func TestCustomFace(t *testing.T) {
+	f := text.NewCustomFace(&testCustomFaceSource{
+		img: ebiten.NewImage(4, 8),
+	})
+
+	if got, want := text.Advance("AVA", f), 6*3-2.0; got != want {
+		t.Errorf("text.Advance: got: %f, want: %f", got, want)
+	}
+	if w, h := text.Measure("AVA\nB", f, 12); w != 16 || h != 22 {
+		t.Errorf("text.Measure: got: (%f, %f), want: (%f, %f)", w, h, 16.0, 22.0)
+	}
+
+	gs := text.AppendGlyphs(nil, "AVA", f, nil)
+	if got, want := len(gs), 3; got != want {
+		t.Fatalf("len(gs): got: %d, want: %d", got, want)
+	}
+	for i, want := range []float64{1, 5, 11} {
+		if got := gs[i].X; got != want {
+			t.Errorf("gs[%d].X: got: %f, want: %f", i, got, want)
+		}
+		// The origin's Y is at the ascent.
+		if got, want := gs[i].Y, 0.0; got != want {
+			t.Errorf("gs[%d].Y: got: %f, want: %f", i, got, want)
+		}
+	}
+
+	dst := ebiten.NewImage(32, 32)
+	text.Draw(dst, "AVA", f, nil)
+}
+
+func TestDrawWithFill(t *testing.T) {
+	glyph := ebiten.NewImage(4, 8)
+	glyph.Fill(color.White)
+	f := text.NewCustomFace(&testCustomFaceSource{
+		img: glyph,
+	})
+
+	t.Run("gradient", func(t *testing.T) {
+		dst := ebiten.NewImage(16, 16)
+		op := &text.DrawOptions{}
+		op.Fill = &text.GradientFill{
+			Vertical:   true,
+			StartColor: color.RGBA{R: 0xff, A: 0xff},
+			EndColor:   color.RGBA{B: 0xff, A: 0xff},
+		}
+		text.Draw(dst, "A", f, op)
+
+		top := dst.At(2, 0).(color.RGBA)
+		if top.R <= top.B || top.A != 0xff {
+			t.Errorf("dst.At(2, 0): got: %v, want: reddish opaque color", top)
+		}
+		bottom := dst.At(2, 7).(color.RGBA)
+		if bottom.B <= bottom.R || bottom.A != 0xff {
+			t.Errorf("dst.At(2, 7): got: %v, want: bluish opaque color", bottom)
+		}
+		if got, want := dst.At(0, 0), (color.RGBA{}); got != want {
+			t.Errorf("dst.At(0, 0): got: %v, want: %v", got, want)
+		}
+	})
+
+	t.Run("texture", func(t *testing.T) {
+		tex := ebiten.NewImage(2, 2)
+		tex.Set(0, 0, color.RGBA{R: 0xff, A: 0xff})
+		tex.Set(1, 0, color.RGBA{G: 0xff, A: 0xff})
+		tex.Set(0, 1, color.RGBA{B: 0xff, A: 0xff})
+		tex.Set(1, 1, color.RGBA{R: 0xff, G: 0xff, A: 0xff})
+
+		dst := ebiten.NewImage(16, 16)
+		op := &text.DrawOptions{}
+		op.Fill = &text.TextureFill{
+			Image: tex,
+		}
+		text.Draw(dst, "A", f, op)
+
+		// The glyph is at (1, 0) in the rendering region, and the texture is repeated.
+		for j := 0; j < 8; j++ {
+			for i := 1; i < 5; i++ {
+				got := dst.At(i, j)
+				want := tex.At(i%2, j%2)
+				if got != want {
+					t.Errorf("dst.At(%d, %d): got: %v, want: %v", i, j, got, want)
+				}
+			}
+		}
+	})
+}
+
+func TestDrawWithBackground(t *testing.T) {
+	glyph := ebiten.NewImage(4, 8)
+	glyph.Fill(color.White)
+	f := text.NewCustomFace(&testCustomFaceSource{
+		img: glyph,
+	})
+
+	dst := ebiten.NewImage(20, 20)
+	op := &text.DrawOptions{}
+	op.GeoM.Translate(4, 4)
+	op.Background = &text.Background{
+		Color:   color.RGBA{B: 0xff, A: 0xff},
+		Padding: 2,
+	}
+	text.Draw(dst, "A", f, op)
+
+	// The rendering region is (0, 0)-(6, 10), and the box is (2, 2)-(12, 16) with the padding and the translation.
+	bg := color.RGBA{B: 0xff, A: 0xff}
+	for _, tc := range []struct {
+		X, Y int
+		Want color.RGBA
+	}{
+		{X: 0, Y: 0, Want: color.RGBA{}},
+		{X: 2, Y: 2, Want: bg},
+		{X: 11, Y: 15, Want: bg},
+		{X: 5, Y: 5, Want: color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}},
+		{X: 12, Y: 12, Want: color.RGBA{}},
+		{X: 8, Y: 16, Want: color.RGBA{}},
+	} {
+		if got := dst.At(tc.X, tc.Y); got != tc.Want {
+			t.Errorf("dst.At(%d, %d): got: %v, want: %v", tc.X, tc.Y, got, tc.Want)
+		}
+	}
+}
+
+func TestTextBox(t *testing.T) {
+	f := text.NewCustomFace(&testCustomFaceSource{
+		img: ebiten.NewImage(4, 8),
+	})
+
+	var revealed []string
+	tb := &text.TextBox{
+		Face:   f,
+		Width:  12,
+		Height: 10,
+		Speed:  0.5,
+		OnReveal: func(cluster string, index int) {
+			revealed = append(revealed, cluster)
+		},
+	}
+	tb.SetText("AB CD")
+
+	tb.Update()
+	if got, want := tb.RevealedText(), ""; got != want {
+		t.Errorf("RevealedText(): got: %q, want: %q", got, want)
+	}
+	tb.Update()
+	if got, want := tb.RevealedText(), "A"; got != want {
+		t.Errorf("RevealedText(): got: %q, want: %q", got, want)
+	}
+	if got, want := tb.ScrollY(), 0.0; got != want {
+		t.Errorf("ScrollY(): got: %f, want: %f", got, want)
+	}
+
+	for !tb.IsFullyRevealed() {
+		tb.Update()
+	}
+	if got, want := revealed, []string{"A", "B", " ", "C", "D"}; !slices.Equal(got, want) {
+		t.Errorf("revealed: got: %q, want: %q", got, want)
+	}
+
+	// "AB CD" is wrapped into two lines, and the second line is scrolled into the visible area.
+	if got, want := tb.ScrollY(), 10.0; got != want {
+		t.Errorf("ScrollY(): got: %f, want: %f", got, want)
+	}
+
+	tb.SetText("EF")
+	if tb.IsFullyRevealed() {
+		t.Errorf("IsFullyRevealed(): got: true, want: false")
+	}
+	tb.RevealAll()
+	if got, want := tb.RevealedText(), "EF"; got != want {
+		t.Errorf("RevealedText(): got: %q, want: %q", got, want)
+	}
+}
+
+func TestLookupGlyph(t *testing.T) {
+	fontdata, err := os.ReadFile(filepath.Join("testdata", "Roboto-Regular.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := text.NewGoTextFaceSource(bytes.NewReader(fontdata))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &text.GoTextFace{
+		Source: source,
+		Size:   32,
+	}
+
+	g, ok := text.LookupGlyph(f, 'A')
+	if !ok {
+		t.Fatalf("LookupGlyph(f, 'A'): got: false, want: true")
+	}
+	if g.Image == nil {
+		t.Fatalf("LookupGlyph(f, 'A').Image: got: nil, want: non-nil")
+	}
+	// The glyph image is above the baseline.
+	if g.Y >= 0 {
+		t.Errorf("LookupGlyph(f, 'A').Y: got: %f, want: < 0", g.Y)
+	}
+	p := g.Padding
+	if p.Left < 0 || p.Left >= 1 || p.Top < 0 || p.Top >= 1 {
+		t.Errorf("LookupGlyph(f, 'A').Padding: got: %v, want: left and top in [0, 1)", p)
+	}
+	if p.Right < 0 || p.Right > 2 || p.Bottom < 0 || p.Bottom > 2 {
+		t.Errorf("LookupGlyph(f, 'A').Padding: got: %v, want: right and bottom in [0, 2]", p)
+	}
+
+	// The image is the same as the one used for rendering.
+	gs := text.AppendGlyphs(nil, "A", f, nil)
+	if got, want := gs[0].Image, g.Image; got != want {
+		t.Errorf("AppendGlyphs(\"A\")[0].Image: got: %p, want: %p", got, want)
+	}
+
+	if g, ok := text.LookupGlyph(f, ' '); !ok || g.Image != nil {
+		t.Errorf("LookupGlyph(f, ' '): got: (%v, %t), want: (nil image, true)", g.Image, ok)
+	}
+
+	cf := text.NewCustomFace(&testCustomFaceSource{
+		img: ebiten.NewImage(4, 8),
+	})
+	if _, ok := text.LookupGlyph(cf, 'a'); ok {
+		t.Errorf("LookupGlyph(cf, 'a'): got: true, want: false")
+	}
+}
+
+func TestDrawWithShader(t *testing.T) {
+	glyph := ebiten.NewImage(4, 8)
+	glyph.Fill(color.White)
+	f := text.NewCustomFace(&testCustomFaceSource{
+		img: glyph,
+	})
+
+	s, err := ebiten.NewShader([]byte(`//kage:unit pixels
+
+package main
+
+func Fragment(dstPos vec4, srcPos vec2, color vec4, custom vec4) vec4 {
+	return vec4(0, custom.z, custom.w, 1) * imageSrc0At(srcPos).a
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dst := ebiten.NewImage(16, 16)
+	text.DrawWithShader(dst, "AB", f, s, nil)
+
+	// The first glyph is at (1, 0), and the second glyph is at (7, 0).
+	if got, want := dst.At(2, 4), (color.RGBA{A: 0xff}); got != want {
+		t.Errorf("dst.At(2, 4): got: %v, want: %v", got, want)
+	}
+	if got, want := dst.At(8, 4), (color.RGBA{G: 0xff, B: 0xff, A: 0xff}); got != want {
+		t.Errorf("dst.At(8, 4): got: %v, want: %v", got, want)
+	}
+	if got, want := dst.At(0, 4), (color.RGBA{}); got != want {
+		t.Errorf("dst.At(0, 4): got: %v, want: %v", got, want)
+	}
+}
+
+func TestRasterizeWithDeviceScaleFactor(t *testing.T) {
+	fontdata, err := os.ReadFile(filepath.Join("testdata", "Roboto-Regular.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := text.NewGoTextFaceSource(bytes.NewReader(fontdata))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	regular := &text.GoTextFace{
+		Source: source,
+		Size:   32,
+	}
+	scaled := &text.GoTextFace{
+		Source:                         source,
+		Size:                           32,
+		RasterizeWithDeviceScaleFactor: true,
+	}
+
+	const str = "AB"
+	g0 := text.AppendGlyphs(nil, str, regular, nil)
+	g1 := text.AppendGlyphs(nil, str, scaled, nil)
+	if len(g0) != len(g1) {
+		t.Fatalf("len(glyphs): got: %d, want: %d", len(g1), len(g0))
+	}
+
+	s := ebiten.Monitor().DeviceScaleFactor()
+	for i := range g0 {
+		if g0[i].ImageScale != 0 {
+			t.Errorf("g0[%d].ImageScale: got: %f, want: 0", i, g0[i].ImageScale)
+		}
+		if s != 1 {
+			if got, want := g1[i].ImageScale, 1/s; got != want {
+				t.Errorf("g1[%d].ImageScale: got: %f, want: %f", i, got, want)
+			}
+		}
+		imageScale := g1[i].ImageScale
+		if imageScale == 0 {
+			imageScale = 1
+		}
+		// The rendering sizes and positions must be almost the same.
+		if got, want := float64(g1[i].Image.Bounds().Dx())*imageScale, float64(g0[i].Image.Bounds().Dx()); math.Abs(got-want) > 2 {
+			t.Errorf("g1[%d] width: got: %f, want: %f", i, got, want)
+		}
+		if got, want := g1[i].X, g0[i].X; math.Abs(got-want) > 1 {
+			t.Errorf("g1[%d].X: got: %f, want: %f", i, got, want)
+		}
+		if got, want := g1[i].OriginX, g0[i].OriginX; got != want {
+			t.Errorf("g1[%d].OriginX: got: %f, want: %f", i, got, want)
+		}
+	}
+
+	if got, want := text.Advance(str, scaled), text.Advance(str, regular); got != want {
+		t.Errorf("Advance: got: %f, want: %f", got, want)
+	}
+}
+
+func TestAppendShapedGlyphs(t *testing.T) {
+	fontdata, err := os.ReadFile(filepath.Join("testdata", "Roboto-Regular.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := text.NewGoTextFaceSource(bytes.NewReader(fontdata))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &text.GoTextFace{
+		Source: source,
+		Size:   32,
+	}
+
+	const str = "AVA"
+	gs := text.AppendShapedGlyphs(nil, str, f)
+	if got, want := len(gs), 3; got != want {
+		t.Fatalf("len(AppendShapedGlyphs(%q)): got: %d, want: %d", str, got, want)
+	}
+	var x float64
+	for i, g := range gs {
+		if g.GID == 0 {
+			t.Errorf("AppendShapedGlyphs(%q)[%d].GID: got: 0, want: non-zero", str, i)
+		}
+		if got, want := g.StartIndexInBytes, i; got != want {
+			t.Errorf("AppendShapedGlyphs(%q)[%d].StartIndexInBytes: got: %d, want: %d", str, i, got, want)
+		}
+		if got, want := g.EndIndexInBytes, i+1; got != want {
+			t.Errorf("AppendShapedGlyphs(%q)[%d].EndIndexInBytes: got: %d, want: %d", str, i, got, want)
+		}
+		x += g.XAdvance
+	}
+	if got, want := x, text.Advance(str, f); got != want {
+		t.Errorf("sum of XAdvance: got: %f, want: %f", got, want)
+	}
+
+	cf := text.NewCustomFace(&testCustomFaceSource{
+		img: ebiten.NewImage(4, 8),
+	})
+	x = 0
+	for _, g := range text.AppendShapedGlyphs(nil, "abc", cf) {
+		x += g.XAdvance
+	}
+	if got, want := x, text.Advance("abc", cf); got != want {
+		t.Errorf("sum of XAdvance for CustomFace: got: %f, want: %f", got, want)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	fontdata, err := os.ReadFile(filepath.Join("testdata", "Roboto-Regular.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := text.NewGoTextFaceSource(bytes.NewReader(fontdata))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &text.GoTextFace{
+		Source: source,
+		Size:   16,
+	}
+
+	const str = "Sword of Legends"
+	if got, want := text.Truncate(str, f, text.Advance(str, f), "…"), str; got != want {
+		t.Errorf("Truncate(%q): got: %q, want: %q", str, got, want)
+	}
+
+	w := text.Advance("Sword of…", f)
+	if got, want := text.Truncate(str, f, w, "…"), "Sword of…"; got != want {
+		t.Errorf("Truncate(%q, %f): got: %q, want: %q", str, w, got, want)
+	}
+	// The white space before the ellipsis is removed.
+	w = text.Advance("Sword of …", f)
+	if got := text.Truncate(str, f, w, "…"); strings.Contains(got, " …") {
+		t.Errorf("Truncate(%q, %f): got: %q, want: no spaces before the ellipsis", str, w, got)
+	}
+	if got, want := text.Truncate(str, f, 0, "…"), ""; got != want {
+		t.Errorf("Truncate(%q, 0): got: %q, want: %q", str, got, want)
+	}
+
+	// A grapheme cluster is never split.
+	const str2 = "éééé"
+	for w := 0.0; w < text.Advance(str2, f); w++ {
+		got := text.Truncate(str2, f, w, "…")
+		if strings.HasSuffix(strings.TrimSuffix(got, "…"), "e") {
+			t.Errorf("Truncate(%q, %f): got: %q, want: no split clusters", str2, w, got)
+		}
+	}
+
+	// An unterminated isolate is terminated before the ellipsis.
+	const str3 = "Item: \u2067abc def ghi\u2069"
+	w = text.Advance("Item: \u2067abc\u2069…", f)
+	if got, want := text.Truncate(str3, f, w, "…"), "Item: \u2067abc\u2069…"; got != want {
+		t.Errorf("Truncate(%q, %f): got: %q, want: %q", str3, w, got, want)
+	}
+}
+
+func TestGoTextFaceSmallCapsAndFigures(t *testing.T) {
+	fontdata, err := os.ReadFile(filepath.Join("testdata", "Roboto-Regular.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := text.NewGoTextFaceSource(bytes.NewReader(fontdata))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &text.GoTextFace{
+		Source: source,
+		Size:   32,
+	}
+
+	regular := text.AppendShapedGlyphs(nil, "a", f)
+	f.SmallCaps = true
+	smallCaps := text.AppendShapedGlyphs(nil, "a", f)
+	if regular[0].GID == smallCaps[0].GID {
+		t.Errorf("GID with SmallCaps: got: %d, want: not %d", smallCaps[0].GID, regular[0].GID)
+	}
+
+	// A feature set by SetFeature takes precedence.
+	f.SetFeature(text.MustParseTag("smcp"), 0)
+	if got, want := text.AppendShapedGlyphs(nil, "a", f)[0].GID, regular[0].GID; got != want {
+		t.Errorf("GID with SmallCaps and 'smcp' disabled: got: %d, want: %d", got, want)
+	}
+	f.RemoveFeature(text.MustParseTag("smcp"))
+	f.SmallCaps = false
+
+	const digits = "0123456789"
+	f.FigureWidth = text.FigureWidthTabular
+	var advances []float64
+	for _, g := range text.AppendShapedGlyphs(nil, digits, f) {
+		advances = append(advances, g.XAdvance)
+	}
+	for i, a := range advances {
+		if a != advances[0] {
+			t.Errorf("XAdvance of %q with FigureWidthTabular: got: %f, want: %f", digits[i], a, advances[0])
+		}
+	}
+}
+
+func TestGoTextFaceNormalization(t *testing.T) {
+	fontdata, err := os.ReadFile(filepath.Join("testdata", "Roboto-Regular.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := text.NewGoTextFaceSource(bytes.NewReader(fontdata))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &text.GoTextFace{
+		Source:        source,
+		Size:          32,
+		Normalization: text.NormalizationNFC,
+	}
+
+	const composed = "\u00e9x"
+	const decomposed = "e\u0301x"
+	gs0 := text.AppendShapedGlyphs(nil, composed, f)
+	gs1 := text.AppendShapedGlyphs(nil, decomposed, f)
+	if len(gs0) != len(gs1) {
+		t.Fatalf("len(AppendShapedGlyphs(%+q)): got: %d, want: %d", decomposed, len(gs1), len(gs0))
+	}
+	for i := range gs0 {
+		if got, want := gs1[i].GID, gs0[i].GID; got != want {
+			t.Errorf("AppendShapedGlyphs(%+q)[%d].GID: got: %d, want: %d", decomposed, i, got, want)
+		}
+	}
+
+	// The indices are for the original text.
+	if got, want := gs1[0].EndIndexInBytes, 3; got != want {
+		t.Errorf("AppendShapedGlyphs(%+q)[0].EndIndexInBytes: got: %d, want: %d", decomposed, got, want)
+	}
+	if got, want := gs1[1].StartIndexInBytes, 3; got != want {
+		t.Errorf("AppendShapedGlyphs(%+q)[1].StartIndexInBytes: got: %d, want: %d", decomposed, got, want)
+	}
+	runs := text.AppendRuns(nil, decomposed, f)
+	if got, want := runs[len(runs)-1].EndIndexInBytes, len(decomposed); got != want {
+		t.Errorf("AppendRuns(%+q): the last EndIndexInBytes: got: %d, want: %d", decomposed, got, want)
+	}
+}
+
+func TestResolveDirection(t *testing.T) {
+	testCases := []struct {
+		In        string
+		Direction text.Direction
+		Want      text.Direction
+	}{
+		{In: "", Direction: text.DirectionAuto, Want: text.DirectionLeftToRight},
+		{In: "123 !?", Direction: text.DirectionAuto, Want: text.DirectionLeftToRight},
+		{In: "abc", Direction: text.DirectionAuto, Want: text.DirectionLeftToRight},
+		{In: "123 שלום abc", Direction: text.DirectionAuto, Want: text.DirectionRightToLeft},
+		{In: "مرحبا", Direction: text.DirectionAuto, Want: text.DirectionRightToLeft},
+		{In: "⁧abc⁩ ש", Direction: text.DirectionAuto, Want: text.DirectionRightToLeft},
+		{In: "⁦ש⁩", Direction: text.DirectionAuto, Want: text.DirectionLeftToRight},
+		{In: "ש", Direction: text.DirectionLeftToRight, Want: text.DirectionLeftToRight},
+		{In: "abc", Direction: text.DirectionRightToLeft, Want: text.DirectionRightToLeft},
+	}
+	for _, tc := range testCases {
+		if got := text.ResolveDirection(tc.Direction, tc.In); got != tc.Want {
+			t.Errorf("ResolveDirection(%d, %+q): got: %d, want: %d", tc.Direction, tc.In, got, tc.Want)
+		}
+	}
+}
+
+func TestGoTextFaceSourceCoverage(t *testing.T) {
+	fontdata, err := os.ReadFile(filepath.Join("testdata", "Roboto-Regular.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := text.NewGoTextFaceSource(bytes.NewReader(fontdata))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range "Hello, World!" {
+		if !source.HasGlyph(r) {
+			t.Errorf("HasGlyph(%q): got: false, want: true", r)
+		}
+	}
+	if source.HasGlyph('あ') {
+		t.Errorf("HasGlyph(%q): got: true, want: false", 'あ')
+	}
+
+	ranges := source.AppendCoverage(nil)
+	if len(ranges) == 0 {
+		t.Fatal("AppendCoverage: got: empty, want: non-empty")
+	}
+	for i, r := range ranges {
+		if r.Start > r.End {
+			t.Errorf("AppendCoverage()[%d]: got: %+v, want: Start <= End", i, r)
+		}
+		if i > 0 && ranges[i-1].End+1 >= r.Start {
+			t.Errorf("AppendCoverage()[%d]: got: %+v after %+v, want: sorted disjoint ranges", i, r, ranges[i-1])
+		}
+	}
+	for _, r := range "Hello, World!" {
+		if !slices.ContainsFunc(ranges, func(rr text.RuneRange) bool {
+			return rr.Start <= r && r <= rr.End
+		}) {
+			t.Errorf("AppendCoverage: %q is not covered", r)
+		}
+	}
+}
+
+func TestVariationsSharingSource(t *testing.T) {
+	fontdata, err := os.ReadFile(filepath.Join("testdata", "Selawik-VF-Subset.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := text.NewGoTextFaceSource(bytes.NewReader(fontdata))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const str = "l"
+	glyphImage := func(f *text.GoTextFace) *ebiten.Image {
+		gs := text.AppendGlyphs(nil, str, f, nil)
+		if len(gs) != 1 || gs[0].Image == nil {
+			t.Fatalf("AppendGlyphs(%q): unexpected glyphs: %v", str, gs)
+		}
+		return gs[0].Image
+	}
+
+	regular := &text.GoTextFace{
+		Source: source,
+		Size:   64,
+	}
+	regularAdvance := text.Advance(str, regular)
+	regularWidth := glyphImage(regular).Bounds().Dx()
+
+	light := &text.GoTextFace{
+		Source: source,
+		Size:   64,
+	}
+	light.SetVariation(text.MustParseTag("wght"), 300)
+	bold := &text.GoTextFace{
+		Source: source,
+		Size:   64,
+	}
+	bold.SetVariation(text.MustParseTag("wght"), 700)
+
+	lightAdvance := text.Advance(str, light)
+	boldAdvance := text.Advance(str, bold)
+	if lightAdvance >= boldAdvance {
+		t.Errorf("advances: got: %f (light) and %f (bold), want: light < bold", lightAdvance, boldAdvance)
+	}
+
+	lightImg := glyphImage(light)
+	boldImg := glyphImage(bold)
+	if lightImg == boldImg {
+		t.Errorf("the light and bold faces must not share a glyph image")
+	}
+	if got, want := lightImg.Bounds().Dx(), boldImg.Bounds().Dx(); got >= want {
+		t.Errorf("glyph widths: got: %d (light) and %d (bold), want: light < bold", got, want)
+	}
+
+	// The face without variations is not affected by the faces with variations.
+	if got, want := text.Advance(str, regular), regularAdvance; got != want {
+		t.Errorf("regular advance: got: %f, want: %f", got, want)
+	}
+	if got, want := glyphImage(regular).Bounds().Dx(), regularWidth; got != want {
+		t.Errorf("regular glyph width: got: %d, want: %d", got, want)
+	}
+	if got := text.Advance(str, regular); got == lightAdvance || got == boldAdvance {
+		t.Errorf("regular advance: got: %f, want: neither %f nor %f", got, lightAdvance, boldAdvance)
+	}
+}
+
+func TestGlyphImageCacheSteadySize(t *testing.T) {
+	fontdata, err := os.ReadFile(filepath.Join("testdata", "Roboto-Regular.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := text.NewGoTextFaceSource(bytes.NewReader(fontdata))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const limit = 128 * 1024
+	defer text.SetGlyphImageCacheBudgetLimitForTesting(limit)()
+
+	const str = "a"
+	glyphImage := func(size float64) *ebiten.Image {
+		gs := text.AppendGlyphs(nil, str, &text.GoTextFace{
+			Source: source,
+			Size:   size,
+		}, nil)
+		if len(gs) != 1 || gs[0].Image == nil {
+			t.Fatalf("AppendGlyphs(%q): unexpected glyphs: %v", str, gs)
+		}
+		return gs[0].Image
+	}
+
+	// Glyph images for many sizes exceed the budget. The size in steady use must not be evicted.
+	steady := glyphImage(24)
+	first := glyphImage(20)
+	for size := 20; size <= 100; size++ {
+		if got, want := glyphImage(24), steady; got != want {
+			t.Fatalf("the glyph image for the steady size was evicted at size %d", size)
+		}
+		_ = glyphImage(float64(size))
+	}
+
+	if got := text.GlyphImageCacheBudgetSizeForTesting(); got > limit {
+		t.Errorf("budget size: got: %d, want: <= %d", got, limit)
+	}
+	// The glyph image for the size no longer used is evicted.
+	if got := glyphImage(20); got == first {
+		t.Errorf("the glyph image for the unused size must be evicted")
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// TextBox is a text component that reveals its text gradually, like a dialogue box or subtitles.
+//
+// A TextBox wraps the text by Width, reveals the text grapheme cluster by grapheme cluster at Update,
+// and scrolls the text so that the last revealed line is visible within Height.
+//
+// TextBox supports only horizontal faces.
+//
+// The zero value of TextBox is an empty text box. Face must be set before Draw.
+// The line wrapping is cached and updated when Face or Width is changed.
+// If the face's properties like the size are modified in place, call SetText again to update the line wrapping.
+type TextBox struct {
+	// Face is the font face.
+	Face Face
+
+	// Width is the width in pixels to wrap lines.
+	// Lines are wrapped at line break opportunities. See also AppendLineBreakSegments.
+	// If Hyphenator is set, a word that doesn't fit is also broken at a hyphenation point.
+	// If Width is 0 or less, lines are not wrapped.
+	Width float64
+
+	// Height is the height in pixels of the visible area.
+	// If the revealed lines don't fit with Height, the text is scrolled so that the last revealed line is visible.
+	// If Height is 0 or less, the text is not scrolled.
+	Height float64
+
+	// LineSpacing is a distance between two adjacent lines's baselines in pixels.
+	// If LineSpacing is 0, the sum of the face's ascent, descent and line gap is used.
+	LineSpacing float64
+
+	// Speed is the number of grapheme clusters revealed per tick.
+	// If Speed is 0 or less, the whole text is revealed at the next Update.
+	Speed float64
+
+	// Hyphenator is used to break a word that doesn't fit in Width with a hyphen.
+	// If Hyphenator is nil, words are not hyphenated.
+	Hyphenator *Hyphenator
+
+	// OnReveal is called for each grapheme cluster revealed at Update, e.g., to play a typing sound.
+	// index is the index of the grapheme cluster.
+	OnReveal func(cluster string, index int)
+
+	text     string
+	clusters []string
+
+	// revealed is the number of revealed grapheme clusters. revealed can be fractional.
+	revealed float64
+
+	// lines are the wrapped lines.
+	lines []textBoxLine
+
+	// The parameters used for the wrapped lines.
+	lineFace       Face
+	lineWidth      float64
+	lineHyphenator *Hyphenator
+
+	tmpGlyphs []Glyph
+	tmpPoints []int
+}
+
+type textBoxLine struct {
+	start int
+	end   int
+
+	// hyphen reports whether the line is broken at a hyphenation point.
+	hyphen bool
+}
+
+const textBoxHyphen = "-"
+
+// SetText sets the text and resets the revealing state.
+func (t *TextBox) SetText(text string) {
+	t.text = text
+	t.clusters = AppendGraphemeClusters(t.clusters[:0], text)
+	t.revealed = 0
+	t.lines = t.lines[:0]
+	t.lineFace = nil
+}
+
+// Text returns the whole text.
+func (t *TextBox) Text() string {
+	return t.text
+}
+
+// RevealedText returns the revealed part of the text.
+func (t *TextBox) RevealedText() string {
+	return t.text[:t.revealedBytes()]
+}
+
+// IsFullyRevealed reports whether the whole text is revealed.
+func (t *TextBox) IsFullyRevealed() bool {
+	return int(t.revealed) >= len(t.clusters)
+}
+
+// RevealAll reveals the whole text immediately, e.g., when the player presses a button to skip.
+// OnReveal is not called for the grapheme clusters revealed by RevealAll.
+func (t *TextBox) RevealAll() {
+	t.revealed = float64(len(t.clusters))
+}
+
+// Update advances the revealing state by one tick.
+func (t *TextBox) Update() {
+	if t.IsFullyRevealed() {
+		return
+	}
+
+	prev := int(t.revealed)
+	if t.Speed <= 0 {
+		t.revealed = float64(len(t.clusters))
+	} else {
+		t.revealed = min(t.revealed+t.Speed, float64(len(t.clusters)))
+	}
+	if t.OnReveal == nil {
+		return
+	}
+	for i := prev; i < int(t.revealed); i++ {
+		t.OnReveal(t.clusters[i], i)
+	}
+}
+
+// ScrollY returns the current scroll position in pixels.
+func (t *TextBox) ScrollY() float64 {
+	if t.Height <= 0 || t.Face == nil {
+		return 0
+	}
+	t.ensureLines()
+
+	n := t.revealedBytes()
+	last := 0
+	for i, l := range t.lines {
+		if l.start > n || (l.start == n && i > 0) {
+			break
+		}
+		last = i
+	}
+	m := t.Face.Metrics()
+	bottom := float64(last)*t.lineSpacing() + m.HAscent + m.HDescent
+	return max(0, bottom-t.Height)
+}
+
+// Draw draws the revealed part of the text on dst.
+//
+// The upper-left corner of the text box is put at (0, 0) and transformed by options.GeoM.
+// Lines that don't fit within Height after scrolling are not rendered.
+func (t *TextBox) Draw(dst *ebiten.Image, options *ebiten.DrawImageOptions) {
+	if t.Face == nil {
+		return
+	}
+	t.ensureLines()
+
+	var op ebiten.DrawImageOptions
+	if options != nil {
+		op = *options
+	}
+	geoM := op.GeoM
+
+	n := t.revealedBytes()
+	scrollY := t.ScrollY()
+	m := t.Face.Metrics()
+	for i, l := range t.lines {
+		if l.start >= n {
+			break
+		}
+		y := float64(i)*t.lineSpacing() - scrollY
+		if y < 0 {
+			continue
+		}
+		if t.Height > 0 && y+m.HAscent+m.HDescent > t.Height {
+			break
+		}
+
+		// Shape the whole line and filter the glyphs so that the shaping cache is reused while revealing.
+		line := t.text[l.start:l.end]
+		if l.hyphen {
+			line += textBoxHyphen
+		}
+		t.tmpGlyphs = AppendGlyphs(t.tmpGlyphs[:0], line, t.Face, nil)
+		for _, g := range t.tmpGlyphs {
+			if g.Image == nil {
+				continue
+			}
+			// The hyphen is revealed with the last character of the line.
+			if l.start+min(g.EndIndexInBytes, l.end-l.start) > n {
+				continue
+			}
+			op.GeoM = g.imageGeoM()
+			op.GeoM.Translate(0, y)
+			op.GeoM.Concat(geoM)
+			dst.DrawImage(g.Image, &op)
+		}
+	}
+}
+
+func (t *TextBox) revealedBytes() int {
+	var n int
+	for _, c := range t.clusters[:min(int(t.revealed), len(t.clusters))] {
+		n += len(c)
+	}
+	return n
+}
+
+func (t *TextBox) lineSpacing() float64 {
+	if t.LineSpacing != 0 {
+		return t.LineSpacing
+	}
+	m := t.Face.Metrics()
+	return m.HAscent + m.HDescent + m.HLineGap
+}
+
+func (t *TextBox) ensureLines() {
+	if t.lineFace != nil && t.lineFace == t.Face && t.lineWidth == t.Width && t.lineHyphenator == t.Hyphenator {
+		return
+	}
+	t.lineFace = t.Face
+	t.lineWidth = t.Width
+	t.lineHyphenator = t.Hyphenator
+	t.lines = t.lines[:0]
+
+	var start, end int
+	for _, seg := range AppendLineBreakSegments(nil, t.text) {
+		next := end + len(seg)
+
+		// Trailing spaces and line breaks don't count for the width.
+		for t.Width > 0 && Advance(strings.TrimRight(t.text[start:next], " \t\r\n"), t.Face) > t.Width {
+			// Break the line at a hyphenation point of the segment to fill the current line as much as possible.
+			if p, ok := t.hyphenationPoint(start, end, seg); ok {
+				t.lines = append(t.lines, textBoxLine{start: start, end: p, hyphen: true})
+				start = p
+				continue
+			}
+			// Break the line before the segment if the segment doesn't fit.
+			if end > start {
+				t.lines = append(t.lines, textBoxLine{start: start, end: end})
+				start = end
+				continue
+			}
+			break
+		}
+		end = next
+
+		if strings.HasSuffix(seg, "\n") {
+			t.lines = append(t.lines, textBoxLine{start: start, end: start + len(strings.TrimRight(t.text[start:end], "\r\n"))})
+			start = end
+		}
+	}
+	if start < len(t.text) || len(t.lines) == 0 {
+		t.lines = append(t.lines, textBoxLine{start: start, end: len(t.text)})
+	}
+}
+
+// hyphenationPoint returns the last hyphenation point in bytes of the segment seg starting at segStart,
+// where the line starting at lineStart fits in Width with a hyphen.
+func (t *TextBox) hyphenationPoint(lineStart, segStart int, seg string) (int, bool) {
+	if t.Hyphenator == nil {
+		return 0, false
+	}
+	t.tmpPoints = t.Hyphenator.AppendHyphenationPoints(t.tmpPoints[:0], seg)
+	for i := len(t.tmpPoints) - 1; i >= 0; i-- {
+		p := segStart + t.tmpPoints[i]
+		if p <= lineStart {
+			break
+		}
+		if Advance(t.text[lineStart:p]+textBoxHyphen, t.Face) <= t.Width {
+			return p, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Truncate returns the text truncated to fit in the given width in pixels, with the given ellipsis appended.
+// If the text already fits in the width, Truncate returns the text as it is.
+//
+// The widths are measured with Advance, i.e., with the actual shaped advances of the face,
+// so kerning and ligatures are taken into account.
+//
+// The text is cut only at the boundaries of grapheme clusters, so an emoji or a character with combining marks is never split.
+// White spaces just before the ellipsis are removed.
+// If the text has unterminated bidi embeddings or isolates after truncation, they are terminated before the ellipsis,
+// so that the ellipsis is placed at the end of the line in the paragraph direction.
+//
+// If even the ellipsis doesn't fit in the width, Truncate returns an empty string.
+//
+// Truncate doesn't treat multiple lines.
+//
+// Truncate is concurrent-safe.
+func Truncate(text string, face Face, width float64, ellipsis string) string {
+	if face.advance(text) <= width {
+		return text
+	}
+
+	// As clusters share the underlying string, the cost of the slice is small.
+	clusters := AppendGraphemeClusters(nil, text)
+	offsets := make([]int, len(clusters)+1)
+	for i, c := range clusters {
+		offsets[i+1] = offsets[i] + len(c)
+	}
+
+	// Find the largest number of clusters that fits in the width by binary search.
+	// The advance is not strictly monotonic due to shaping, but it is monotonic enough for this purpose.
+	var best string
+	var found bool
+	lo, hi := 0, len(clusters)-1
+	for lo <= hi {
+		n := (lo + hi) / 2
+		s := truncatedString(text[:offsets[n]], ellipsis)
+		if face.advance(s) <= width {
+			best = s
+			found = true
+			lo = n + 1
+		} else {
+			hi = n - 1
+		}
+	}
+	if !found {
+		return ""
+	}
+	return best
+}
+
+func truncatedString(text string, ellipsis string) string {
+	text = strings.TrimRightFunc(text, unicode.IsSpace)
+
+	// Terminate unterminated bidi embeddings, overrides, and isolates.
+	var stack []rune
+	for _, r := range text {
+		switch r {
+		case '\u202a', '\u202b', '\u202d', '\u202e': // LRE, RLE, LRO, RLO
+			stack = append(stack, '\u202c') // PDF
+		case '\u2066', '\u2067', '\u2068': // LRI, RLI, FSI
+			stack = append(stack, '\u2069') // PDI
+		case '\u202c':
+			// A PDF doesn't terminate an isolate.
+			if len(stack) > 0 && stack[len(stack)-1] == '\u202c' {
+				stack = stack[:len(stack)-1]
+			}
+		case '\u2069':
+			// A PDI terminates the last isolate and all the embeddings in it.
+			for len(stack) > 0 {
+				r := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				if r == '\u2069' {
+					break
+				}
+			}
+		}
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(text) + len(stack)*3 + len(ellipsis))
+	sb.WriteString(text)
+	for i := len(stack) - 1; i >= 0; i-- {
+		sb.WriteRune(stack[i])
+	}
+	sb.WriteString(ellipsis)
+	return sb.String()
+}
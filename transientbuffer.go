@@ -0,0 +1,113 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten
+
+import (
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/hook"
+)
+
+// transientBuffer is an arena allocator of slices that are valid until the end of the current frame.
+type transientBuffer[T any] struct {
+	buf  []T
+	used int
+
+	// overflow is the number of elements allocated outside of buf in the current frame.
+	overflow int
+
+	m sync.Mutex
+}
+
+func (t *transientBuffer[T]) alloc(n int) []T {
+	if n < 0 {
+		panic("ebiten: n must be non-negative")
+	}
+
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if t.used+n > len(t.buf) {
+		// The buffer is exhausted. Allocate a new slice for this frame, and extend the buffer at reset.
+		t.overflow += n
+		return make([]T, n)
+	}
+
+	// Limit the capacity so that appending to the returned slice never overwrites other slices.
+	s := t.buf[t.used : t.used+n : t.used+n]
+	t.used += n
+	clear(s)
+	return s
+}
+
+func (t *transientBuffer[T]) reset() {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if t.overflow > 0 {
+		// Reallocate the buffer so that the next frames with the same usage don't allocate any slices.
+		t.buf = make([]T, 2*(t.used+t.overflow))
+	}
+	t.used = 0
+	t.overflow = 0
+}
+
+var (
+	theTransientVertices  transientBuffer[Vertex]
+	theTransientIndices   transientBuffer[uint16]
+	theTransientIndices32 transientBuffer[uint32]
+)
+
+func init() {
+	hook.AppendHookOnAfterFrame(func() {
+		theTransientVertices.reset()
+		theTransientIndices.reset()
+		theTransientIndices32.reset()
+	})
+}
+
+// Vertices returns a zero-cleared slice of n vertices from a per-frame buffer.
+//
+// The returned slice is valid until the end of the current frame, i.e., until Draw returns.
+// After that, the slice's content might be overwritten by the following calls of Vertices.
+// Do not hold the returned slice across frames.
+//
+// Vertices is useful to call DrawTriangles many times without allocating slices every frame.
+// After the first several frames, Vertices doesn't allocate as long as the total size per frame doesn't grow.
+//
+// The capacity of the returned slice is n. Appending to the returned slice allocates a new slice.
+//
+// Vertices is concurrent-safe.
+func Vertices(n int) []Vertex {
+	return theTransientVertices.alloc(n)
+}
+
+// Indices returns a zero-cleared slice of n indices for DrawTriangles from a per-frame buffer.
+//
+// The returned slice has the same lifetime as slices returned by Vertices.
+//
+// Indices is concurrent-safe.
+func Indices(n int) []uint16 {
+	return theTransientIndices.alloc(n)
+}
+
+// Indices32 returns a zero-cleared slice of n indices for DrawTriangles32 from a per-frame buffer.
+//
+// The returned slice has the same lifetime as slices returned by Vertices.
+//
+// Indices32 is concurrent-safe.
+func Indices32(n int) []uint32 {
+	return theTransientIndices32.alloc(n)
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+type validator struct {
+	handler func(err error)
+	errs    []error
+	m       sync.Mutex
+}
+
+var theValidator validator
+
+func (v *validator) setHandler(handler func(err error)) {
+	v.m.Lock()
+	defer v.m.Unlock()
+	v.handler = handler
+}
+
+// isStrict reports whether the strict validation mode is enabled.
+func (v *validator) isStrict() bool {
+	v.m.Lock()
+	defer v.m.Unlock()
+	return v.handler != nil
+}
+
+// report reports an invalid operation with the given message.
+//
+// In the strict validation mode, report records an error with the caller's location.
+// Otherwise, report panics with the message.
+func (v *validator) report(msg string) {
+	v.m.Lock()
+	defer v.m.Unlock()
+
+	if v.handler == nil {
+		panic(msg)
+	}
+
+	if file, line, ok := callerOutsideEbitengine(); ok {
+		msg = fmt.Sprintf("%s (called at %s:%d)", msg, file, line)
+	}
+	v.errs = append(v.errs, errors.New(msg))
+}
+
+// flush calls the handler with the recorded errors if any.
+func (v *validator) flush() {
+	v.m.Lock()
+	handler := v.handler
+	errs := v.errs
+	v.errs = nil
+	v.m.Unlock()
+
+	if handler == nil || len(errs) == 0 {
+		return
+	}
+	handler(errors.Join(errs...))
+}
+
+// callerOutsideEbitengine returns the location of the nearest caller outside of this package.
+func callerOutsideEbitengine() (file string, line int, ok bool) {
+	var pcs [16]uintptr
+	// Skip runtime.Callers, callerOutsideEbitengine, and validator.report.
+	n := runtime.Callers(3, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		f, more := frames.Next()
+		if f.Function != "" && !isEbitenginePackageFunction(f.Function) {
+			return f.File, f.Line, true
+		}
+		if !more {
+			break
+		}
+	}
+	return "", 0, false
+}
+
+func isEbitenginePackageFunction(name string) bool {
+	// Functions in subpackages like github.com/hajimehoshi/ebiten/v2/vector don't have this prefix.
+	return strings.HasPrefix(name, "github.com/hajimehoshi/ebiten/v2.")
+}
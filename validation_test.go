@@ -0,0 +1,143 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type validationTestGame struct {
+	draw func(screen *ebiten.Image)
+}
+
+func (g *validationTestGame) Update() error {
+	return nil
+}
+
+func (g *validationTestGame) Draw(screen *ebiten.Image) {
+	g.draw(screen)
+}
+
+func (g *validationTestGame) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return outsideWidth, outsideHeight
+}
+
+func TestValidationPanicWithoutHandler(t *testing.T) {
+	img := ebiten.NewImage(16, 16)
+	defer img.Deallocate()
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("DrawImage must panic")
+		}
+		if got, want := fmt.Sprint(r), "ebiten: the given image to DrawImage must be different from the receiver"; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+	}()
+	img.DrawImage(img, nil)
+}
+
+func TestValidationHandlerRecordsCaller(t *testing.T) {
+	var errs []error
+	ebiten.SetValidationErrorHandler(func(err error) {
+		errs = append(errs, err)
+	})
+	defer ebiten.SetValidationErrorHandler(nil)
+
+	img := ebiten.NewImage(16, 16)
+	defer img.Deallocate()
+
+	var file string
+	var line int
+	g := &validationTestGame{
+		draw: func(screen *ebiten.Image) {
+			screen.DrawImage(screen, nil)
+			_, file, line, _ = runtime.Caller(0)
+			line--
+		},
+	}
+	if err := ebiten.DrawOffscreenForTesting(g, img); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(errs), 1; got != want {
+		t.Fatalf("len(errs): got: %d, want: %d", got, want)
+	}
+	msg := errs[0].Error()
+	if want := "ebiten: the given image to DrawImage must be different from the receiver"; !strings.HasPrefix(msg, want) {
+		t.Errorf("got: %q, want: prefix %q", msg, want)
+	}
+	if want := fmt.Sprintf("%s:%d)", filepath.ToSlash(file), line); !strings.HasSuffix(filepath.ToSlash(msg), want) {
+		t.Errorf("got: %q, want: suffix %q", msg, want)
+	}
+}
+
+func TestValidationFlushAfterDraw(t *testing.T) {
+	var events []string
+	var errs []error
+	ebiten.SetValidationErrorHandler(func(err error) {
+		events = append(events, "handler")
+		errs = append(errs, err)
+	})
+	defer ebiten.SetValidationErrorHandler(nil)
+
+	img := ebiten.NewImage(16, 16)
+	defer img.Deallocate()
+	src := ebiten.NewImage(16, 16)
+	defer src.Deallocate()
+
+	g := &validationTestGame{
+		draw: func(screen *ebiten.Image) {
+			events = append(events, "draw start")
+			screen.DrawImage(screen, nil)
+			screen.DrawTriangles(nil, []uint16{0, 1}, src, nil)
+			events = append(events, "draw end")
+		},
+	}
+	if err := ebiten.DrawOffscreenForTesting(g, img); err != nil {
+		t.Fatal(err)
+	}
+
+	// The errors in one frame are joined and passed to the handler once, after Draw.
+	if got, want := events, []string{"draw start", "draw end", "handler"}; !slices.Equal(got, want) {
+		t.Errorf("events: got: %v, want: %v", got, want)
+	}
+	if got, want := len(errs), 1; got != want {
+		t.Fatalf("len(errs): got: %d, want: %d", got, want)
+	}
+	if got, want := len(strings.Split(errs[0].Error(), "\n")), 2; got != want {
+		t.Errorf("the number of joined errors: got: %d, want: %d", got, want)
+	}
+
+	// The handler is not called when there are no errors.
+	events = nil
+	g.draw = func(screen *ebiten.Image) {
+		events = append(events, "draw")
+	}
+	if err := ebiten.DrawOffscreenForTesting(g, img); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := events, []string{"draw"}; !slices.Equal(got, want) {
+		t.Errorf("events: got: %v, want: %v", got, want)
+	}
+}
@@ -589,6 +589,47 @@ type StrokeOptions struct {
 	//
 	// The default (zero) value is 0.
 	MiterLimit float32
+
+	// WidthFunc is a function to specify the stroke width at each position along a subpath,
+	// e.g., for brush strokes, tapered trails, and calligraphic lines.
+	//
+	// progress is the length from the start of the subpath to the position divided by the subpath's total length,
+	// in [0, 1]. WidthFunc returns the stroke width in pixels at the position.
+	// The width is interpolated linearly between the points of the flattened subpath.
+	//
+	// If WidthFunc is non-nil, Width is ignored.
+	//
+	// The default (zero) value is nil.
+	WidthFunc func(progress float32) float32
+}
+
+// appendStrokeWidths appends the stroke widths at the points of the subpath to widths and returns the extended slice.
+func (s *StrokeOptions) appendStrokeWidths(widths []float32, subpath subpath) []float32 {
+	if s.WidthFunc == nil {
+		for range subpath.points {
+			widths = append(widths, s.Width)
+		}
+		return widths
+	}
+
+	// Use widths as a buffer for the accumulated lengths first.
+	n := len(widths)
+	var length float32
+	for i, pt := range subpath.points {
+		if i > 0 {
+			prev := subpath.points[i-1]
+			length += float32(math.Hypot(float64(pt.x-prev.x), float64(pt.y-prev.y)))
+		}
+		widths = append(widths, length)
+	}
+	for i := n; i < len(widths); i++ {
+		var progress float32
+		if length > 0 {
+			progress = widths[i] / length
+		}
+		widths[i] = s.WidthFunc(progress)
+	}
+	return widths
 }
 
 // AppendVerticesAndIndicesForStroke appends vertices and indices to render a stroke of this path and returns them.
@@ -605,12 +646,16 @@ func (p *Path) AppendVerticesAndIndicesForStroke(vertices []ebiten.Vertex, indic
 	}
 
 	var rects [][4]point
+	var widths []float32
 	var tmpPath Path
 	for _, subpath := range p.ensureSubpaths() {
 		if subpath.pointCount() < 2 {
 			continue
 		}
 
+		widths = op.appendStrokeWidths(widths[:0], subpath)
+
+		// A rect can be a trapezoid when the widths at the both ends differ.
 		rects = rects[:0]
 		for i := 0; i < subpath.pointCount()-1; i++ {
 			pt := subpath.points[i]
@@ -619,8 +664,10 @@ func (p *Path) AppendVerticesAndIndicesForStroke(vertices []ebiten.Vertex, indic
 			dx := nextPt.x - pt.x
 			dy := nextPt.y - pt.y
 			dist := float32(math.Sqrt(float64(dx*dx + dy*dy)))
-			extX := (dy) * op.Width / 2 / dist
-			extY := (-dx) * op.Width / 2 / dist
+			extX := (dy) * widths[i] / 2 / dist
+			extY := (-dx) * widths[i] / 2 / dist
+			nextExtX := (dy) * widths[i+1] / 2 / dist
+			nextExtY := (-dx) * widths[i+1] / 2 / dist
 
 			rects = append(rects, [4]point{
 				{
@@ -628,16 +675,16 @@ func (p *Path) AppendVerticesAndIndicesForStroke(vertices []ebiten.Vertex, indic
 					y: pt.y + extY,
 				},
 				{
-					x: nextPt.x + extX,
-					y: nextPt.y + extY,
+					x: nextPt.x + nextExtX,
+					y: nextPt.y + nextExtY,
 				},
 				{
 					x: pt.x - extX,
 					y: pt.y - extY,
 				},
 				{
-					x: nextPt.x - extX,
-					y: nextPt.y - extY,
+					x: nextPt.x - nextExtX,
+					y: nextPt.y - nextExtY,
 				},
 			})
 		}
@@ -729,9 +776,9 @@ func (p *Path) AppendVerticesAndIndicesForStroke(vertices []ebiten.Vertex, indic
 				tmpPath.Reset()
 				tmpPath.MoveTo(c.x, c.y)
 				if da < math.Pi {
-					tmpPath.Arc(c.x, c.y, op.Width/2, a0, a1, Clockwise)
+					tmpPath.Arc(c.x, c.y, widths[i+1]/2, a0, a1, Clockwise)
 				} else {
-					tmpPath.Arc(c.x, c.y, op.Width/2, a0+math.Pi, a1+math.Pi, CounterClockwise)
+					tmpPath.Arc(c.x, c.y, widths[i+1]/2, a0+math.Pi, a1+math.Pi, CounterClockwise)
 				}
 				vertices, indices = tmpPath.AppendVerticesAndIndicesForFilling(vertices, indices)
 			}
@@ -752,6 +799,7 @@ func (p *Path) AppendVerticesAndIndicesForStroke(vertices []ebiten.Vertex, indic
 
 		case LineCapRound:
 			startR, endR := rects[0], rects[len(rects)-1]
+			startW, endW := widths[0], widths[len(widths)-1]
 			{
 				c := point{
 					x: (startR[0].x + startR[2].x) / 2,
@@ -761,7 +809,7 @@ func (p *Path) AppendVerticesAndIndicesForStroke(vertices []ebiten.Vertex, indic
 				// Arc
 				tmpPath.Reset()
 				tmpPath.MoveTo(startR[0].x, startR[0].y)
-				tmpPath.Arc(c.x, c.y, op.Width/2, a, a+math.Pi, CounterClockwise)
+				tmpPath.Arc(c.x, c.y, startW/2, a, a+math.Pi, CounterClockwise)
 				vertices, indices = tmpPath.AppendVerticesAndIndicesForFilling(vertices, indices)
 			}
 			{
@@ -773,16 +821,20 @@ func (p *Path) AppendVerticesAndIndicesForStroke(vertices []ebiten.Vertex, indic
 				// Arc
 				tmpPath.Reset()
 				tmpPath.MoveTo(endR[1].x, endR[1].y)
-				tmpPath.Arc(c.x, c.y, op.Width/2, a, a+math.Pi, Clockwise)
+				tmpPath.Arc(c.x, c.y, endW/2, a, a+math.Pi, Clockwise)
 				vertices, indices = tmpPath.AppendVerticesAndIndicesForFilling(vertices, indices)
 			}
 
 		case LineCapSquare:
 			startR, endR := rects[0], rects[len(rects)-1]
+			startW, endW := widths[0], widths[len(widths)-1]
+			// Use the subpath's points for the directions, as a rect's edge is not parallel to the segment
+			// when the widths at the both ends differ.
+			pts := subpath.points
 			{
-				a := math.Atan2(float64(startR[0].y-startR[1].y), float64(startR[0].x-startR[1].x))
+				a := math.Atan2(float64(pts[0].y-pts[1].y), float64(pts[0].x-pts[1].x))
 				s, c := math.Sincos(a)
-				dx, dy := float32(c)*op.Width/2, float32(s)*op.Width/2
+				dx, dy := float32(c)*startW/2, float32(s)*startW/2
 
 				// Quadrilateral
 				tmpPath.Reset()
@@ -793,9 +845,9 @@ func (p *Path) AppendVerticesAndIndicesForStroke(vertices []ebiten.Vertex, indic
 				vertices, indices = tmpPath.AppendVerticesAndIndicesForFilling(vertices, indices)
 			}
 			{
-				a := math.Atan2(float64(endR[1].y-endR[0].y), float64(endR[1].x-endR[0].x))
+				a := math.Atan2(float64(pts[len(pts)-1].y-pts[len(pts)-2].y), float64(pts[len(pts)-1].x-pts[len(pts)-2].x))
 				s, c := math.Sincos(a)
-				dx, dy := float32(c)*op.Width/2, float32(s)*op.Width/2
+				dx, dy := float32(c)*endW/2, float32(s)*endW/2
 
 				// Quadrilateral
 				tmpPath.Reset()
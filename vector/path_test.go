@@ -86,3 +86,129 @@ func TestIsPointCloseToSegment(t *testing.T) {
 		}
 	}
 }
+
+func TestStrokeWidthFunc(t *testing.T) {
+	var p vector.Path
+	p.MoveTo(0, 0)
+	p.LineTo(100, 0)
+	p.LineTo(200, 0)
+
+	op := &vector.StrokeOptions{
+		Width: 100,
+		WidthFunc: func(progress float32) float32 {
+			// Taper from 20 to 0.
+			return 20 * (1 - progress)
+		},
+	}
+	vs, _ := p.AppendVerticesAndIndicesForStroke(nil, nil, op)
+	if len(vs) == 0 {
+		t.Fatal("AppendVerticesAndIndicesForStroke: got: no vertices, want: some vertices")
+	}
+	for _, v := range vs {
+		// The half width at x is 10 * (1 - x/200).
+		want := 10 * (1 - v.DstX/200)
+		if got := abs32(v.DstY); got > want+1e-3 {
+			t.Errorf("|DstY| at DstX=%f: got: %f, want: <= %f", v.DstX, got, want)
+		}
+	}
+}
+
+func abs32(x float32) float32 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func TestStrokeWidthFuncCapsAndJoins(t *testing.T) {
+	// An L-shaped path. The total length is 200, and the width at the corner is 15.
+	var p vector.Path
+	p.MoveTo(0, 0)
+	p.LineTo(100, 0)
+	p.LineTo(100, 100)
+
+	widthFunc := func(progress float32) float32 {
+		// Taper from 20 to 10.
+		return 20 - 10*progress
+	}
+
+	testCases := []struct {
+		Name      string
+		LineCap   vector.LineCap
+		LineJoin  vector.LineJoin
+		MinX      float32
+		MinY      float32
+		MaxX      float32
+		MaxY      float32
+		Tolerance float32
+	}{
+		{
+			Name:      "butt and bevel",
+			LineCap:   vector.LineCapButt,
+			LineJoin:  vector.LineJoinBevel,
+			MinX:      0,
+			MinY:      -10,
+			MaxX:      107.5,
+			MaxY:      100,
+			Tolerance: 1e-3,
+		},
+		{
+			// The square caps must be along the segments, even though the edges of the stroke are not parallel to them.
+			// The miter is the crossing point of the tapered edges.
+			Name:      "square and miter",
+			LineCap:   vector.LineCapSquare,
+			LineJoin:  vector.LineJoinMiter,
+			MinX:      -10,
+			MinY:      -10,
+			MaxX:      107.6827,
+			MaxY:      105,
+			Tolerance: 1e-3,
+		},
+		{
+			// The arcs are flattened, so the bounds are approximate.
+			Name:      "round and round",
+			LineCap:   vector.LineCapRound,
+			LineJoin:  vector.LineJoinRound,
+			MinX:      -10,
+			MinY:      -10,
+			MaxX:      107.5,
+			MaxY:      105,
+			Tolerance: 0.5,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			op := &vector.StrokeOptions{
+				WidthFunc:  widthFunc,
+				LineCap:    tc.LineCap,
+				LineJoin:   tc.LineJoin,
+				MiterLimit: 10,
+			}
+			vs, _ := p.AppendVerticesAndIndicesForStroke(nil, nil, op)
+			if len(vs) == 0 {
+				t.Fatal("AppendVerticesAndIndicesForStroke: got: no vertices, want: some vertices")
+			}
+
+			minX, minY, maxX, maxY := vs[0].DstX, vs[0].DstY, vs[0].DstX, vs[0].DstY
+			for _, v := range vs {
+				minX = min(minX, v.DstX)
+				minY = min(minY, v.DstY)
+				maxX = max(maxX, v.DstX)
+				maxY = max(maxY, v.DstY)
+			}
+			for _, b := range []struct {
+				name      string
+				got, want float32
+			}{
+				{"min x", minX, tc.MinX},
+				{"min y", minY, tc.MinY},
+				{"max x", maxX, tc.MaxX},
+				{"max y", maxY, tc.MaxY},
+			} {
+				if abs32(b.got-b.want) > tc.Tolerance {
+					t.Errorf("%s: got: %f, want: %f", b.name, b.got, b.want)
+				}
+			}
+		})
+	}
+}
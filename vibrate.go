@@ -54,6 +54,46 @@ func Vibrate(options *VibrateOptions) {
 	vibrate.Vibrate(options.Duration, options.Magnitude)
 }
 
+// VibrateStep represents a step of a vibration pattern.
+type VibrateStep struct {
+	// Duration is the time duration of the step.
+	Duration time.Duration
+
+	// Magnitude is the strength of the device vibration.
+	// The value is in between 0 and 1.
+	// A step with 0 magnitude is a pause.
+	Magnitude float64
+}
+
+// VibratePatternOptions represents the options for a device vibration pattern.
+type VibratePatternOptions struct {
+	// Steps is the sequence of the vibration steps.
+	Steps []VibrateStep
+}
+
+// VibratePattern vibrates the device with the specified pattern.
+//
+// VibratePattern works on mobiles and browsers.
+//
+// On browsers, Magnitude in the steps is ignored except that a step with 0 magnitude is a pause.
+//
+// On Android, the same manifest setting as Vibrate is required.
+// On Android, Magnitude in the steps is recognized only when the API Level is 26 or newer and the device supports
+// amplitude control. Otherwise, Magnitude is ignored except that a step with 0 magnitude is a pause.
+//
+// On iOS, the same requirements as Vibrate are applied.
+//
+// VibratePattern is concurrent-safe.
+func VibratePattern(options *VibratePatternOptions) {
+	durations := make([]time.Duration, len(options.Steps))
+	magnitudes := make([]float64, len(options.Steps))
+	for i, s := range options.Steps {
+		durations[i] = s.Duration
+		magnitudes[i] = s.Magnitude
+	}
+	vibrate.VibratePattern(durations, magnitudes)
+}
+
 // VibrateGamepadOptions represents the options for gamepad vibration.
 type VibrateGamepadOptions struct {
 	// Duration is the time duration of the effect.
@@ -0,0 +1,59 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebiten
+
+import (
+	"github.com/hajimehoshi/ebiten/v2/internal/ui"
+)
+
+// ShowVirtualKeyboard shows the on-screen virtual keyboard.
+//
+// The input from the virtual keyboard is available via AppendInputChars and IsKeyPressed.
+// If you use exp/textinput's Field, you don't have to call ShowVirtualKeyboard, as Field's Focus shows the virtual
+// keyboard.
+//
+// ShowVirtualKeyboard works only on Android and iOS so far.
+// On browsers, the virtual keyboard is shown by the browser when an editable element is focused, e.g., by exp/textinput.
+//
+// ShowVirtualKeyboard is concurrent-safe.
+func ShowVirtualKeyboard() {
+	ui.Get().ShowVirtualKeyboard()
+}
+
+// HideVirtualKeyboard hides the on-screen virtual keyboard.
+//
+// HideVirtualKeyboard works only on Android and iOS so far.
+//
+// HideVirtualKeyboard is concurrent-safe.
+func HideVirtualKeyboard() {
+	ui.Get().HideVirtualKeyboard()
+}
+
+// VirtualKeyboardHeight returns the height of the area covered by the on-screen virtual keyboard at the bottom of
+// the game screen in device-independent pixels.
+// The height is in the same unit as the outside size given to Layout.
+// VirtualKeyboardHeight returns 0 when the virtual keyboard is hidden.
+//
+// The height changes while the virtual keyboard is appearing or disappearing.
+// You can check the height every tick and, for example, scroll a chat box above the virtual keyboard.
+//
+// On Android, VirtualKeyboardHeight works only on Android 11 (API level 30) or newer.
+// On browsers, VirtualKeyboardHeight works only when navigator.virtualKeyboard.overlaysContent is true.
+// On the other environments, VirtualKeyboardHeight returns 0.
+//
+// VirtualKeyboardHeight is concurrent-safe.
+func VirtualKeyboardHeight() float64 {
+	return ui.Get().VirtualKeyboardHeight()
+}
@@ -351,3 +351,159 @@ func IsWindowMousePassthrough() bool {
 func RequestAttention() {
 	ui.Get().Window().RequestAttention()
 }
+
+// WindowProgressState represents the state of a progress shown on the taskbar.
+type WindowProgressState int
+
+const (
+	// WindowProgressStateNone indicates that no progress is shown.
+	WindowProgressStateNone WindowProgressState = WindowProgressState(ui.WindowProgressStateNone)
+
+	// WindowProgressStateNormal indicates that a progress is shown in the normal state.
+	WindowProgressStateNormal WindowProgressState = WindowProgressState(ui.WindowProgressStateNormal)
+
+	// WindowProgressStateIndeterminate indicates that an indeterminate progress is shown.
+	// The progress value is ignored in this state.
+	WindowProgressStateIndeterminate WindowProgressState = WindowProgressState(ui.WindowProgressStateIndeterminate)
+
+	// WindowProgressStatePaused indicates that a progress is shown in the paused state.
+	WindowProgressStatePaused WindowProgressState = WindowProgressState(ui.WindowProgressStatePaused)
+
+	// WindowProgressStateError indicates that a progress is shown in the error state.
+	WindowProgressStateError WindowProgressState = WindowProgressState(ui.WindowProgressStateError)
+)
+
+// SetWindowProgress sets the progress shown on the taskbar button of the window.
+// progress is in the range of [0, 1]. If progress is out of the range, progress is clamped.
+//
+// SetWindowProgress is useful to show the progress of a long task, e.g., baking assets, while the window is not focused.
+//
+// SetWindowProgress works only on Windows so far.
+// SetWindowProgress does nothing on the other platforms.
+// SetWindowProgress does nothing before the game starts.
+//
+// SetWindowProgress is concurrent-safe.
+func SetWindowProgress(state WindowProgressState, progress float64) {
+	ui.Get().Window().SetProgress(ui.WindowProgressState(state), progress)
+}
+
+// SetWindowBadge sets the badge label shown on the application icon, e.g., the number of unread notifications.
+// An empty label removes the badge.
+//
+// SetWindowBadge works only on macOS so far, where the label is shown on the Dock icon.
+// SetWindowBadge does nothing on the other platforms.
+// SetWindowBadge does nothing before the game starts.
+//
+// To request user attention, e.g., bouncing the Dock icon on macOS, flashing the taskbar button on Windows,
+// or setting the urgency hint on Linux, use RequestAttention.
+//
+// SetWindowBadge is concurrent-safe.
+func SetWindowBadge(label string) {
+	ui.Get().Window().SetBadge(label)
+}
+
+// WindowHitTestKind represents the kind of a hit-test region of the window.
+type WindowHitTestKind int
+
+const (
+	// WindowHitTestKindCaption indicates a region that works as a title bar.
+	// Dragging the region with the left mouse button moves the window.
+	WindowHitTestKindCaption WindowHitTestKind = WindowHitTestKind(ui.WindowHitTestKindCaption)
+
+	// WindowHitTestKindMinimize indicates a region that works as a minimize button.
+	WindowHitTestKindMinimize WindowHitTestKind = WindowHitTestKind(ui.WindowHitTestKindMinimize)
+
+	// WindowHitTestKindMaximize indicates a region that works as a maximize button.
+	// Clicking the region maximizes the window, or restores the window if the window is already maximized.
+	WindowHitTestKindMaximize WindowHitTestKind = WindowHitTestKind(ui.WindowHitTestKindMaximize)
+
+	// WindowHitTestKindClose indicates a region that works as a close button.
+	// Clicking the region is treated in the same way as clicking the close button of the title bar.
+	// See also SetWindowClosingHandled.
+	WindowHitTestKindClose WindowHitTestKind = WindowHitTestKind(ui.WindowHitTestKindClose)
+
+	// WindowHitTestKindResizeLeft indicates a region that works as the left edge of the window frame.
+	// Dragging the region resizes the window.
+	// The resize regions work only on Windows and when the window is resizable.
+	WindowHitTestKindResizeLeft WindowHitTestKind = WindowHitTestKind(ui.WindowHitTestKindResizeLeft)
+
+	// WindowHitTestKindResizeRight indicates a region that works as the right edge of the window frame.
+	WindowHitTestKindResizeRight WindowHitTestKind = WindowHitTestKind(ui.WindowHitTestKindResizeRight)
+
+	// WindowHitTestKindResizeTop indicates a region that works as the top edge of the window frame.
+	WindowHitTestKindResizeTop WindowHitTestKind = WindowHitTestKind(ui.WindowHitTestKindResizeTop)
+
+	// WindowHitTestKindResizeBottom indicates a region that works as the bottom edge of the window frame.
+	WindowHitTestKindResizeBottom WindowHitTestKind = WindowHitTestKind(ui.WindowHitTestKindResizeBottom)
+
+	// WindowHitTestKindResizeTopLeft indicates a region that works as the top-left corner of the window frame.
+	WindowHitTestKindResizeTopLeft WindowHitTestKind = WindowHitTestKind(ui.WindowHitTestKindResizeTopLeft)
+
+	// WindowHitTestKindResizeTopRight indicates a region that works as the top-right corner of the window frame.
+	WindowHitTestKindResizeTopRight WindowHitTestKind = WindowHitTestKind(ui.WindowHitTestKindResizeTopRight)
+
+	// WindowHitTestKindResizeBottomLeft indicates a region that works as the bottom-left corner of the window frame.
+	WindowHitTestKindResizeBottomLeft WindowHitTestKind = WindowHitTestKind(ui.WindowHitTestKindResizeBottomLeft)
+
+	// WindowHitTestKindResizeBottomRight indicates a region that works as the bottom-right corner of the window frame.
+	WindowHitTestKindResizeBottomRight WindowHitTestKind = WindowHitTestKind(ui.WindowHitTestKindResizeBottomRight)
+)
+
+// WindowHitTestRegion represents a region of the window with a native title bar behavior.
+type WindowHitTestRegion struct {
+	// Bounds is the region in the same coordinate as CursorPosition.
+	Bounds image.Rectangle
+
+	// Kind is the kind of the region.
+	Kind WindowHitTestKind
+}
+
+// SetWindowHitTestRegions sets the regions that behave like a native title bar and its buttons.
+//
+// SetWindowHitTestRegions is useful to draw a custom title bar with an undecorated window (see SetWindowDecorated).
+// A button region is activated when the left mouse button is pressed and released on the region.
+// If regions overlap, a later region takes priority.
+// Passing nil or an empty slice removes all the regions.
+//
+// The regions are evaluated in the layout's coordinate, so the game should update the regions
+// when the layout changes.
+//
+// The caption and resize regions are handled by the platform's hit test where available,
+// so that the platform's behaviors like window snapping work.
+// On Windows, the caption and resize regions are treated as the non-client area, and
+// mouse events on them are not reported to the game.
+// On macOS, a caption region starts the platform's window dragging. The resize regions are ignored.
+// On Linux, moving a window by a caption region is emulated, and might not work with Wayland as the window position cannot be changed.
+// The resize regions are ignored.
+// Mouse events on the button regions are always reported to the game.
+//
+// SetWindowHitTestRegions works only on desktops.
+// SetWindowHitTestRegions does nothing if the platform is not a desktop.
+//
+// SetWindowHitTestRegions is concurrent-safe.
+func SetWindowHitTestRegions(regions []WindowHitTestRegion) {
+	rs := make([]ui.WindowHitTestRegion, len(regions))
+	for i, r := range regions {
+		rs[i] = ui.WindowHitTestRegion{
+			Bounds: r.Bounds,
+			Kind:   ui.WindowHitTestKind(r.Kind),
+		}
+	}
+	ui.Get().Window().SetHitTestRegions(rs)
+}
+
+// IsWindowBeingResized reports whether the window is being resized interactively by the user, e.g. by dragging its border.
+//
+// While the window is being resized interactively, the game's Update and Draw are not called on some platforms,
+// as the OS blocks the event loop.
+// Instead, Ebitengine re-presents the last frame scaled to the current window size,
+// and the game's DrawFinalScreen is called if the game implements FinalScreenDrawer.
+// IsWindowBeingResized is useful e.g. to skip heavy processing at DrawFinalScreen during the resizing.
+//
+// IsWindowBeingResized works only on Windows and macOS.
+// IsWindowBeingResized always returns false on the other platforms.
+//
+// IsWindowBeingResized is concurrent-safe.
+func IsWindowBeingResized() bool {
+	return ui.Get().Window().IsBeingResized()
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hajimehoshi/ebiten/v2/internal/hook"
+)
+
+// theGeneration is incremented when all the caches should be purged, e.g., when the system is low on memory.
+// Each budget is purged lazily when it is accessed next time.
+var theGeneration atomic.Int64
+
+func init() {
+	hook.AppendHookOnLifecycleEvent(func(event hook.LifecycleEvent) {
+		if event != hook.LifecycleEventLowMemory {
+			return
+		}
+		theGeneration.Add(1)
+	})
+}
+
+type budgetEntry interface {
+	entrySize() int64
+
+	// detach removes the entry from its cache, and returns a callback to notify the removal if needed.
+	detach() func()
+}
+
+// Budget is a size limit shared by multiple caches.
+//
+// When the total size of the entries in the caches sharing a budget exceeds the limit,
+// the least recently used entries among all the caches are evicted.
+type Budget struct {
+	limit int64
+	size  int64
+
+	// lru is the list of all the entries in the caches sharing the budget.
+	// The front is the most recently used entry.
+	lru list.List
+
+	generation int64
+
+	m sync.Mutex
+}
+
+// NewBudget creates a new budget with the given limit.
+//
+// The unit of the limit is the same as the size returned by Options.Size, e.g., bytes.
+// A limit zero or less means that the budget is unlimited.
+func NewBudget(limit int64) *Budget {
+	return &Budget{
+		limit:      limit,
+		generation: theGeneration.Load(),
+	}
+}
+
+// Limit returns the current limit of the budget.
+//
+// Limit is concurrent-safe.
+func (b *Budget) Limit() int64 {
+	b.m.Lock()
+	defer b.m.Unlock()
+	return b.limit
+}
+
+// SetLimit sets the limit of the budget.
+// If the current total size exceeds the new limit, the least recently used entries are evicted immediately.
+//
+// SetLimit is concurrent-safe.
+func (b *Budget) SetLimit(limit int64) {
+	b.m.Lock()
+	b.limit = limit
+	fs := b.shrink(nil, nil)
+	b.m.Unlock()
+
+	runCallbacks(fs)
+}
+
+// Size returns the total size of the entries in the caches sharing the budget.
+//
+// Size is concurrent-safe.
+func (b *Budget) Size() int64 {
+	b.m.Lock()
+	defer b.m.Unlock()
+	return b.size
+}
+
+// Clear removes all the entries in the caches sharing the budget.
+//
+// Clear is concurrent-safe.
+func (b *Budget) Clear() {
+	b.m.Lock()
+	var fs []func()
+	for b.lru.Len() > 0 {
+		fs = b.remove(fs, b.lru.Back())
+	}
+	b.m.Unlock()
+
+	runCallbacks(fs)
+}
+
+// remove removes the entry of the given element.
+//
+// remove must be called with the lock held.
+func (b *Budget) remove(callbacks []func(), e *list.Element) []func() {
+	ent := e.Value.(budgetEntry)
+	b.lru.Remove(e)
+	b.size -= ent.entrySize()
+	if f := ent.detach(); f != nil {
+		callbacks = append(callbacks, f)
+	}
+	return callbacks
+}
+
+// shrink evicts the least recently used entries until the total size fits the limit.
+// keep is never evicted even if the total size exceeds the limit.
+//
+// shrink must be called with the lock held.
+func (b *Budget) shrink(callbacks []func(), keep *list.Element) []func() {
+	for b.limit > 0 && b.size > b.limit {
+		e := b.lru.Back()
+		if e == nil || e == keep {
+			break
+		}
+		callbacks = b.remove(callbacks, e)
+	}
+	return callbacks
+}
+
+// purgeIfNeeded removes all the entries if the system was low on memory after the last access.
+//
+// purgeIfNeeded must be called with the lock held.
+func (b *Budget) purgeIfNeeded(callbacks []func()) []func() {
+	g := theGeneration.Load()
+	if b.generation == g {
+		return callbacks
+	}
+	for b.lru.Len() > 0 {
+		callbacks = b.remove(callbacks, b.lru.Back())
+	}
+	b.generation = g
+	return callbacks
+}
+
+func runCallbacks(callbacks []func()) {
+	for _, f := range callbacks {
+		f()
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache provides a generic LRU cache with size-aware eviction.
+//
+// Multiple caches can share one memory budget by Budget, e.g., to limit the total size of image and audio assets.
+// Like Ebitengine's internal caches, all the caches are purged when the system is low on memory.
+// See also LifecycleEventLowMemory in the ebiten package.
+package cache
+
+import (
+	"container/list"
+)
+
+// Options represents options for a cache.
+type Options[Key comparable, Value any] struct {
+	// Budget is the budget shared with other caches.
+	// If Budget is nil, the cache has its own unlimited budget.
+	Budget *Budget
+
+	// MaxEntries is the maximum number of entries in the cache.
+	// When the number of entries exceeds MaxEntries, the least recently used entries in the cache are evicted.
+	// MaxEntries zero or less means that the number of entries is unlimited.
+	MaxEntries int
+
+	// RetainTicks is the number of ticks to keep an entry after its last access even if MaxEntries is exceeded.
+	// With a positive RetainTicks, MaxEntries is a soft limit: the entries used in the recent ticks are never evicted by MaxEntries.
+	// This is useful for entries used every frame like glyph images, which should not be evicted while they are being drawn.
+	// RetainTicks zero or less means that the entries are evicted as soon as MaxEntries is exceeded.
+	//
+	// RetainTicks works only when Tick is specified.
+	RetainTicks int
+
+	// Tick returns the current tick count, which is used for RetainTicks.
+	// Typically, ebiten.Tick is specified.
+	//
+	// If Tick is nil, RetainTicks is ignored.
+	Tick func() int64
+
+	// Size returns the size of an entry, e.g., the number of bytes of the value.
+	// If Size is nil, the size of every entry is 1.
+	Size func(key Key, value Value) int64
+
+	// OnRemove is called when an entry is removed from the cache for any reason,
+	// including eviction, replacement by Add, Remove, and Clear.
+	// OnRemove is useful to release resources like deallocating images.
+	//
+	// OnRemove is called without the cache locked, so OnRemove can access the cache.
+	OnRemove func(key Key, value Value)
+}
+
+type entry[Key comparable, Value any] struct {
+	cache *Cache[Key, Value]
+	key   Key
+	value Value
+	size  int64
+
+	// atime is the tick when the entry was accessed last time.
+	atime int64
+
+	// global is the element in the budget's list.
+	global *list.Element
+
+	// local is the element in the cache's list.
+	local *list.Element
+}
+
+func (e *entry[Key, Value]) entrySize() int64 {
+	return e.size
+}
+
+func (e *entry[Key, Value]) detach() func() {
+	c := e.cache
+	delete(c.entries, e.key)
+	c.lru.Remove(e.local)
+	c.size -= e.size
+	if c.onRemove == nil {
+		return nil
+	}
+	k, v := e.key, e.value
+	return func() {
+		c.onRemove(k, v)
+	}
+}
+
+// Cache is a generic LRU cache.
+//
+// Cache is concurrent-safe.
+type Cache[Key comparable, Value any] struct {
+	budget      *Budget
+	maxEntries  int
+	retainTicks int
+	tick        func() int64
+	sizeFunc    func(key Key, value Value) int64
+	onRemove    func(key Key, value Value)
+
+	entries map[Key]*entry[Key, Value]
+
+	// lru is the list of the entries in this cache.
+	// The front is the most recently used entry.
+	lru list.List
+
+	size int64
+}
+
+// New creates a new cache with the given options.
+// options can be nil.
+func New[Key comparable, Value any](options *Options[Key, Value]) *Cache[Key, Value] {
+	if options == nil {
+		options = &Options[Key, Value]{}
+	}
+	c := &Cache[Key, Value]{
+		budget:      options.Budget,
+		maxEntries:  options.MaxEntries,
+		retainTicks: options.RetainTicks,
+		tick:        options.Tick,
+		sizeFunc:    options.Size,
+		onRemove:    options.OnRemove,
+		entries:     map[Key]*entry[Key, Value]{},
+	}
+	if c.budget == nil {
+		c.budget = NewBudget(0)
+	}
+	if c.tick == nil {
+		c.retainTicks = 0
+	}
+	return c
+}
+
+// now returns the current tick count, or 0 if the tick source is not specified.
+func (c *Cache[Key, Value]) now() int64 {
+	if c.tick == nil {
+		return 0
+	}
+	return c.tick()
+}
+
+// Get returns the value for the given key, and marks the entry as the most recently used one.
+// The second return value reports whether the key exists.
+func (c *Cache[Key, Value]) Get(key Key) (Value, bool) {
+	b := c.budget
+	b.m.Lock()
+	fs := b.purgeIfNeeded(nil)
+	e, ok := c.entries[key]
+	if ok {
+		c.touch(e)
+	}
+	b.m.Unlock()
+
+	runCallbacks(fs)
+
+	if !ok {
+		var zero Value
+		return zero, false
+	}
+	return e.value, true
+}
+
+// touch marks the entry as the most recently used one.
+//
+// touch must be called with the budget's lock held.
+func (c *Cache[Key, Value]) touch(e *entry[Key, Value]) {
+	c.budget.lru.MoveToFront(e.global)
+	c.lru.MoveToFront(e.local)
+	e.atime = c.now()
+}
+
+// Add adds a value for the given key as the most recently used entry.
+// If an entry for the key already exists, the entry is replaced.
+//
+// After adding, the least recently used entries are evicted if the limits are exceeded.
+// The added entry itself is never evicted at this time even if its size exceeds the budget's limit.
+func (c *Cache[Key, Value]) Add(key Key, value Value) {
+	size := c.entrySize(key, value)
+	now := c.now()
+
+	b := c.budget
+	b.m.Lock()
+	fs := b.purgeIfNeeded(nil)
+	fs = c.addLocked(fs, key, value, size, now)
+	b.m.Unlock()
+
+	runCallbacks(fs)
+}
+
+// entrySize returns the size of the entry for the given key and value.
+func (c *Cache[Key, Value]) entrySize(key Key, value Value) int64 {
+	if c.sizeFunc == nil {
+		return 1
+	}
+	return c.sizeFunc(key, value)
+}
+
+// addLocked adds a value for the given key, and evicts the entries if needed.
+// addLocked appends the callbacks to notify the removals to fs, and returns the extended slice.
+//
+// addLocked must be called with the budget's lock held.
+func (c *Cache[Key, Value]) addLocked(fs []func(), key Key, value Value, size int64, now int64) []func() {
+	b := c.budget
+	if e, ok := c.entries[key]; ok {
+		fs = b.remove(fs, e.global)
+	}
+
+	e := &entry[Key, Value]{
+		cache: c,
+		key:   key,
+		value: value,
+		size:  size,
+		atime: now,
+	}
+	e.global = b.lru.PushFront(e)
+	e.local = c.lru.PushFront(e)
+	c.entries[key] = e
+	c.size += size
+	b.size += size
+
+	if c.maxEntries > 0 {
+		for len(c.entries) > c.maxEntries {
+			back := c.lru.Back().Value.(*entry[Key, Value])
+			// The entries are sorted by the access time, so the other entries are also retained.
+			if c.retainTicks > 0 && back.atime > now-int64(c.retainTicks) {
+				break
+			}
+			fs = b.remove(fs, back.global)
+		}
+	}
+	return b.shrink(fs, e.global)
+}
+
+// GetOrAdd returns the value for the given key if exists.
+// Otherwise, GetOrAdd adds a value created by create, and returns it.
+//
+// create is called without the cache locked.
+// If another goroutine adds a value for the same key while create is running, the created value is discarded
+// and the existing value is returned.
+// OnRemove is called for the discarded value so that its resources can be released.
+func (c *Cache[Key, Value]) GetOrAdd(key Key, create func() Value) Value {
+	if v, ok := c.Get(key); ok {
+		return v
+	}
+
+	v := create()
+	size := c.entrySize(key, v)
+	now := c.now()
+
+	b := c.budget
+	b.m.Lock()
+	// Check the existence and add the value in the same critical section.
+	// Otherwise, another goroutine's value for the same key might be replaced while it is being used.
+	if e, ok := c.entries[key]; ok {
+		c.touch(e)
+		b.m.Unlock()
+		if c.onRemove != nil {
+			c.onRemove(key, v)
+		}
+		return e.value
+	}
+	fs := c.addLocked(nil, key, v, size, now)
+	b.m.Unlock()
+
+	runCallbacks(fs)
+	return v
+}
+
+// Remove removes the entry for the given key.
+// Remove reports whether the entry existed.
+func (c *Cache[Key, Value]) Remove(key Key) bool {
+	b := c.budget
+	b.m.Lock()
+	var fs []func()
+	e, ok := c.entries[key]
+	if ok {
+		fs = b.remove(fs, e.global)
+	}
+	b.m.Unlock()
+
+	runCallbacks(fs)
+	return ok
+}
+
+// Clear removes all the entries in the cache.
+func (c *Cache[Key, Value]) Clear() {
+	b := c.budget
+	b.m.Lock()
+	var fs []func()
+	for c.lru.Len() > 0 {
+		fs = b.remove(fs, c.lru.Back().Value.(*entry[Key, Value]).global)
+	}
+	b.m.Unlock()
+
+	runCallbacks(fs)
+}
+
+// Len returns the number of entries in the cache.
+func (c *Cache[Key, Value]) Len() int {
+	b := c.budget
+	b.m.Lock()
+	defer b.m.Unlock()
+	return len(c.entries)
+}
+
+// Size returns the total size of the entries in the cache.
+func (c *Cache[Key, Value]) Size() int64 {
+	b := c.budget
+	b.m.Lock()
+	defer b.m.Unlock()
+	return c.size
+}
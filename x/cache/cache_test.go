@@ -0,0 +1,273 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2/x/cache"
+)
+
+func TestCacheMaxEntries(t *testing.T) {
+	var removed []string
+	c := cache.New(&cache.Options[string, int]{
+		MaxEntries: 2,
+		OnRemove: func(key string, value int) {
+			removed = append(removed, key)
+		},
+	})
+	c.Add("a", 1)
+	c.Add("b", 2)
+	// Access a so that b is the least recently used.
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("c.Get(%q): got: (%d, %t), want: (%d, %t)", "a", v, ok, 1, true)
+	}
+	c.Add("c", 3)
+
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("c.Get(%q): got: true, want: false", "b")
+	}
+	if got, want := c.Len(), 2; got != want {
+		t.Errorf("c.Len(): got: %d, want: %d", got, want)
+	}
+	if got, want := removed, []string{"b"}; !slices.Equal(got, want) {
+		t.Errorf("removed: got: %v, want: %v", got, want)
+	}
+}
+
+func TestCacheSharedBudget(t *testing.T) {
+	b := cache.NewBudget(10)
+	size := func(key string, value []byte) int64 {
+		return int64(len(value))
+	}
+	c0 := cache.New(&cache.Options[string, []byte]{
+		Budget: b,
+		Size:   size,
+	})
+	c1 := cache.New(&cache.Options[string, []byte]{
+		Budget: b,
+		Size:   size,
+	})
+
+	c0.Add("a", make([]byte, 4))
+	c1.Add("b", make([]byte, 4))
+	if got, want := b.Size(), int64(8); got != want {
+		t.Errorf("b.Size(): got: %d, want: %d", got, want)
+	}
+
+	// The least recently used entry among all the caches is evicted.
+	c1.Add("c", make([]byte, 4))
+	if _, ok := c0.Get("a"); ok {
+		t.Errorf("c0.Get(%q): got: true, want: false", "a")
+	}
+	if got, want := c1.Size(), int64(8); got != want {
+		t.Errorf("c1.Size(): got: %d, want: %d", got, want)
+	}
+
+	// An entry exceeding the limit is kept until another entry is added.
+	c0.Add("d", make([]byte, 16))
+	if _, ok := c0.Get("d"); !ok {
+		t.Errorf("c0.Get(%q): got: false, want: true", "d")
+	}
+	if got, want := c1.Len(), 0; got != want {
+		t.Errorf("c1.Len(): got: %d, want: %d", got, want)
+	}
+
+	b.SetLimit(0)
+	c1.Add("e", make([]byte, 4))
+	if got, want := b.Size(), int64(20); got != want {
+		t.Errorf("b.Size(): got: %d, want: %d", got, want)
+	}
+	b.SetLimit(4)
+	if got, want := b.Size(), int64(4); got != want {
+		t.Errorf("b.Size(): got: %d, want: %d", got, want)
+	}
+}
+
+func TestCacheGetOrAddAndRemove(t *testing.T) {
+	var removed int
+	c := cache.New(&cache.Options[int, int]{
+		OnRemove: func(key int, value int) {
+			removed++
+		},
+	})
+
+	var created int
+	create := func() int {
+		created++
+		return 42
+	}
+	for i := 0; i < 3; i++ {
+		if got, want := c.GetOrAdd(1, create), 42; got != want {
+			t.Errorf("c.GetOrAdd(1): got: %d, want: %d", got, want)
+		}
+	}
+	if got, want := created, 1; got != want {
+		t.Errorf("created: got: %d, want: %d", got, want)
+	}
+
+	c.Add(1, 43)
+	if got, want := removed, 1; got != want {
+		t.Errorf("removed after replacing: got: %d, want: %d", got, want)
+	}
+	if !c.Remove(1) {
+		t.Errorf("c.Remove(1): got: false, want: true")
+	}
+	if c.Remove(1) {
+		t.Errorf("c.Remove(1): got: true, want: false")
+	}
+
+	c.Add(2, 0)
+	c.Add(3, 0)
+	c.Clear()
+	if got, want := c.Len(), 0; got != want {
+		t.Errorf("c.Len(): got: %d, want: %d", got, want)
+	}
+	if got, want := removed, 4; got != want {
+		t.Errorf("removed: got: %d, want: %d", got, want)
+	}
+}
+
+func TestCacheGetOrAddDiscarded(t *testing.T) {
+	var removed []int
+	c := cache.New(&cache.Options[int, int]{
+		OnRemove: func(key int, value int) {
+			removed = append(removed, value)
+		},
+	})
+
+	// Emulate another goroutine adding a value while the value is being created.
+	got := c.GetOrAdd(1, func() int {
+		c.Add(1, 1)
+		return 2
+	})
+	if want := 1; got != want {
+		t.Errorf("c.GetOrAdd(1): got: %d, want: %d", got, want)
+	}
+	if got, want := removed, []int{2}; !slices.Equal(got, want) {
+		t.Errorf("removed: got: %v, want: %v", got, want)
+	}
+}
+
+func TestCacheRetainTicks(t *testing.T) {
+	var tick int64
+	c := cache.New(&cache.Options[int, int]{
+		MaxEntries:  2,
+		RetainTicks: 60,
+		Tick: func() int64 {
+			return tick
+		},
+	})
+
+	// All the entries are accessed at the same tick, so none of them are evicted.
+	for i := 0; i < 4; i++ {
+		c.Add(i, i)
+	}
+	if got, want := c.Len(), 4; got != want {
+		t.Errorf("c.Len(): got: %d, want: %d", got, want)
+	}
+
+	// After RetainTicks, the old entries are evicted by MaxEntries.
+	tick = 60
+	c.Add(4, 4)
+	if got, want := c.Len(), 2; got != want {
+		t.Errorf("c.Len(): got: %d, want: %d", got, want)
+	}
+}
+
+func TestCacheRetainTicksWithoutTick(t *testing.T) {
+	c := cache.New(&cache.Options[int, int]{
+		MaxEntries:  2,
+		RetainTicks: 60,
+	})
+
+	// Without Tick, RetainTicks is ignored.
+	for i := 0; i < 4; i++ {
+		c.Add(i, i)
+	}
+	if got, want := c.Len(), 2; got != want {
+		t.Errorf("c.Len(): got: %d, want: %d", got, want)
+	}
+}
+
+func TestCacheGetOrAddConcurrent(t *testing.T) {
+	var m sync.Mutex
+	removed := map[*int]struct{}{}
+	c := cache.New(&cache.Options[int, *int]{
+		OnRemove: func(key int, value *int) {
+			m.Lock()
+			defer m.Unlock()
+			removed[value] = struct{}{}
+		},
+	})
+
+	const n = 64
+	values := make([]*int, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values[i] = c.GetOrAdd(0, func() *int {
+				return new(int)
+			})
+		}()
+	}
+	wg.Wait()
+
+	// All the callers must get the same value, and the value must not be removed.
+	for i, v := range values {
+		if v != values[0] {
+			t.Errorf("values[%d]: got: %p, want: %p", i, v, values[0])
+		}
+		if _, ok := removed[v]; ok {
+			t.Errorf("values[%d] was removed while it was used", i)
+		}
+	}
+	if got, want := c.Len(), 1; got != want {
+		t.Errorf("c.Len(): got: %d, want: %d", got, want)
+	}
+}
+
+func TestBudgetClear(t *testing.T) {
+	var removed int
+	b := cache.NewBudget(0)
+	onRemove := func(key string, value int) {
+		removed++
+	}
+	c0 := cache.New(&cache.Options[string, int]{
+		Budget:   b,
+		OnRemove: onRemove,
+	})
+	c1 := cache.New(&cache.Options[string, int]{
+		Budget:   b,
+		OnRemove: onRemove,
+	})
+	c0.Add("a", 0)
+	c1.Add("b", 0)
+
+	b.Clear()
+	if got, want := c0.Len()+c1.Len(), 0; got != want {
+		t.Errorf("c0.Len()+c1.Len(): got: %d, want: %d", got, want)
+	}
+	if got, want := b.Size(), int64(0); got != want {
+		t.Errorf("b.Size(): got: %d, want: %d", got, want)
+	}
+	if got, want := removed, 2; got != want {
+		t.Errorf("removed: got: %d, want: %d", got, want)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fixedgeom provides deterministic geometry matrices in fixed-point numbers.
+//
+// ebiten.GeoM uses float64 values. Even though each float64 operation is deterministic in IEEE 754,
+// the Go compiler is allowed to fuse x*y+z into one FMA instruction on some architectures like arm64,
+// and the results of math functions like math.Sincos might differ by implementations.
+// Then, the results of ebiten.GeoM's operations might differ between CPUs.
+//
+// The operations in this package use only integer arithmetic, so the results are identical on any platforms.
+// This is useful for lockstep multiplayer games, where all the players' simulations must produce the same results.
+// Use GeoM.GeoM to convert a matrix to ebiten.GeoM for rendering.
+package fixedgeom
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+const fracBits = 16
+
+// Fixed is a signed fixed-point number with 16 fractional bits.
+//
+// Fixed values can be added and subtracted with the regular operators + and -.
+// Use Mul and Div for multiplications and divisions.
+type Fixed int64
+
+// One is 1 in Fixed.
+const One Fixed = 1 << fracBits
+
+// Pi is π in Fixed.
+const Pi Fixed = 205887
+
+// FromInt returns a Fixed value of the integer i.
+func FromInt(i int) Fixed {
+	return Fixed(i) << fracBits
+}
+
+// FromFloat64 returns the nearest Fixed value of f.
+//
+// FromFloat64 is deterministic, so FromFloat64 can be used to convert constants or user inputs.
+func FromFloat64(f float64) Fixed {
+	return Fixed(math.Round(f * (1 << fracBits)))
+}
+
+// Float64 returns the value as float64.
+func (x Fixed) Float64() float64 {
+	return float64(x) / (1 << fracBits)
+}
+
+// String returns a string representation of the value.
+func (x Fixed) String() string {
+	return fmt.Sprintf("%f", x.Float64())
+}
+
+// Mul returns x * y, rounding half away from zero.
+//
+// If the result overflows, the result is undefined.
+func (x Fixed) Mul(y Fixed) Fixed {
+	ux, nx := abs(x)
+	uy, ny := abs(y)
+	hi, lo := bits.Mul64(ux, uy)
+	var carry uint64
+	lo, carry = bits.Add64(lo, 1<<(fracBits-1), 0)
+	hi += carry
+	r := Fixed(hi<<(64-fracBits) | lo>>fracBits)
+	if nx != ny {
+		return -r
+	}
+	return r
+}
+
+// Div returns x / y, truncating toward zero.
+//
+// Div panics if y is zero or the result overflows.
+func (x Fixed) Div(y Fixed) Fixed {
+	if y == 0 {
+		panic("fixedgeom: division by zero")
+	}
+	ux, nx := abs(x)
+	uy, ny := abs(y)
+	hi, lo := ux>>(64-fracBits), ux<<fracBits
+	if hi >= uy {
+		panic("fixedgeom: division overflow")
+	}
+	q, _ := bits.Div64(hi, lo, uy)
+	r := Fixed(q)
+	if nx != ny {
+		return -r
+	}
+	return r
+}
+
+func abs(x Fixed) (uint64, bool) {
+	if x < 0 {
+		return uint64(-x), true
+	}
+	return uint64(x), false
+}
+
+// The constants and the intermediate values for trigonometric functions have 30 fractional bits.
+const (
+	fracBits30  = 30
+	one30       = 1 << fracBits30
+	halfPi30    = 1686629713
+	quarterPi30 = 843314857
+	twoPi       = 411775
+)
+
+func mul30(x, y int64) int64 {
+	return (x*y + 1<<(fracBits30-1)) >> fracBits30
+}
+
+// sin30 returns sin(x) for x in [0, π/4] with the Taylor series.
+func sin30(x int64) int64 {
+	x2 := mul30(x, x)
+	p := one30 - x2/42
+	p = one30 - mul30(x2, p)/20
+	p = one30 - mul30(x2, p)/6
+	return mul30(x, p)
+}
+
+// cos30 returns cos(x) for x in [0, π/4] with the Taylor series.
+func cos30(x int64) int64 {
+	x2 := mul30(x, x)
+	p := one30 - x2/56
+	p = one30 - mul30(x2, p)/30
+	p = one30 - mul30(x2, p)/12
+	return one30 - mul30(x2, p)/2
+}
+
+// Sincos returns sin(theta) and cos(theta). The unit is radian.
+//
+// The error is less than 2^-15.
+func Sincos(theta Fixed) (sin, cos Fixed) {
+	t := int64(theta) % twoPi
+	if t < 0 {
+		t += twoPi
+	}
+	x := t << (fracBits30 - fracBits)
+	q := min(x/halfPi30, 3)
+	x -= q * halfPi30
+
+	var s, c int64
+	if x <= quarterPi30 {
+		s, c = sin30(x), cos30(x)
+	} else {
+		s, c = cos30(halfPi30-x), sin30(halfPi30-x)
+	}
+	switch q {
+	case 1:
+		s, c = c, -s
+	case 2:
+		s, c = -s, -c
+	case 3:
+		s, c = -c, s
+	}
+
+	const shift = fracBits30 - fracBits
+	return Fixed((s + 1<<(shift-1)) >> shift), Fixed((c + 1<<(shift-1)) >> shift)
+}
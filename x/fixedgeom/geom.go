@@ -0,0 +1,205 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixedgeom
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// A GeoM represents a matrix to transform geometry in fixed-point numbers.
+//
+// The operations of GeoM are the same as ebiten.GeoM, but deterministic on any platforms.
+//
+// The initial value is identity.
+type GeoM struct {
+	a_1 Fixed // The actual 'a' value minus 1
+	b   Fixed
+	c   Fixed
+	d_1 Fixed // The actual 'd' value minus 1
+	tx  Fixed
+	ty  Fixed
+}
+
+// String returns a string representation of GeoM.
+func (g *GeoM) String() string {
+	return fmt.Sprintf("[[%s, %s, %s], [%s, %s, %s]]", g.a_1+One, g.b, g.tx, g.c, g.d_1+One, g.ty)
+}
+
+// Reset resets the GeoM as identity.
+func (g *GeoM) Reset() {
+	*g = GeoM{}
+}
+
+// Apply pre-multiplies a vector (x, y, 1) by the matrix.
+// In other words, Apply calculates GeoM * (x, y, 1)^T.
+// The return value is x and y values of the result vector.
+func (g *GeoM) Apply(x, y Fixed) (Fixed, Fixed) {
+	return (g.a_1 + One).Mul(x) + g.b.Mul(y) + g.tx, g.c.Mul(x) + (g.d_1 + One).Mul(y) + g.ty
+}
+
+// Element returns a value of a matrix at (i, j).
+func (g *GeoM) Element(i, j int) Fixed {
+	switch {
+	case i == 0 && j == 0:
+		return g.a_1 + One
+	case i == 0 && j == 1:
+		return g.b
+	case i == 0 && j == 2:
+		return g.tx
+	case i == 1 && j == 0:
+		return g.c
+	case i == 1 && j == 1:
+		return g.d_1 + One
+	case i == 1 && j == 2:
+		return g.ty
+	default:
+		panic("fixedgeom: i or j is out of index")
+	}
+}
+
+// SetElement sets an element at (i, j).
+func (g *GeoM) SetElement(i, j int, element Fixed) {
+	e := element
+	switch {
+	case i == 0 && j == 0:
+		g.a_1 = e - One
+	case i == 0 && j == 1:
+		g.b = e
+	case i == 0 && j == 2:
+		g.tx = e
+	case i == 1 && j == 0:
+		g.c = e
+	case i == 1 && j == 1:
+		g.d_1 = e - One
+	case i == 1 && j == 2:
+		g.ty = e
+	default:
+		panic("fixedgeom: i or j is out of index")
+	}
+}
+
+// Concat multiplies a geometry matrix with the other geometry matrix.
+// This is same as multiplying the matrix other and the matrix g in this order.
+func (g *GeoM) Concat(other GeoM) {
+	a := (other.a_1 + One).Mul(g.a_1+One) + other.b.Mul(g.c)
+	b := (other.a_1 + One).Mul(g.b) + other.b.Mul(g.d_1+One)
+	tx := (other.a_1 + One).Mul(g.tx) + other.b.Mul(g.ty) + other.tx
+	c := other.c.Mul(g.a_1+One) + (other.d_1 + One).Mul(g.c)
+	d := other.c.Mul(g.b) + (other.d_1 + One).Mul(g.d_1+One)
+	ty := other.c.Mul(g.tx) + (other.d_1 + One).Mul(g.ty) + other.ty
+
+	g.a_1 = a - One
+	g.b = b
+	g.c = c
+	g.d_1 = d - One
+	g.tx = tx
+	g.ty = ty
+}
+
+// Scale scales the matrix by (x, y).
+func (g *GeoM) Scale(x, y Fixed) {
+	a := (g.a_1 + One).Mul(x)
+	b := g.b.Mul(x)
+	tx := g.tx.Mul(x)
+	c := g.c.Mul(y)
+	d := (g.d_1 + One).Mul(y)
+	ty := g.ty.Mul(y)
+
+	g.a_1 = a - One
+	g.b = b
+	g.c = c
+	g.d_1 = d - One
+	g.tx = tx
+	g.ty = ty
+}
+
+// Translate translates the matrix by (tx, ty).
+func (g *GeoM) Translate(tx, ty Fixed) {
+	g.tx += tx
+	g.ty += ty
+}
+
+// Rotate rotates the matrix clockwise by theta.
+// The unit is radian.
+func (g *GeoM) Rotate(theta Fixed) {
+	if theta == 0 {
+		return
+	}
+
+	sin, cos := Sincos(theta)
+
+	a := cos.Mul(g.a_1+One) - sin.Mul(g.c)
+	b := cos.Mul(g.b) - sin.Mul(g.d_1+One)
+	tx := cos.Mul(g.tx) - sin.Mul(g.ty)
+	c := sin.Mul(g.a_1+One) + cos.Mul(g.c)
+	d := sin.Mul(g.b) + cos.Mul(g.d_1+One)
+	ty := sin.Mul(g.tx) + cos.Mul(g.ty)
+
+	g.a_1 = a - One
+	g.b = b
+	g.c = c
+	g.d_1 = d - One
+	g.tx = tx
+	g.ty = ty
+}
+
+func (g *GeoM) det2x2() Fixed {
+	return (g.a_1 + One).Mul(g.d_1+One) - g.b.Mul(g.c)
+}
+
+// IsInvertible returns a boolean value indicating
+// whether the matrix g is invertible or not.
+func (g *GeoM) IsInvertible() bool {
+	return g.det2x2() != 0
+}
+
+// Invert inverts the matrix.
+// If g is not invertible, Invert panics.
+func (g *GeoM) Invert() {
+	det := g.det2x2()
+	if det == 0 {
+		panic("fixedgeom: g is not invertible")
+	}
+
+	a := (g.d_1 + One).Div(det)
+	b := (-g.b).Div(det)
+	c := (-g.c).Div(det)
+	d := (g.a_1 + One).Div(det)
+	tx := (-(g.d_1 + One).Mul(g.tx) + g.b.Mul(g.ty)).Div(det)
+	ty := (g.c.Mul(g.tx) - (g.a_1 + One).Mul(g.ty)).Div(det)
+
+	g.a_1 = a - One
+	g.b = b
+	g.c = c
+	g.d_1 = d - One
+	g.tx = tx
+	g.ty = ty
+}
+
+// GeoM returns the matrix as ebiten.GeoM for rendering.
+//
+// The result is used only for rendering, so the result doesn't have to be deterministic.
+func (g *GeoM) GeoM() ebiten.GeoM {
+	var r ebiten.GeoM
+	r.SetElement(0, 0, (g.a_1 + One).Float64())
+	r.SetElement(0, 1, g.b.Float64())
+	r.SetElement(0, 2, g.tx.Float64())
+	r.SetElement(1, 0, g.c.Float64())
+	r.SetElement(1, 1, (g.d_1 + One).Float64())
+	r.SetElement(1, 2, g.ty.Float64())
+	return r
+}
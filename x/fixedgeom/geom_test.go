@@ -0,0 +1,136 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixedgeom_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/x/fixedgeom"
+)
+
+func TestFixedMulDiv(t *testing.T) {
+	testCases := []struct {
+		X   fixedgeom.Fixed
+		Y   fixedgeom.Fixed
+		Mul fixedgeom.Fixed
+		Div fixedgeom.Fixed
+	}{
+		{
+			X:   fixedgeom.FromFloat64(1.5),
+			Y:   fixedgeom.FromFloat64(-2.25),
+			Mul: fixedgeom.FromFloat64(-3.375),
+			Div: fixedgeom.FromFloat64(-1.5 / 2.25),
+		},
+		{
+			X:   fixedgeom.FromInt(-3),
+			Y:   fixedgeom.FromInt(-2),
+			Mul: fixedgeom.FromInt(6),
+			Div: fixedgeom.FromFloat64(1.5),
+		},
+		{
+			X:   fixedgeom.FromInt(1 << 40),
+			Y:   fixedgeom.FromInt(2),
+			Mul: fixedgeom.FromInt(1 << 41),
+			Div: fixedgeom.FromInt(1 << 39),
+		},
+	}
+	for _, tc := range testCases {
+		if got, want := tc.X.Mul(tc.Y), tc.Mul; got != want {
+			t.Errorf("%s * %s: got: %s, want: %s", tc.X, tc.Y, got, want)
+		}
+		// Div truncates the result, so allow 1 unit of errors.
+		if got, want := tc.X.Div(tc.Y), tc.Div; got-want > 1 || want-got > 1 {
+			t.Errorf("%s / %s: got: %s, want: %s", tc.X, tc.Y, got, want)
+		}
+	}
+}
+
+func TestSincos(t *testing.T) {
+	for i := -2000; i < 2000; i++ {
+		theta := fixedgeom.FromFloat64(float64(i) / 100)
+		s, c := fixedgeom.Sincos(theta)
+		if got, want := s.Float64(), math.Sin(theta.Float64()); math.Abs(got-want) > 1.0/(1<<15) {
+			t.Errorf("sin(%s): got: %f, want: %f", theta, got, want)
+		}
+		if got, want := c.Float64(), math.Cos(theta.Float64()); math.Abs(got-want) > 1.0/(1<<15) {
+			t.Errorf("cos(%s): got: %f, want: %f", theta, got, want)
+		}
+	}
+}
+
+func TestGeoM(t *testing.T) {
+	var g fixedgeom.GeoM
+	g.Scale(fixedgeom.FromInt(2), fixedgeom.FromInt(3))
+	g.Rotate(fixedgeom.Pi / 6)
+	g.Translate(fixedgeom.FromInt(10), fixedgeom.FromInt(-20))
+
+	var eg ebiten.GeoM
+	eg.Scale(2, 3)
+	eg.Rotate(math.Pi / 6)
+	eg.Translate(10, -20)
+
+	const delta = 1e-3
+	x, y := g.Apply(fixedgeom.FromInt(5), fixedgeom.FromInt(7))
+	ex, ey := eg.Apply(5, 7)
+	if math.Abs(x.Float64()-ex) > delta || math.Abs(y.Float64()-ey) > delta {
+		t.Errorf("Apply: got: (%s, %s), want: (%f, %f)", x, y, ex, ey)
+	}
+
+	gg := g.GeoM()
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			if got, want := gg.Element(i, j), eg.Element(i, j); math.Abs(got-want) > delta {
+				t.Errorf("GeoM().Element(%d, %d): got: %f, want: %f", i, j, got, want)
+			}
+		}
+	}
+
+	inv := g
+	inv.Invert()
+	x, y = inv.Apply(x, y)
+	if math.Abs(x.Float64()-5) > delta || math.Abs(y.Float64()-7) > delta {
+		t.Errorf("Invert: got: (%s, %s), want: (5, 7)", x, y)
+	}
+
+	g2 := g
+	g2.Concat(inv)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if got := g2.Element(i, j).Float64(); math.Abs(got-want) > delta {
+				t.Errorf("Concat: Element(%d, %d): got: %f, want: %f", i, j, got, want)
+			}
+		}
+	}
+}
+
+func TestGeoMDeterministic(t *testing.T) {
+	// The results must be the same bit by bit on any platforms.
+	var g fixedgeom.GeoM
+	for i := 0; i < 100; i++ {
+		g.Rotate(fixedgeom.FromFloat64(0.1))
+		g.Scale(fixedgeom.FromFloat64(1.01), fixedgeom.FromFloat64(0.99))
+		g.Translate(fixedgeom.FromInt(1), fixedgeom.FromInt(2))
+	}
+	x, y := g.Apply(fixedgeom.FromInt(3), fixedgeom.FromInt(4))
+	if got, want := [2]fixedgeom.Fixed{x, y}, [2]fixedgeom.Fixed{-2709361, 141590}; got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ribbon provides a ribbon mesh made from time-stamped points, e.g., for sword slashes and projectile trails.
+//
+// A Ribbon is a smooth triangle strip along the points, with texture coordinates and fading colors.
+// The vertices and the indices are intended to be passed to DrawTriangles or DrawTrianglesShader.
+package ribbon
+
+import (
+	"image"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Point is a point of a ribbon with its time stamp.
+type Point struct {
+	X float32
+	Y float32
+
+	// Time is the time when the point is added.
+	// The unit is arbitrary, e.g., seconds or ticks, but must be the same as Options.Now and Options.Lifetime.
+	Time float64
+}
+
+// Ribbon is a trail of time-stamped points.
+//
+// The zero value is an empty ribbon.
+type Ribbon struct {
+	// points is the points from the oldest to the newest.
+	points []Point
+
+	// tmp is a buffer for the smoothed points.
+	tmp []Point
+}
+
+// AddPoint adds a new point as the head of the ribbon.
+//
+// time must not be less than the time of the last added point.
+func (r *Ribbon) AddPoint(x, y float32, time float64) {
+	if n := len(r.points); n > 0 {
+		last := r.points[n-1]
+		if time < last.Time {
+			panic("ribbon: time must not be less than the last point's time")
+		}
+		// Skip a point too close to the last point, which makes the direction unstable.
+		if abs(last.X-x) < 1e-2 && abs(last.Y-y) < 1e-2 {
+			r.points[n-1].Time = time
+			return
+		}
+	}
+	r.points = append(r.points, Point{X: x, Y: y, Time: time})
+}
+
+// RemovePointsBefore removes the points older than time.
+//
+// RemovePointsBefore is typically called every tick with the current time minus the lifetime.
+func (r *Ribbon) RemovePointsBefore(time float64) {
+	var n int
+	for n < len(r.points) && r.points[n].Time < time {
+		n++
+	}
+	r.points = r.points[:copy(r.points, r.points[n:])]
+}
+
+// Reset removes all the points.
+func (r *Ribbon) Reset() {
+	r.points = r.points[:0]
+}
+
+// Len returns the number of the points.
+func (r *Ribbon) Len() int {
+	return len(r.points)
+}
+
+// AppendPoints appends the points from the oldest to the newest to points and returns the extended slice.
+func (r *Ribbon) AppendPoints(points []Point) []Point {
+	return append(points, r.points...)
+}
+
+// Options represents options to generate a ribbon mesh.
+type Options struct {
+	// Width is the width of the ribbon in pixels.
+	Width float32
+
+	// WidthFunc returns the width of the ribbon at the age, e.g., to taper the tail.
+	// age is in [0, 1], where 0 is the head (the newest point) and 1 is the tail.
+	//
+	// If WidthFunc is non-nil, Width is ignored.
+	WidthFunc func(age float32) float32
+
+	// Now is the current time, used to calculate the ages of the points.
+	Now float64
+
+	// Lifetime is the duration while a point lives.
+	// The age of a point is (Now - Time) / Lifetime.
+	//
+	// If Lifetime is 0, the age is the length from the head divided by the total length,
+	// regardless of the time stamps.
+	Lifetime float64
+
+	// Subdivisions is the number of the segments between two adjacent points to smooth the ribbon
+	// with a Catmull-Rom spline.
+	//
+	// If Subdivisions is 0, 4 is used. If Subdivisions is 1, the ribbon is not smoothed.
+	Subdivisions int
+
+	// SrcRect is the region of the source image to texture the ribbon.
+	// The X direction of the source image is mapped along the ribbon from the head to the tail,
+	// and the Y direction is mapped across the ribbon.
+	//
+	// If SrcRect is empty, the source positions are all 0, which is suitable for a solid color.
+	SrcRect image.Rectangle
+
+	// Fade indicates whether the ribbon fades out toward the tail.
+	// If Fade is true, the color values of a vertex are 1 - age in premultiplied alpha.
+	// Otherwise, the color values are all 1.
+	Fade bool
+}
+
+const defaultSubdivisions = 4
+
+// AppendVerticesAndIndices appends vertices and indices to render the ribbon and returns them.
+// AppendVerticesAndIndices works in a similar way to the built-in append function.
+//
+// The ribbon is rendered as a triangle strip. The returned values are intended to be passed to
+// DrawTriangles or DrawTrianglesShader.
+//
+// If the ribbon has less than 2 points, AppendVerticesAndIndices appends nothing.
+func (r *Ribbon) AppendVerticesAndIndices(vertices []ebiten.Vertex, indices []uint16, options *Options) ([]ebiten.Vertex, []uint16) {
+	if len(r.points) < 2 {
+		return vertices, indices
+	}
+	if options == nil {
+		options = &Options{}
+	}
+
+	r.tmp = r.appendSmoothedPoints(r.tmp[:0], options)
+	pts := r.tmp
+
+	// Calculate the lengths from the head.
+	lengths := make([]float32, len(pts))
+	for i := len(pts) - 2; i >= 0; i-- {
+		lengths[i] = lengths[i+1] + dist(pts[i], pts[i+1])
+	}
+	total := lengths[0]
+
+	base := uint16(len(vertices))
+	for i, pt := range pts {
+		// The tangent direction is calculated with the neighbors.
+		prev, next := pts[max(i-1, 0)], pts[min(i+1, len(pts)-1)]
+		tx, ty := next.X-prev.X, next.Y-prev.Y
+		l := float32(math.Hypot(float64(tx), float64(ty)))
+		if l == 0 {
+			tx, ty, l = 1, 0, 1
+		}
+		nx, ny := -ty/l, tx/l
+
+		var lengthRatio float32
+		if total > 0 {
+			lengthRatio = lengths[i] / total
+		}
+
+		age := lengthRatio
+		if options.Lifetime > 0 {
+			age = float32(min(max((options.Now-pt.Time)/options.Lifetime, 0), 1))
+		}
+
+		w := options.Width
+		if options.WidthFunc != nil {
+			w = options.WidthFunc(age)
+		}
+
+		var c float32 = 1
+		if options.Fade {
+			c = 1 - age
+		}
+
+		var sx, sy0, sy1 float32
+		if sr := options.SrcRect; !sr.Empty() {
+			sx = float32(sr.Min.X) + float32(sr.Dx())*lengthRatio
+			sy0 = float32(sr.Min.Y)
+			sy1 = float32(sr.Max.Y)
+		}
+
+		vertices = append(vertices, ebiten.Vertex{
+			DstX:   pt.X + nx*w/2,
+			DstY:   pt.Y + ny*w/2,
+			SrcX:   sx,
+			SrcY:   sy0,
+			ColorR: c,
+			ColorG: c,
+			ColorB: c,
+			ColorA: c,
+		}, ebiten.Vertex{
+			DstX:   pt.X - nx*w/2,
+			DstY:   pt.Y - ny*w/2,
+			SrcX:   sx,
+			SrcY:   sy1,
+			ColorR: c,
+			ColorG: c,
+			ColorB: c,
+			ColorA: c,
+		})
+	}
+
+	for i := 0; i < len(pts)-1; i++ {
+		idx := base + uint16(2*i)
+		indices = append(indices, idx, idx+1, idx+2, idx+1, idx+3, idx+2)
+	}
+
+	return vertices, indices
+}
+
+// appendSmoothedPoints appends the points interpolated with a Catmull-Rom spline to points and returns the extended slice.
+func (r *Ribbon) appendSmoothedPoints(points []Point, options *Options) []Point {
+	n := options.Subdivisions
+	if n == 0 {
+		n = defaultSubdivisions
+	}
+
+	for i := 0; i < len(r.points)-1; i++ {
+		p0 := r.points[max(i-1, 0)]
+		p1 := r.points[i]
+		p2 := r.points[i+1]
+		p3 := r.points[min(i+2, len(r.points)-1)]
+		for j := 0; j < n; j++ {
+			t := float32(j) / float32(n)
+			points = append(points, Point{
+				X:    catmullRom(p0.X, p1.X, p2.X, p3.X, t),
+				Y:    catmullRom(p0.Y, p1.Y, p2.Y, p3.Y, t),
+				Time: p1.Time + (p2.Time-p1.Time)*float64(t),
+			})
+		}
+	}
+	return append(points, r.points[len(r.points)-1])
+}
+
+func catmullRom(p0, p1, p2, p3, t float32) float32 {
+	t2 := t * t
+	t3 := t2 * t
+	return 0.5 * (2*p1 + (-p0+p2)*t + (2*p0-5*p1+4*p2-p3)*t2 + (-p0+3*p1-3*p2+p3)*t3)
+}
+
+func dist(p0, p1 Point) float32 {
+	return float32(math.Hypot(float64(p1.X-p0.X), float64(p1.Y-p0.Y)))
+}
+
+func abs(x float32) float32 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
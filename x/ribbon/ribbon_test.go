@@ -0,0 +1,69 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ribbon_test
+
+import (
+	"image"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2/x/ribbon"
+)
+
+func TestRibbon(t *testing.T) {
+	var r ribbon.Ribbon
+	r.AddPoint(0, 0, 0)
+	r.AddPoint(10, 0, 1)
+	r.AddPoint(20, 0, 2)
+
+	vs, is := r.AppendVerticesAndIndices(nil, nil, &ribbon.Options{
+		Width:        4,
+		Now:          2,
+		Lifetime:     2,
+		Subdivisions: 1,
+		SrcRect:      image.Rect(0, 0, 16, 8),
+		Fade:         true,
+	})
+	if got, want := len(vs), 6; got != want {
+		t.Fatalf("len(vertices): got: %d, want: %d", got, want)
+	}
+	if got, want := len(is), 12; got != want {
+		t.Fatalf("len(indices): got: %d, want: %d", got, want)
+	}
+
+	// The tail is the oldest point.
+	if got, want := vs[0].SrcX, float32(16); got != want {
+		t.Errorf("vertices[0].SrcX: got: %f, want: %f", got, want)
+	}
+	if got, want := vs[0].ColorA, float32(0); got != want {
+		t.Errorf("vertices[0].ColorA: got: %f, want: %f", got, want)
+	}
+	// The head is the newest point.
+	if got, want := vs[4].SrcX, float32(0); got != want {
+		t.Errorf("vertices[4].SrcX: got: %f, want: %f", got, want)
+	}
+	if got, want := vs[4].ColorA, float32(1); got != want {
+		t.Errorf("vertices[4].ColorA: got: %f, want: %f", got, want)
+	}
+	for i := 0; i < len(vs); i += 2 {
+		if got, want := vs[i].DstY-vs[i+1].DstY, float32(4); got != want && got != -want {
+			t.Errorf("the width at vertices[%d]: got: %f, want: %f", i, got, want)
+		}
+	}
+
+	r.RemovePointsBefore(1)
+	if got, want := r.Len(), 2; got != want {
+		t.Errorf("Len() after RemovePointsBefore: got: %d, want: %d", got, want)
+	}
+}
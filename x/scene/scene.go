@@ -0,0 +1,202 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package scene provides a stack-based scene manager with transitions.
+//
+// Almost every game has scenes like a title, a menu, and the game itself.
+// This package provides a small helper to manage them, independently from how the game objects are organized.
+package scene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Scene represents a game scene like a title scene or a game scene.
+type Scene interface {
+	// Enter is called when the scene is pushed to the manager's stack.
+	Enter()
+
+	// Exit is called when the scene is removed from the manager's stack.
+	// If the scene is removed with a transition, Exit is called after the transition ends.
+	Exit()
+
+	// Update updates the scene.
+	// Update is called only for the current scene, which is the top of the stack.
+	Update() error
+
+	// Draw draws the scene.
+	// Draw is called only for the current scene, and for the outgoing scene during a transition.
+	Draw(screen *ebiten.Image)
+}
+
+// Manager manages scenes in a stack.
+//
+// The zero value of Manager is an empty manager and ready to use.
+type Manager struct {
+	stack []Scene
+
+	transition Transition
+	from       Scene
+	exitFrom   bool
+	tick       int
+
+	fromImage *ebiten.Image
+	toImage   *ebiten.Image
+}
+
+// NewManager creates a new manager with the given initial scene.
+// Enter of the initial scene is called.
+func NewManager(initial Scene) *Manager {
+	m := &Manager{}
+	m.Push(initial, nil)
+	return m
+}
+
+// Current returns the current scene, which is the top of the stack.
+// Current returns nil if the stack is empty.
+func (m *Manager) Current() Scene {
+	if len(m.stack) == 0 {
+		return nil
+	}
+	return m.stack[len(m.stack)-1]
+}
+
+// Len returns the number of scenes in the stack.
+func (m *Manager) Len() int {
+	return len(m.stack)
+}
+
+// IsTransitioning reports whether a transition is in progress.
+func (m *Manager) IsTransitioning() bool {
+	return m.transition != nil
+}
+
+// Push pushes a scene to the stack, and makes it the current scene.
+// The previous current scene is kept in the stack and doesn't receive Update and Draw until it becomes the current scene again.
+//
+// transition can be nil. If transition is nil, the scene is switched immediately.
+func (m *Manager) Push(scene Scene, transition Transition) {
+	m.finishTransition()
+	from := m.Current()
+	m.stack = append(m.stack, scene)
+	scene.Enter()
+	m.startTransition(from, false, transition)
+}
+
+// Pop removes the current scene from the stack, and makes the next scene the current scene.
+// Pop does nothing if the stack is empty.
+//
+// transition can be nil. If transition is nil, the scene is switched immediately.
+func (m *Manager) Pop(transition Transition) {
+	m.finishTransition()
+	if len(m.stack) == 0 {
+		return
+	}
+	from := m.stack[len(m.stack)-1]
+	m.stack[len(m.stack)-1] = nil
+	m.stack = m.stack[:len(m.stack)-1]
+	m.startTransition(from, true, transition)
+}
+
+// Replace replaces the current scene with the given scene.
+// If the stack is empty, Replace works in the same way as Push.
+//
+// transition can be nil. If transition is nil, the scene is switched immediately.
+func (m *Manager) Replace(scene Scene, transition Transition) {
+	m.finishTransition()
+	if len(m.stack) == 0 {
+		m.Push(scene, transition)
+		return
+	}
+	from := m.stack[len(m.stack)-1]
+	m.stack[len(m.stack)-1] = scene
+	scene.Enter()
+	m.startTransition(from, true, transition)
+}
+
+func (m *Manager) startTransition(from Scene, exitFrom bool, transition Transition) {
+	if transition == nil || transition.Duration() <= 0 || from == nil {
+		if exitFrom && from != nil {
+			from.Exit()
+		}
+		return
+	}
+	m.transition = transition
+	m.from = from
+	m.exitFrom = exitFrom
+	m.tick = 0
+}
+
+func (m *Manager) finishTransition() {
+	if m.transition == nil {
+		return
+	}
+	if m.exitFrom {
+		m.from.Exit()
+	}
+	m.transition = nil
+	m.from = nil
+	m.exitFrom = false
+	m.tick = 0
+}
+
+// Update updates the current scene and the transition.
+// Update is expected to be called from the game's Update.
+func (m *Manager) Update() error {
+	if m.transition != nil {
+		m.tick++
+		if m.tick >= m.transition.Duration() {
+			m.finishTransition()
+		}
+	}
+	s := m.Current()
+	if s == nil {
+		return nil
+	}
+	return s.Update()
+}
+
+// Draw draws the current scene, or the transition if a transition is in progress.
+// Draw is expected to be called from the game's Draw.
+func (m *Manager) Draw(screen *ebiten.Image) {
+	to := m.Current()
+	if m.transition == nil {
+		if to != nil {
+			to.Draw(screen)
+		}
+		return
+	}
+
+	m.fromImage = ensureOffscreen(m.fromImage, screen)
+	m.toImage = ensureOffscreen(m.toImage, screen)
+	m.fromImage.Clear()
+	m.toImage.Clear()
+	m.from.Draw(m.fromImage)
+	if to != nil {
+		to.Draw(m.toImage)
+	}
+	progress := float64(m.tick) / float64(m.transition.Duration())
+	m.transition.Draw(screen, m.fromImage, m.toImage, progress)
+}
+
+func ensureOffscreen(img *ebiten.Image, screen *ebiten.Image) *ebiten.Image {
+	w, h := screen.Bounds().Dx(), screen.Bounds().Dy()
+	if img != nil {
+		if img.Bounds().Dx() == w && img.Bounds().Dy() == h {
+			return img
+		}
+		img.Deallocate()
+	}
+	return ebiten.NewImage(w, h)
+}
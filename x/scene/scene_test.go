@@ -0,0 +1,104 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scene_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/x/scene"
+)
+
+type testScene struct {
+	name string
+	log  *[]string
+}
+
+func (t *testScene) Enter() {
+	*t.log = append(*t.log, "enter "+t.name)
+}
+
+func (t *testScene) Exit() {
+	*t.log = append(*t.log, "exit "+t.name)
+}
+
+func (t *testScene) Update() error {
+	*t.log = append(*t.log, "update "+t.name)
+	return nil
+}
+
+func (t *testScene) Draw(screen *ebiten.Image) {
+}
+
+func TestManagerStack(t *testing.T) {
+	var log []string
+	a := &testScene{name: "a", log: &log}
+	b := &testScene{name: "b", log: &log}
+	c := &testScene{name: "c", log: &log}
+
+	m := scene.NewManager(a)
+	_ = m.Update()
+	m.Push(b, nil)
+	_ = m.Update()
+	m.Replace(c, nil)
+	_ = m.Update()
+	m.Pop(nil)
+	_ = m.Update()
+	m.Pop(nil)
+	_ = m.Update()
+
+	want := []string{
+		"enter a", "update a",
+		"enter b", "update b",
+		"enter c", "exit b", "update c",
+		"exit c", "update a",
+		"exit a",
+	}
+	if !slices.Equal(log, want) {
+		t.Errorf("got: %v, want: %v", log, want)
+	}
+	if got := m.Current(); got != nil {
+		t.Errorf("m.Current(): got: %v, want: nil", got)
+	}
+}
+
+func TestManagerTransition(t *testing.T) {
+	var log []string
+	a := &testScene{name: "a", log: &log}
+	b := &testScene{name: "b", log: &log}
+
+	m := scene.NewManager(a)
+	m.Replace(b, &scene.Crossfade{Ticks: 3})
+	if !m.IsTransitioning() {
+		t.Errorf("m.IsTransitioning(): got: false, want: true")
+	}
+	for i := 0; i < 3; i++ {
+		_ = m.Update()
+	}
+	if m.IsTransitioning() {
+		t.Errorf("m.IsTransitioning(): got: true, want: false")
+	}
+
+	// The outgoing scene exits after the transition ends, and only the incoming scene is updated.
+	want := []string{
+		"enter a",
+		"enter b", "update b", "update b",
+		"exit a", "update b",
+	}
+	if !slices.Equal(log, want) {
+		t.Errorf("got: %v, want: %v", log, want)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scene
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Transition represents a visual effect when a scene is switched.
+type Transition interface {
+	// Duration returns the duration of the transition in ticks.
+	Duration() int
+
+	// Draw draws the transition onto screen.
+	// from and to are offscreen images where the outgoing scene and the incoming scene are rendered.
+	// progress is in [0, 1).
+	Draw(screen, from, to *ebiten.Image, progress float64)
+}
+
+// Fade is a Transition that fades out the outgoing scene to a color, and then fades in the incoming scene.
+type Fade struct {
+	// Ticks is the duration of the transition in ticks.
+	Ticks int
+
+	// Color is the color in the middle of the transition.
+	// If Color is nil, black is used.
+	Color color.Color
+}
+
+// Duration implements Transition.Duration.
+func (f *Fade) Duration() int {
+	return f.Ticks
+}
+
+// Draw implements Transition.Draw.
+func (f *Fade) Draw(screen, from, to *ebiten.Image, progress float64) {
+	var alpha float64
+	if progress < 0.5 {
+		screen.DrawImage(from, nil)
+		alpha = progress * 2
+	} else {
+		screen.DrawImage(to, nil)
+		alpha = (1 - progress) * 2
+	}
+
+	clr := f.Color
+	if clr == nil {
+		clr = color.Black
+	}
+	r, g, b, a := clr.RGBA()
+	scaled := color.RGBA64{
+		R: uint16(float64(r) * alpha),
+		G: uint16(float64(g) * alpha),
+		B: uint16(float64(b) * alpha),
+		A: uint16(float64(a) * alpha),
+	}
+	if scaled.A == 0 {
+		return
+	}
+	// Fill can't be used as Fill replaces the pixels. Use DrawImage with a 1x1 image instead.
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(float64(screen.Bounds().Dx()), float64(screen.Bounds().Dy()))
+	op.GeoM.Translate(float64(screen.Bounds().Min.X), float64(screen.Bounds().Min.Y))
+	op.ColorScale.ScaleWithColor(scaled)
+	screen.DrawImage(whiteImage, op)
+}
+
+// Crossfade is a Transition that blends the outgoing scene and the incoming scene.
+type Crossfade struct {
+	// Ticks is the duration of the transition in ticks.
+	Ticks int
+}
+
+// Duration implements Transition.Duration.
+func (c *Crossfade) Duration() int {
+	return c.Ticks
+}
+
+// Draw implements Transition.Draw.
+func (c *Crossfade) Draw(screen, from, to *ebiten.Image, progress float64) {
+	op := &ebiten.DrawImageOptions{}
+	op.ColorScale.ScaleAlpha(float32(1 - progress))
+	screen.DrawImage(from, op)
+
+	op = &ebiten.DrawImageOptions{}
+	op.Blend = ebiten.BlendLighter
+	op.ColorScale.ScaleAlpha(float32(progress))
+	screen.DrawImage(to, op)
+}
+
+var whiteImage *ebiten.Image
+
+func init() {
+	whiteImage = ebiten.NewImage(1, 1)
+	whiteImage.Fill(color.White)
+}
@@ -0,0 +1,297 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package skeleton
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+type timelineType int
+
+const (
+	timelineTypeRotate timelineType = iota
+	timelineTypeTranslate
+	timelineTypeScale
+	timelineTypeAttachment
+	timelineTypeColor
+	timelineTypeDeform
+)
+
+type curveType int
+
+const (
+	curveTypeLinear curveType = iota
+	curveTypeStepped
+	curveTypeBezier
+)
+
+type curve struct {
+	typ curveType
+
+	// cx1, cy1, cx2, and cy2 are the control points of a cubic Bézier curve from (0, 0) to (1, 1).
+	cx1 float64
+	cy1 float64
+	cx2 float64
+	cy2 float64
+}
+
+// apply returns the eased progress for the linear progress t in [0, 1].
+func (c *curve) apply(t float64) float64 {
+	switch c.typ {
+	case curveTypeStepped:
+		return 0
+	case curveTypeBezier:
+		// Find the Bézier parameter for x = t by bisection, as x is monotonic for valid control points.
+		lo, hi := 0.0, 1.0
+		u := t
+		for range 20 {
+			x := bezier(c.cx1, c.cx2, u)
+			if math.Abs(x-t) < 1e-6 {
+				break
+			}
+			if x < t {
+				lo = u
+			} else {
+				hi = u
+			}
+			u = (lo + hi) / 2
+		}
+		return bezier(c.cy1, c.cy2, u)
+	default:
+		return t
+	}
+}
+
+// bezier returns the value of a cubic Bézier curve from 0 to 1 with the control values p1 and p2 at u.
+func bezier(p1, p2, u float64) float64 {
+	v := 1 - u
+	return 3*v*v*u*p1 + 3*v*u*u*p2 + u*u*u
+}
+
+func parseCurve(raw json.RawMessage) (curve, error) {
+	if len(raw) == 0 {
+		return curve{}, nil
+	}
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		switch name {
+		case "linear":
+			return curve{}, nil
+		case "stepped":
+			return curve{typ: curveTypeStepped}, nil
+		default:
+			return curve{}, fmt.Errorf("skeleton: unknown curve %q", name)
+		}
+	}
+	var ps []float64
+	if err := json.Unmarshal(raw, &ps); err != nil || len(ps) != 4 {
+		return curve{}, fmt.Errorf("skeleton: curve must be \"linear\", \"stepped\", or 4 numbers but %s", string(raw))
+	}
+	return curve{
+		typ: curveTypeBezier,
+		cx1: ps[0],
+		cy1: ps[1],
+		cx2: ps[2],
+		cy2: ps[3],
+	}, nil
+}
+
+type keyframe struct {
+	time   float64
+	values []float64
+	name   string
+	curve  curve
+}
+
+type timeline struct {
+	typ    timelineType
+	target int
+	keys   []keyframe
+}
+
+// valuesAt interpolates the values at the time into dst and returns it.
+// valuesAt also returns the name of the keyframe just before the time.
+func (t *timeline) valuesAt(dst []float64, time float64) ([]float64, string) {
+	dst = dst[:0]
+
+	if time <= t.keys[0].time {
+		return append(dst, t.keys[0].values...), t.keys[0].name
+	}
+	last := t.keys[len(t.keys)-1]
+	if time >= last.time {
+		return append(dst, last.values...), last.name
+	}
+
+	var i int
+	for i < len(t.keys)-1 && t.keys[i+1].time <= time {
+		i++
+	}
+	k0, k1 := &t.keys[i], &t.keys[i+1]
+	p := k0.curve.apply((time - k0.time) / (k1.time - k0.time))
+	for j := range k0.values {
+		dst = append(dst, k0.values[j]+(k1.values[j]-k0.values[j])*p)
+	}
+	return dst, k0.name
+}
+
+// Animation is an animation of a skeleton.
+type Animation struct {
+	name      string
+	duration  float64
+	timelines []timeline
+}
+
+func (a *Animation) addTimeline(typ timelineType, target int, jkeys []jsonKeyframe) error {
+	if len(jkeys) == 0 {
+		return nil
+	}
+
+	keys := make([]keyframe, 0, len(jkeys))
+	for i, jk := range jkeys {
+		if i > 0 && jk.Time < jkeys[i-1].Time {
+			return fmt.Errorf("skeleton: keyframes in animation %q must be sorted by time", a.name)
+		}
+		c, err := parseCurve(jk.Curve)
+		if err != nil {
+			return err
+		}
+		k := keyframe{
+			time:  jk.Time,
+			curve: c,
+		}
+		switch typ {
+		case timelineTypeRotate:
+			k.values = []float64{jk.Value}
+		case timelineTypeTranslate:
+			k.values = []float64{valueOr(jk.X, 0), valueOr(jk.Y, 0)}
+		case timelineTypeScale:
+			k.values = []float64{valueOr(jk.X, 1), valueOr(jk.Y, 1)}
+		case timelineTypeAttachment:
+			if jk.Name != nil {
+				k.name = *jk.Name
+			}
+			k.curve = curve{typ: curveTypeStepped}
+		case timelineTypeColor:
+			clr, err := parseColor(jk.Color)
+			if err != nil {
+				return err
+			}
+			k.values = []float64{float64(clr.R) / 0xff, float64(clr.G) / 0xff, float64(clr.B) / 0xff, float64(clr.A) / 0xff}
+		case timelineTypeDeform:
+			if i > 0 && len(jk.Vertices) != len(jkeys[0].Vertices) {
+				return fmt.Errorf("skeleton: deform keyframes in animation %q must have the same number of vertices", a.name)
+			}
+			k.values = make([]float64, len(jk.Vertices))
+			for j, v := range jk.Vertices {
+				k.values[j] = float64(v)
+			}
+		}
+		keys = append(keys, k)
+		a.duration = max(a.duration, jk.Time)
+	}
+
+	a.timelines = append(a.timelines, timeline{
+		typ:    typ,
+		target: target,
+		keys:   keys,
+	})
+	return nil
+}
+
+// Name returns the animation's name.
+func (a *Animation) Name() string {
+	return a.name
+}
+
+// Duration returns the animation's duration, which is the time of the last keyframe.
+func (a *Animation) Duration() float64 {
+	return a.duration
+}
+
+// Apply poses the skeleton with the animation at the time.
+//
+// If loop is true, the time is wrapped around the duration.
+//
+// Apply changes only the properties that the animation has timelines for.
+// Call Skeleton.SetToSetupPose before Apply to reset the other properties.
+func (a *Animation) Apply(s *Skeleton, time float64, loop bool) {
+	a.Mix(s, time, loop, 1)
+}
+
+// Mix poses the skeleton with the animation at the time, mixed with the current pose by alpha in [0, 1].
+//
+// Mix is useful to cross-fade two animations: apply the first animation, and then mix the second animation
+// while increasing alpha from 0 to 1.
+// Attachments are switched when alpha is 0.5 or more.
+//
+// If loop is true, the time is wrapped around the duration.
+func (a *Animation) Mix(s *Skeleton, time float64, loop bool, alpha float64) {
+	if s.data.animations[a.name] != a {
+		panic("skeleton: the animation must belong to the skeleton's data")
+	}
+	if loop && a.duration > 0 {
+		time = math.Mod(time, a.duration)
+		if time < 0 {
+			time += a.duration
+		}
+	}
+
+	var buf [4]float64
+	for i := range a.timelines {
+		t := &a.timelines[i]
+		vs, name := t.valuesAt(buf[:0], time)
+
+		switch t.typ {
+		case timelineTypeRotate:
+			b, setup := &s.bones[t.target], &s.data.bones[t.target]
+			b.rot = mix(b.rot, setup.rot+vs[0], alpha)
+		case timelineTypeTranslate:
+			b, setup := &s.bones[t.target], &s.data.bones[t.target]
+			b.x = mix(b.x, setup.x+vs[0], alpha)
+			b.y = mix(b.y, setup.y+vs[1], alpha)
+		case timelineTypeScale:
+			b, setup := &s.bones[t.target], &s.data.bones[t.target]
+			b.scaleX = mix(b.scaleX, setup.scaleX*vs[0], alpha)
+			b.scaleY = mix(b.scaleY, setup.scaleY*vs[1], alpha)
+		case timelineTypeAttachment:
+			if alpha >= 0.5 {
+				s.slots[t.target].attachment = name
+			}
+		case timelineTypeColor:
+			sl := &s.slots[t.target]
+			for j := range sl.color {
+				sl.color[j] = mix(sl.color[j], vs[j], alpha)
+			}
+		case timelineTypeDeform:
+			sl := &s.slots[t.target]
+			if len(sl.deform) != len(vs) {
+				sl.deform = make([]float64, len(vs))
+			}
+			for j := range vs {
+				sl.deform[j] = mix(sl.deform[j], vs[j], alpha)
+			}
+		}
+	}
+	s.dirty = true
+}
+
+func mix(from, to, alpha float64) float64 {
+	if alpha >= 1 {
+		return to
+	}
+	return from + (to-from)*alpha
+}
@@ -0,0 +1,381 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package skeleton provides a loader and a runtime for simple 2D skeletal animations.
+//
+// The data format is a JSON subset inspired by common skeletal animation tools like Spine and DragonBones.
+// A skeleton consists of bones, slots, attachments, and animations:
+//
+//	{
+//	  "bones": [
+//	    {"name": "root"},
+//	    {"name": "arm", "parent": "root", "x": 10, "y": 0, "rotation": 30, "scaleX": 1, "scaleY": 1}
+//	  ],
+//	  "slots": [
+//	    {"name": "arm", "bone": "arm", "attachment": "arm", "color": "ffffffff"}
+//	  ],
+//	  "attachments": {
+//	    "arm": {"type": "region", "x": 8, "y": 0, "width": 16, "height": 4, "src": [0, 0, 16, 4]},
+//	    "cape": {"type": "mesh", "src": [0, 8, 32, 32], "uvs": [0, 0, 1, 0, 0, 1, 1, 1], "vertices": [0, 0, 32, 0, 0, 32, 32, 32], "triangles": [0, 1, 2, 1, 3, 2]}
+//	  },
+//	  "animations": {
+//	    "wave": {
+//	      "bones": {
+//	        "arm": {
+//	          "rotate": [{"time": 0, "value": 0, "curve": [0.25, 0, 0.75, 1]}, {"time": 0.5, "value": 45}],
+//	          "translate": [{"time": 0, "x": 0, "y": 0}],
+//	          "scale": [{"time": 0, "x": 1, "y": 1}]
+//	        }
+//	      },
+//	      "slots": {
+//	        "arm": {
+//	          "attachment": [{"time": 0, "name": "arm"}],
+//	          "color": [{"time": 0, "color": "ffffffff"}]
+//	        }
+//	      },
+//	      "deform": {
+//	        "cape": [{"time": 0, "vertices": [0, 0, 0, 0, 0, 0, 0, 0]}, {"time": 1, "vertices": [0, 0, 0, 0, 4, 0, 4, 0]}]
+//	      }
+//	    }
+//	  }
+//	}
+//
+// The coordinate system is the same as Ebitengine's: the Y axis points downward, and rotations are in degrees clockwise.
+// The values of bones' and attachments' transforms are relative to their parent bones.
+// The slots are drawn in the order of the slots.
+// The vertices of a mesh are in the coordinates of its slot's bone, and deform timelines add offsets to them.
+// The colors are in RRGGBBAA hexadecimal in straight alpha.
+// The curve of a keyframe is "linear" (default), "stepped", or a cubic Bézier curve [cx1, cy1, cx2, cy2].
+package skeleton
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"slices"
+	"strconv"
+)
+
+// Data is a loaded skeleton data, which is shared by multiple Skeleton objects.
+//
+// Data is immutable after loading and can be used concurrently.
+type Data struct {
+	bones       []boneData
+	slots       []slotData
+	attachments map[string]*attachmentData
+	animations  map[string]*Animation
+}
+
+type boneData struct {
+	name   string
+	parent int
+	x      float64
+	y      float64
+	rot    float64
+	scaleX float64
+	scaleY float64
+}
+
+type slotData struct {
+	name       string
+	bone       int
+	attachment string
+	color      color.RGBA
+}
+
+type attachmentType int
+
+const (
+	attachmentTypeRegion attachmentType = iota
+	attachmentTypeMesh
+)
+
+type attachmentData struct {
+	typ attachmentType
+	src image.Rectangle
+
+	// For regions.
+	x      float64
+	y      float64
+	rot    float64
+	scaleX float64
+	scaleY float64
+	width  float64
+	height float64
+
+	// For meshes.
+	uvs       []float32
+	vertices  []float32
+	triangles []uint16
+}
+
+type jsonData struct {
+	Bones []struct {
+		Name     string   `json:"name"`
+		Parent   string   `json:"parent"`
+		X        float64  `json:"x"`
+		Y        float64  `json:"y"`
+		Rotation float64  `json:"rotation"`
+		ScaleX   *float64 `json:"scaleX"`
+		ScaleY   *float64 `json:"scaleY"`
+	} `json:"bones"`
+	Slots []struct {
+		Name       string `json:"name"`
+		Bone       string `json:"bone"`
+		Attachment string `json:"attachment"`
+		Color      string `json:"color"`
+	} `json:"slots"`
+	Attachments map[string]struct {
+		Type      string    `json:"type"`
+		Src       []int     `json:"src"`
+		X         float64   `json:"x"`
+		Y         float64   `json:"y"`
+		Rotation  float64   `json:"rotation"`
+		ScaleX    *float64  `json:"scaleX"`
+		ScaleY    *float64  `json:"scaleY"`
+		Width     float64   `json:"width"`
+		Height    float64   `json:"height"`
+		UVs       []float32 `json:"uvs"`
+		Vertices  []float32 `json:"vertices"`
+		Triangles []uint16  `json:"triangles"`
+	} `json:"attachments"`
+	Animations map[string]struct {
+		Bones map[string]struct {
+			Rotate    []jsonKeyframe `json:"rotate"`
+			Translate []jsonKeyframe `json:"translate"`
+			Scale     []jsonKeyframe `json:"scale"`
+		} `json:"bones"`
+		Slots map[string]struct {
+			Attachment []jsonKeyframe `json:"attachment"`
+			Color      []jsonKeyframe `json:"color"`
+		} `json:"slots"`
+		Deform map[string][]jsonKeyframe `json:"deform"`
+	} `json:"animations"`
+}
+
+type jsonKeyframe struct {
+	Time     float64         `json:"time"`
+	Value    float64         `json:"value"`
+	X        *float64        `json:"x"`
+	Y        *float64        `json:"y"`
+	Name     *string         `json:"name"`
+	Color    string          `json:"color"`
+	Vertices []float32       `json:"vertices"`
+	Curve    json.RawMessage `json:"curve"`
+}
+
+// Load loads skeleton data in the JSON format from r.
+//
+// For the format, see the package document.
+func Load(r io.Reader) (*Data, error) {
+	var j jsonData
+	if err := json.NewDecoder(r).Decode(&j); err != nil {
+		return nil, err
+	}
+
+	d := &Data{
+		attachments: map[string]*attachmentData{},
+		animations:  map[string]*Animation{},
+	}
+
+	boneIndices := map[string]int{}
+	for _, b := range j.Bones {
+		if _, ok := boneIndices[b.Name]; ok {
+			return nil, fmt.Errorf("skeleton: duplicated bone name: %q", b.Name)
+		}
+		parent := -1
+		if b.Parent != "" {
+			p, ok := boneIndices[b.Parent]
+			if !ok {
+				return nil, fmt.Errorf("skeleton: parent bone %q of %q must be defined before the bone", b.Parent, b.Name)
+			}
+			parent = p
+		}
+		boneIndices[b.Name] = len(d.bones)
+		d.bones = append(d.bones, boneData{
+			name:   b.Name,
+			parent: parent,
+			x:      b.X,
+			y:      b.Y,
+			rot:    b.Rotation,
+			scaleX: valueOr(b.ScaleX, 1),
+			scaleY: valueOr(b.ScaleY, 1),
+		})
+	}
+
+	slotIndices := map[string]int{}
+	for _, s := range j.Slots {
+		if _, ok := slotIndices[s.Name]; ok {
+			return nil, fmt.Errorf("skeleton: duplicated slot name: %q", s.Name)
+		}
+		bone, ok := boneIndices[s.Bone]
+		if !ok {
+			return nil, fmt.Errorf("skeleton: bone %q of slot %q is not found", s.Bone, s.Name)
+		}
+		clr, err := parseColor(s.Color)
+		if err != nil {
+			return nil, err
+		}
+		slotIndices[s.Name] = len(d.slots)
+		d.slots = append(d.slots, slotData{
+			name:       s.Name,
+			bone:       bone,
+			attachment: s.Attachment,
+			color:      clr,
+		})
+	}
+
+	for name, a := range j.Attachments {
+		if len(a.Src) != 4 {
+			return nil, fmt.Errorf("skeleton: src of attachment %q must have 4 values but %d", name, len(a.Src))
+		}
+		ad := &attachmentData{
+			src: image.Rect(a.Src[0], a.Src[1], a.Src[0]+a.Src[2], a.Src[1]+a.Src[3]),
+		}
+		switch a.Type {
+		case "", "region":
+			ad.typ = attachmentTypeRegion
+			ad.x = a.X
+			ad.y = a.Y
+			ad.rot = a.Rotation
+			ad.scaleX = valueOr(a.ScaleX, 1)
+			ad.scaleY = valueOr(a.ScaleY, 1)
+			ad.width = a.Width
+			ad.height = a.Height
+		case "mesh":
+			ad.typ = attachmentTypeMesh
+			if len(a.Vertices)%2 != 0 || len(a.UVs) != len(a.Vertices) {
+				return nil, fmt.Errorf("skeleton: vertices and uvs of mesh %q must have the same even number of values", name)
+			}
+			for _, t := range a.Triangles {
+				if int(t) >= len(a.Vertices)/2 {
+					return nil, fmt.Errorf("skeleton: triangles of mesh %q are out of range", name)
+				}
+			}
+			ad.uvs = a.UVs
+			ad.vertices = a.Vertices
+			ad.triangles = a.Triangles
+		default:
+			return nil, fmt.Errorf("skeleton: unknown attachment type %q for %q", a.Type, name)
+		}
+		d.attachments[name] = ad
+	}
+
+	for _, s := range d.slots {
+		if s.attachment == "" {
+			continue
+		}
+		if _, ok := d.attachments[s.attachment]; !ok {
+			return nil, fmt.Errorf("skeleton: attachment %q of slot %q is not found", s.attachment, s.name)
+		}
+	}
+
+	for name, ja := range j.Animations {
+		a := &Animation{
+			name: name,
+		}
+		for boneName, bt := range ja.Bones {
+			bone, ok := boneIndices[boneName]
+			if !ok {
+				return nil, fmt.Errorf("skeleton: bone %q in animation %q is not found", boneName, name)
+			}
+			if err := a.addTimeline(timelineTypeRotate, bone, bt.Rotate); err != nil {
+				return nil, err
+			}
+			if err := a.addTimeline(timelineTypeTranslate, bone, bt.Translate); err != nil {
+				return nil, err
+			}
+			if err := a.addTimeline(timelineTypeScale, bone, bt.Scale); err != nil {
+				return nil, err
+			}
+		}
+		for slotName, st := range ja.Slots {
+			slot, ok := slotIndices[slotName]
+			if !ok {
+				return nil, fmt.Errorf("skeleton: slot %q in animation %q is not found", slotName, name)
+			}
+			for _, k := range st.Attachment {
+				if k.Name != nil && *k.Name != "" {
+					if _, ok := d.attachments[*k.Name]; !ok {
+						return nil, fmt.Errorf("skeleton: attachment %q in animation %q is not found", *k.Name, name)
+					}
+				}
+			}
+			if err := a.addTimeline(timelineTypeAttachment, slot, st.Attachment); err != nil {
+				return nil, err
+			}
+			if err := a.addTimeline(timelineTypeColor, slot, st.Color); err != nil {
+				return nil, err
+			}
+		}
+		for slotName, keys := range ja.Deform {
+			slot, ok := slotIndices[slotName]
+			if !ok {
+				return nil, fmt.Errorf("skeleton: slot %q in animation %q is not found", slotName, name)
+			}
+			if err := a.addTimeline(timelineTypeDeform, slot, keys); err != nil {
+				return nil, err
+			}
+		}
+		// Sort the timelines to apply them in a deterministic order.
+		slices.SortFunc(a.timelines, func(t0, t1 timeline) int {
+			if t0.target != t1.target {
+				return t0.target - t1.target
+			}
+			return int(t0.typ) - int(t1.typ)
+		})
+		d.animations[name] = a
+	}
+
+	return d, nil
+}
+
+// Animation returns the animation of the given name, or nil if not found.
+func (d *Data) Animation(name string) *Animation {
+	return d.animations[name]
+}
+
+// AppendAnimationNames appends the names of the animations to names and returns the extended slice.
+// The order is sorted by the names.
+func (d *Data) AppendAnimationNames(names []string) []string {
+	n := len(names)
+	for name := range d.animations {
+		names = append(names, name)
+	}
+	slices.Sort(names[n:])
+	return names
+}
+
+func valueOr(v *float64, defaultValue float64) float64 {
+	if v == nil {
+		return defaultValue
+	}
+	return *v
+}
+
+func parseColor(str string) (color.RGBA, error) {
+	if str == "" {
+		return color.RGBA{0xff, 0xff, 0xff, 0xff}, nil
+	}
+	if len(str) != 8 {
+		return color.RGBA{}, fmt.Errorf("skeleton: color must be RRGGBBAA but %q", str)
+	}
+	v, err := strconv.ParseUint(str, 16, 32)
+	if err != nil {
+		return color.RGBA{}, fmt.Errorf("skeleton: color must be RRGGBBAA but %q", str)
+	}
+	return color.RGBA{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: uint8(v)}, nil
+}
@@ -0,0 +1,276 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package skeleton
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Skeleton is an instance of skeleton data with its own pose.
+type Skeleton struct {
+	data  *Data
+	bones []bone
+	slots []slot
+
+	// worlds is the world transforms of the bones.
+	worlds []ebiten.GeoM
+	dirty  bool
+
+	vertices []ebiten.Vertex
+	indices  []uint16
+}
+
+type bone struct {
+	x      float64
+	y      float64
+	rot    float64
+	scaleX float64
+	scaleY float64
+}
+
+type slot struct {
+	attachment string
+
+	// color is in straight alpha.
+	color [4]float64
+
+	// deform is the offsets of the mesh vertices.
+	deform []float64
+}
+
+// NewSkeleton creates a new skeleton in the setup pose.
+func NewSkeleton(data *Data) *Skeleton {
+	s := &Skeleton{
+		data:   data,
+		bones:  make([]bone, len(data.bones)),
+		slots:  make([]slot, len(data.slots)),
+		worlds: make([]ebiten.GeoM, len(data.bones)),
+	}
+	s.SetToSetupPose()
+	return s
+}
+
+// Data returns the skeleton data.
+func (s *Skeleton) Data() *Data {
+	return s.data
+}
+
+// SetToSetupPose resets the bones and the slots to the setup pose.
+func (s *Skeleton) SetToSetupPose() {
+	for i, b := range s.data.bones {
+		s.bones[i] = bone{
+			x:      b.x,
+			y:      b.y,
+			rot:    b.rot,
+			scaleX: b.scaleX,
+			scaleY: b.scaleY,
+		}
+	}
+	for i, sl := range s.data.slots {
+		s.slots[i] = slot{
+			attachment: sl.attachment,
+			color: [4]float64{
+				float64(sl.color.R) / 0xff,
+				float64(sl.color.G) / 0xff,
+				float64(sl.color.B) / 0xff,
+				float64(sl.color.A) / 0xff,
+			},
+			deform: s.slots[i].deform[:0],
+		}
+	}
+	s.dirty = true
+}
+
+func (s *Skeleton) updateWorldTransforms() {
+	if !s.dirty {
+		return
+	}
+	// The bones are sorted so that a parent comes before its children.
+	for i, b := range s.bones {
+		var g ebiten.GeoM
+		g.Scale(b.scaleX, b.scaleY)
+		g.Rotate(b.rot * math.Pi / 180)
+		g.Translate(b.x, b.y)
+		if p := s.data.bones[i].parent; p >= 0 {
+			g.Concat(s.worlds[p])
+		}
+		s.worlds[i] = g
+	}
+	s.dirty = false
+}
+
+// BoneGeoM returns the world transform of the bone of the given name in the skeleton's coordinates.
+// BoneGeoM is useful to attach other objects to a bone.
+//
+// BoneGeoM returns false if the bone is not found.
+func (s *Skeleton) BoneGeoM(name string) (ebiten.GeoM, bool) {
+	for i, b := range s.data.bones {
+		if b.name != name {
+			continue
+		}
+		s.updateWorldTransforms()
+		return s.worlds[i], true
+	}
+	return ebiten.GeoM{}, false
+}
+
+// AppendVerticesAndIndices appends the vertices and the indices to render the skeleton in the current pose,
+// and returns the extended slices.
+//
+// The vertices are in the skeleton's coordinates.
+// The source positions are in the coordinates of the atlas image that the attachments' src refer to.
+// The colors of the vertices are in premultiplied alpha.
+//
+// If the number of the vertices exceeds the limit of uint16 indices, the result is undefined.
+func (s *Skeleton) AppendVerticesAndIndices(vertices []ebiten.Vertex, indices []uint16) ([]ebiten.Vertex, []uint16) {
+	s.updateWorldTransforms()
+
+	for i := range s.slots {
+		sl := &s.slots[i]
+		if sl.attachment == "" {
+			continue
+		}
+		a := s.data.attachments[sl.attachment]
+		world := s.worlds[s.data.slots[i].bone]
+
+		alpha := sl.color[3]
+		cr := float32(sl.color[0] * alpha)
+		cg := float32(sl.color[1] * alpha)
+		cb := float32(sl.color[2] * alpha)
+		ca := float32(alpha)
+
+		base := uint16(len(vertices))
+		sx0, sy0 := float32(a.src.Min.X), float32(a.src.Min.Y)
+		sw, sh := float32(a.src.Dx()), float32(a.src.Dy())
+
+		switch a.typ {
+		case attachmentTypeRegion:
+			var g ebiten.GeoM
+			g.Scale(a.scaleX, a.scaleY)
+			g.Rotate(a.rot * math.Pi / 180)
+			g.Translate(a.x, a.y)
+			g.Concat(world)
+
+			w, h := a.width/2, a.height/2
+			for _, c := range [4][4]float64{
+				{-w, -h, 0, 0},
+				{w, -h, 1, 0},
+				{-w, h, 0, 1},
+				{w, h, 1, 1},
+			} {
+				x, y := g.Apply(c[0], c[1])
+				vertices = append(vertices, ebiten.Vertex{
+					DstX:   float32(x),
+					DstY:   float32(y),
+					SrcX:   sx0 + sw*float32(c[2]),
+					SrcY:   sy0 + sh*float32(c[3]),
+					ColorR: cr,
+					ColorG: cg,
+					ColorB: cb,
+					ColorA: ca,
+				})
+			}
+			indices = append(indices, base, base+1, base+2, base+1, base+3, base+2)
+
+		case attachmentTypeMesh:
+			// Apply the deform only when it matches the mesh, as the slot's attachment might be switched.
+			deform := sl.deform
+			if len(deform) != len(a.vertices) {
+				deform = nil
+			}
+			for j := 0; j < len(a.vertices); j += 2 {
+				vx, vy := float64(a.vertices[j]), float64(a.vertices[j+1])
+				if deform != nil {
+					vx += deform[j]
+					vy += deform[j+1]
+				}
+				x, y := world.Apply(vx, vy)
+				vertices = append(vertices, ebiten.Vertex{
+					DstX:   float32(x),
+					DstY:   float32(y),
+					SrcX:   sx0 + sw*a.uvs[j],
+					SrcY:   sy0 + sh*a.uvs[j+1],
+					ColorR: cr,
+					ColorG: cg,
+					ColorB: cb,
+					ColorA: ca,
+				})
+			}
+			for _, t := range a.triangles {
+				indices = append(indices, base+t)
+			}
+		}
+	}
+
+	return vertices, indices
+}
+
+// DrawOptions represents options to render a skeleton.
+type DrawOptions struct {
+	// GeoM is a geometry matrix to transform the skeleton's coordinates.
+	//
+	// The default (zero) value is identity.
+	GeoM ebiten.GeoM
+
+	// ColorScale is a scale of the color in premultiplied alpha.
+	//
+	// The default (zero) value is identity, which is (1, 1, 1, 1).
+	ColorScale ebiten.ColorScale
+
+	// Blend is a blending way of the source color and the destination color.
+	//
+	// The default (zero) value is the regular alpha blending.
+	Blend ebiten.Blend
+
+	// Filter is a type of texture filter.
+	//
+	// The default (zero) value is ebiten.FilterNearest.
+	Filter ebiten.Filter
+}
+
+// Draw renders the skeleton in the current pose onto dst.
+// img is the atlas image that the attachments' src refer to.
+//
+// options can be nil.
+func (s *Skeleton) Draw(dst, img *ebiten.Image, options *DrawOptions) {
+	if options == nil {
+		options = &DrawOptions{}
+	}
+
+	s.vertices, s.indices = s.AppendVerticesAndIndices(s.vertices[:0], s.indices[:0])
+	if len(s.indices) == 0 {
+		return
+	}
+
+	cr, cg, cb, ca := options.ColorScale.R(), options.ColorScale.G(), options.ColorScale.B(), options.ColorScale.A()
+	for i := range s.vertices {
+		v := &s.vertices[i]
+		x, y := options.GeoM.Apply(float64(v.DstX), float64(v.DstY))
+		v.DstX = float32(x)
+		v.DstY = float32(y)
+		v.ColorR *= cr
+		v.ColorG *= cg
+		v.ColorB *= cb
+		v.ColorA *= ca
+	}
+
+	op := &ebiten.DrawTrianglesOptions{}
+	op.ColorScaleMode = ebiten.ColorScaleModePremultipliedAlpha
+	op.Blend = options.Blend
+	op.Filter = options.Filter
+	dst.DrawTriangles(s.vertices, s.indices, img, op)
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package skeleton_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/x/skeleton"
+)
+
+const testData = `{
+  "bones": [
+    {"name": "root", "x": 100},
+    {"name": "arm", "parent": "root", "x": 10}
+  ],
+  "slots": [
+    {"name": "arm", "bone": "arm", "attachment": "arm", "color": "ff000080"},
+    {"name": "cape", "bone": "root", "attachment": "cape"}
+  ],
+  "attachments": {
+    "arm": {"type": "region", "x": 8, "width": 16, "height": 4, "src": [0, 0, 16, 4]},
+    "cape": {"type": "mesh", "src": [0, 8, 32, 32], "uvs": [0, 0, 1, 0, 0, 1], "vertices": [0, 0, 32, 0, 0, 32], "triangles": [0, 1, 2]}
+  },
+  "animations": {
+    "wave": {
+      "bones": {
+        "arm": {
+          "rotate": [{"time": 0, "value": 0}, {"time": 1, "value": 90}]
+        }
+      },
+      "deform": {
+        "cape": [{"time": 0, "vertices": [0, 0, 0, 0, 0, 0]}, {"time": 1, "vertices": [0, 0, 0, 0, 4, 0]}]
+      }
+    }
+  }
+}`
+
+func approxEqual(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 1e-3
+}
+
+func checkVertex(t *testing.T, v ebiten.Vertex, x, y float32) {
+	t.Helper()
+	if !approxEqual(v.DstX, x) || !approxEqual(v.DstY, y) {
+		t.Errorf("vertex: got: (%f, %f), want: (%f, %f)", v.DstX, v.DstY, x, y)
+	}
+}
+
+func TestSkeleton(t *testing.T) {
+	d, err := skeleton.Load(strings.NewReader(testData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := skeleton.NewSkeleton(d)
+
+	vs, is := s.AppendVerticesAndIndices(nil, nil)
+	if got, want := len(vs), 7; got != want {
+		t.Fatalf("len(vertices): got: %d, want: %d", got, want)
+	}
+	if got, want := len(is), 9; got != want {
+		t.Fatalf("len(indices): got: %d, want: %d", got, want)
+	}
+	checkVertex(t, vs[0], 110, -2)
+	checkVertex(t, vs[3], 126, 2)
+	checkVertex(t, vs[6], 100, 32)
+	if got, want := vs[0].ColorR, vs[0].ColorA; !approxEqual(got, want) {
+		t.Errorf("ColorR: got: %f, want: %f", got, want)
+	}
+	if got, want := vs[3].SrcX, float32(16); got != want {
+		t.Errorf("SrcX: got: %f, want: %f", got, want)
+	}
+	if got, want := vs[6].SrcY, float32(40); got != want {
+		t.Errorf("SrcY: got: %f, want: %f", got, want)
+	}
+
+	a := d.Animation("wave")
+	if a == nil {
+		t.Fatal("animation wave must exist")
+	}
+	if got, want := a.Duration(), 1.0; got != want {
+		t.Errorf("Duration(): got: %f, want: %f", got, want)
+	}
+
+	// The arm is rotated by 90 degrees clockwise.
+	a.Apply(s, 1, false)
+	vs, _ = s.AppendVerticesAndIndices(vs[:0], nil)
+	checkVertex(t, vs[0], 112, 0)
+	checkVertex(t, vs[3], 108, 16)
+	checkVertex(t, vs[6], 104, 32)
+
+	// With looping, the time 1.5 is the same as 0.5.
+	s.SetToSetupPose()
+	a.Apply(s, 1.5, true)
+	g, ok := s.BoneGeoM("arm")
+	if !ok {
+		t.Fatal("bone arm must exist")
+	}
+	x, y := g.Apply(8, 0)
+	if want := 110 + 8/math.Sqrt2; math.Abs(x-want) > 1e-3 || math.Abs(y-8/math.Sqrt2) > 1e-3 {
+		t.Errorf("arm: got: (%f, %f), want: (%f, %f)", x, y, want, 8/math.Sqrt2)
+	}
+
+	// Mixing the animation with the setup pose.
+	s.SetToSetupPose()
+	a.Mix(s, 1, false, 0.5)
+	g, _ = s.BoneGeoM("arm")
+	x, y = g.Apply(8, 0)
+	if want := 110 + 8/math.Sqrt2; math.Abs(x-want) > 1e-3 || math.Abs(y-8/math.Sqrt2) > 1e-3 {
+		t.Errorf("arm: got: (%f, %f), want: (%f, %f)", x, y, want, 8/math.Sqrt2)
+	}
+
+	if _, ok := s.BoneGeoM("leg"); ok {
+		t.Error("bone leg must not exist")
+	}
+}
+
+func TestLoadError(t *testing.T) {
+	for _, data := range []string{
+		`{"bones": [{"name": "a", "parent": "b"}]}`,
+		`{"bones": [{"name": "a"}], "slots": [{"name": "s", "bone": "a", "attachment": "x"}]}`,
+		`{"bones": [{"name": "a"}], "animations": {"x": {"bones": {"a": {"rotate": [{"time": 1}, {"time": 0}]}}}}}`,
+		`{"bones": [{"name": "a"}], "animations": {"x": {"bones": {"a": {"rotate": [{"time": 0, "curve": "unknown"}]}}}}}`,
+	} {
+		if _, err := skeleton.Load(strings.NewReader(data)); err == nil {
+			t.Errorf("Load(%q) must return an error", data)
+		}
+	}
+}
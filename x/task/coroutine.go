@@ -0,0 +1,113 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"iter"
+)
+
+// Coroutine is a task that runs a function like a coroutine.
+//
+// The function can suspend its execution with the methods of Co, and is resumed at the next Update.
+// As the function runs only in Update, the function can safely access the game state without locks.
+type Coroutine struct {
+	next func() (struct{}, bool)
+	stop func()
+	done bool
+}
+
+// Co is passed to the function of a coroutine to suspend its execution.
+type Co struct {
+	yield func(struct{}) bool
+}
+
+type coroutineStopped struct{}
+
+// NewCoroutine creates a new coroutine with the given function.
+// f starts at the first Update.
+//
+// A coroutine uses a goroutine internally.
+// If a coroutine is discarded before it is done, call Stop to release the resources.
+func NewCoroutine(f func(co *Co)) *Coroutine {
+	seq := func(yield func(struct{}) bool) {
+		defer func() {
+			if r := recover(); r != nil {
+				if _, ok := r.(coroutineStopped); !ok {
+					panic(r)
+				}
+			}
+		}()
+		f(&Co{yield: yield})
+	}
+	c := &Coroutine{}
+	c.next, c.stop = iter.Pull(seq)
+	return c
+}
+
+// Update implements Task.Update.
+//
+// Update resumes the function until the function is suspended or returns.
+func (c *Coroutine) Update() {
+	if c.done {
+		return
+	}
+	if _, ok := c.next(); !ok {
+		c.done = true
+	}
+}
+
+// Done implements Task.Done.
+func (c *Coroutine) Done() bool {
+	return c.done
+}
+
+// Stop stops the coroutine.
+// The function's deferred functions are executed.
+func (c *Coroutine) Stop() {
+	c.stop()
+	c.done = true
+}
+
+// Yield suspends the function until the next tick.
+func (c *Co) Yield() {
+	if !c.yield(struct{}{}) {
+		panic(coroutineStopped{})
+	}
+}
+
+// Wait suspends the function for the given ticks.
+// If ticks is 0 or less, Wait returns immediately.
+func (c *Co) Wait(ticks int) {
+	for range ticks {
+		c.Yield()
+	}
+}
+
+// WaitUntil suspends the function until cond returns true.
+// cond is evaluated once per tick.
+func (c *Co) WaitUntil(cond func() bool) {
+	for !cond() {
+		c.Yield()
+	}
+}
+
+// Await runs the given task until the task is done.
+// The task is updated once per tick from the next tick, so Await(Wait(n)) is equivalent to Wait(n).
+func (c *Co) Await(task Task) {
+	for !task.Done() {
+		c.Yield()
+		task.Update()
+	}
+}
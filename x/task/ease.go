@@ -0,0 +1,106 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"math"
+)
+
+// EaseFunc is an easing function.
+// An easing function takes a progress in [0, 1] and returns an eased progress.
+// An easing function should return 0 for 0 and 1 for 1.
+type EaseFunc func(t float64) float64
+
+// Linear is the linear easing function.
+func Linear(t float64) float64 {
+	return t
+}
+
+// EaseInQuad is the quadratic ease-in function.
+func EaseInQuad(t float64) float64 {
+	return t * t
+}
+
+// EaseOutQuad is the quadratic ease-out function.
+func EaseOutQuad(t float64) float64 {
+	return 1 - (1-t)*(1-t)
+}
+
+// EaseInOutQuad is the quadratic ease-in-out function.
+func EaseInOutQuad(t float64) float64 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return 1 - 2*(1-t)*(1-t)
+}
+
+// EaseInCubic is the cubic ease-in function.
+func EaseInCubic(t float64) float64 {
+	return t * t * t
+}
+
+// EaseOutCubic is the cubic ease-out function.
+func EaseOutCubic(t float64) float64 {
+	return 1 - (1-t)*(1-t)*(1-t)
+}
+
+// EaseInOutCubic is the cubic ease-in-out function.
+func EaseInOutCubic(t float64) float64 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	return 1 - 4*(1-t)*(1-t)*(1-t)
+}
+
+// EaseInSine is the sinusoidal ease-in function.
+func EaseInSine(t float64) float64 {
+	return 1 - math.Cos(t*math.Pi/2)
+}
+
+// EaseOutSine is the sinusoidal ease-out function.
+func EaseOutSine(t float64) float64 {
+	return math.Sin(t * math.Pi / 2)
+}
+
+// EaseInOutSine is the sinusoidal ease-in-out function.
+func EaseInOutSine(t float64) float64 {
+	return (1 - math.Cos(t*math.Pi)) / 2
+}
+
+// EaseOutBack is the ease-out function that overshoots the end slightly.
+func EaseOutBack(t float64) float64 {
+	const c1 = 1.70158
+	const c3 = c1 + 1
+	return 1 + c3*math.Pow(t-1, 3) + c1*math.Pow(t-1, 2)
+}
+
+// EaseOutBounce is the ease-out function that bounces at the end.
+func EaseOutBounce(t float64) float64 {
+	const n1 = 7.5625
+	const d1 = 2.75
+	switch {
+	case t < 1/d1:
+		return n1 * t * t
+	case t < 2/d1:
+		t -= 1.5 / d1
+		return n1*t*t + 0.75
+	case t < 2.5/d1:
+		t -= 2.25 / d1
+		return n1*t*t + 0.9375
+	default:
+		t -= 2.625 / d1
+		return n1*t*t + 0.984375
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package task provides tick-based timers, tweens, and coroutine-like sequences.
+//
+// All the tasks in this package advance only when their Update is called, typically from the game's Update.
+// As the game's Update is called TPS times per second, the tasks are deterministic with TPS,
+// and never depend on the wall-clock time.
+package task
+
+// Task represents a task that progresses for each tick.
+type Task interface {
+	// Update advances the task by one tick.
+	// Update does nothing if the task is already done.
+	Update()
+
+	// Done reports whether the task is done.
+	Done() bool
+}
+
+// Scheduler runs multiple tasks in parallel.
+//
+// The zero value of Scheduler is an empty scheduler and ready to use.
+type Scheduler struct {
+	tasks []Task
+	added []Task
+}
+
+// Add adds a task to the scheduler.
+// The added task is updated from the next Update.
+//
+// Add can be called during Update, e.g., from a coroutine in the scheduler.
+func (s *Scheduler) Add(task Task) {
+	s.added = append(s.added, task)
+}
+
+// Update advances all the tasks by one tick, and removes the done tasks.
+func (s *Scheduler) Update() {
+	s.tasks = append(s.tasks, s.added...)
+	clear(s.added)
+	s.added = s.added[:0]
+
+	var n int
+	for _, t := range s.tasks {
+		t.Update()
+		if t.Done() {
+			continue
+		}
+		s.tasks[n] = t
+		n++
+	}
+	clear(s.tasks[n:])
+	s.tasks = s.tasks[:n]
+}
+
+// Len returns the number of tasks that are not done yet.
+func (s *Scheduler) Len() int {
+	return len(s.tasks) + len(s.added)
+}
+
+// Clear removes all the tasks.
+// Clear doesn't stop coroutines. Call Coroutine.Stop explicitly if needed.
+func (s *Scheduler) Clear() {
+	clear(s.tasks)
+	s.tasks = s.tasks[:0]
+	clear(s.added)
+	s.added = s.added[:0]
+}
+
+type sequence struct {
+	tasks   []Task
+	current int
+}
+
+// Sequence returns a task that runs the given tasks one by one.
+// The next task starts at the same tick when the previous task is done.
+func Sequence(tasks ...Task) Task {
+	return &sequence{
+		tasks: tasks,
+	}
+}
+
+func (s *sequence) Update() {
+	if s.current >= len(s.tasks) {
+		return
+	}
+	t := s.tasks[s.current]
+	t.Update()
+	if !t.Done() {
+		return
+	}
+	s.current++
+
+	// Run the following instant tasks at the same tick.
+	for s.current < len(s.tasks) && isInstant(s.tasks[s.current]) {
+		s.tasks[s.current].Update()
+		s.current++
+	}
+}
+
+func (s *sequence) Done() bool {
+	return s.current >= len(s.tasks)
+}
+
+type parallel struct {
+	tasks []Task
+}
+
+// Parallel returns a task that runs the given tasks at the same time.
+// The task is done when all the given tasks are done.
+func Parallel(tasks ...Task) Task {
+	return &parallel{
+		tasks: tasks,
+	}
+}
+
+func (p *parallel) Update() {
+	for _, t := range p.tasks {
+		if t.Done() {
+			continue
+		}
+		t.Update()
+	}
+}
+
+func (p *parallel) Done() bool {
+	for _, t := range p.tasks {
+		if !t.Done() {
+			return false
+		}
+	}
+	return true
+}
+
+type funcTask struct {
+	f    func()
+	done bool
+}
+
+// Func returns a task that calls f once at its first Update.
+// Func doesn't consume a tick in Sequence.
+func Func(f func()) Task {
+	return &funcTask{
+		f: f,
+	}
+}
+
+func (f *funcTask) Update() {
+	if f.done {
+		return
+	}
+	f.f()
+	f.done = true
+}
+
+func (f *funcTask) Done() bool {
+	return f.done
+}
+
+// isInstant reports whether the task is done without consuming a tick.
+func isInstant(t Task) bool {
+	if t.Done() {
+		return true
+	}
+	_, ok := t.(*funcTask)
+	return ok
+}
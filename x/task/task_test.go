@@ -0,0 +1,162 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2/x/task"
+)
+
+func TestTimer(t *testing.T) {
+	timer := task.NewTimer(3)
+	for i := 0; i < 3; i++ {
+		if timer.Done() {
+			t.Errorf("tick %d: timer.Done(): got: true, want: false", i)
+		}
+		timer.Update()
+	}
+	if !timer.Done() {
+		t.Errorf("timer.Done(): got: false, want: true")
+	}
+	if got, want := timer.Progress(), 1.0; got != want {
+		t.Errorf("timer.Progress(): got: %f, want: %f", got, want)
+	}
+}
+
+func TestTween(t *testing.T) {
+	tw := task.NewTween(10, 20, 4, task.EaseInQuad)
+	var values []float64
+	tw.OnUpdate = func(value float64) {
+		values = append(values, value)
+	}
+	for !tw.Done() {
+		tw.Update()
+	}
+	if want := []float64{10.625, 12.5, 15.625, 20}; !slices.Equal(values, want) {
+		t.Errorf("got: %v, want: %v", values, want)
+	}
+}
+
+func TestEaseFuncs(t *testing.T) {
+	for i, f := range []task.EaseFunc{
+		task.Linear,
+		task.EaseInQuad,
+		task.EaseOutQuad,
+		task.EaseInOutQuad,
+		task.EaseInCubic,
+		task.EaseOutCubic,
+		task.EaseInOutCubic,
+		task.EaseInSine,
+		task.EaseOutSine,
+		task.EaseInOutSine,
+		task.EaseOutBack,
+		task.EaseOutBounce,
+	} {
+		if got := f(0); math.Abs(got) > 1e-9 {
+			t.Errorf("%d: f(0): got: %f, want: 0", i, got)
+		}
+		if got := f(1); math.Abs(got-1) > 1e-9 {
+			t.Errorf("%d: f(1): got: %f, want: 1", i, got)
+		}
+	}
+}
+
+func TestSequence(t *testing.T) {
+	var log []int
+	var tick int
+	s := task.Sequence(
+		task.Func(func() { log = append(log, tick) }),
+		task.Wait(3),
+		task.Func(func() { log = append(log, tick) }),
+		task.Func(func() { log = append(log, tick) }),
+		task.Wait(2),
+		task.Func(func() { log = append(log, tick) }),
+	)
+	for tick = 1; !s.Done(); tick++ {
+		s.Update()
+	}
+	if want := []int{1, 4, 4, 6}; !slices.Equal(log, want) {
+		t.Errorf("got: %v, want: %v", log, want)
+	}
+}
+
+func TestCoroutine(t *testing.T) {
+	var log []int
+	var tick int
+	c := task.NewCoroutine(func(co *task.Co) {
+		log = append(log, tick)
+		co.Wait(3)
+		log = append(log, tick)
+		co.Await(task.Wait(2))
+		log = append(log, tick)
+		co.WaitUntil(func() bool { return tick >= 10 })
+		log = append(log, tick)
+	})
+	for tick = 1; !c.Done(); tick++ {
+		c.Update()
+	}
+	if want := []int{1, 4, 6, 10}; !slices.Equal(log, want) {
+		t.Errorf("got: %v, want: %v", log, want)
+	}
+}
+
+func TestCoroutineStop(t *testing.T) {
+	var deferred bool
+	c := task.NewCoroutine(func(co *task.Co) {
+		defer func() {
+			deferred = true
+		}()
+		for {
+			co.Yield()
+		}
+	})
+	c.Update()
+	c.Update()
+	c.Stop()
+	if !c.Done() {
+		t.Errorf("c.Done(): got: false, want: true")
+	}
+	if !deferred {
+		t.Errorf("deferred: got: false, want: true")
+	}
+}
+
+func TestScheduler(t *testing.T) {
+	var s task.Scheduler
+	var count int
+	s.Add(task.NewCoroutine(func(co *task.Co) {
+		s.Add(task.Func(func() { count++ }))
+		co.Wait(2)
+	}))
+	s.Add(task.Wait(1))
+	if got, want := s.Len(), 2; got != want {
+		t.Errorf("s.Len(): got: %d, want: %d", got, want)
+	}
+	s.Update()
+	if got, want := s.Len(), 2; got != want {
+		t.Errorf("s.Len(): got: %d, want: %d", got, want)
+	}
+	s.Update()
+	if got, want := count, 1; got != want {
+		t.Errorf("count: got: %d, want: %d", got, want)
+	}
+	s.Update()
+	if got, want := s.Len(), 0; got != want {
+		t.Errorf("s.Len(): got: %d, want: %d", got, want)
+	}
+}
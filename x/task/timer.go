@@ -0,0 +1,70 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+// Timer is a task that is done after the given ticks.
+type Timer struct {
+	ticks   int
+	elapsed int
+}
+
+// NewTimer creates a new timer that is done after the given ticks.
+// If ticks is 0 or less, the timer is done from the beginning.
+func NewTimer(ticks int) *Timer {
+	return &Timer{
+		ticks: ticks,
+	}
+}
+
+// Wait is an alias of NewTimer, which is useful in Sequence.
+func Wait(ticks int) Task {
+	return NewTimer(ticks)
+}
+
+// Update implements Task.Update.
+func (t *Timer) Update() {
+	if t.Done() {
+		return
+	}
+	t.elapsed++
+}
+
+// Done implements Task.Done.
+func (t *Timer) Done() bool {
+	return t.elapsed >= t.ticks
+}
+
+// Elapsed returns the elapsed ticks.
+func (t *Timer) Elapsed() int {
+	return t.elapsed
+}
+
+// Remaining returns the remaining ticks.
+func (t *Timer) Remaining() int {
+	return max(t.ticks-t.elapsed, 0)
+}
+
+// Progress returns the progress of the timer in [0, 1].
+func (t *Timer) Progress() float64 {
+	if t.ticks <= 0 {
+		return 1
+	}
+	return float64(t.elapsed) / float64(t.ticks)
+}
+
+// Reset resets the elapsed ticks.
+func (t *Timer) Reset() {
+	t.elapsed = 0
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+// Tween is a task that interpolates a value from From to To over the given ticks.
+type Tween struct {
+	// From is the value at the beginning.
+	From float64
+
+	// To is the value at the end.
+	To float64
+
+	// Ticks is the duration in ticks.
+	Ticks int
+
+	// Ease is an easing function.
+	// If Ease is nil, Linear is used.
+	Ease EaseFunc
+
+	// OnUpdate is called with the current value at every Update, if OnUpdate is not nil.
+	OnUpdate func(value float64)
+
+	elapsed int
+}
+
+// NewTween creates a new tween.
+func NewTween(from, to float64, ticks int, ease EaseFunc) *Tween {
+	return &Tween{
+		From:  from,
+		To:    to,
+		Ticks: ticks,
+		Ease:  ease,
+	}
+}
+
+// Update implements Task.Update.
+func (t *Tween) Update() {
+	if t.Done() {
+		return
+	}
+	t.elapsed++
+	if t.OnUpdate != nil {
+		t.OnUpdate(t.Value())
+	}
+}
+
+// Done implements Task.Done.
+func (t *Tween) Done() bool {
+	return t.elapsed >= t.Ticks
+}
+
+// Value returns the current value.
+func (t *Tween) Value() float64 {
+	if t.Ticks <= 0 || t.elapsed >= t.Ticks {
+		return t.To
+	}
+	ease := t.Ease
+	if ease == nil {
+		ease = Linear
+	}
+	r := ease(float64(t.elapsed) / float64(t.Ticks))
+	return t.From + (t.To-t.From)*r
+}
+
+// Reset resets the elapsed ticks.
+func (t *Tween) Reset() {
+	t.elapsed = 0
+}
@@ -0,0 +1,413 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package video provides a video player that decodes a video into an Ebitengine image frame by frame.
+//
+// The video format must be MPEG-1 (video) and MP2 (audio), which is decoded in pure Go.
+// You can convert a video to this format with the below command:
+//
+//	ffmpeg -i YOUR_VIDEO -c:v mpeg1video -q:v 8 -c:a mp2 -format mpeg -ar 48000 output.mpg
+//
+// If the video has an audio stream, the video frames are synchronized with the audio played via the audio package.
+// Otherwise, the video frames are synchronized with the wall clock.
+//
+// This package is useful e.g. for cutscenes and attract screens.
+package video
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/gen2brain/mpeg"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/audio"
+)
+
+// yCbCrShaderSrc is a shader to convert YCbCr to RGB.
+var yCbCrShaderSrc = []byte(`//kage:unit pixels
+
+package main
+
+func Fragment(dstPos vec4, srcPos vec2, color vec4) vec4 {
+	// For this calculation, see the comment in the standard library color.YCbCrToRGB function.
+	c := imageSrc0UnsafeAt(srcPos)
+	return vec4(
+		c.x + 1.40200 * (c.z-0.5),
+		c.x - 0.34414 * (c.y-0.5) - 0.71414 * (c.z-0.5),
+		c.x + 1.77200 * (c.y-0.5),
+		1,
+	)
+}
+`)
+
+var (
+	yCbCrShader     *ebiten.Shader
+	yCbCrShaderErr  error
+	yCbCrShaderOnce sync.Once
+)
+
+// Player is a video player.
+type Player struct {
+	mpg *mpeg.MPEG
+
+	// yCbCrImage is the current frame image in YCbCr format.
+	// An MPEG frame is stored in this image first.
+	// Then, this image data is converted to RGB to frameImage.
+	yCbCrImage *ebiten.Image
+
+	// yCbCrBytes is the byte slice to store YCbCr data.
+	// This includes Y, Cb, Cr, and alpha (unused) data for each pixel.
+	yCbCrBytes []byte
+
+	// frameImage is the current frame image in RGB format.
+	frameImage *ebiten.Image
+
+	audioPlayer *audio.Player
+
+	// These members are used when the video doesn't have an audio stream.
+	refTime time.Time
+	elapsed time.Duration
+
+	playing bool
+	closed  bool
+
+	src io.ReadCloser
+
+	m sync.Mutex
+}
+
+// NewPlayer creates a new video player from the given MPEG-1 stream.
+//
+// If the video has an audio stream, an audio context must be initialized before NewPlayer is called,
+// and its sample rate must match with the video's audio sample rate.
+// The audio stream must be stereo.
+//
+// The returned player is paused. Call Play to start playing.
+func NewPlayer(src io.ReadCloser) (*Player, error) {
+	mpg, err := mpeg.New(src)
+	if err != nil {
+		return nil, err
+	}
+	if mpg.NumVideoStreams() == 0 {
+		return nil, errors.New("video: no video streams")
+	}
+	if !mpg.HasHeaders() {
+		return nil, errors.New("video: missing headers")
+	}
+
+	yCbCrShaderOnce.Do(func() {
+		yCbCrShader, yCbCrShaderErr = ebiten.NewShader(yCbCrShaderSrc)
+	})
+	if yCbCrShaderErr != nil {
+		return nil, yCbCrShaderErr
+	}
+
+	w, h := mpg.Width(), mpg.Height()
+	p := &Player{
+		mpg:        mpg,
+		yCbCrImage: ebiten.NewImage(w, h),
+		yCbCrBytes: make([]byte, 4*w*h),
+		frameImage: ebiten.NewImage(w, h),
+		src:        src,
+	}
+
+	// If the video doesn't have an audio stream, initialization is done.
+	if mpg.NumAudioStreams() == 0 {
+		return p, nil
+	}
+
+	// If the video has an audio stream, initialize an audio player.
+	ctx := audio.CurrentContext()
+	if ctx == nil {
+		return nil, errors.New("video: audio.Context is not initialized")
+	}
+	if mpg.Channels() != 2 {
+		return nil, fmt.Errorf("video: the number of the audio channels must be 2 but was %d", mpg.Channels())
+	}
+	if ctx.SampleRate() != mpg.Samplerate() {
+		return nil, fmt.Errorf("video: the audio sample rate %d doesn't match with the audio context sample rate %d", mpg.Samplerate(), ctx.SampleRate())
+	}
+
+	mpg.SetAudioFormat(mpeg.AudioF32N)
+
+	audioPlayer, err := ctx.NewPlayerF32(&mpegAudio{
+		audio: mpg.Audio(),
+		m:     &p.m,
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.audioPlayer = audioPlayer
+
+	return p, nil
+}
+
+// Frame returns the current frame image.
+//
+// The returned image is updated by Update. Do not modify or dispose the returned image.
+func (p *Player) Frame() *ebiten.Image {
+	return p.frameImage
+}
+
+// Position returns the current playing position.
+func (p *Player) Position() time.Duration {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.position()
+}
+
+func (p *Player) position() time.Duration {
+	if p.audioPlayer != nil {
+		return p.audioPlayer.Position()
+	}
+	if p.playing {
+		return p.elapsed + time.Since(p.refTime)
+	}
+	return p.elapsed
+}
+
+// Duration returns the duration of the video.
+func (p *Player) Duration() time.Duration {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.mpg.Duration()
+}
+
+// IsPlaying reports whether the video is being played.
+func (p *Player) IsPlaying() bool {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.playing && !p.mpg.HasEnded()
+}
+
+// IsEnded reports whether the video has ended.
+func (p *Player) IsEnded() bool {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.mpg.Video().HasEnded()
+}
+
+// Play starts or resumes playing the video.
+//
+// If the video has ended or the player is closed, Play does nothing.
+func (p *Player) Play() {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.closed || p.mpg.HasEnded() || p.playing {
+		return
+	}
+	p.playing = true
+
+	if p.audioPlayer != nil {
+		// Play refers (*mpegAudio).Read function, where the same mutex is used.
+		// In order to avoid dead lock, use a different goroutine to start playing.
+		// This issue happens especially on Windows where goroutines at Play are avoided in Oto (#1768).
+		// TODO: Remove this hack in the future (ebitengine/oto#235).
+		go p.audioPlayer.Play()
+		return
+	}
+	p.refTime = time.Now()
+}
+
+// Pause pauses playing the video.
+func (p *Player) Pause() {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if !p.playing {
+		return
+	}
+	p.playing = false
+
+	if p.audioPlayer != nil {
+		// For the same reason as Play, use a different goroutine.
+		go p.audioPlayer.Pause()
+		return
+	}
+	p.elapsed += time.Since(p.refTime)
+}
+
+// Update decodes the video frames up to the current position and updates the frame image.
+//
+// Update should be called every tick, e.g. in the game's Update.
+func (p *Player) Update() error {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.closed {
+		return nil
+	}
+
+	video := p.mpg.Video()
+	if video.HasEnded() {
+		p.playing = false
+		return nil
+	}
+
+	mpegFrame := decodeFramesUntil(video, p.position().Seconds(), 1/p.mpg.Framerate())
+	if mpegFrame == nil {
+		return nil
+	}
+
+	img := mpegFrame.YCbCr()
+	if img.SubsampleRatio != image.YCbCrSubsampleRatio420 {
+		return errors.New("video: subsample ratio must be 4:2:0")
+	}
+	w, h := p.mpg.Width(), p.mpg.Height()
+	for j := 0; j < h; j++ {
+		yi := j * img.YStride
+		ci := (j / 2) * img.CStride
+		// Create temporary slices to encourage BCE (boundary-checking elimination).
+		ys := img.Y[yi : yi+w]
+		cbs := img.Cb[ci : ci+w/2]
+		crs := img.Cr[ci : ci+w/2]
+		for i := 0; i < w; i++ {
+			idx := 4 * (j*w + i)
+			buf := p.yCbCrBytes[idx : idx+3]
+			buf[0] = ys[i]
+			buf[1] = cbs[i/2]
+			buf[2] = crs[i/2]
+			// p.yCbCrBytes[3] = 0xff is not needed as the shader ignores this part.
+		}
+	}
+
+	p.yCbCrImage.WritePixels(p.yCbCrBytes)
+
+	// Converting YCbCr to RGB on CPU is slow. Use a shader instead.
+	op := &ebiten.DrawRectShaderOptions{}
+	op.Images[0] = p.yCbCrImage
+	op.Blend = ebiten.BlendCopy
+	p.frameImage.DrawRectShader(w, h, yCbCrShader, op)
+
+	return nil
+}
+
+// Close stops the video and closes the source stream.
+//
+// After Close is called, the player and its frame image are no longer available.
+func (p *Player) Close() error {
+	p.m.Lock()
+	if p.closed {
+		p.m.Unlock()
+		return nil
+	}
+	p.closed = true
+	p.playing = false
+	audioPlayer := p.audioPlayer
+	p.m.Unlock()
+
+	// Close the audio player without the lock, as the audio player's Read uses the same mutex.
+	var errs []error
+	if audioPlayer != nil {
+		if err := audioPlayer.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.yCbCrImage.Dispose()
+	p.frameImage.Dispose()
+	if err := p.src.Close(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// frameDecoder is an interface to decode video frames. *mpeg.Video implements this.
+type frameDecoder interface {
+	Time() float64
+	HasEnded() bool
+	Decode() *mpeg.Frame
+}
+
+// decodeFramesUntil decodes the video frames until the frame at pos in seconds, and returns the last decoded frame.
+//
+// frameDuration is the duration of one frame in seconds.
+// If no frames need to be decoded, decodeFramesUntil returns nil.
+func decodeFramesUntil(video frameDecoder, pos float64, frameDuration float64) *mpeg.Frame {
+	var mpegFrame *mpeg.Frame
+	for video.Time()+frameDuration <= pos && !video.HasEnded() {
+		mpegFrame = video.Decode()
+	}
+	return mpegFrame
+}
+
+// sampleDecoder is an interface to decode audio samples. *mpeg.Audio implements this.
+type sampleDecoder interface {
+	HasEnded() bool
+	Decode() *mpeg.Samples
+}
+
+type mpegAudio struct {
+	audio sampleDecoder
+
+	// leftovers is the remaining audio samples of the previous Read call.
+	leftovers []byte
+
+	// m is the mutex shared with the Player.
+	// As *mpeg.MPEG is not concurrent safe, this mutex is necessary.
+	m *sync.Mutex
+}
+
+func (a *mpegAudio) Read(buf []byte) (int, error) {
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	var readBytes int
+	if len(a.leftovers) > 0 {
+		n := copy(buf, a.leftovers)
+		readBytes += n
+		buf = buf[n:]
+
+		copy(a.leftovers, a.leftovers[n:])
+		a.leftovers = a.leftovers[:len(a.leftovers)-n]
+	}
+
+	for len(buf) > 0 && !a.audio.HasEnded() {
+		mpegSamples := a.audio.Decode()
+		if mpegSamples == nil {
+			break
+		}
+
+		bs := make([]byte, len(mpegSamples.Interleaved)*4)
+		for i, s := range mpegSamples.Interleaved {
+			v := math.Float32bits(s)
+			bs[4*i] = byte(v)
+			bs[4*i+1] = byte(v >> 8)
+			bs[4*i+2] = byte(v >> 16)
+			bs[4*i+3] = byte(v >> 24)
+		}
+
+		n := copy(buf, bs)
+		readBytes += n
+		buf = buf[n:]
+
+		if n < len(bs) {
+			a.leftovers = append(a.leftovers, bs[n:]...)
+			break
+		}
+	}
+
+	if a.audio.HasEnded() && len(a.leftovers) == 0 {
+		return readBytes, io.EOF
+	}
+	return readBytes, nil
+}
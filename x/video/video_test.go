@@ -0,0 +1,148 @@
+// Copyright 2026 The Ebitengine Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package video_test
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/gen2brain/mpeg"
+
+	"github.com/hajimehoshi/ebiten/v2/x/video"
+)
+
+type testFrameDecoder struct {
+	time          float64
+	frameDuration float64
+	decoded       int
+	numFrames     int
+}
+
+func (d *testFrameDecoder) Time() float64 {
+	return d.time
+}
+
+func (d *testFrameDecoder) HasEnded() bool {
+	return d.decoded >= d.numFrames
+}
+
+func (d *testFrameDecoder) Decode() *mpeg.Frame {
+	if d.HasEnded() {
+		return nil
+	}
+	f := &mpeg.Frame{
+		Time: float64(d.decoded) * d.frameDuration,
+	}
+	d.time = f.Time
+	d.decoded++
+	return f
+}
+
+func TestDecodeFramesUntil(t *testing.T) {
+	const frameDuration = 1.0 / 30
+	d := &testFrameDecoder{
+		frameDuration: frameDuration,
+		numFrames:     10,
+	}
+
+	if got := video.DecodeFramesUntil(d, 0, frameDuration); got != nil {
+		t.Errorf("DecodeFramesUntil at 0: got: frame at %f, want: nil", got.Time)
+	}
+
+	// The frame at the current position should be decoded, and the frames before it should be skipped.
+	got := video.DecodeFramesUntil(d, 3.5*frameDuration, frameDuration)
+	if got == nil {
+		t.Fatalf("DecodeFramesUntil at 3.5 frames: got: nil, want: non-nil")
+	}
+	if want := 3 * frameDuration; got.Time != want {
+		t.Errorf("DecodeFramesUntil at 3.5 frames: got: frame at %f, want: frame at %f", got.Time, want)
+	}
+
+	// The position doesn't reach the next frame yet.
+	if got := video.DecodeFramesUntil(d, 3.9*frameDuration, frameDuration); got != nil {
+		t.Errorf("DecodeFramesUntil at 3.9 frames: got: frame at %f, want: nil", got.Time)
+	}
+
+	// The position is beyond the end.
+	got = video.DecodeFramesUntil(d, 100*frameDuration, frameDuration)
+	if got == nil {
+		t.Fatalf("DecodeFramesUntil at 100 frames: got: nil, want: non-nil")
+	}
+	if want := 9 * frameDuration; got.Time != want {
+		t.Errorf("DecodeFramesUntil at 100 frames: got: frame at %f, want: frame at %f", got.Time, want)
+	}
+	if !d.HasEnded() {
+		t.Errorf("HasEnded: got: false, want: true")
+	}
+}
+
+type testSampleDecoder struct {
+	samples [][]float32
+}
+
+func (d *testSampleDecoder) HasEnded() bool {
+	return len(d.samples) == 0
+}
+
+func (d *testSampleDecoder) Decode() *mpeg.Samples {
+	if d.HasEnded() {
+		return nil
+	}
+	s := &mpeg.Samples{
+		Interleaved: d.samples[0],
+	}
+	d.samples = d.samples[1:]
+	return s
+}
+
+func TestMPEGAudioRead(t *testing.T) {
+	var want []float32
+	var samples [][]float32
+	for i := 0; i < 4; i++ {
+		s := make([]float32, 6)
+		for j := range s {
+			s[j] = float32(i*len(s)+j) / 32
+		}
+		samples = append(samples, s)
+		want = append(want, s...)
+	}
+	r := video.NewMPEGAudio(&testSampleDecoder{samples: samples})
+
+	// Use a buffer size that doesn't match with the decoded sample size to test the leftovers.
+	var bs []byte
+	buf := make([]byte, 20)
+	for {
+		n, err := r.Read(buf)
+		bs = append(bs, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got, want := len(bs), 4*len(want); got != want {
+		t.Fatalf("len(bs): got: %d, want: %d", got, want)
+	}
+	for i := range want {
+		got := math.Float32frombits(binary.LittleEndian.Uint32(bs[4*i:]))
+		if got != want[i] {
+			t.Errorf("sample %d: got: %f, want: %f", i, got, want[i])
+		}
+	}
+}